@@ -0,0 +1,235 @@
+package xsqlparser
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// Completion is the result of Complete.
+type Completion struct {
+	// Prefix is the word being typed before the cursor, which is excluded from parsing.
+	Prefix string
+	// Keywords are keywords valid at the cursor which start with Prefix (case-insensitive), in alphabetical order.
+	Keywords []string
+	// Tokens are kinds of tokens valid at the cursor, i.e. sqltoken.SQLKeyword for an identifier
+	// and sqltoken.LParen for a parenthesis. They are not filtered by Prefix.
+	Tokens []sqltoken.Kind
+	// Stmts are the statements before the statement at the cursor.
+	Stmts []sqlast.Stmt
+	// Stmt is the statement at the cursor, or nil if it can't be completed.
+	// A missing identifier or expression is an *sqlast.Ident with empty Value at the cursor,
+	// and unclosed parentheses are closed at the cursor.
+	Stmt sqlast.Stmt
+}
+
+// maxRepairs is the number of tokens Complete adds to the statement at the cursor.
+const maxRepairs = 8
+
+// Complete parses src up to cursor, a byte offset of src, for editor completion.
+// It returns the keywords and tokens which the parser tries at the cursor and the best-effort AST.
+// Candidates are best-effort: they may lack tokens which the parser checks without a keyword or a kind,
+// and are empty if the cursor is in a string, a quoted identifier or a comment.
+// It is an error if the source before the cursor has a syntax error.
+func Complete(src string, cursor int, dialect dialect.Dialect) (*Completion, error) {
+	if cursor < 0 || cursor > len(src) {
+		return nil, errors.Errorf("cursor %d is out of the source", cursor)
+	}
+	before := src[:cursor]
+	prefix := before[len(strings.TrimRightFunc(before, isWordRune)):]
+	if prefix != "" && unicode.IsDigit(rune(prefix[0])) {
+		prefix = ""
+	}
+	c := &Completion{Prefix: prefix}
+
+	parser, err := NewParser(strings.NewReader(before[:len(before)-len(prefix)]), dialect)
+	if err != nil {
+		var uerr *sqltoken.UnterminatedError
+		if errors.As(err, &uerr) {
+			return c, nil
+		}
+		return nil, errors.Errorf("NewParser failed: %w", err)
+	}
+	pos := parser.tokenizer.Pos()
+
+	for {
+		for ok, _ := parser.consumeToken(sqltoken.Semicolon); ok; ok, _ = parser.consumeToken(sqltoken.Semicolon) {
+		}
+		start := parser.index
+		parser.candidates = &candidates{}
+		stmt, err := parser.tryParseStatement()
+
+		if _, perr := parser.peekToken(); perr != EOF {
+			if err != nil {
+				return nil, errors.Errorf("ParseStatement failed: %w", err)
+			}
+			c.Stmts = append(c.Stmts, stmt)
+			// inline data of COPY follows the delimiter
+			if s, ok := stmt.(*sqlast.CopyStmt); ok && s.Data != nil {
+				continue
+			}
+			if ok, _ := parser.consumeToken(sqltoken.Semicolon); !ok {
+				tok, _ := parser.peekToken()
+				return nil, errors.Errorf("expect semicolon but %+v", tok)
+			}
+			continue
+		}
+
+		if err == nil {
+			parser.candidates.addTokens(sqltoken.Semicolon)
+		}
+		c.Keywords = parser.candidates.keywordsWithPrefix(prefix)
+		c.Tokens = parser.candidates.tokens
+
+		if err == nil {
+			c.Stmt = stmt
+		} else {
+			c.Stmt = parser.repair(start, pos)
+		}
+		return c, nil
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// repair adds an empty identifier for a missing identifier or expression, or a right parenthesis
+// for an unclosed one, at pos until the statement from start is parsed.
+func (p *Parser) repair(start uint, pos sqltoken.Pos) sqlast.Stmt {
+	for i := 0; i < maxRepairs; i++ {
+		tok := &sqltoken.Token{From: pos, To: pos}
+		switch {
+		case p.candidates.has(sqltoken.SQLKeyword):
+			tok.Kind = sqltoken.SQLKeyword
+			tok.Value = &sqltoken.SQLWord{}
+		case p.unclosedParens(start) > 0:
+			tok.Kind = sqltoken.RParen
+			tok.Value = ")"
+		default:
+			return nil
+		}
+		p.tokens = append(p.tokens, tok)
+
+		p.index = start
+		p.candidates = &candidates{}
+		stmt, err := p.tryParseStatement()
+		if err == nil {
+			if _, err := p.peekToken(); err == EOF {
+				return stmt
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// tryParseStatement calls ParseStatement and returns an error if it panics,
+// which it may do when the tokens end in the middle of the statement.
+func (p *Parser) tryParseStatement() (stmt sqlast.Stmt, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("parse panicked: %v", r)
+		}
+	}()
+	return p.ParseStatement()
+}
+
+// unclosedParens returns the number of parentheses which are opened after start but not closed.
+func (p *Parser) unclosedParens(start uint) int {
+	var n int
+	for _, tok := range p.tokens[start:] {
+		switch tok.Kind {
+		case sqltoken.LParen:
+			n++
+		case sqltoken.RParen:
+			n--
+		}
+	}
+	return n
+}
+
+// candidates are keywords and kinds of tokens which the parser tries at the end of the tokens.
+// Methods of nil candidates do nothing.
+type candidates struct {
+	keywords []string
+	tokens   []sqltoken.Kind
+}
+
+func (c *candidates) addKeywords(keywords ...string) {
+	if c == nil {
+		return
+	}
+	for _, k := range keywords {
+		i := sort.SearchStrings(c.keywords, k)
+		if i < len(c.keywords) && c.keywords[i] == k {
+			continue
+		}
+		c.keywords = append(c.keywords, "")
+		copy(c.keywords[i+1:], c.keywords[i:])
+		c.keywords[i] = k
+	}
+}
+
+func (c *candidates) addTokens(kinds ...sqltoken.Kind) {
+	if c == nil {
+		return
+	}
+	for _, k := range kinds {
+		if c.has(k) {
+			continue
+		}
+		c.tokens = append(c.tokens, k)
+		sort.Slice(c.tokens, func(i, j int) bool { return c.tokens[i] < c.tokens[j] })
+	}
+}
+
+func (c *candidates) has(kind sqltoken.Kind) bool {
+	for _, k := range c.tokens {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *candidates) keywordsWithPrefix(prefix string) []string {
+	var keywords []string
+	for _, k := range c.keywords {
+		if strings.HasPrefix(k, strings.ToUpper(prefix)) {
+			keywords = append(keywords, k)
+		}
+	}
+	return keywords
+}
+
+// keywords at the beginning of statements
+var statementKeywords = []string{
+	"ALTER", "ANALYZE", "COMMENT", "COPY", "CREATE", "DEALLOCATE", "DELETE", "DESC", "DESCRIBE", "DROP", "EXECUTE",
+	"EXPLAIN", "INSERT", "KILL", "PREPARE", "REINDEX", "SELECT", "SET", "SHOW", "TABLE", "TRUNCATE", "UPDATE", "USE",
+	"VACUUM", "VALUES", "WITH",
+}
+
+// keywords and tokens at the beginning of expressions except identifiers and literals
+var (
+	prefixKeywords = []string{"CASE", "CAST", "EXISTS", "FALSE", "NOT", "NULL", "TRUE"}
+	prefixTokens   = []sqltoken.Kind{
+		sqltoken.SQLKeyword, sqltoken.Number, sqltoken.SingleQuotedString, sqltoken.Placeholder,
+		sqltoken.LParen, sqltoken.Plus, sqltoken.Minus,
+	}
+)
+
+// keywords and tokens of binary operators
+var (
+	infixKeywords = []string{"AND", "BETWEEN", "ILIKE", "IN", "IS", "LIKE", "NOT", "OR", "SIMILAR"}
+	infixTokens   = []sqltoken.Kind{
+		sqltoken.Eq, sqltoken.Neq, sqltoken.Lt, sqltoken.Gt, sqltoken.LtEq, sqltoken.GtEq,
+		sqltoken.Plus, sqltoken.Minus, sqltoken.Mult, sqltoken.Div, sqltoken.Mod,
+	}
+)
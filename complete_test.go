@@ -0,0 +1,155 @@
+package xsqlparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestComplete(t *testing.T) {
+	exprTokens := []sqltoken.Kind{
+		sqltoken.SQLKeyword, sqltoken.Number, sqltoken.SingleQuotedString,
+		sqltoken.Plus, sqltoken.Minus, sqltoken.LParen, sqltoken.Placeholder,
+	}
+
+	cases := []struct {
+		name     string
+		dialect  dialect.Dialect // GenericSQLDialect if nil
+		src      string          // | is the cursor
+		prefix   string
+		keywords []string
+		tokens   []sqltoken.Kind
+		stmts    int
+		stmt     string // empty if nil
+	}{
+		{
+			name:     "beginning of statement",
+			src:      "SEL|",
+			prefix:   "SEL",
+			keywords: []string{"SELECT"},
+		},
+		{
+			name:     "after FROM clause",
+			src:      "SELECT a FROM t W| ORDER BY a",
+			prefix:   "W",
+			keywords: []string{"WHERE", "WITH"},
+			tokens:   []sqltoken.Kind{sqltoken.Comma, sqltoken.LParen, sqltoken.Semicolon},
+			stmt:     "SELECT a FROM t",
+		},
+		{
+			name:     "expression",
+			src:      "SELECT a FROM t WHERE |",
+			keywords: prefixKeywords,
+			tokens:   exprTokens,
+			stmt:     "SELECT a FROM t WHERE ",
+		},
+		{
+			name:     "operator",
+			src:      "UPDATE t SET a = 1 WHERE b |",
+			keywords: []string{"AND", "BETWEEN", "ILIKE", "IN", "IS", "LIKE", "LIMIT", "NOT", "OR", "ORDER", "RETURNING", "SIMILAR"},
+			tokens: []sqltoken.Kind{
+				sqltoken.Eq, sqltoken.Neq, sqltoken.Lt, sqltoken.Gt, sqltoken.LtEq, sqltoken.GtEq,
+				sqltoken.Plus, sqltoken.Minus, sqltoken.Mult, sqltoken.Div, sqltoken.Mod, sqltoken.Semicolon,
+			},
+			stmt: "UPDATE t SET a = 1 WHERE b",
+		},
+		{
+			name:     "unclosed parentheses",
+			src:      "SELECT a FROM t WHERE b IN (1, |",
+			keywords: prefixKeywords,
+			tokens:   exprTokens,
+			stmt:     "SELECT a FROM t WHERE b IN (1, )",
+		},
+		{
+			name:     "after statements",
+			src:      "SELECT 1;\nINSERT INTO t |",
+			keywords: []string{"SELECT", "TABLE", "VALUES", "WITH"},
+			tokens:   []sqltoken.Kind{sqltoken.LParen},
+			stmts:    1,
+		},
+		{
+			name:     "mysql modify column",
+			dialect:  &dialect.MySQLDialect{},
+			src:      "ALTER TABLE t M|",
+			prefix:   "M",
+			keywords: []string{"MODIFY"},
+		},
+		{
+			name:    "postgres modify column",
+			dialect: &dialect.PostgresqlDialect{},
+			src:     "ALTER TABLE t M|",
+			prefix:  "M",
+		},
+		{
+			name:   "in string",
+			src:    "SELECT 'ab|c'",
+			prefix: "ab",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cursor := strings.Index(c.src, "|")
+			src := strings.Replace(c.src, "|", "", 1)
+
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			act, err := Complete(src, cursor, d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act.Prefix != c.prefix {
+				t.Errorf("prefix must be %q but %q", c.prefix, act.Prefix)
+			}
+			if diff := cmp.Diff(c.keywords, act.Keywords); diff != "" {
+				t.Errorf("diff of keywords %s", diff)
+			}
+			if diff := cmp.Diff(c.tokens, act.Tokens); diff != "" {
+				t.Errorf("diff of tokens %s", diff)
+			}
+			if len(act.Stmts) != c.stmts {
+				t.Errorf("must be %d statements but %d", c.stmts, len(act.Stmts))
+			}
+			var stmt string
+			if act.Stmt != nil {
+				stmt = act.Stmt.ToSQLString()
+			}
+			if stmt != c.stmt {
+				t.Errorf("statement must be %q but %q", c.stmt, stmt)
+			}
+		})
+	}
+
+	t.Run("hole", func(t *testing.T) {
+		act, err := Complete("SELECT a\nFROM t WHERE a = ", 25, &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		where := act.Stmt.(*sqlast.QueryStmt).Body.(*sqlast.SQLSelect).WhereClause.(*sqlast.BinaryExpr)
+		ident, ok := where.Right.(*sqlast.Ident)
+		if !ok || ident.Value != "" {
+			t.Fatalf("must be an empty identifier but %#v", where.Right)
+		}
+		if exp := (sqltoken.Pos{Line: 2, Col: 17, Offset: 25}); ident.Pos() != exp {
+			t.Errorf("must be at %+v but %+v", exp, ident.Pos())
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, src := range []string{"SELECT a b c FROM; SELECT ", "SELECT 1 SELECT "} {
+			if _, err := Complete(src, len(src), &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%q must be an error", src)
+			}
+		}
+		if _, err := Complete("SELECT", 7, &dialect.GenericSQLDialect{}); err == nil {
+			t.Errorf("cursor out of the source must be an error")
+		}
+	})
+}
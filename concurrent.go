@@ -0,0 +1,71 @@
+package xsqlparser
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// ParseResult is the result of parsing a statement by ParseConcurrently.
+type ParseResult struct {
+	Stmt sqlast.Stmt
+	Err  error
+}
+
+// ParseConcurrently parses each of statements with a pool of workers goroutines.
+// Results are returned in the same order as statements. A panic while parsing
+// a statement is reported as its Err, and statements which are not parsed
+// before ctx is done have ctx.Err() as Err.
+func ParseConcurrently(ctx context.Context, statements []string, d dialect.Dialect, workers int) []ParseResult {
+	if workers < 1 {
+		workers = 1
+	}
+	results := make([]ParseResult, len(statements))
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indices {
+				if err := ctx.Err(); err != nil {
+					results[idx].Err = err
+					continue
+				}
+				results[idx] = parseStatementString(statements[idx], d)
+			}
+		}()
+	}
+
+	for i := range statements {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return results
+}
+
+func parseStatementString(str string, d dialect.Dialect) (result ParseResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = ParseResult{Err: errors.Errorf("parse panicked: %v", r)}
+		}
+	}()
+
+	parser, err := NewParser(strings.NewReader(str), d)
+	if err != nil {
+		return ParseResult{Err: errors.Errorf("NewParser failed: %w", err)}
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		return ParseResult{Err: errors.Errorf("ParseStatement failed: %w", err)}
+	}
+	return ParseResult{Stmt: stmt}
+}
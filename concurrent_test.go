@@ -0,0 +1,61 @@
+package xsqlparser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// panicDialect panics when an identifier starts with '!'.
+type panicDialect struct {
+	dialect.GenericSQLDialect
+}
+
+func (d *panicDialect) IsIdentifierStart(r rune) bool {
+	if r == '!' {
+		panic("identifier starts with '!'")
+	}
+	return d.GenericSQLDialect.IsIdentifierStart(r)
+}
+
+func TestParseConcurrently(t *testing.T) {
+	t.Run("preserve order", func(t *testing.T) {
+		var stmts []string
+		for i := 0; i < 100; i++ {
+			stmts = append(stmts, fmt.Sprintf("SELECT c%d FROM t", i))
+		}
+		stmts = append(stmts, "SELECT !a FROM t", "UNKNOWN a")
+
+		results := ParseConcurrently(context.Background(), stmts, &panicDialect{}, 4)
+		if len(results) != len(stmts) {
+			t.Fatalf("must be %d results but %d", len(stmts), len(results))
+		}
+		for i := 0; i < 100; i++ {
+			if results[i].Err != nil {
+				t.Fatalf("%+v", results[i].Err)
+			}
+			if act := results[i].Stmt.ToSQLString(); act != stmts[i] {
+				t.Errorf("must be %s but %s", stmts[i], act)
+			}
+		}
+		if err := results[100].Err; err == nil || !strings.Contains(err.Error(), "panicked") {
+			t.Errorf("panic must be reported as error but %v", err)
+		}
+		if results[101].Err == nil {
+			t.Error("must be error")
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		results := ParseConcurrently(ctx, []string{"SELECT a FROM t"}, &dialect.GenericSQLDialect{}, 1)
+		if !errors.Is(results[0].Err, context.Canceled) {
+			t.Errorf("must be context.Canceled but %v", results[0].Err)
+		}
+	})
+}
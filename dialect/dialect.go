@@ -1,9 +1,99 @@
 package dialect
 
+import "strings"
+
 type Dialect interface {
 	IsIdentifierStart(r rune) bool
 	IsIdentifierPart(r rune) bool
 	IsDelimitedIdentifierStart(r rune) bool
+	// IsReservedForTableAlias reports whether keyword can't be used as a table alias without AS.
+	IsReservedForTableAlias(keyword string) bool
+	// IsReservedForColumnAlias reports whether keyword can't be used as a column alias without AS.
+	IsReservedForColumnAlias(keyword string) bool
+	// QuoteIdentifier returns ident enclosed with the dialect's delimiter.
+	QuoteIdentifier(ident string) string
+	// UnescapeString returns the character escaped by backslash in single quoted strings.
+	// ok is false when backslash isn't an escape character in the dialect.
+	UnescapeString(r rune) (s string, ok bool)
+}
+
+// Feature represents a syntax which is accepted only by some dialects.
+type Feature int
+
+const (
+	// QUALIFY clause of SELECT (Snowflake, BigQuery)
+	Qualify Feature = iota
+	// numbered placeholder i.e: $1 (PostgreSQL)
+	DollarPlaceholder
+	// named placeholder i.e: :name
+	ColonPlaceholder
+	// named placeholder i.e: @name (MSSQL)
+	AtPlaceholder
+	// dollar quoted string i.e: $$string$$, $tag$string$tag$ (PostgreSQL)
+	DollarQuotedString
+	// TOP clause of SELECT (MSSQL)
+	Top
+	// CREATE { TABLE | SCHEMA | SEQUENCE } IF NOT EXISTS
+	CreateIfNotExists
+	// DROP { TABLE | SCHEMA | SEQUENCE | TRIGGER } IF EXISTS
+	DropIfExists
+	// CREATE OR REPLACE FUNCTION (PostgreSQL)
+	CreateOrReplace
+	// CHECK (...) [ NOT ] ENFORCED (MySQL 8.0.16+, PostgreSQL 18+)
+	EnforcedCheckConstraint
+	// UNIQUE NULLS [ NOT ] DISTINCT (PostgreSQL 15+)
+	UniqueNullsDistinct
+	// ORDER BY and LIMIT clauses of UPDATE and DELETE (MySQL)
+	ModifyOrderByLimit
+	// display width and ZEROFILL attribute of numeric types i.e: int(11) unsigned zerofill (MySQL)
+	DisplayWidth
+	// MODIFY COLUMN of ALTER TABLE to change a column definition (MySQL)
+	ModifyColumn
+	// DROP INDEX index_name ON table_name (MySQL)
+	DropIndexOnTable
+	// # line comment (MySQL)
+	HashComment
+	// nested block comment i.e: /* outer /* inner */ outer */ (PostgreSQL, MSSQL)
+	NestedComment
+	// optimizer hint comment after SELECT, UPDATE and DELETE i.e: /*+ MAX_EXECUTION_TIME(1000) */ (MySQL)
+	OptimizerHint
+	// table hint after table name i.e: WITH (NOLOCK) (MSSQL)
+	TableHint
+	// index hint after table name i.e: USE INDEX (idx), FORCE INDEX FOR JOIN (idx) (MySQL)
+	IndexHint
+	// DROP PRIMARY KEY of ALTER TABLE (MySQL)
+	DropPrimaryKey
+)
+
+// FeatureDialect is implemented by dialects which accept optional syntax.
+type FeatureDialect interface {
+	Supports(f Feature) bool
+}
+
+// keywords which are reserved only when the dialect supports the syntax
+var featureKeywords = map[string]Feature{
+	QUALIFY: Qualify,
+	TOP:     Top,
+}
+
+func isReserved(d Dialect, reserved map[string]struct{}, keyword string) bool {
+	if _, ok := reserved[keyword]; !ok {
+		return false
+	}
+	if f, ok := featureKeywords[keyword]; ok {
+		return Supports(d, f)
+	}
+	return true
+}
+
+// Supports reports whether d accepts the syntax f.
+// Dialects which don't implement FeatureDialect accept no optional syntax.
+func Supports(d Dialect, f Feature) bool {
+	fd, ok := d.(FeatureDialect)
+	if !ok {
+		return false
+	}
+	return fd.Supports(f)
 }
 
 type GenericSQLDialect struct {
@@ -21,4 +111,31 @@ func (*GenericSQLDialect) IsDelimitedIdentifierStart(r rune) bool {
 	return r == '"'
 }
 
+func (d *GenericSQLDialect) IsReservedForTableAlias(keyword string) bool {
+	return isReserved(d, ReservedForTableAlias, keyword)
+}
+
+func (d *GenericSQLDialect) IsReservedForColumnAlias(keyword string) bool {
+	return isReserved(d, ReservedForColumnAlias, keyword)
+}
+
+func (*GenericSQLDialect) QuoteIdentifier(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+func (*GenericSQLDialect) UnescapeString(r rune) (string, bool) {
+	return "", false
+}
+
+// GenericSQLDialect accepts all optional syntax except # comments, which conflict with the #> and #>> operators,
+// and TOP, which would reserve the word for column names and aliases.
+func (*GenericSQLDialect) Supports(f Feature) bool {
+	switch f {
+	case HashComment, Top:
+		return false
+	}
+	return true
+}
+
 var _ Dialect = &GenericSQLDialect{}
+var _ FeatureDialect = &GenericSQLDialect{}
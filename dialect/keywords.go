@@ -1,13 +1,50 @@
 package dialect
 
 var Keywords map[string]struct{}
-var ReservedForTableAlias map[string]struct{}
-var ReservedForColumnAlias map[string]struct{}
+
+// ReservedForTableAlias is the keywords which can't be a table alias without AS in GenericSQLDialect.
+// The other dialects derive their own lists from it.
+var ReservedForTableAlias = keywordSet(
+	WITH, SELECT, WHERE, GROUP, ORDER, UNION, EXCEPT, INTERSECT,
+	ON, JOIN, INNER, CROSS, FULL, LEFT, RIGHT, NATURAL, USING,
+	QUALIFY, LIMIT, OFFSET, FETCH, RETURNING, FOR, CREATE, GRANT,
+)
+
+// ReservedForColumnAlias is the keywords which can't be a column alias without AS in GenericSQLDialect.
+var ReservedForColumnAlias = keywordSet(
+	WITH, SELECT, WHERE, GROUP, ORDER, UNION, EXCEPT, INTERSECT,
+	FROM, QUALIFY, TOP, LIMIT, OFFSET, FETCH, RETURNING, FOR, CREATE, GRANT,
+)
+
+func keywordSet(keywords ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(keywords))
+	for _, k := range keywords {
+		set[k] = struct{}{}
+	}
+	return set
+}
+
+// reservedWords returns a copy of base with add and without remove.
+func reservedWords(base map[string]struct{}, add []string, remove []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(base)+len(add))
+	for k := range base {
+		set[k] = struct{}{}
+	}
+	for _, k := range add {
+		set[k] = struct{}{}
+	}
+	for _, k := range remove {
+		delete(set, k)
+	}
+	return set
+}
 
 func init() {
 	Keywords = make(map[string]struct{})
 	Keywords[ABS] = struct{}{}
 	Keywords[ADD] = struct{}{}
+	Keywords[AFTER] = struct{}{}
+	Keywords[ANALYZE] = struct{}{}
 	Keywords[ASC] = struct{}{}
 	Keywords[ALL] = struct{}{}
 	Keywords[ALLOCATE] = struct{}{}
@@ -23,19 +60,23 @@ func init() {
 	Keywords[ASYMMETRIC] = struct{}{}
 	Keywords[AT] = struct{}{}
 	Keywords[ATOMIC] = struct{}{}
+	Keywords[ATTACH] = struct{}{}
 	Keywords[AUTHORIZATION] = struct{}{}
 	Keywords[AVG] = struct{}{}
+	Keywords[BEFORE] = struct{}{}
 	Keywords[BEGIN] = struct{}{}
 	Keywords[BEGIN_FRAME] = struct{}{}
 	Keywords[BEGIN_PARTITION] = struct{}{}
 	Keywords[BETWEEN] = struct{}{}
 	Keywords[BIGINT] = struct{}{}
+	Keywords[BIGSERIAL] = struct{}{}
 	Keywords[BINARY] = struct{}{}
 	Keywords[BLOB] = struct{}{}
 	Keywords[BOOLEAN] = struct{}{}
 	Keywords[BOTH] = struct{}{}
 	Keywords[BY] = struct{}{}
 	Keywords[BYTEA] = struct{}{}
+	Keywords[CACHE] = struct{}{}
 	Keywords[CALL] = struct{}{}
 	Keywords[CALLED] = struct{}{}
 	Keywords[CARDINALITY] = struct{}{}
@@ -44,6 +85,7 @@ func init() {
 	Keywords[CAST] = struct{}{}
 	Keywords[CEIL] = struct{}{}
 	Keywords[CEILING] = struct{}{}
+	Keywords[CHANGE] = struct{}{}
 	Keywords[CHR] = struct{}{}
 	Keywords[CHAR] = struct{}{}
 	Keywords[CHAR_LENGTH] = struct{}{}
@@ -56,9 +98,13 @@ func init() {
 	Keywords[COLLATE] = struct{}{}
 	Keywords[COLLECT] = struct{}{}
 	Keywords[COLUMN] = struct{}{}
+	Keywords[COMMENT] = struct{}{}
 	Keywords[COMMIT] = struct{}{}
+	Keywords[CONCURRENTLY] = struct{}{}
 	Keywords[CONDITION] = struct{}{}
+	Keywords[CONFLICT] = struct{}{}
 	Keywords[CONNECT] = struct{}{}
+	Keywords[CONNECTION] = struct{}{}
 	Keywords[CONSTRAINT] = struct{}{}
 	Keywords[CONTAINS] = struct{}{}
 	Keywords[CONVERT] = struct{}{}
@@ -88,6 +134,7 @@ func init() {
 	Keywords[CURSOR] = struct{}{}
 	Keywords[CYCLE] = struct{}{}
 	Keywords[DATE] = struct{}{}
+	Keywords[DATETIME] = struct{}{}
 	Keywords[DAY] = struct{}{}
 	Keywords[DEALLOCATE] = struct{}{}
 	Keywords[DEC] = struct{}{}
@@ -99,9 +146,12 @@ func init() {
 	Keywords[DEREF] = struct{}{}
 	Keywords[DESC] = struct{}{}
 	Keywords[DESCRIBE] = struct{}{}
+	Keywords[DETACH] = struct{}{}
 	Keywords[DETERMINISTIC] = struct{}{}
 	Keywords[DISCONNECT] = struct{}{}
 	Keywords[DISTINCT] = struct{}{}
+	Keywords[DO] = struct{}{}
+	Keywords[DOMAIN] = struct{}{}
 	Keywords[DOUBLE] = struct{}{}
 	Keywords[DROP] = struct{}{}
 	Keywords[DYNAMIC] = struct{}{}
@@ -111,29 +161,39 @@ func init() {
 	Keywords[END] = struct{}{}
 	Keywords[END_FRAME] = struct{}{}
 	Keywords[END_PARTITION] = struct{}{}
+	Keywords[ENUM] = struct{}{}
 	Keywords[EQUALS] = struct{}{}
+	Keywords[ERRORS] = struct{}{}
 	Keywords[ESCAPE] = struct{}{}
 	Keywords[EVERY] = struct{}{}
 	Keywords[EXCEPT] = struct{}{}
+	Keywords[EXCLUDING] = struct{}{}
 	Keywords[EXEC] = struct{}{}
 	Keywords[EXECUTE] = struct{}{}
 	Keywords[EXISTS] = struct{}{}
 	Keywords[EXP] = struct{}{}
+	Keywords[EXTENSION] = struct{}{}
 	Keywords[EXTERNAL] = struct{}{}
 	Keywords[EXTRACT] = struct{}{}
 	Keywords[FALSE] = struct{}{}
 	Keywords[FETCH] = struct{}{}
 	Keywords[FILTER] = struct{}{}
+	Keywords[FINALIZE] = struct{}{}
+	Keywords[FIRST] = struct{}{}
 	Keywords[FIRST_VALUE] = struct{}{}
 	Keywords[FLOAT] = struct{}{}
 	Keywords[FLOOR] = struct{}{}
 	Keywords[FOLLOWING] = struct{}{}
 	Keywords[FOR] = struct{}{}
+	Keywords[FORCE] = struct{}{}
 	Keywords[FOREIGN] = struct{}{}
+	Keywords[FORMAT] = struct{}{}
 	Keywords[FRAME_ROW] = struct{}{}
 	Keywords[FREE] = struct{}{}
+	Keywords[FREEZE] = struct{}{}
 	Keywords[FROM] = struct{}{}
 	Keywords[FULL] = struct{}{}
+	Keywords[FULLTEXT] = struct{}{}
 	Keywords[FUNCTION] = struct{}{}
 	Keywords[FUSION] = struct{}{}
 	Keywords[GET] = struct{}{}
@@ -142,17 +202,26 @@ func init() {
 	Keywords[GROUP] = struct{}{}
 	Keywords[GROUPING] = struct{}{}
 	Keywords[GROUPS] = struct{}{}
+	Keywords[HASH] = struct{}{}
 	Keywords[HAVING] = struct{}{}
 	Keywords[HEADER] = struct{}{}
 	Keywords[HOLD] = struct{}{}
 	Keywords[HOUR] = struct{}{}
 	Keywords[IDENTITY] = struct{}{}
+	Keywords[IGNORE] = struct{}{}
+	Keywords[ILIKE] = struct{}{}
+	Keywords[IMMUTABLE] = struct{}{}
 	Keywords[IN] = struct{}{}
+	Keywords[INCLUDE] = struct{}{}
+	Keywords[INCLUDING] = struct{}{}
+	Keywords[INCREMENT] = struct{}{}
 	Keywords[INDICATOR] = struct{}{}
+	Keywords[INHERITS] = struct{}{}
 	Keywords[INNER] = struct{}{}
 	Keywords[INOUT] = struct{}{}
 	Keywords[INSENSITIVE] = struct{}{}
 	Keywords[INSERT] = struct{}{}
+	Keywords[INSTEAD] = struct{}{}
 	Keywords[INT] = struct{}{}
 	Keywords[INTEGER] = struct{}{}
 	Keywords[INTERSECT] = struct{}{}
@@ -161,7 +230,9 @@ func init() {
 	Keywords[INTO] = struct{}{}
 	Keywords[IS] = struct{}{}
 	Keywords[JOIN] = struct{}{}
+	Keywords[JSON] = struct{}{}
 	Keywords[KEY] = struct{}{}
+	Keywords[KILL] = struct{}{}
 	Keywords[LAG] = struct{}{}
 	Keywords[LANGUAGE] = struct{}{}
 	Keywords[LARGE] = struct{}{}
@@ -169,43 +240,61 @@ func init() {
 	Keywords[LATERAL] = struct{}{}
 	Keywords[LEAD] = struct{}{}
 	Keywords[LEADING] = struct{}{}
+	Keywords[LEAKPROOF] = struct{}{}
 	Keywords[LEFT] = struct{}{}
 	Keywords[LIKE] = struct{}{}
 	Keywords[LIKE_REGEX] = struct{}{}
 	Keywords[LIMIT] = struct{}{}
+	Keywords[LIST] = struct{}{}
 	Keywords[LN] = struct{}{}
 	Keywords[LOCAL] = struct{}{}
 	Keywords[LOCALTIME] = struct{}{}
 	Keywords[LOCALTIMESTAMP] = struct{}{}
 	Keywords[LOCATION] = struct{}{}
+	Keywords[LOCKED] = struct{}{}
+	Keywords[LONGBLOB] = struct{}{}
+	Keywords[LONGTEXT] = struct{}{}
 	Keywords[LOWER] = struct{}{}
 	Keywords[MATCH] = struct{}{}
 	Keywords[MATERIALIZED] = struct{}{}
 	Keywords[MAX] = struct{}{}
+	Keywords[MAXVALUE] = struct{}{}
+	Keywords[MEDIUMBLOB] = struct{}{}
+	Keywords[MEDIUMINT] = struct{}{}
+	Keywords[MEDIUMTEXT] = struct{}{}
 	Keywords[MEMBER] = struct{}{}
 	Keywords[MERGE] = struct{}{}
 	Keywords[METHOD] = struct{}{}
 	Keywords[MIN] = struct{}{}
 	Keywords[MINUTE] = struct{}{}
+	Keywords[MINVALUE] = struct{}{}
 	Keywords[MOD] = struct{}{}
 	Keywords[MODIFIES] = struct{}{}
+	Keywords[MODIFY] = struct{}{}
 	Keywords[MODULE] = struct{}{}
+	Keywords[MODULUS] = struct{}{}
 	Keywords[MONTH] = struct{}{}
 	Keywords[MULTISET] = struct{}{}
+	Keywords[NAMES] = struct{}{}
 	Keywords[NATIONAL] = struct{}{}
 	Keywords[NATURAL] = struct{}{}
 	Keywords[NCHAR] = struct{}{}
 	Keywords[NCLOB] = struct{}{}
 	Keywords[NEW] = struct{}{}
+	Keywords[NEXT] = struct{}{}
 	Keywords[NO] = struct{}{}
 	Keywords[NONE] = struct{}{}
 	Keywords[NORMALIZE] = struct{}{}
 	Keywords[NOT] = struct{}{}
+	Keywords[NOTHING] = struct{}{}
+	Keywords[NOWAIT] = struct{}{}
 	Keywords[NTH_VALUE] = struct{}{}
 	Keywords[NTILE] = struct{}{}
 	Keywords[NULL] = struct{}{}
 	Keywords[NULLIF] = struct{}{}
+	Keywords[NULLS] = struct{}{}
 	Keywords[NUMERIC] = struct{}{}
+	Keywords[NVARCHAR] = struct{}{}
 	Keywords[OBJECT] = struct{}{}
 	Keywords[OCTET_LENGTH] = struct{}{}
 	Keywords[OCCURRENCES_REGEX] = struct{}{}
@@ -217,11 +306,13 @@ func init() {
 	Keywords[OPEN] = struct{}{}
 	Keywords[OR] = struct{}{}
 	Keywords[ORDER] = struct{}{}
+	Keywords[ORDINALITY] = struct{}{}
 	Keywords[OUT] = struct{}{}
 	Keywords[OUTER] = struct{}{}
 	Keywords[OVER] = struct{}{}
 	Keywords[OVERLAPS] = struct{}{}
 	Keywords[OVERLAY] = struct{}{}
+	Keywords[OWNED] = struct{}{}
 	Keywords[PARAMETER] = struct{}{}
 	Keywords[PARTITION] = struct{}{}
 	Keywords[PARQUET] = struct{}{}
@@ -230,6 +321,7 @@ func init() {
 	Keywords[PERCENTILE_CONT] = struct{}{}
 	Keywords[PERCENTILE_DISC] = struct{}{}
 	Keywords[PERIOD] = struct{}{}
+	Keywords[PLACING] = struct{}{}
 	Keywords[PORTION] = struct{}{}
 	Keywords[POSITION] = struct{}{}
 	Keywords[POSITION_REGEX] = struct{}{}
@@ -240,6 +332,8 @@ func init() {
 	Keywords[PREPARE] = struct{}{}
 	Keywords[PRIMARY] = struct{}{}
 	Keywords[PROCEDURE] = struct{}{}
+	Keywords[QUALIFY] = struct{}{}
+	Keywords[QUERY] = struct{}{}
 	Keywords[RANGE] = struct{}{}
 	Keywords[RANK] = struct{}{}
 	Keywords[READS] = struct{}{}
@@ -258,9 +352,16 @@ func init() {
 	Keywords[REGR_SXX] = struct{}{}
 	Keywords[REGR_SXY] = struct{}{}
 	Keywords[REGR_SYY] = struct{}{}
+	Keywords[REINDEX] = struct{}{}
 	Keywords[RELEASE] = struct{}{}
+	Keywords[REMAINDER] = struct{}{}
+	Keywords[RENAME] = struct{}{}
+	Keywords[REPLACE] = struct{}{}
+	Keywords[RESTART] = struct{}{}
+	Keywords[RESTRICT] = struct{}{}
 	Keywords[RESULT] = struct{}{}
 	Keywords[RETURN] = struct{}{}
+	Keywords[RETURNING] = struct{}{}
 	Keywords[RETURNS] = struct{}{}
 	Keywords[REVOKE] = struct{}{}
 	Keywords[RIGHT] = struct{}{}
@@ -270,17 +371,27 @@ func init() {
 	Keywords[ROW_NUMBER] = struct{}{}
 	Keywords[ROWS] = struct{}{}
 	Keywords[SAVEPOINT] = struct{}{}
+	Keywords[SCHEMA] = struct{}{}
 	Keywords[SCOPE] = struct{}{}
 	Keywords[SCROLL] = struct{}{}
 	Keywords[SEARCH] = struct{}{}
 	Keywords[SECOND] = struct{}{}
 	Keywords[SELECT] = struct{}{}
 	Keywords[SENSITIVE] = struct{}{}
+	Keywords[SEQUENCE] = struct{}{}
+	Keywords[SERIAL] = struct{}{}
+	Keywords[SESSION] = struct{}{}
 	Keywords[SESSION_USER] = struct{}{}
 	Keywords[SET] = struct{}{}
+	Keywords[SETOF] = struct{}{}
+	Keywords[SHARE] = struct{}{}
+	Keywords[SHOW] = struct{}{}
 	Keywords[SIMILAR] = struct{}{}
+	Keywords[SKIP] = struct{}{}
 	Keywords[SMALLINT] = struct{}{}
+	Keywords[SMALLSERIAL] = struct{}{}
 	Keywords[SOME] = struct{}{}
+	Keywords[SPATIAL] = struct{}{}
 	Keywords[SPECIFIC] = struct{}{}
 	Keywords[SPECIFICTYPE] = struct{}{}
 	Keywords[SQL] = struct{}{}
@@ -288,12 +399,16 @@ func init() {
 	Keywords[SQLSTATE] = struct{}{}
 	Keywords[SQLWARNING] = struct{}{}
 	Keywords[SQRT] = struct{}{}
+	Keywords[STABLE] = struct{}{}
 	Keywords[START] = struct{}{}
+	Keywords[STATEMENT] = struct{}{}
 	Keywords[STATIC] = struct{}{}
 	Keywords[STDDEV_POP] = struct{}{}
 	Keywords[STDDEV_SAMP] = struct{}{}
 	Keywords[STDIN] = struct{}{}
+	Keywords[STDOUT] = struct{}{}
 	Keywords[STORED] = struct{}{}
+	Keywords[STRICT] = struct{}{}
 	Keywords[SUBMULTISET] = struct{}{}
 	Keywords[SUBSTRING] = struct{}{}
 	Keywords[SUBSTRING_REGEX] = struct{}{}
@@ -305,13 +420,20 @@ func init() {
 	Keywords[SYSTEM_USER] = struct{}{}
 	Keywords[TABLE] = struct{}{}
 	Keywords[TABLESAMPLE] = struct{}{}
+	Keywords[TEMP] = struct{}{}
+	Keywords[TEMPORARY] = struct{}{}
 	Keywords[TEXT] = struct{}{}
 	Keywords[THEN] = struct{}{}
+	Keywords[TIES] = struct{}{}
 	Keywords[TIME] = struct{}{}
 	Keywords[TIMESTAMP] = struct{}{}
 	Keywords[TIMEZONE_HOUR] = struct{}{}
 	Keywords[TIMEZONE_MINUTE] = struct{}{}
+	Keywords[TINYBLOB] = struct{}{}
+	Keywords[TINYINT] = struct{}{}
+	Keywords[TINYTEXT] = struct{}{}
 	Keywords[TO] = struct{}{}
+	Keywords[TOP] = struct{}{}
 	Keywords[TRAILING] = struct{}{}
 	Keywords[TRANSLATE] = struct{}{}
 	Keywords[TRANSLATE_REGEX] = struct{}{}
@@ -322,27 +444,36 @@ func init() {
 	Keywords[TRIM] = struct{}{}
 	Keywords[TRIM_ARRAY] = struct{}{}
 	Keywords[TRUE] = struct{}{}
+	Keywords[TYPE] = struct{}{}
 	Keywords[UESCAPE] = struct{}{}
 	Keywords[UNBOUNDED] = struct{}{}
 	Keywords[UNION] = struct{}{}
 	Keywords[UNIQUE] = struct{}{}
 	Keywords[UNKNOWN] = struct{}{}
+	Keywords[UNLOGGED] = struct{}{}
 	Keywords[UNNEST] = struct{}{}
 	Keywords[UPDATE] = struct{}{}
 	Keywords[UPPER] = struct{}{}
+	Keywords[USE] = struct{}{}
 	Keywords[USER] = struct{}{}
 	Keywords[USING] = struct{}{}
 	Keywords[UUID] = struct{}{}
+	Keywords[VACUUM] = struct{}{}
 	Keywords[VALUE] = struct{}{}
 	Keywords[VALUES] = struct{}{}
 	Keywords[VALUE_OF] = struct{}{}
+	Keywords[VARIADIC] = struct{}{}
 	Keywords[VAR_POP] = struct{}{}
 	Keywords[VAR_SAMP] = struct{}{}
 	Keywords[VARBINARY] = struct{}{}
 	Keywords[VARCHAR] = struct{}{}
 	Keywords[VARYING] = struct{}{}
+	Keywords[VERBOSE] = struct{}{}
+	Keywords[VERSION] = struct{}{}
 	Keywords[VERSIONING] = struct{}{}
 	Keywords[VIEW] = struct{}{}
+	Keywords[VOLATILE] = struct{}{}
+	Keywords[WARNINGS] = struct{}{}
 	Keywords[WHEN] = struct{}{}
 	Keywords[WHENEVER] = struct{}{}
 	Keywords[WHERE] = struct{}{}
@@ -352,42 +483,15 @@ func init() {
 	Keywords[WITHIN] = struct{}{}
 	Keywords[WITHOUT] = struct{}{}
 	Keywords[YEAR] = struct{}{}
+	Keywords[ZEROFILL] = struct{}{}
 	Keywords[ZONE] = struct{}{}
-
-	ReservedForTableAlias = make(map[string]struct{})
-	ReservedForTableAlias[WITH] = struct{}{}
-	ReservedForTableAlias[SELECT] = struct{}{}
-	ReservedForTableAlias[WHERE] = struct{}{}
-	ReservedForTableAlias[GROUP] = struct{}{}
-	ReservedForTableAlias[ORDER] = struct{}{}
-	ReservedForTableAlias[UNION] = struct{}{}
-	ReservedForTableAlias[EXCEPT] = struct{}{}
-	ReservedForTableAlias[INTERSECT] = struct{}{}
-	ReservedForTableAlias[ON] = struct{}{}
-	ReservedForTableAlias[JOIN] = struct{}{}
-	ReservedForTableAlias[INNER] = struct{}{}
-	ReservedForTableAlias[CROSS] = struct{}{}
-	ReservedForTableAlias[FULL] = struct{}{}
-	ReservedForTableAlias[LEFT] = struct{}{}
-	ReservedForTableAlias[RIGHT] = struct{}{}
-	ReservedForTableAlias[NATURAL] = struct{}{}
-	ReservedForTableAlias[USING] = struct{}{}
-
-	ReservedForColumnAlias = make(map[string]struct{})
-	ReservedForColumnAlias[WITH] = struct{}{}
-	ReservedForColumnAlias[SELECT] = struct{}{}
-	ReservedForColumnAlias[WHERE] = struct{}{}
-	ReservedForColumnAlias[GROUP] = struct{}{}
-	ReservedForColumnAlias[ORDER] = struct{}{}
-	ReservedForColumnAlias[UNION] = struct{}{}
-	ReservedForColumnAlias[EXCEPT] = struct{}{}
-	ReservedForColumnAlias[INTERSECT] = struct{}{}
-	ReservedForColumnAlias[FROM] = struct{}{}
 }
 
 const (
 	ABS                              string = "ABS"
 	ADD                                     = "ADD"
+	AFTER                                   = "AFTER"
+	ANALYZE                                 = "ANALYZE"
 	ASC                                     = "ASC"
 	ALL                                     = "ALL"
 	ALLOCATE                                = "ALLOCATE"
@@ -403,19 +507,23 @@ const (
 	ASYMMETRIC                              = "ASYMMETRIC"
 	AT                                      = "AT"
 	ATOMIC                                  = "ATOMIC"
+	ATTACH                                  = "ATTACH"
 	AUTHORIZATION                           = "AUTHORIZATION"
 	AVG                                     = "AVG"
+	BEFORE                                  = "BEFORE"
 	BEGIN                                   = "BEGIN"
 	BEGIN_FRAME                             = "BEGIN_FRAME"
 	BEGIN_PARTITION                         = "BEGIN_PARTITION"
 	BETWEEN                                 = "BETWEEN"
 	BIGINT                                  = "BIGINT"
+	BIGSERIAL                               = "BIGSERIAL"
 	BINARY                                  = "BINARY"
 	BLOB                                    = "BLOB"
 	BOOLEAN                                 = "BOOLEAN"
 	BOTH                                    = "BOTH"
 	BY                                      = "BY"
 	BYTEA                                   = "BYTEA"
+	CACHE                                   = "CACHE"
 	CALL                                    = "CALL"
 	CALLED                                  = "CALLED"
 	CARDINALITY                             = "CARDINALITY"
@@ -424,6 +532,7 @@ const (
 	CAST                                    = "CAST"
 	CEIL                                    = "CEIL"
 	CEILING                                 = "CEILING"
+	CHANGE                                  = "CHANGE"
 	CHR                                     = "CHR"
 	CHAR                                    = "CHAR"
 	CHAR_LENGTH                             = "CHAR_LENGTH"
@@ -436,9 +545,13 @@ const (
 	COLLATE                                 = "COLLATE"
 	COLLECT                                 = "COLLECT"
 	COLUMN                                  = "COLUMN"
+	COMMENT                                 = "COMMENT"
 	COMMIT                                  = "COMMIT"
+	CONCURRENTLY                            = "CONCURRENTLY"
 	CONDITION                               = "CONDITION"
+	CONFLICT                                = "CONFLICT"
 	CONNECT                                 = "CONNECT"
+	CONNECTION                              = "CONNECTION"
 	CONSTRAINT                              = "CONSTRAINT"
 	CONTAINS                                = "CONTAINS"
 	CONVERT                                 = "CONVERT"
@@ -468,6 +581,7 @@ const (
 	CURSOR                                  = "CURSOR"
 	CYCLE                                   = "CYCLE"
 	DATE                                    = "DATE"
+	DATETIME                                = "DATETIME"
 	DAY                                     = "DAY"
 	DEALLOCATE                              = "DEALLOCATE"
 	DEC                                     = "DEC"
@@ -479,9 +593,12 @@ const (
 	DEREF                                   = "DEREF"
 	DESC                                    = "DESC"
 	DESCRIBE                                = "DESCRIBE"
+	DETACH                                  = "DETACH"
 	DETERMINISTIC                           = "DETERMINISTIC"
 	DISCONNECT                              = "DISCONNECT"
 	DISTINCT                                = "DISTINCT"
+	DO                                      = "DO"
+	DOMAIN                                  = "DOMAIN"
 	DOUBLE                                  = "DOUBLE"
 	DROP                                    = "DROP"
 	DYNAMIC                                 = "DYNAMIC"
@@ -491,29 +608,39 @@ const (
 	END                                     = "END"
 	END_FRAME                               = "END_FRAME"
 	END_PARTITION                           = "END_PARTITION"
+	ENUM                                    = "ENUM"
 	EQUALS                                  = "EQUALS"
+	ERRORS                                  = "ERRORS"
 	ESCAPE                                  = "ESCAPE"
 	EVERY                                   = "EVERY"
 	EXCEPT                                  = "EXCEPT"
+	EXCLUDING                               = "EXCLUDING"
 	EXEC                                    = "EXEC"
 	EXECUTE                                 = "EXECUTE"
 	EXISTS                                  = "EXISTS"
 	EXP                                     = "EXP"
+	EXTENSION                               = "EXTENSION"
 	EXTERNAL                                = "EXTERNAL"
 	EXTRACT                                 = "EXTRACT"
 	FALSE                                   = "FALSE"
 	FETCH                                   = "FETCH"
 	FILTER                                  = "FILTER"
+	FINALIZE                                = "FINALIZE"
+	FIRST                                   = "FIRST"
 	FIRST_VALUE                             = "FIRST_VALUE"
 	FLOAT                                   = "FLOAT"
 	FLOOR                                   = "FLOOR"
 	FOLLOWING                               = "FOLLOWING"
 	FOR                                     = "FOR"
+	FORCE                                   = "FORCE"
 	FOREIGN                                 = "FOREIGN"
+	FORMAT                                  = "FORMAT"
 	FRAME_ROW                               = "FRAME_ROW"
 	FREE                                    = "FREE"
+	FREEZE                                  = "FREEZE"
 	FROM                                    = "FROM"
 	FULL                                    = "FULL"
+	FULLTEXT                                = "FULLTEXT"
 	FUNCTION                                = "FUNCTION"
 	FUSION                                  = "FUSION"
 	GET                                     = "GET"
@@ -522,17 +649,26 @@ const (
 	GROUP                                   = "GROUP"
 	GROUPING                                = "GROUPING"
 	GROUPS                                  = "GROUPS"
+	HASH                                    = "HASH"
 	HAVING                                  = "HAVING"
 	HEADER                                  = "HEADER"
 	HOLD                                    = "HOLD"
 	HOUR                                    = "HOUR"
 	IDENTITY                                = "IDENTITY"
+	IGNORE                                  = "IGNORE"
+	ILIKE                                   = "ILIKE"
+	IMMUTABLE                               = "IMMUTABLE"
 	IN                                      = "IN"
+	INCLUDE                                 = "INCLUDE"
+	INCLUDING                               = "INCLUDING"
+	INCREMENT                               = "INCREMENT"
 	INDICATOR                               = "INDICATOR"
+	INHERITS                                = "INHERITS"
 	INNER                                   = "INNER"
 	INOUT                                   = "INOUT"
 	INSENSITIVE                             = "INSENSITIVE"
 	INSERT                                  = "INSERT"
+	INSTEAD                                 = "INSTEAD"
 	INT                                     = "INT"
 	INTEGER                                 = "INTEGER"
 	INTERSECT                               = "INTERSECT"
@@ -541,7 +677,9 @@ const (
 	INTO                                    = "INTO"
 	IS                                      = "IS"
 	JOIN                                    = "JOIN"
+	JSON                                    = "JSON"
 	KEY                                     = "KEY"
+	KILL                                    = "KILL"
 	LAG                                     = "LAG"
 	LANGUAGE                                = "LANGUAGE"
 	LARGE                                   = "LARGE"
@@ -549,43 +687,61 @@ const (
 	LATERAL                                 = "LATERAL"
 	LEAD                                    = "LEAD"
 	LEADING                                 = "LEADING"
+	LEAKPROOF                               = "LEAKPROOF"
 	LEFT                                    = "LEFT"
 	LIKE                                    = "LIKE"
 	LIKE_REGEX                              = "LIKE_REGEX"
 	LIMIT                                   = "LIMIT"
+	LIST                                    = "LIST"
 	LN                                      = "LN"
 	LOCAL                                   = "LOCAL"
 	LOCALTIME                               = "LOCALTIME"
 	LOCALTIMESTAMP                          = "LOCALTIMESTAMP"
 	LOCATION                                = "LOCATION"
+	LOCKED                                  = "LOCKED"
+	LONGBLOB                                = "LONGBLOB"
+	LONGTEXT                                = "LONGTEXT"
 	LOWER                                   = "LOWER"
 	MATCH                                   = "MATCH"
 	MATERIALIZED                            = "MATERIALIZED"
 	MAX                                     = "MAX"
+	MAXVALUE                                = "MAXVALUE"
+	MEDIUMBLOB                              = "MEDIUMBLOB"
+	MEDIUMINT                               = "MEDIUMINT"
+	MEDIUMTEXT                              = "MEDIUMTEXT"
 	MEMBER                                  = "MEMBER"
 	MERGE                                   = "MERGE"
 	METHOD                                  = "METHOD"
 	MIN                                     = "MIN"
 	MINUTE                                  = "MINUTE"
+	MINVALUE                                = "MINVALUE"
 	MOD                                     = "MOD"
 	MODIFIES                                = "MODIFIES"
+	MODIFY                                  = "MODIFY"
 	MODULE                                  = "MODULE"
+	MODULUS                                 = "MODULUS"
 	MONTH                                   = "MONTH"
 	MULTISET                                = "MULTISET"
+	NAMES                                   = "NAMES"
 	NATIONAL                                = "NATIONAL"
 	NATURAL                                 = "NATURAL"
 	NCHAR                                   = "NCHAR"
 	NCLOB                                   = "NCLOB"
 	NEW                                     = "NEW"
+	NEXT                                    = "NEXT"
 	NO                                      = "NO"
 	NONE                                    = "NONE"
 	NORMALIZE                               = "NORMALIZE"
 	NOT                                     = "NOT"
+	NOTHING                                 = "NOTHING"
+	NOWAIT                                  = "NOWAIT"
 	NTH_VALUE                               = "NTH_VALUE"
 	NTILE                                   = "NTILE"
 	NULL                                    = "NULL"
 	NULLIF                                  = "NULLIF"
+	NULLS                                   = "NULLS"
 	NUMERIC                                 = "NUMERIC"
+	NVARCHAR                                = "NVARCHAR"
 	OBJECT                                  = "OBJECT"
 	OCTET_LENGTH                            = "OCTET_LENGTH"
 	OCCURRENCES_REGEX                       = "OCCURRENCES_REGEX"
@@ -597,11 +753,13 @@ const (
 	OPEN                                    = "OPEN"
 	OR                                      = "OR"
 	ORDER                                   = "ORDER"
+	ORDINALITY                              = "ORDINALITY"
 	OUT                                     = "OUT"
 	OUTER                                   = "OUTER"
 	OVER                                    = "OVER"
 	OVERLAPS                                = "OVERLAPS"
 	OVERLAY                                 = "OVERLAY"
+	OWNED                                   = "OWNED"
 	PARAMETER                               = "PARAMETER"
 	PARTITION                               = "PARTITION"
 	PARQUET                                 = "PARQUET"
@@ -610,6 +768,7 @@ const (
 	PERCENTILE_CONT                         = "PERCENTILE_CONT"
 	PERCENTILE_DISC                         = "PERCENTILE_DISC"
 	PERIOD                                  = "PERIOD"
+	PLACING                                 = "PLACING"
 	PORTION                                 = "PORTION"
 	POSITION                                = "POSITION"
 	POSITION_REGEX                          = "POSITION_REGEX"
@@ -620,6 +779,8 @@ const (
 	PREPARE                                 = "PREPARE"
 	PRIMARY                                 = "PRIMARY"
 	PROCEDURE                               = "PROCEDURE"
+	QUALIFY                                 = "QUALIFY"
+	QUERY                                   = "QUERY"
 	RANGE                                   = "RANGE"
 	RANK                                    = "RANK"
 	READS                                   = "READS"
@@ -638,9 +799,16 @@ const (
 	REGR_SXX                                = "REGR_SXX"
 	REGR_SXY                                = "REGR_SXY"
 	REGR_SYY                                = "REGR_SYY"
+	REINDEX                                 = "REINDEX"
 	RELEASE                                 = "RELEASE"
+	REMAINDER                               = "REMAINDER"
+	RENAME                                  = "RENAME"
+	REPLACE                                 = "REPLACE"
+	RESTART                                 = "RESTART"
+	RESTRICT                                = "RESTRICT"
 	RESULT                                  = "RESULT"
 	RETURN                                  = "RETURN"
+	RETURNING                               = "RETURNING"
 	RETURNS                                 = "RETURNS"
 	REVOKE                                  = "REVOKE"
 	RIGHT                                   = "RIGHT"
@@ -650,17 +818,27 @@ const (
 	ROW_NUMBER                              = "ROW_NUMBER"
 	ROWS                                    = "ROWS"
 	SAVEPOINT                               = "SAVEPOINT"
+	SCHEMA                                  = "SCHEMA"
 	SCOPE                                   = "SCOPE"
 	SCROLL                                  = "SCROLL"
 	SEARCH                                  = "SEARCH"
 	SECOND                                  = "SECOND"
 	SELECT                                  = "SELECT"
 	SENSITIVE                               = "SENSITIVE"
+	SEQUENCE                                = "SEQUENCE"
+	SERIAL                                  = "SERIAL"
+	SESSION                                 = "SESSION"
 	SESSION_USER                            = "SESSION_USER"
 	SET                                     = "SET"
+	SETOF                                   = "SETOF"
+	SHARE                                   = "SHARE"
+	SHOW                                    = "SHOW"
 	SIMILAR                                 = "SIMILAR"
+	SKIP                                    = "SKIP"
 	SMALLINT                                = "SMALLINT"
+	SMALLSERIAL                             = "SMALLSERIAL"
 	SOME                                    = "SOME"
+	SPATIAL                                 = "SPATIAL"
 	SPECIFIC                                = "SPECIFIC"
 	SPECIFICTYPE                            = "SPECIFICTYPE"
 	SQL                                     = "SQL"
@@ -668,12 +846,16 @@ const (
 	SQLSTATE                                = "SQLSTATE"
 	SQLWARNING                              = "SQLWARNING"
 	SQRT                                    = "SQRT"
+	STABLE                                  = "STABLE"
 	START                                   = "START"
+	STATEMENT                               = "STATEMENT"
 	STATIC                                  = "STATIC"
 	STDDEV_POP                              = "STDDEV_POP"
 	STDDEV_SAMP                             = "STDDEV_SAMP"
 	STDIN                                   = "STDIN"
+	STDOUT                                  = "STDOUT"
 	STORED                                  = "STORED"
+	STRICT                                  = "STRICT"
 	SUBMULTISET                             = "SUBMULTISET"
 	SUBSTRING                               = "SUBSTRING"
 	SUBSTRING_REGEX                         = "SUBSTRING_REGEX"
@@ -685,13 +867,20 @@ const (
 	SYSTEM_USER                             = "SYSTEM_USER"
 	TABLE                                   = "TABLE"
 	TABLESAMPLE                             = "TABLESAMPLE"
+	TEMP                                    = "TEMP"
+	TEMPORARY                               = "TEMPORARY"
 	TEXT                                    = "TEXT"
 	THEN                                    = "THEN"
+	TIES                                    = "TIES"
 	TIME                                    = "TIME"
 	TIMESTAMP                               = "TIMESTAMP"
 	TIMEZONE_HOUR                           = "TIMEZONE_HOUR"
 	TIMEZONE_MINUTE                         = "TIMEZONE_MINUTE"
+	TINYBLOB                                = "TINYBLOB"
+	TINYINT                                 = "TINYINT"
+	TINYTEXT                                = "TINYTEXT"
 	TO                                      = "TO"
+	TOP                                     = "TOP"
 	TRAILING                                = "TRAILING"
 	TRANSLATE                               = "TRANSLATE"
 	TRANSLATE_REGEX                         = "TRANSLATE_REGEX"
@@ -702,27 +891,36 @@ const (
 	TRIM                                    = "TRIM"
 	TRIM_ARRAY                              = "TRIM_ARRAY"
 	TRUE                                    = "TRUE"
+	TYPE                                    = "TYPE"
 	UESCAPE                                 = "UESCAPE"
 	UNBOUNDED                               = "UNBOUNDED"
 	UNION                                   = "UNION"
 	UNIQUE                                  = "UNIQUE"
 	UNKNOWN                                 = "UNKNOWN"
+	UNLOGGED                                = "UNLOGGED"
 	UNNEST                                  = "UNNEST"
 	UPDATE                                  = "UPDATE"
 	UPPER                                   = "UPPER"
+	USE                                     = "USE"
 	USER                                    = "USER"
 	USING                                   = "USING"
 	UUID                                    = "UUID"
+	VACUUM                                  = "VACUUM"
 	VALUE                                   = "VALUE"
 	VALUES                                  = "VALUES"
 	VALUE_OF                                = "VALUE_OF"
+	VARIADIC                                = "VARIADIC"
 	VAR_POP                                 = "VAR_POP"
 	VAR_SAMP                                = "VAR_SAMP"
 	VARBINARY                               = "VARBINARY"
 	VARCHAR                                 = "VARCHAR"
 	VARYING                                 = "VARYING"
+	VERBOSE                                 = "VERBOSE"
+	VERSION                                 = "VERSION"
 	VERSIONING                              = "VERSIONING"
 	VIEW                                    = "VIEW"
+	VOLATILE                                = "VOLATILE"
+	WARNINGS                                = "WARNINGS"
 	WHEN                                    = "WHEN"
 	WHENEVER                                = "WHENEVER"
 	WHERE                                   = "WHERE"
@@ -732,5 +930,6 @@ const (
 	WITHIN                                  = "WITHIN"
 	WITHOUT                                 = "WITHOUT"
 	YEAR                                    = "YEAR"
+	ZEROFILL                                = "ZEROFILL"
 	ZONE                                    = "ZONE"
 )
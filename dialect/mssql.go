@@ -0,0 +1,51 @@
+package dialect
+
+import "strings"
+
+type MSSQLDialect struct {
+}
+
+func (*MSSQLDialect) IsIdentifierStart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_' || r == '#'
+}
+
+func (*MSSQLDialect) IsIdentifierPart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '@' || r == '$' || r == '#' || r == '_'
+}
+
+func (*MSSQLDialect) IsDelimitedIdentifierStart(r rune) bool {
+	return r == '"' || r == '['
+}
+
+// MSSQL has no LIMIT and RETURNING clauses, and reserves INTO of SELECT INTO.
+var (
+	mssqlReservedForTableAlias  = reservedWords(ReservedForTableAlias, nil, []string{LIMIT, RETURNING})
+	mssqlReservedForColumnAlias = reservedWords(ReservedForColumnAlias, []string{INTO}, []string{LIMIT, RETURNING})
+)
+
+func (d *MSSQLDialect) IsReservedForTableAlias(keyword string) bool {
+	return isReserved(d, mssqlReservedForTableAlias, keyword)
+}
+
+func (d *MSSQLDialect) IsReservedForColumnAlias(keyword string) bool {
+	return isReserved(d, mssqlReservedForColumnAlias, keyword)
+}
+
+func (*MSSQLDialect) QuoteIdentifier(ident string) string {
+	return "[" + strings.ReplaceAll(ident, "]", "]]") + "]"
+}
+
+func (*MSSQLDialect) UnescapeString(r rune) (string, bool) {
+	return "", false
+}
+
+func (*MSSQLDialect) Supports(f Feature) bool {
+	switch f {
+	case Top, AtPlaceholder, DropIfExists, NestedComment, TableHint:
+		return true
+	}
+	return false
+}
+
+var _ Dialect = &MSSQLDialect{}
+var _ FeatureDialect = &MSSQLDialect{}
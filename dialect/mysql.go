@@ -1,11 +1,66 @@
 package dialect
 
+import "strings"
+
 type MySQLDialect struct {
 	GenericSQLDialect
+	Version Version
 }
 
 func (*MySQLDialect) IsDelimitedIdentifierStart(r rune) bool {
 	return r == '"' || r == '`'
 }
 
+// MySQL reserves the keywords of index hints and partition selection after a table name,
+// but not OFFSET and RETURNING.
+var (
+	mysqlReservedForTableAlias  = reservedWords(ReservedForTableAlias, []string{USE, IGNORE, FORCE, PARTITION, WINDOW}, []string{OFFSET, RETURNING})
+	mysqlReservedForColumnAlias = reservedWords(ReservedForColumnAlias, []string{INTO, WINDOW}, []string{OFFSET, RETURNING})
+)
+
+func (d *MySQLDialect) IsReservedForTableAlias(keyword string) bool {
+	return isReserved(d, mysqlReservedForTableAlias, keyword)
+}
+
+func (d *MySQLDialect) IsReservedForColumnAlias(keyword string) bool {
+	return isReserved(d, mysqlReservedForColumnAlias, keyword)
+}
+
+func (*MySQLDialect) QuoteIdentifier(ident string) string {
+	return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+}
+
+// https://dev.mysql.com/doc/refman/8.0/en/string-literals.html
+func (*MySQLDialect) UnescapeString(r rune) (string, bool) {
+	switch r {
+	case '0':
+		return "\x00", true
+	case 'b':
+		return "\b", true
+	case 'n':
+		return "\n", true
+	case 'r':
+		return "\r", true
+	case 't':
+		return "\t", true
+	case 'Z':
+		return "\x1a", true
+	case '%', '_':
+		// kept as is for LIKE patterns
+		return "\\" + string(r), true
+	}
+	return string(r), true
+}
+
+func (d *MySQLDialect) Supports(f Feature) bool {
+	switch f {
+	case CreateIfNotExists, DropIfExists, ModifyOrderByLimit, DisplayWidth, ModifyColumn, DropIndexOnTable, HashComment, OptimizerHint, IndexHint, DropPrimaryKey:
+		return true
+	case EnforcedCheckConstraint:
+		return d.Version.AtLeast(8, 0, 16)
+	}
+	return false
+}
+
 var _ Dialect = &MySQLDialect{}
+var _ FeatureDialect = &MySQLDialect{}
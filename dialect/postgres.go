@@ -1,6 +1,9 @@
 package dialect
 
+import "strings"
+
 type PostgresqlDialect struct {
+	Version Version
 }
 
 func (*PostgresqlDialect) IsIdentifierStart(r rune) bool {
@@ -15,4 +18,40 @@ func (*PostgresqlDialect) IsDelimitedIdentifierStart(r rune) bool {
 	return r == '"' || r == '`'
 }
 
+// PostgreSQL reserves WINDOW and INTO of SELECT INTO as well.
+var (
+	postgresqlReservedForTableAlias  = reservedWords(ReservedForTableAlias, []string{WINDOW}, nil)
+	postgresqlReservedForColumnAlias = reservedWords(ReservedForColumnAlias, []string{INTO, WINDOW}, nil)
+)
+
+func (d *PostgresqlDialect) IsReservedForTableAlias(keyword string) bool {
+	return isReserved(d, postgresqlReservedForTableAlias, keyword)
+}
+
+func (d *PostgresqlDialect) IsReservedForColumnAlias(keyword string) bool {
+	return isReserved(d, postgresqlReservedForColumnAlias, keyword)
+}
+
+func (*PostgresqlDialect) QuoteIdentifier(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+// backslash escapes are available only in escape string constants (E'...')
+func (*PostgresqlDialect) UnescapeString(r rune) (string, bool) {
+	return "", false
+}
+
+func (d *PostgresqlDialect) Supports(f Feature) bool {
+	switch f {
+	case DollarPlaceholder, DollarQuotedString, CreateIfNotExists, DropIfExists, CreateOrReplace, NestedComment:
+		return true
+	case UniqueNullsDistinct:
+		return d.Version.AtLeast(15, 0, 0)
+	case EnforcedCheckConstraint:
+		return d.Version.AtLeast(18, 0, 0)
+	}
+	return false
+}
+
 var _ Dialect = &PostgresqlDialect{}
+var _ FeatureDialect = &PostgresqlDialect{}
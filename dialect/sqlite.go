@@ -0,0 +1,56 @@
+package dialect
+
+import "strings"
+
+// SQLiteDialect accepts identifiers quoted by `"`, "`" and `[]`.
+// SQLite treats a double quoted identifier which doesn't match any column as
+// a string literal. Such tokens are parsed as identifiers and written back
+// verbatim, so the fallback is left to SQLite.
+type SQLiteDialect struct {
+}
+
+func (*SQLiteDialect) IsIdentifierStart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+}
+
+func (*SQLiteDialect) IsIdentifierPart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '$' || r == '_'
+}
+
+func (*SQLiteDialect) IsDelimitedIdentifierStart(r rune) bool {
+	return r == '"' || r == '`' || r == '['
+}
+
+// SQLite has no FETCH clause, and reserves WINDOW.
+var (
+	sqliteReservedForTableAlias  = reservedWords(ReservedForTableAlias, []string{WINDOW}, []string{FETCH})
+	sqliteReservedForColumnAlias = reservedWords(ReservedForColumnAlias, []string{WINDOW}, []string{FETCH})
+)
+
+func (d *SQLiteDialect) IsReservedForTableAlias(keyword string) bool {
+	return isReserved(d, sqliteReservedForTableAlias, keyword)
+}
+
+func (d *SQLiteDialect) IsReservedForColumnAlias(keyword string) bool {
+	return isReserved(d, sqliteReservedForColumnAlias, keyword)
+}
+
+func (*SQLiteDialect) QuoteIdentifier(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+func (*SQLiteDialect) UnescapeString(r rune) (string, bool) {
+	return "", false
+}
+
+// https://www.sqlite.org/lang_expr.html#varparam
+func (*SQLiteDialect) Supports(f Feature) bool {
+	switch f {
+	case ColonPlaceholder, AtPlaceholder, CreateIfNotExists, DropIfExists:
+		return true
+	}
+	return false
+}
+
+var _ Dialect = &SQLiteDialect{}
+var _ FeatureDialect = &SQLiteDialect{}
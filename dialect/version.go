@@ -0,0 +1,53 @@
+package dialect
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+)
+
+// Version is the version of the database server which a dialect targets.
+// The zero Version targets the latest version.
+type Version struct {
+	Major, Minor, Patch int
+}
+
+// ParseVersion parses version strings like "8.0.16" or "15".
+func ParseVersion(s string) (Version, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return Version{}, errors.Errorf("invalid version %s", s)
+	}
+
+	var nums [3]int
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return Version{}, errors.Errorf("invalid version %s", s)
+		}
+		nums[i] = n
+	}
+
+	return Version{Major: nums[0], Minor: nums[1], Patch: nums[2]}, nil
+}
+
+// AtLeast reports whether v is major.minor.patch or later.
+// The zero Version is later than any version.
+func (v Version) AtLeast(major, minor, patch int) bool {
+	if v == (Version{}) {
+		return true
+	}
+	if v.Major != major {
+		return v.Major > major
+	}
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+	return v.Patch >= patch
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
@@ -0,0 +1,58 @@
+package dialect
+
+import "testing"
+
+func TestVersion_AtLeast(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		target  Version
+		expect  bool
+	}{
+		{
+			name:    "later major",
+			version: "15",
+			target:  Version{Major: 12},
+			expect:  true,
+		},
+		{
+			name:    "earlier patch",
+			version: "8.0.15",
+			target:  Version{Major: 8, Patch: 16},
+			expect:  false,
+		},
+		{
+			name:    "same version",
+			version: "8.0.16",
+			target:  Version{Major: 8, Patch: 16},
+			expect:  true,
+		},
+		{
+			name:    "zero version is the latest",
+			version: "0",
+			target:  Version{Major: 99},
+			expect:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v, err := ParseVersion(c.version)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if got := v.AtLeast(c.target.Major, c.target.Minor, c.target.Patch); got != c.expect {
+				t.Errorf("%s.AtLeast(%s) should be %v but %v", v, c.target, c.expect, got)
+			}
+		})
+	}
+}
+
+func TestMySQLDialect_Supports(t *testing.T) {
+	if Supports(&MySQLDialect{Version: Version{Major: 5, Minor: 7}}, EnforcedCheckConstraint) {
+		t.Error("MySQL 5.7 should not support CHECK ... ENFORCED")
+	}
+	if !Supports(&MySQLDialect{Version: Version{Major: 8, Patch: 16}}, EnforcedCheckConstraint) {
+		t.Error("MySQL 8.0.16 should support CHECK ... ENFORCED")
+	}
+}
@@ -46,6 +46,42 @@ func TestInspect(t *testing.T) {
 			name: "INSERT",
 			dir:  "insert",
 		},
+		{
+			name: "SCHEMA",
+			dir:  "schema",
+		},
+		{
+			name: "SEQUENCE",
+			dir:  "sequence",
+		},
+		{
+			name: "CREATE FUNCTION",
+			dir:  "create_function",
+		},
+		{
+			name: "TRIGGER",
+			dir:  "trigger",
+		},
+		{
+			name: "TRUNCATE",
+			dir:  "truncate",
+		},
+		{
+			name: "SESSION",
+			dir:  "session",
+		},
+		{
+			name: "COMMENT ON",
+			dir:  "comment_on",
+		},
+		{
+			name: "COPY",
+			dir:  "copy",
+		},
+		{
+			name: "VIEW",
+			dir:  "view",
+		},
 	}
 
 	for _, c := range cases {
@@ -118,6 +154,42 @@ func TestApply(t *testing.T) {
 			name: "INSERT",
 			dir:  "insert",
 		},
+		{
+			name: "SCHEMA",
+			dir:  "schema",
+		},
+		{
+			name: "SEQUENCE",
+			dir:  "sequence",
+		},
+		{
+			name: "CREATE FUNCTION",
+			dir:  "create_function",
+		},
+		{
+			name: "TRIGGER",
+			dir:  "trigger",
+		},
+		{
+			name: "TRUNCATE",
+			dir:  "truncate",
+		},
+		{
+			name: "SESSION",
+			dir:  "session",
+		},
+		{
+			name: "COMMENT ON",
+			dir:  "comment_on",
+		},
+		{
+			name: "COPY",
+			dir:  "copy",
+		},
+		{
+			name: "VIEW",
+			dir:  "view",
+		},
 	}
 
 	for _, c := range cases {
@@ -147,10 +219,17 @@ func TestApply(t *testing.T) {
 					if err != nil {
 						t.Fatalf("%+v", err)
 					}
-					sqlastutil.Apply(stmt, func(c *sqlastutil.Cursor) bool {
-						// fmt.Printf("%T\n", node)
+					expect := stmt.ToSQLString()
+
+					res := sqlastutil.Apply(stmt, func(c *sqlastutil.Cursor) bool {
+						if c.Node() != nil {
+							c.Replace(c.Node())
+						}
 						return true
 					}, nil)
+					if act := res.ToSQLString(); act != expect {
+						t.Errorf("should be \n %s but \n %s", expect, act)
+					}
 				})
 			}
 		})
@@ -9,6 +9,7 @@ import (
 	"github.com/akito0107/xsqlparser"
 	"github.com/akito0107/xsqlparser/dialect"
 	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
 	"github.com/akito0107/xsqlparser/sqltoken"
 )
 
@@ -47,8 +48,8 @@ SELECT * from test;
 				List: []*sqlast.Comment{
 					{
 						Text: "test",
-						From: sqltoken.NewPos(2, 1),
-						To:   sqltoken.NewPos(2, 7),
+						From: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
+						To:   sqltoken.Pos{Line: 2, Col: 7, Offset: 7},
 					},
 				},
 			},
@@ -73,8 +74,8 @@ INSERT INTO tbl_name (col1,col2) VALUES(15,col1*2);
 				List: []*sqlast.Comment{
 					{
 						Text: "select",
-						From: sqltoken.NewPos(2, 1),
-						To:   sqltoken.NewPos(2, 9),
+						From: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
+						To:   sqltoken.Pos{Line: 2, Col: 9, Offset: 9},
 					},
 				},
 			},
@@ -85,8 +86,8 @@ INSERT INTO tbl_name (col1,col2) VALUES(15,col1*2);
 				List: []*sqlast.Comment{
 					{
 						Text: "\ninsert\n",
-						From: sqltoken.NewPos(5, 1),
-						To:   sqltoken.NewPos(7, 3),
+						From: sqltoken.Pos{Line: 5, Col: 1, Offset: 31},
+						To:   sqltoken.Pos{Line: 7, Col: 3, Offset: 43},
 					},
 				},
 			},
@@ -115,8 +116,8 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "associate with stmts1",
-						From: sqltoken.NewPos(2, 1),
-						To:   sqltoken.NewPos(2, 26),
+						From: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
+						To:   sqltoken.Pos{Line: 2, Col: 26, Offset: 26},
 					},
 				},
 			},
@@ -124,10 +125,11 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "associate with stmts2",
-						From: sqltoken.NewPos(11, 4),
-						To:   sqltoken.NewPos(11, 27),
+						From: sqltoken.Pos{Line: 11, Col: 4, Offset: 368},
+						To:   sqltoken.Pos{Line: 11, Col: 27, Offset: 391},
 					},
 				},
+				Placement: sqlast.TrailingComment,
 			},
 		})
 
@@ -136,8 +138,8 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "associate with columndef",
-						From: sqltoken.NewPos(4, 5),
-						To:   sqltoken.NewPos(4, 33),
+						From: sqltoken.Pos{Line: 4, Col: 5, Offset: 48},
+						To:   sqltoken.Pos{Line: 4, Col: 33, Offset: 76},
 					},
 				},
 			},
@@ -145,10 +147,11 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "columndef",
-						From: sqltoken.NewPos(5, 27),
-						To:   sqltoken.NewPos(5, 38),
+						From: sqltoken.Pos{Line: 5, Col: 27, Offset: 103},
+						To:   sqltoken.Pos{Line: 5, Col: 38, Offset: 114},
 					},
 				},
+				Placement: sqlast.TrailingComment,
 			},
 		})
 
@@ -157,8 +160,8 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "with constraints",
-						From: sqltoken.NewPos(6, 5),
-						To:   sqltoken.NewPos(6, 25),
+						From: sqltoken.Pos{Line: 6, Col: 5, Offset: 116},
+						To:   sqltoken.Pos{Line: 6, Col: 25, Offset: 136},
 					},
 				},
 			},
@@ -169,10 +172,11 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "table constraints1",
-						From: sqltoken.NewPos(8, 60),
-						To:   sqltoken.NewPos(8, 80),
+						From: sqltoken.Pos{Line: 8, Col: 60, Offset: 274},
+						To:   sqltoken.Pos{Line: 8, Col: 80, Offset: 294},
 					},
 				},
+				Placement: sqlast.TrailingComment,
 			},
 		})
 
@@ -181,11 +185,158 @@ CREATE TABLE test (
 				List: []*sqlast.Comment{
 					{
 						Text: "table constraints2",
-						From: sqltoken.NewPos(9, 5),
-						To:   sqltoken.NewPos(9, 25),
+						From: sqltoken.Pos{Line: 9, Col: 5, Offset: 296},
+						To:   sqltoken.Pos{Line: 9, Col: 25, Offset: 316},
 					},
 				},
 			},
 		})
 	})
+
+	t.Run("create schema elements", func(t *testing.T) {
+
+		f := parseFile(t, `
+--schema
+CREATE SCHEMA sales
+	--table
+	CREATE TABLE orders (id int)
+	/*view*/
+	CREATE VIEW recent AS SELECT id FROM orders;
+`)
+
+		m := sqlast.NewCommentMap(f)
+		cs := f.Stmts[0].(*sqlast.CreateSchemaStmt)
+		compareComment(t, m[cs], []*sqlast.CommentGroup{
+			{
+				List: []*sqlast.Comment{
+					{
+						Text: "schema",
+						From: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
+						To:   sqltoken.Pos{Line: 2, Col: 9, Offset: 9},
+					},
+				},
+			},
+		})
+
+		compareComment(t, m[cs.Elements[0]], []*sqlast.CommentGroup{
+			{
+				List: []*sqlast.Comment{
+					{
+						Text: "table",
+						From: sqltoken.Pos{Line: 4, Col: 5, Offset: 31},
+						To:   sqltoken.Pos{Line: 4, Col: 12, Offset: 38},
+					},
+				},
+			},
+		})
+
+		compareComment(t, m[cs.Elements[1]], []*sqlast.CommentGroup{
+			{
+				List: []*sqlast.Comment{
+					{
+						Text: "view",
+						From: sqltoken.Pos{Line: 6, Col: 5, Offset: 70},
+						To:   sqltoken.Pos{Line: 6, Col: 13, Offset: 78},
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestCommentMap_Placement(t *testing.T) {
+	f := parseFile(t, `
+-- leading
+SELECT /* inline */ a FROM t; -- trailing
+`)
+
+	m := sqlast.NewCommentMap(f)
+	texts := func(list []*sqlast.CommentGroup) []string {
+		var texts []string
+		for _, c := range list {
+			texts = append(texts, c.ToSQLString())
+		}
+		return texts
+	}
+
+	var inline []string
+	for n := range m {
+		inline = append(inline, texts(m.Filter(n, sqlast.InlineComment))...)
+	}
+	cases := []struct {
+		placement sqlast.CommentPlacement
+		expect    []string
+	}{
+		{placement: sqlast.LeadingComment, expect: []string{" leading"}},
+		{placement: sqlast.TrailingComment, expect: []string{" trailing"}},
+	}
+	for _, c := range cases {
+		if diff := cmp.Diff(c.expect, texts(m.Filter(f.Stmts[0], c.placement))); diff != "" {
+			t.Errorf("diff of %d comments %s", c.placement, diff)
+		}
+	}
+	if diff := cmp.Diff([]string{" inline "}, inline); diff != "" {
+		t.Errorf("diff of inline comments %s", diff)
+	}
+}
+
+func TestCommentMap_Rewrite(t *testing.T) {
+	f := parseFile(t, `
+-- drop the old table
+DROP TABLE old; -- obsolete
+
+-- users
+CREATE TABLE users (
+    id int, -- identifier
+    name text
+);
+`)
+	m := sqlast.NewCommentMap(f)
+	drop, create := f.Stmts[0], f.Stmts[1].(*sqlast.CreateTableStmt)
+	id := create.Elements[0]
+
+	var detached []*sqlast.CommentGroup
+	sqlastutil.Apply(f, func(c *sqlastutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *sqlast.DropTableStmt:
+			detached = m.Detach(n)
+			c.Delete()
+		case *sqlast.ColumnDef:
+			if n == id {
+				def := &sqlast.ColumnDef{Name: sqlast.NewIdent("id"), DataType: &sqlast.BigInt{}}
+				c.Replace(m.Update(n, def))
+			}
+		}
+		return true
+	}, nil)
+
+	if len(detached) != 2 || m[drop] != nil {
+		t.Errorf("comments of DROP TABLE must be detached but %+v", detached)
+	}
+	newID := create.Elements[0]
+	if newID == id {
+		t.Fatal("column must be replaced")
+	}
+	if l := m.Filter(newID, sqlast.TrailingComment); len(l) != 1 || l[0].ToSQLString() != " identifier" {
+		t.Errorf("comment must be moved to the new column but %+v", m[newID])
+	}
+
+	index := &sqlast.CreateIndexStmt{
+		TableName: sqlast.NewObjectName("users"),
+		IndexName: sqlast.NewIdent("users_name"),
+		Columns:   []*sqlast.IndexElement{{Expr: sqlast.NewIdent("name")}},
+	}
+	f.Stmts = append(f.Stmts, index)
+	m.Attach(index, sqlast.LeadingComment, detached[0])
+	if l := m.Filter(index, sqlast.LeadingComment); len(l) != 1 || l[0] != detached[0] {
+		t.Errorf("comment must be attached to the index but %+v", m[index])
+	}
+
+	var texts []string
+	for _, c := range m.Comments() {
+		texts = append(texts, c.ToSQLString())
+	}
+	if diff := cmp.Diff([]string{" drop the old table", " users", " identifier"}, texts); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
 }
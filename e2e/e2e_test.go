@@ -48,6 +48,58 @@ func TestParseQuery(t *testing.T) {
 			name: "INSERT",
 			dir:  "insert",
 		},
+		{
+			name: "SCHEMA",
+			dir:  "schema",
+		},
+		{
+			name: "SEQUENCE",
+			dir:  "sequence",
+		},
+		{
+			name: "CREATE FUNCTION",
+			dir:  "create_function",
+		},
+		{
+			name: "TRIGGER",
+			dir:  "trigger",
+		},
+		{
+			name: "TRUNCATE",
+			dir:  "truncate",
+		},
+		{
+			name: "SESSION",
+			dir:  "session",
+		},
+		{
+			name: "COMMENT ON",
+			dir:  "comment_on",
+		},
+		{
+			name: "COPY",
+			dir:  "copy",
+		},
+		{
+			name: "VIEW",
+			dir:  "view",
+		},
+		{
+			name: "EXPLAIN",
+			dir:  "explain",
+		},
+		{
+			name: "PREPARE",
+			dir:  "prepare",
+		},
+		{
+			name: "MAINTENANCE",
+			dir:  "maintenance",
+		},
+		{
+			name: "CREATE TYPE",
+			dir:  "create_type",
+		},
 	}
 
 	for _, c := range cases {
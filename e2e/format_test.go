@@ -0,0 +1,121 @@
+package e2e_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestFormat(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.sql")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	options := map[string]sqlast.FormatOptions{
+		"default": {},
+		"narrow":  {Indent: "\t", MaxWidth: 20, KeywordCase: sqlast.KeywordCaseLower, CommaStyle: sqlast.LeadingComma},
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			fi, err := os.Open(file)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			defer fi.Close()
+			parser, err := xsqlparser.NewParser(fi, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			orig, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			for name, opts := range options {
+				formatted := sqlast.Format(orig, opts)
+				parser, err := xsqlparser.NewParser(bytes.NewBufferString(formatted), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				stmt, err := parser.ParseStatement()
+				if err != nil {
+					t.Log(formatted)
+					t.Fatalf("%s: %+v", name, err)
+				}
+				if diff := sqlastutil.Diff(orig, stmt); diff != "" {
+					t.Log(formatted)
+					t.Errorf("%s: should be same ast but diff:\n %s", name, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPrint_QuoteIdentifiers(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.sql")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	// identifiers are compared regardless of their quotes, but keywords such as FORMAT of
+	// EXPLAIN options, which the corpus writes in upper case, must not be quoted.
+	unquoted := cmp.Comparer(func(a, b *sqlast.Ident) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		if (a.QuoteStyle == 0) != (b.QuoteStyle == 0) {
+			word := a
+			if a.QuoteStyle != 0 {
+				word = b
+			}
+			if _, ok := dialect.Keywords[word.Value]; ok {
+				return false
+			}
+		}
+		return strings.Trim(a.Value, `"`) == strings.Trim(b.Value, `"`)
+	})
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			fi, err := os.Open(file)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			defer fi.Close()
+			parser, err := xsqlparser.NewParser(fi, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			orig, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			quoted := sqlast.PrintString(orig, sqlast.PrintOptions{QuoteIdentifiers: &dialect.GenericSQLDialect{}})
+			parser, err = xsqlparser.NewParser(bytes.NewBufferString(quoted), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Log(quoted)
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(orig, stmt, sqlastutil.IgnoreMarker, sqlastutil.IgnorePos, unquoted); diff != "" {
+				t.Log(quoted)
+				t.Errorf("should be same ast but diff:\n %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package e2e_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// TestOffset checks that positions of nodes have the offsets of the tokens at the same line and column.
+func TestOffset(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.sql")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	for _, file := range files {
+		t.Run(file, func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			src := string(b)
+
+			tokens, err := sqltoken.NewTokenizer(strings.NewReader(src), &dialect.GenericSQLDialect{}).Tokenize()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			offsets := make(map[sqltoken.Pos]int)
+			for _, tok := range tokens {
+				offsets[sqltoken.NewPos(tok.From.Line, tok.From.Col)] = tok.From.Offset
+				offsets[sqltoken.NewPos(tok.To.Line, tok.To.Col)] = tok.To.Offset
+			}
+
+			parser, err := xsqlparser.NewParser(strings.NewReader(src), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			sqlast.Inspect(stmt, func(node sqlast.Node) bool {
+				if node == nil {
+					return false
+				}
+				for _, pos := range []sqltoken.Pos{node.Pos(), node.End()} {
+					// some nodes don't have positions
+					if pos.Line == 0 {
+						continue
+					}
+					offset, ok := offsets[sqltoken.NewPos(pos.Line, pos.Col)]
+					if !ok {
+						t.Errorf("%T %s is not at a token boundary", node, pos.String())
+						continue
+					}
+					if pos.Offset != offset {
+						t.Errorf("offset of %T at %s must be %d but %d", node, pos.String(), offset, pos.Offset)
+					}
+				}
+				return true
+			})
+		})
+	}
+}
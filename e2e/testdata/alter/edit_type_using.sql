@@ -0,0 +1 @@
+ALTER TABLE test1 ALTER COLUMN price TYPE INTEGER USING price::integer;
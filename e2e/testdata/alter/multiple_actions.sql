@@ -0,0 +1 @@
+ALTER TABLE t ADD COLUMN a int NOT NULL, DROP COLUMN b CASCADE, ALTER COLUMN c SET NOT NULL, ADD CONSTRAINT uq UNIQUE (a), RENAME COLUMN d TO e;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE t MODIFY col bigint NOT NULL AFTER other, CHANGE COLUMN a b varchar(10) FIRST, MODIFY COLUMN c int;
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE measurement ATTACH PARTITION measurement_y2024 FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+ALTER TABLE cities_list ATTACH PARTITION cities_other DEFAULT;
+ALTER TABLE measurement DETACH PARTITION measurement_y2023;
+ALTER TABLE measurement DETACH PARTITION measurement_y2022 FINALIZE;
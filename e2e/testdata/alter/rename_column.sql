@@ -0,0 +1 @@
+ALTER TABLE products RENAME COLUMN product_no TO product_number;
\ No newline at end of file
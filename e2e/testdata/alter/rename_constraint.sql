@@ -0,0 +1 @@
+ALTER TABLE products RENAME CONSTRAINT products_pkey TO items_pkey;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE products RENAME TO items;
\ No newline at end of file
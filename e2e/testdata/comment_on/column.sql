@@ -0,0 +1 @@
+COMMENT ON COLUMN public.customers.name IS NULL;
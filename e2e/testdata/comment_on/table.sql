@@ -0,0 +1 @@
+COMMENT ON TABLE customers IS 'Customer master';
@@ -0,0 +1,4 @@
+COPY customers (id, name) FROM STDIN;
+1	john
+2	\N
+\.
@@ -0,0 +1,5 @@
+CREATE PROCEDURE insert_data(a integer, INOUT b integer)
+LANGUAGE SQL
+AS $$
+INSERT INTO tbl VALUES (a);
+$$;
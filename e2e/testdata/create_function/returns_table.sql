@@ -0,0 +1 @@
+CREATE FUNCTION active_users(IN since timestamp with time zone, OUT total bigint) RETURNS TABLE (id int, name varchar(255)) AS $body$ SELECT id, name FROM users $body$ LANGUAGE sql STABLE;
@@ -0,0 +1,4 @@
+CREATE OR REPLACE FUNCTION add(integer, b integer DEFAULT 1) RETURNS integer
+    LANGUAGE sql
+    IMMUTABLE STRICT
+    AS 'select $1 + b;';
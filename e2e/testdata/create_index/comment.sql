@@ -0,0 +1 @@
+CREATE INDEX idx_name ON customers (name) COMMENT 'lookup by name';
@@ -0,0 +1 @@
+CREATE INDEX users_name_idx ON users (last_name DESC NULLS LAST, first_name NULLS FIRST);
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS users_email_idx ON users USING btree (lower(email) DESC, name COLLATE "C" text_pattern_ops, (a + b)) INCLUDE (id, created_at) WHERE deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+CREATE TABLE grids (id int, cells int[3][3], tags text[], names varchar(10)[]);
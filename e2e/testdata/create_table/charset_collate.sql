@@ -0,0 +1,6 @@
+CREATE TABLE sessions (
+    id char(36) CHARACTER SET latin1 COLLATE latin1_bin NOT NULL,
+    name varchar(255) CHARSET utf8mb4,
+    body text COLLATE "C",
+    PRIMARY KEY (id)
+) ENGINE = InnoDB, DEFAULT CHARACTER SET = utf8mb4, COLLATE = utf8mb4_bin;
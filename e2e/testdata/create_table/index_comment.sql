@@ -0,0 +1,6 @@
+CREATE TABLE customers (
+  id int,
+  email varchar(255),
+  PRIMARY KEY (id) COMMENT 'surrogate key',
+  UNIQUE KEY (email) COMMENT 'login'
+);
@@ -0,0 +1,6 @@
+CREATE TABLE cities (name text, population float, elevation int);
+CREATE TABLE capitals (state char(2)) INHERITS (cities);
+CREATE TABLE measurement_y2024 PARTITION OF measurement FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+CREATE TABLE cities_ab PARTITION OF cities_list FOR VALUES IN ('a', 'b') PARTITION BY RANGE (population);
+CREATE TABLE orders_p0 PARTITION OF orders FOR VALUES WITH (MODULUS 4, REMAINDER 0);
+CREATE TABLE cities_other PARTITION OF cities_list DEFAULT;
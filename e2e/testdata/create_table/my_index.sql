@@ -0,0 +1 @@
+CREATE TABLE t (id int, name varchar(100), body text, geo geometry, KEY idx_name (name(10)) USING BTREE, UNIQUE KEY uq_name (name, id), FULLTEXT KEY ft (body), SPATIAL INDEX sp (geo), INDEX (id DESC), KEY idx_using USING HASH (id) COMMENT 'hash index')
@@ -0,0 +1 @@
+CREATE TABLE t (a tinyint(1) unsigned, b mediumint, c enum('small', 'large') CHARACTER SET utf8mb4, d set('x', 'y', 'z'), e json, f datetime(6), g datetime, h year, i tinytext, j mediumtext COLLATE utf8mb4_bin, k longtext, l blob, m blob(1024), n tinyblob, o mediumblob, p longblob)
@@ -0,0 +1,5 @@
+CREATE TEMPORARY TABLE tmp_sessions (id int, payload text);
+CREATE UNLOGGED TABLE cache (key text PRIMARY KEY, value text);
+CREATE TABLE measurement (city_id int NOT NULL, logdate date NOT NULL, peaktemp int) PARTITION BY RANGE (logdate);
+CREATE TABLE orders_archive (LIKE orders INCLUDING DEFAULTS INCLUDING CONSTRAINTS EXCLUDING INDEXES, archived_at timestamp) PARTITION BY LIST (archived_at);
+CREATE TABLE events (id int, tenant int) PARTITION BY HASH (tenant);
@@ -0,0 +1 @@
+CREATE TABLE t (a timestamp(6) NOT NULL, b timestamp(3) with time zone, c timestamp without time zone, d time(0), e time with time zone (2), f timestamp)
@@ -0,0 +1,4 @@
+CREATE TYPE address AS (
+    street varchar(80),
+    city text
+);
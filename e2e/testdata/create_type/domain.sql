@@ -0,0 +1,2 @@
+CREATE DOMAIN public.posint AS integer DEFAULT 1
+    CONSTRAINT posint_check CHECK (VALUE > 0);
@@ -0,0 +1 @@
+CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy');
@@ -0,0 +1 @@
+DROP INDEX title_idx ON films;
\ No newline at end of file
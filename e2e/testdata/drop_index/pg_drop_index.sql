@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS public.title_idx, title_idx2 CASCADE;
\ No newline at end of file
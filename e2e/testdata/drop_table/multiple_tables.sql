@@ -0,0 +1 @@
+DROP TABLE IF EXISTS a, b.c RESTRICT;
\ No newline at end of file
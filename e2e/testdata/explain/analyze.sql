@@ -0,0 +1,5 @@
+EXPLAIN ANALYZE VERBOSE
+SELECT c.name, count(*)
+FROM customers AS c
+    INNER JOIN orders AS o ON c.id = o.customer_id
+GROUP BY c.name;
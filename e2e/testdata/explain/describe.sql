@@ -0,0 +1 @@
+DESCRIBE customers;
@@ -0,0 +1,2 @@
+EXPLAIN FORMAT = TREE
+DELETE FROM orders WHERE created_at < '2020-01-01';
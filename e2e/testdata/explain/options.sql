@@ -0,0 +1,2 @@
+EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON)
+UPDATE orders SET status = 'shipped' WHERE id = 1;
@@ -0,0 +1 @@
+INSERT INTO customers (id, name) VALUES (1, 'john') ON CONFLICT ON CONSTRAINT customers_pkey DO NOTHING;
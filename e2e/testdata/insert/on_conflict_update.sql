@@ -0,0 +1,2 @@
+INSERT INTO customers (id, name) VALUES (1, 'john')
+ON CONFLICT (id) WHERE deleted_at IS NULL DO UPDATE SET name = excluded.name WHERE customers.locked = false;
@@ -0,0 +1 @@
+INSERT INTO customers (name) SELECT name FROM staging RETURNING id, name;
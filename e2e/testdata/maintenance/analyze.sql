@@ -0,0 +1 @@
+ANALYZE orders (amount);
@@ -0,0 +1 @@
+REINDEX TABLE CONCURRENTLY public.orders;
@@ -0,0 +1 @@
+VACUUM (ANALYZE, SKIP_LOCKED true) orders (customer_id, amount), customers;
@@ -0,0 +1 @@
+DEALLOCATE find_orders;
@@ -0,0 +1 @@
+EXECUTE find_orders (42, '2020-01-01');
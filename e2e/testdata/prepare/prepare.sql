@@ -0,0 +1,4 @@
+PREPARE find_orders (int, date) AS
+SELECT id, amount
+FROM orders
+WHERE customer_id = $1 AND created_at >= $2;
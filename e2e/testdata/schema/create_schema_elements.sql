@@ -0,0 +1 @@
+CREATE SCHEMA hollywood CREATE VIEW winners AS SELECT title, release FROM films WHERE awards IS NOT NULL CREATE TABLE films (title text, release date) CREATE INDEX films_title ON films (title)
@@ -0,0 +1 @@
+DROP SCHEMA IF EXISTS sales, archive CASCADE
@@ -0,0 +1,3 @@
+SELECT e.emp, e.dept FROM employees AS e (emp, dept, salary) WHERE e.salary > 1000;
+WITH totals (dept, total) AS (SELECT dept_id, sum(salary) FROM employees GROUP BY dept_id)
+SELECT t.dept, s.n FROM totals t, generate_series(1, 2) AS s(n);
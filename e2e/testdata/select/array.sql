@@ -0,0 +1 @@
+SELECT a[1], a[1:2], a[:3], b[1][2], ARRAY[1, 2, 3], ARRAY[[1, 2], [3, 4]], ARRAY[] FROM t WHERE tags::text[][] IS NOT NULL;
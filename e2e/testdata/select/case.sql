@@ -0,0 +1,3 @@
+SELECT id, CASE WHEN score >= 90 THEN 'A' WHEN score >= 70 THEN 'B' ELSE 'C' END AS grade
+FROM students
+WHERE CASE status WHEN 'active' THEN 1 ELSE 0 END = 1
@@ -0,0 +1 @@
+SELECT CASE WHEN a = 1 THEN 'x' END FROM t
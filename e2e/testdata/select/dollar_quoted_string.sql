@@ -0,0 +1 @@
+SELECT $$it's$$, $body$ SELECT 1; $body$ FROM users
@@ -0,0 +1 @@
+SELECT a.id FROM a INNER JOIN b ON a.id = b.a_id FOR NO KEY UPDATE OF a NOWAIT FOR KEY SHARE OF b;
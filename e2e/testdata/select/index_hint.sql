@@ -0,0 +1,5 @@
+SELECT o.id, o.total FROM orders o FORCE INDEX (idx_created_at)
+    WHERE o.created_at > '2024-01-01'
+    ORDER BY o.created_at;
+SELECT * FROM users USE KEY FOR GROUP BY (idx_country) IGNORE INDEX (idx_name) GROUP BY country;
+SELECT c.name FROM customers AS c WITH (NOLOCK) WHERE c.id = 1;
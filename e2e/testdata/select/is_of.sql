@@ -0,0 +1 @@
+SELECT * FROM customers WHERE info IS OF (jsonb, json) OR info IS NOT OF (text);
@@ -0,0 +1 @@
+SELECT data->'name', data->>'name', data#>'{a,b}', data#>>'{a,b}' FROM docs WHERE data @> '{"active": true}' AND data->'tags'->>0 = 'x';
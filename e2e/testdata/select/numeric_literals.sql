@@ -0,0 +1 @@
+SELECT 1_000_000, 1e10, 1.5E-3, 2e+2, 1.50, 99999999999999999999, 9223372036854775807 FROM t WHERE a > 1_000;
@@ -0,0 +1 @@
+SELECT name FROM users ORDER BY age OFFSET 5 ROWS FETCH FIRST 10 ROWS ONLY
@@ -0,0 +1 @@
+SELECT * FROM t WHERE a LIKE 'x!%%' ESCAPE '!' AND b NOT ILIKE 'foo%' AND c SIMILAR TO '(a|b)%' AND d NOT SIMILAR TO 'z%' ESCAPE '#' AND e ~ '^a' AND f ~* 'b$' AND g !~ 'c' AND h !~* 'd' AND i ILIKE 'x';
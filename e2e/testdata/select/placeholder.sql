@@ -0,0 +1 @@
+SELECT id, name FROM users WHERE id = ? AND name = :name AND age > $2
@@ -0,0 +1,3 @@
+SELECT id, name, ROW_NUMBER() OVER (PARTITION BY name ORDER BY id) AS rn
+FROM users
+QUALIFY rn = 1
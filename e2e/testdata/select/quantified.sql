@@ -0,0 +1 @@
+SELECT * FROM t WHERE a = ANY (SELECT id FROM u) AND b > ALL (ARRAY[1, 2]) AND c <> SOME (VALUES (1), (2)) AND d = ANY (tags);
@@ -0,0 +1,6 @@
+(SELECT id FROM customers ORDER BY created_at DESC LIMIT 10)
+UNION
+(SELECT id FROM vip_customers ORDER BY id);
+SELECT name FROM a UNION DISTINCT SELECT name FROM b EXCEPT DISTINCT SELECT name FROM c;
+(SELECT 1 UNION SELECT 2) INTERSECT ALL SELECT 2 ORDER BY 1;
+SELECT x FROM ((SELECT 1 AS x) UNION ALL (SELECT 2)) AS u;
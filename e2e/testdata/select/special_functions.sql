@@ -0,0 +1 @@
+SELECT EXTRACT(YEAR FROM created_at), POSITION('b' IN name), SUBSTRING(name FROM 2 FOR 3), SUBSTRING(name FOR 3), SUBSTRING(name, 1, 2), TRIM(BOTH ' ' FROM name), TRIM(LEADING FROM name), TRIM('x' FROM name), TRIM(name), OVERLAY(name PLACING 'xx' FROM 2 FOR 2) FROM users WHERE EXTRACT(EPOCH FROM now() - created_at) > 3600;
@@ -0,0 +1 @@
+TABLE public.films UNION ALL TABLE archived_films ORDER BY 1 LIMIT 10;
@@ -0,0 +1,6 @@
+SELECT d.id, e.key, e.value FROM documents d
+    CROSS JOIN LATERAL jsonb_each_text(d.body) AS e(key, value)
+    WHERE e.key <> 'id';
+SELECT u.elem, u.n FROM unnest(ARRAY['a', 'b']) WITH ORDINALITY AS u(elem, n);
+SELECT * FROM ROWS FROM (generate_series(1, 3), unnest(ARRAY[10, 20])) WITH ORDINALITY AS r(a, b, n);
+SELECT v.x, v.y FROM (VALUES (1, 2), (3, 4)) AS v(x, y);
@@ -0,0 +1 @@
+SELECT DATE '2020-01-01', TIME '12:00', TIME '12:00:01.5', TIMESTAMP '2020-01-01 00:00:00', TIMESTAMP '2020-01-01 09:00:00+09', E'foo\n\'bar', X'deadbeef', x'00', B'0101' FROM t WHERE created_at >= DATE '2020-01-01' AND b = b'1';
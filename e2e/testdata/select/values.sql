@@ -0,0 +1 @@
+VALUES (1, 'a'), (2, 'b') UNION SELECT id, name FROM t;
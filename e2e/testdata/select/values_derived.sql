@@ -0,0 +1 @@
+SELECT v.a FROM (VALUES (1), (2)) AS v WHERE v.a IN (VALUES (1)) ORDER BY 1;
@@ -0,0 +1,2 @@
+WITH RECURSIVE t(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 100)
+SELECT sum(n) FROM t;
@@ -0,0 +1 @@
+ALTER SEQUENCE public.account_account_id_seq OWNED BY public.account.account_id;
@@ -0,0 +1 @@
+ALTER SEQUENCE IF EXISTS serial INCREMENT -2 MINVALUE -100 MAXVALUE 100 RESTART WITH 10 CYCLE;
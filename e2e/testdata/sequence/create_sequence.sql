@@ -0,0 +1,7 @@
+CREATE SEQUENCE public.account_account_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS serial, serial2 CASCADE;
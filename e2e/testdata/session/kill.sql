@@ -0,0 +1 @@
+KILL CONNECTION 12;
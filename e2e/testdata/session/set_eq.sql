@@ -0,0 +1 @@
+SET SESSION sql_mode = 'STRICT_ALL_TABLES';
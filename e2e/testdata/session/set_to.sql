@@ -0,0 +1 @@
+SET search_path TO sales, public;
@@ -0,0 +1 @@
+SHOW ERRORS LIMIT 3;
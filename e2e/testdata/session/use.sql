@@ -0,0 +1 @@
+USE sales;
@@ -0,0 +1 @@
+CREATE TRIGGER update_updated_at BEFORE UPDATE ON public.accounts FOR EACH ROW EXECUTE FUNCTION public.update_updated_at();
@@ -0,0 +1 @@
+CREATE TRIGGER view_insert INSTEAD OF INSERT ON my_view FOR EACH STATEMENT EXECUTE FUNCTION view_insert_row();
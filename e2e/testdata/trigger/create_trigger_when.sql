@@ -0,0 +1,5 @@
+CREATE TRIGGER check_update
+    AFTER INSERT OR UPDATE OF balance, status OR DELETE ON accounts
+    FOR EACH ROW
+    WHEN (OLD.balance <> NEW.balance)
+    EXECUTE PROCEDURE check_account_update('balance', 1);
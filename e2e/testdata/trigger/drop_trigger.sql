@@ -0,0 +1 @@
+DROP TRIGGER IF EXISTS check_update ON accounts CASCADE;
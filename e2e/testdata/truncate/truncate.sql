@@ -0,0 +1 @@
+TRUNCATE TABLE accounts, sessions RESTART IDENTITY CASCADE;
@@ -0,0 +1 @@
+truncate logs;
@@ -0,0 +1 @@
+ALTER VIEW v (a) AS SELECT x FROM t;
\ No newline at end of file
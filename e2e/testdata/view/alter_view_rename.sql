@@ -0,0 +1 @@
+ALTER VIEW v RENAME TO v2;
\ No newline at end of file
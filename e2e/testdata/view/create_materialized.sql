@@ -0,0 +1 @@
+CREATE MATERIALIZED VIEW IF NOT EXISTS mv AS SELECT x FROM t;
\ No newline at end of file
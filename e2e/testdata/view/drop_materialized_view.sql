@@ -0,0 +1 @@
+DROP MATERIALIZED VIEW mv;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP VIEW IF EXISTS v, v2 CASCADE;
\ No newline at end of file
@@ -0,0 +1,75 @@
+package xsqlparser
+
+import (
+	"fmt"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// ErrUnsupportedFeature is matched by errors.Is for every *UnsupportedFeatureError.
+var ErrUnsupportedFeature = errors.New("unsupported feature")
+
+// UnsupportedFeatureError is returned when the parser recognizes a syntax
+// which it doesn't support yet.
+type UnsupportedFeatureError struct {
+	Feature string
+	Pos     sqltoken.Pos
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("unsupported feature %s at %s", e.Feature, e.Pos.String())
+}
+
+func (e *UnsupportedFeatureError) Is(target error) bool {
+	return target == ErrUnsupportedFeature
+}
+
+func unsupported(feature string, pos sqltoken.Pos) error {
+	return &UnsupportedFeatureError{Feature: feature, Pos: pos}
+}
+
+// ParseError is returned when the source can't be tokenized.
+// Pos points at the beginning of the offending token, e.g. the opening quote of an unclosed string.
+// It is also returned instead of a panic of the parser by the entry points which recover it,
+// with Pos at the token where parsing stopped.
+type ParseError struct {
+	Pos sqltoken.Pos
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error at %s: %s", e.Pos.String(), e.Err.Error())
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// recoverParseError recovers a panic of the parser and sets a *ParseError to err.
+// It must be deferred directly, e.g. defer p.recoverParseError(&err).
+func (p *Parser) recoverParseError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	var pos sqltoken.Pos
+	if i := int(p.index); i < len(p.tokens) {
+		pos = p.tokens[i].From
+	} else if len(p.tokens) > 0 {
+		pos = p.tokens[len(p.tokens)-1].To
+	}
+	*err = &ParseError{Pos: pos, Err: errors.Errorf("parse panicked: %v", r)}
+}
+
+// statements which are known SQL but not supported by the parser
+var unsupportedStatements = map[string]string{
+	"BEGIN":    "BEGIN statement",
+	"CALL":     "CALL statement",
+	"COMMIT":   "COMMIT statement",
+	"GRANT":    "GRANT statement",
+	"MERGE":    "MERGE statement",
+	"REVOKE":   "REVOKE statement",
+	"ROLLBACK": "ROLLBACK statement",
+}
@@ -0,0 +1,30 @@
+package xsqlparser
+
+import (
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// ParseExpr parses src as a standalone expression, e.g. the body of CHECK or a filter condition
+// like `age >= 20 AND name LIKE 'a%'`. It is an error if src has tokens after the expression.
+// Malformed src results in an error, never a panic.
+func ParseExpr(src string, dialect dialect.Dialect) (node sqlast.Node, err error) {
+	parser, err := NewParser(strings.NewReader(src), dialect)
+	if err != nil {
+		return nil, errors.Errorf("NewParser failed: %w", err)
+	}
+	defer parser.recoverParseError(&err)
+
+	expr, err := parser.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	if tok, err := parser.peekToken(); err != EOF {
+		return nil, errors.Errorf("unexpected token %+v after expression", tok)
+	}
+	return expr, nil
+}
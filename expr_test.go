@@ -0,0 +1,60 @@
+package xsqlparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+func TestParseExpr(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		out  string
+	}{
+		{name: "condition", src: "age >= 20 AND name LIKE 'a%'", out: "age >= 20 AND name LIKE 'a%'"},
+		{name: "check body", src: "(price > 0)", out: "(price > 0)"},
+		{name: "function", src: "lower(email)", out: "lower(email)"},
+		{name: "comment", src: "a = 1 -- comment\n", out: "a = 1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			expr, err := ParseExpr(c.src, &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act := expr.ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+
+	t.Run("node", func(t *testing.T) {
+		expr, err := ParseExpr("a + 1", &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, ok := expr.(*sqlast.BinaryExpr); !ok {
+			t.Errorf("must be *sqlast.BinaryExpr but %T", expr)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for _, src := range []string{"", "a = 1 b", "a = 1;", "a ="} {
+			if _, err := ParseExpr(src, &dialect.GenericSQLDialect{}); err == nil {
+				t.Errorf("%q must be an error", src)
+			}
+		}
+
+		for _, src := range []string{"name = 'a", ".5"} {
+			_, err := ParseExpr(src, &dialect.GenericSQLDialect{})
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Errorf("%q must be ParseError but %+v", src, err)
+			}
+		}
+	})
+}
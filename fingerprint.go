@@ -0,0 +1,80 @@
+package xsqlparser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// Normalize parses sql and returns it in a canonical form: statements are written as
+// ToSQLString does and joined by "; ", literals are replaced by `?` as sqlastutil.Parameterize does,
+// placeholders are written as `?`, and unquoted identifiers are lowercased.
+// Queries which differ only in whitespace, comments, letter case and literal values
+// have the same normalized SQL. Malformed sql results in an error, never a panic.
+func Normalize(sql string, d dialect.Dialect) (normalized string, err error) {
+	parser, err := NewParser(strings.NewReader(sql), d)
+	if err != nil {
+		return "", errors.Errorf("NewParser failed: %w", err)
+	}
+	defer parser.recoverParseError(&err)
+
+	var b strings.Builder
+	for {
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			return "", errors.Errorf("ParseStatement failed: %w", err)
+		}
+		if b.Len() > 0 {
+			b.WriteString("; ")
+		}
+		p := sqlastutil.Parameterize(stmt)
+		if _, err := sqlast.WriteWithHook(&b, p.Stmt, normalizeHook); err != nil {
+			return "", errors.Errorf("write normalized statement failed: %w", err)
+		}
+
+		// the semicolon after the last statement is optional
+		ok, _ := parser.consumeToken(sqltoken.Semicolon)
+		if _, err := parser.peekToken(); err == EOF {
+			break
+		}
+		if !ok {
+			tok, _ := parser.peekToken()
+			return "", errors.Errorf("expect semicolon but %+v", tok)
+		}
+	}
+	return b.String(), nil
+}
+
+func normalizeHook(sw *sqlast.SQLWriter, n sqlast.Node) bool {
+	switch n := n.(type) {
+	case *sqlast.Placeholder:
+		sw.Bytes([]byte("?"))
+		return true
+	case *sqlast.Ident:
+		if n.QuoteStyle != 0 {
+			return false
+		}
+		sw.Bytes([]byte(strings.ToLower(n.Value)))
+		return true
+	}
+	return false
+}
+
+// Fingerprint returns the hex encoded SHA-256 digest of the normalized sql.
+// It is stable across versions as long as Normalize returns the same SQL,
+// and can be used to group similar queries as pg_stat_statements does.
+func Fingerprint(sql string, d dialect.Dialect) (string, error) {
+	normalized, err := Normalize(sql, d)
+	if err != nil {
+		return "", errors.Errorf("Normalize failed: %w", err)
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:]), nil
+}
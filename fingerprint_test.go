@@ -0,0 +1,79 @@
+package xsqlparser
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  string
+	}{
+		{
+			name: "literals",
+			in:   "SELECT a FROM t WHERE b = 'x' AND c IN (1, 2.5) AND d IS NULL AND e = $1 LIMIT 10",
+			out:  "SELECT a FROM t WHERE b = ? AND c IN (?, ?) AND d IS NULL AND e = ? LIMIT ?",
+		},
+		{
+			name: "case and whitespace",
+			in:   "select  Count(*)\n  from \"Users\" u -- comment\n where U.Age > 20",
+			out:  `SELECT count(*) FROM "Users" AS u WHERE u.age > ?`,
+		},
+		{
+			name: "multiple statements",
+			in:   "INSERT INTO t (a) VALUES (1, NULL); DELETE FROM t WHERE a = 2;",
+			out:  "INSERT INTO t (a) VALUES (?, NULL); DELETE FROM t WHERE a = ?",
+		},
+		{
+			name: "case",
+			in:   "SELECT CASE WHEN a = 1 THEN 'x' ELSE 'y' END FROM t",
+			out:  "SELECT CASE WHEN a = ? THEN ? ELSE ? END FROM t",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			act, err := Normalize(c.in, &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act != c.out {
+				t.Errorf("should be\n%s\nbut\n%s", c.out, act)
+			}
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	fingerprint := func(sql string) string {
+		t.Helper()
+		f, err := Fingerprint(sql, &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return f
+	}
+
+	a := fingerprint("SELECT name FROM users WHERE id = 1")
+	if len(a) != 64 {
+		t.Errorf("must be a hex encoded SHA-256 but %s", a)
+	}
+	if b := fingerprint("select NAME\nfrom USERS where ID = 42"); a != b {
+		t.Errorf("similar queries must have the same fingerprint but %s and %s", a, b)
+	}
+	if c := fingerprint("SELECT name FROM users WHERE age = 1"); a == c {
+		t.Error("different queries must have different fingerprints")
+	}
+	if d, e := fingerprint("SELECT a FROM t LIMIT 10 OFFSET 5"), fingerprint("SELECT a FROM t LIMIT 20 OFFSET 0"); d != e {
+		t.Errorf("queries which differ only in LIMIT must have the same fingerprint but %s and %s", d, e)
+	}
+
+	for _, src := range []string{"SELECT a FROM t WHERE", "SELECT .5"} {
+		if _, err := Fingerprint(src, &dialect.GenericSQLDialect{}); err == nil {
+			t.Errorf("%q must be error", src)
+		}
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"math/big"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	errors "golang.org/x/xerrors"
 
@@ -20,6 +22,13 @@ type Parser struct {
 	index        uint
 	comments     map[sqltoken.Pos]*sqlast.CommentGroup
 	parseComment bool
+	dialect      dialect.Dialect
+	tokenizer    *sqltoken.Tokenizer
+	stream       bool              // tokens are read lazily by Next
+	pool         []*sqltoken.Token // tokens allocated by tokenize, reused after Reset
+	candidates   *candidates       // non-nil if tokens tried at the end of the source are collected
+
+	maxInListItems int
 }
 
 type ParserOption func(*Parser)
@@ -31,24 +40,88 @@ func ParseComment() ParserOption {
 	}
 }
 
-func NewParser(src io.Reader, dialect dialect.Dialect, opts ...ParserOption) (*Parser, error) {
-	tokenizer := sqltoken.NewTokenizer(src, dialect)
-	set, err := tokenizer.Tokenize()
-	if err != nil {
-		return nil, errors.Errorf("tokenize err failed: %w", err)
+// MaxInListItems limits the number of items stored in sqlast.InList.List to n.
+// The following items are parsed but only counted in InList.Elided,
+// which keeps memory usage low for huge IN lists in query logs.
+func MaxInListItems(n int) ParserOption {
+	return func(p *Parser) {
+		p.maxInListItems = n
 	}
+}
 
-	parser := &Parser{tokens: set, index: 0}
+func NewParser(src io.Reader, dialect dialect.Dialect, opts ...ParserOption) (*Parser, error) {
+	parser := &Parser{index: 0, dialect: dialect}
 
 	for _, o := range opts {
 		o(parser)
 	}
 
+	if err := parser.tokenize(src); err != nil {
+		return nil, err
+	}
+
 	return parser, nil
 }
 
+// Reset makes p parse src, reusing the tokenizer and the tokens allocated for the previous source.
+// Nodes returned before Reset remain valid.
+// Parsers created by NewStreamParser read src lazily after Reset too.
+func (p *Parser) Reset(src io.Reader) error {
+	p.index = 0
+	for pos := range p.comments {
+		delete(p.comments, pos)
+	}
+	if p.stream {
+		p.tokenizer.Reset(src)
+		p.tokens = p.tokens[:0]
+		return nil
+	}
+	return p.tokenize(src)
+}
+
+// tokenize reads all tokens of src.
+func (p *Parser) tokenize(src io.Reader) error {
+	if p.tokenizer == nil {
+		p.tokenizer = sqltoken.NewTokenizer(src, p.dialect)
+	} else {
+		p.tokenizer.Reset(src)
+	}
+	p.tokens = p.tokens[:0]
+
+	for {
+		if len(p.tokens) == len(p.pool) {
+			p.growPool()
+		}
+		tok, err := p.tokenizer.Scan(p.pool[len(p.tokens)])
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if tok != nil && tok.Kind == sqltoken.ILLEGAL {
+				return &ParseError{Pos: tok.From, Err: err}
+			}
+			return errors.Errorf("tokenize err failed: %w", err)
+		}
+		if tok != nil {
+			p.tokens = append(p.tokens, tok)
+		}
+	}
+}
+
+// growPool allocates tokens in a block to reduce allocations.
+func (p *Parser) growPool() {
+	n := len(p.pool)
+	if n < 64 {
+		n = 64
+	}
+	block := make([]sqltoken.Token, n)
+	for i := range block {
+		p.pool = append(p.pool, &block[i])
+	}
+}
+
 func NewParserWithOptions(opts ...ParserOption) *Parser {
-	parser := &Parser{index: 0}
+	parser := &Parser{index: 0, dialect: &dialect.GenericSQLDialect{}}
 	for _, o := range opts {
 		o(parser)
 	}
@@ -85,6 +158,19 @@ func (p *Parser) ParseFile() (*sqlast.File, error) {
 
 func (p *Parser) ParseSQL() ([]sqlast.Stmt, error) {
 	var stmts []sqlast.Stmt
+	if p.stream {
+		for {
+			stmt, err := p.Next()
+			if err == io.EOF {
+				return stmts, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			stmts = append(stmts, stmt)
+		}
+	}
+
 	var expectingDelimiter bool
 
 	for {
@@ -120,6 +206,10 @@ func (p *Parser) ParseSQL() ([]sqlast.Stmt, error) {
 		}
 		stmts = append(stmts, stmt)
 		expectingDelimiter = true
+		// inline data of COPY follows the delimiter
+		if c, ok := stmt.(*sqlast.CopyStmt); ok && c.Data != nil {
+			expectingDelimiter = false
+		}
 
 	}
 
@@ -129,15 +219,21 @@ func (p *Parser) ParseSQL() ([]sqlast.Stmt, error) {
 func (p *Parser) ParseStatement() (sqlast.Stmt, error) {
 	tok, err := p.nextToken()
 	if err != nil {
+		p.candidates.addKeywords(statementKeywords...)
 		return nil, err
 	}
+	// parenthesized query i.e: (SELECT ...) UNION (SELECT ...)
+	if tok.Kind == sqltoken.LParen {
+		p.prevToken()
+		return p.parseQuery()
+	}
 	word, ok := tok.Value.(*sqltoken.SQLWord)
 	if !ok {
 		return nil, errors.Errorf("a keyword at the beginning of statement %s", tok.Value)
 	}
 
 	switch word.Keyword {
-	case "SELECT", "WITH":
+	case "SELECT", "WITH", "VALUES", "TABLE":
 		p.prevToken()
 		return p.parseQuery()
 	case "CREATE":
@@ -158,20 +254,93 @@ func (p *Parser) ParseStatement() (sqlast.Stmt, error) {
 	case "DROP":
 		p.prevToken()
 		return p.parseDrop()
-	case "EXPLAIN":
-		stmt, err := p.ParseStatement()
-		if err != nil {
-			return nil, err
-		}
-		return &sqlast.ExplainStmt{Stmt: stmt}, nil
+	case "TRUNCATE":
+		p.prevToken()
+		return p.parseTruncate()
+	case "SET":
+		p.prevToken()
+		return p.parseSetVariable()
+	case "SHOW":
+		p.prevToken()
+		return p.parseShow()
+	case "USE":
+		p.prevToken()
+		return p.parseUse()
+	case "KILL":
+		p.prevToken()
+		return p.parseKill()
+	case "COMMENT":
+		p.prevToken()
+		return p.parseCommentOn()
+	case "COPY":
+		p.prevToken()
+		return p.parseCopy()
+	case "EXPLAIN", "DESCRIBE", "DESC":
+		p.prevToken()
+		return p.parseExplain()
+	case "PREPARE":
+		p.prevToken()
+		return p.parsePrepare()
+	case "EXECUTE":
+		p.prevToken()
+		return p.parseExecute()
+	case "DEALLOCATE":
+		p.prevToken()
+		return p.parseDeallocate()
+	case "VACUUM":
+		p.prevToken()
+		return p.parseVacuum()
+	case "ANALYZE":
+		p.prevToken()
+		return p.parseAnalyze()
+	case "REINDEX":
+		p.prevToken()
+		return p.parseReindex()
 	default:
-		return nil, errors.Errorf("unexpected (or unsupported) keyword %s", word.Keyword)
+		if feature, ok := unsupportedStatements[word.Keyword]; ok {
+			return nil, unsupported(feature, tok.From)
+		}
+		return nil, errors.Errorf("unexpected keyword %s", word.Keyword)
 	}
 }
 
 func (p *Parser) ParseDataType() (sqlast.Type, error) {
+	tp, err := p.parseElementDataType()
+	if err != nil {
+		return nil, err
+	}
+
+	// array types i.e: int[], int[3][3]
+	for {
+		if ok, _ := p.consumeToken(sqltoken.LBracket); !ok {
+			break
+		}
+		var size *uint
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Number {
+			i, _, err := p.parseLiteralInt()
+			if err != nil {
+				return nil, errors.Errorf("invalid array size: %w", err)
+			}
+			size = sqlast.NewSize(uint(i))
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RBracket {
+			return nil, errors.Errorf("expected RBracket but %+v", r)
+		}
+		tp = &sqlast.Array{
+			Ty:     tp,
+			Size:   size,
+			RParen: r.To,
+		}
+	}
+
+	return tp, nil
+}
+
+func (p *Parser) parseElementDataType() (sqlast.Type, error) {
 	tok, err := p.nextToken()
 	if err != nil {
+		p.candidates.addTokens(sqltoken.SQLKeyword)
 		return nil, errors.Errorf("nextToken failed: %w", err)
 	}
 	word, ok := tok.Value.(*sqltoken.SQLWord)
@@ -191,76 +360,171 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 			return nil, errors.Errorf("parsePrecision failed: %w", err)
 		}
 		unsigned, pos := p.parseMyUnsigned()
-		return &sqlast.Float{Size: size, From: tok.From, To: tok.To, RParen: r, IsUnsigned: unsigned, Unsigned: pos}, nil
+		zerofill, zpos := p.parseMyZerofill()
+		return &sqlast.Float{Size: size, From: tok.From, To: tok.To, RParen: r, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
 	case "REAL":
 		unsigned, pos := p.parseMyUnsigned()
-		return &sqlast.Real{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: pos}, nil
+		zerofill, zpos := p.parseMyZerofill()
+		return &sqlast.Real{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
 	case "DOUBLE":
 		p := p.expectKeyword("PRECISION")
 		return &sqlast.Double{From: tok.From, To: p.To}, nil
-	case "SMALLINT":
-		unsigned, pos := p.parseMyUnsigned()
-		return &sqlast.SmallInt{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: pos}, nil
-	case "INTEGER", "INT":
+	case "TINYINT", "SMALLINT", "MEDIUMINT", "INTEGER", "INT", "BIGINT":
+		width, r, err := p.parseMyDisplayWidth()
+		if err != nil {
+			return nil, errors.Errorf("parseMyDisplayWidth failed: %w", err)
+		}
 		unsigned, pos := p.parseMyUnsigned()
-		return &sqlast.Int{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: pos}, nil
-	case "BIGINT":
-		unsigned, u, _ := p.parseKeyword("UNSIGNED")
-		return &sqlast.BigInt{From: tok.From, To: tok.To, IsUnsigned: unsigned, Unsigned: u.To}, nil
+		zerofill, zpos := p.parseMyZerofill()
+		switch word.Keyword {
+		case "TINYINT":
+			return &sqlast.TinyInt{From: tok.From, To: tok.To, Width: width, RParen: r, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
+		case "SMALLINT":
+			return &sqlast.SmallInt{From: tok.From, To: tok.To, Width: width, RParen: r, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
+		case "MEDIUMINT":
+			return &sqlast.MediumInt{From: tok.From, To: tok.To, Width: width, RParen: r, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
+		case "BIGINT":
+			return &sqlast.BigInt{From: tok.From, To: tok.To, Width: width, RParen: r, IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
+		default:
+			return &sqlast.Int{From: tok.From, To: tok.To, Width: width, RParen: r, IsInteger: word.Keyword == "INTEGER", IsUnsigned: unsigned, Unsigned: pos, IsZerofill: zerofill, Zerofill: zpos}, nil
+		}
+	case "ENUM", "SET":
+		values, r, err := p.parseMyEnumValues()
+		if err != nil {
+			return nil, errors.Errorf("parseMyEnumValues failed: %w", err)
+		}
+		cc, err := p.parseCharsetCollation()
+		if err != nil {
+			return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
+		}
+		if word.Keyword == "ENUM" {
+			return &sqlast.Enum{From: tok.From, Values: values, RParen: r, CharsetCollation: cc}, nil
+		}
+		return &sqlast.Set{From: tok.From, Values: values, RParen: r, CharsetCollation: cc}, nil
+	case "JSON":
+		return &sqlast.JSON{From: tok.From, To: tok.To}, nil
+	case "DATETIME":
+		precision, r, err := p.parseOptionalPrecision()
+		if err != nil {
+			return nil, errors.Errorf("parsePrecision failed: %w", err)
+		}
+		return &sqlast.DateTime{From: tok.From, To: tok.To, Precision: precision, RParen: r}, nil
+	case "YEAR":
+		return &sqlast.Year{From: tok.From, To: tok.To}, nil
+	case "TINYTEXT", "MEDIUMTEXT", "LONGTEXT":
+		cc, err := p.parseCharsetCollation()
+		if err != nil {
+			return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
+		}
+		switch word.Keyword {
+		case "TINYTEXT":
+			return &sqlast.TinyText{From: tok.From, To: tok.To, CharsetCollation: cc}, nil
+		case "MEDIUMTEXT":
+			return &sqlast.MediumText{From: tok.From, To: tok.To, CharsetCollation: cc}, nil
+		default:
+			return &sqlast.LongText{From: tok.From, To: tok.To, CharsetCollation: cc}, nil
+		}
+	case "BLOB":
+		size, r, err := p.parseOptionalPrecision()
+		if err != nil {
+			return nil, errors.Errorf("parsePrecision failed: %w", err)
+		}
+		if size == nil {
+			return &sqlast.Blob{Blob: tok.From, RParen: tok.To}, nil
+		}
+		return &sqlast.Blob{Size: *size, Blob: tok.From, RParen: r}, nil
+	case "TINYBLOB":
+		return &sqlast.TinyBlob{From: tok.From, To: tok.To}, nil
+	case "MEDIUMBLOB":
+		return &sqlast.MediumBlob{From: tok.From, To: tok.To}, nil
+	case "LONGBLOB":
+		return &sqlast.LongBlob{From: tok.From, To: tok.To}, nil
+	case "SMALLSERIAL":
+		return &sqlast.SmallSerial{From: tok.From, To: tok.To}, nil
+	case "SERIAL":
+		return &sqlast.Serial{From: tok.From, To: tok.To}, nil
+	case "BIGSERIAL":
+		return &sqlast.BigSerial{From: tok.From, To: tok.To}, nil
 	case "VARCHAR":
-		p, r, err := p.parseOptionalPrecision()
+		size, max, r, err := p.parseOptionalPrecisionOrMax()
 		if err != nil {
 			return nil, errors.Errorf("parsePrecision failed: %w", err)
 
 		}
+		cc, err := p.parseCharsetCollation()
+		if err != nil {
+			return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
+		}
 		// FIXME Character
-		return &sqlast.VarcharType{Size: p, RParen: r, Character: tok.From}, nil
+		return &sqlast.VarcharType{Size: size, IsMax: max, RParen: r, Character: tok.From, CharsetCollation: cc}, nil
+	case "NVARCHAR":
+		p, max, r, err := p.parseOptionalPrecisionOrMax()
+		if err != nil {
+			return nil, errors.Errorf("parsePrecision failed: %w", err)
+		}
+		return &sqlast.NVarcharType{Size: p, IsMax: max, From: tok.From, To: tok.To, RParen: r}, nil
 	case "CHAR", "CHARACTER":
 		if ok, v, _ := p.parseKeyword("VARYING"); ok {
-			p, r, err := p.parseOptionalPrecision()
+			size, r, err := p.parseOptionalPrecision()
 			if err != nil {
 				return nil, errors.Errorf("parsePrecision failed: %w", err)
 			}
-			return &sqlast.VarcharType{Size: p, Character: tok.From, Varying: v.To, RParen: r}, nil
+			cc, err := p.parseCharsetCollation()
+			if err != nil {
+				return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
+			}
+			return &sqlast.VarcharType{Size: size, Character: tok.From, Varying: v.To, RParen: r, CharsetCollation: cc}, nil
 		}
-		p, r, err := p.parseOptionalPrecision()
+		size, r, err := p.parseOptionalPrecision()
 		if err != nil {
 			return nil, errors.Errorf("parsePrecision failed: %w", err)
 		}
-		return &sqlast.CharType{Size: p, From: tok.From, To: tok.To, RParen: r}, nil
+		cc, err := p.parseCharsetCollation()
+		if err != nil {
+			return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
+		}
+		return &sqlast.CharType{Size: size, From: tok.From, To: tok.To, RParen: r, CharsetCollation: cc}, nil
 	case "UUID":
 		return &sqlast.UUID{From: tok.From, To: tok.To}, nil
 	case "DATE":
 		return &sqlast.Date{}, nil
 	case "TIMESTAMP":
-		wok, _, _ := p.parseKeyword("WITH")
-		ook, _, _ := p.parseKeyword("WITHOUT")
-		if wok || ook {
-			p.expectKeyword("TIME")
-			p.expectKeyword("ZONE")
+		precision, r, withTimeZone, zone, err := p.parseTimePrecisionAndZone()
+		if err != nil {
+			return nil, errors.Errorf("parseTimePrecisionAndZone failed: %w", err)
 		}
 		return &sqlast.Timestamp{
 			Timestamp:    tok.From,
-			WithTimeZone: wok,
+			WithTimeZone: withTimeZone,
+			Zone:         zone,
+			Precision:    precision,
+			RParen:       r,
 		}, nil
 	case "TIME":
-		wok, _, _ := p.parseKeyword("WITH")
-		ook, _, _ := p.parseKeyword("WITHOUT")
-		if wok || ook {
-			p.expectKeyword("TIME")
-			p.expectKeyword("ZONE")
-		}
-		return &sqlast.Time{}, nil
+		precision, r, withTimeZone, zone, err := p.parseTimePrecisionAndZone()
+		if err != nil {
+			return nil, errors.Errorf("parseTimePrecisionAndZone failed: %w", err)
+		}
+		return &sqlast.Time{
+			From:         tok.From,
+			To:           tok.To,
+			WithTimeZone: withTimeZone,
+			Zone:         zone,
+			Precision:    precision,
+			RParen:       r,
+		}, nil
 	case "REGCLASS":
 		return &sqlast.Regclass{}, nil
 	case "TEXT":
-		if ok, _ := p.consumeToken(sqltoken.LBracket); ok {
-			p.expectToken(sqltoken.RBracket)
-			return &sqlast.Array{
-				Ty: &sqlast.Text{},
-			}, nil
+		cc, err := p.parseCharsetCollation()
+		if err != nil {
+			return nil, errors.Errorf("parseCharsetCollation failed: %w", err)
 		}
-		return &sqlast.Text{}, nil
+		return &sqlast.Text{
+			From:             tok.From,
+			To:               tok.To,
+			CharsetCollation: cc,
+		}, nil
 	case "BYTEA":
 		return &sqlast.Bytea{}, nil
 	case "NUMERIC":
@@ -270,11 +534,12 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 		}
 		p.prevToken()
 		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
+		if r == nil || r.Kind != sqltoken.RParen {
 			return nil, errors.Errorf("expected RParen but %s", r)
 		}
 
 		unsigned, pos := p.parseMyUnsigned()
+		zerofill, zpos := p.parseMyZerofill()
 		return &sqlast.Decimal{
 			Precision:  precision,
 			Scale:      scale,
@@ -282,6 +547,8 @@ func (p *Parser) ParseDataType() (sqlast.Type, error) {
 			RParen:     r.To,
 			IsUnsigned: unsigned,
 			Unsigned:   pos,
+			IsZerofill: zerofill,
+			Zerofill:   zpos,
 		}, nil
 
 	default:
@@ -301,9 +568,13 @@ func (p *Parser) ParseExpr() (sqlast.Node, error) {
 }
 
 func (p *Parser) parseQuery() (*sqlast.QueryStmt, error) {
-	hasCTE, _, _ := p.parseKeyword("WITH")
+	hasCTE, with, _ := p.parseKeyword("WITH")
 	var ctes []*sqlast.CTE
+	var withPos sqltoken.Pos
+	var recursive bool
 	if hasCTE {
+		withPos = with.From
+		recursive, _, _ = p.parseKeyword("RECURSIVE")
 		cts, err := p.parseCTEList()
 		if err != nil {
 			return nil, errors.Errorf("parseCTEList failed: %w", err)
@@ -334,11 +605,47 @@ func (p *Parser) parseQuery() (*sqlast.QueryStmt, error) {
 		limit = l
 	}
 
+	var offset *sqlast.OffsetExpr
+	if ok, tok, _ := p.parseKeyword("OFFSET"); ok {
+		o, err := p.parseOffset(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid offset expression: %w", err)
+		}
+		offset = o
+	}
+
+	var fetch *sqlast.FetchExpr
+	if ok, tok, _ := p.parseKeyword("FETCH"); ok {
+		f, err := p.parseFetch(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid fetch expression: %w", err)
+		}
+		fetch = f
+	}
+
+	var locking []*sqlast.LockingClause
+	for {
+		ok, tok, _ := p.parseKeyword("FOR")
+		if !ok {
+			break
+		}
+		l, err := p.parseLockingClause(tok)
+		if err != nil {
+			return nil, errors.Errorf("invalid locking clause: %w", err)
+		}
+		locking = append(locking, l)
+	}
+
 	return &sqlast.QueryStmt{
-		CTEs:    ctes,
-		Body:    body,
-		Limit:   limit,
-		OrderBy: orderBy,
+		With:      withPos,
+		Recursive: recursive,
+		CTEs:      ctes,
+		Body:      body,
+		Limit:     limit,
+		Offset:    offset,
+		Fetch:     fetch,
+		Locking:   locking,
+		OrderBy:   orderBy,
 	}, nil
 }
 
@@ -351,17 +658,41 @@ func (p *Parser) parseQueryBody(precedence uint8) (sqlast.SQLSetExpr, error) {
 		}
 		s.Select = tok.From
 		expr = s
-	} else if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+	} else if ok, tok, _ := p.parseKeyword("VALUES"); ok {
+		rows, err := p.parseValuesRows()
+		if err != nil {
+			return nil, errors.Errorf("parseValuesRows failed: %w", err)
+		}
+		expr = &sqlast.ValuesExpr{
+			Values: tok.From,
+			Rows:   rows,
+		}
+	} else if ok, tok, _ := p.parseKeyword("TABLE"); ok {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		expr = &sqlast.TableExpr{
+			Table: tok.From,
+			Name:  name,
+		}
+	} else if lparen, _ := p.peekToken(); lparen != nil && lparen.Kind == sqltoken.LParen {
+		p.mustNextToken()
 		subquery, err := p.parseQuery()
 		if err != nil {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
 		expr = &sqlast.QueryExpr{
-			Query: subquery,
+			LParen: lparen.From,
+			RParen: rparen.To,
+			Query:  subquery,
 		}
 	} else {
-		log.Panicln("expect SELECT or subquery in the query body")
+		return nil, errors.Errorf("expect SELECT, VALUES, TABLE or subquery in the query body")
 	}
 BODY_LOOP:
 	for {
@@ -381,16 +712,21 @@ BODY_LOOP:
 		}
 		p.mustNextToken()
 		all, _, _ := p.parseKeyword("ALL")
+		var distinct bool
+		if !all {
+			distinct, _, _ = p.parseKeyword("DISTINCT")
+		}
 		right, err := p.parseQueryBody(nextPrecedence)
 		if err != nil {
 			return nil, errors.Errorf("parseQueryBody failed: %w", err)
 		}
 
 		expr = &sqlast.SetOperationExpr{
-			Left:  expr,
-			Right: right,
-			Op:    op,
-			All:   all,
+			Left:     expr,
+			Right:    right,
+			Op:       op,
+			All:      all,
+			Distinct: distinct,
 		}
 	}
 
@@ -419,10 +755,20 @@ func (p *Parser) parseSetOperator(token *sqltoken.Token) sqlast.SQLSetOperator {
 }
 
 func (p *Parser) parseSelect() (*sqlast.SQLSelect, error) {
-	distinct, _, err := p.parseKeyword("DISTINCT")
-	if err != nil {
-		return nil, errors.Errorf("parseKeyword failed: %w", err)
+	hint := p.parseHint()
+	distinct, _, _ := p.parseKeyword("DISTINCT")
+
+	var top *sqlast.TopExpr
+	if dialect.Supports(p.dialect, dialect.Top) {
+		if ok, tok, _ := p.parseKeyword("TOP"); ok {
+			t, err := p.parseTop(tok)
+			if err != nil {
+				return nil, errors.Errorf("parseTop failed: %w", err)
+			}
+			top = t
+		}
 	}
+
 	projection, err := p.parseSelectList()
 	if err != nil {
 		return nil, errors.Errorf("parseSelectList failed: %w", err)
@@ -463,13 +809,27 @@ func (p *Parser) parseSelect() (*sqlast.SQLSelect, error) {
 		having = h
 	}
 
+	var qualify sqlast.Node
+	if dialect.Supports(p.dialect, dialect.Qualify) {
+		if ok, _, _ := p.parseKeyword("QUALIFY"); ok {
+			q, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			qualify = q
+		}
+	}
+
 	return &sqlast.SQLSelect{
+		Hint:          hint,
+		Top:           top,
 		Distinct:      distinct,
 		Projection:    projection,
 		WhereClause:   selection,
 		FromClause:    tableRefs,
 		GroupByClause: groupBy,
 		HavingClause:  having,
+		QualifyClause: qualify,
 	}, nil
 
 }
@@ -491,7 +851,7 @@ func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 				},
 			})
 		} else {
-			alias := p.parseOptionalAlias(dialect.ReservedForColumnAlias)
+			alias := p.parseOptionalAlias(p.dialect.IsReservedForColumnAlias)
 
 			if alias != nil {
 				projections = append(projections, &sqlast.AliasSelectItem{
@@ -505,7 +865,7 @@ func (p *Parser) parseSelectList() ([]sqlast.SQLSelectItem, error) {
 			}
 		}
 
-		if t, _ := p.peekToken(); t.Kind == sqltoken.Comma {
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Comma {
 			p.mustNextToken()
 		} else {
 			break
@@ -519,894 +879,3670 @@ func (p *Parser) parseCreate() (sqlast.Stmt, error) {
 	if !ok {
 		return nil, errors.Errorf("expect CREATE but %+v", t)
 	}
-	if ok, _, _ := p.parseKeyword("TABLE"); ok {
-		return p.parseCreateTable(t)
+
+	orReplace, _, _ := p.parseKeywords("OR", "REPLACE")
+	if ok, _, _ := p.parseKeyword("FUNCTION"); ok {
+		return p.parseCreateFunction(t, orReplace, false)
+	}
+	if ok, _, _ := p.parseKeyword("PROCEDURE"); ok {
+		return p.parseCreateFunction(t, orReplace, true)
+	}
+	if ok, view, _ := p.parseKeyword("VIEW"); ok {
+		return p.parseCreateView(t, orReplace, nil, view)
+	}
+	if ok, toks, _ := p.parseKeywords("MATERIALIZED", "VIEW"); ok {
+		return p.parseCreateView(t, orReplace, toks[0], toks[1])
+	}
+	if orReplace {
+		return nil, errors.Errorf("OR REPLACE is only supported for FUNCTION, PROCEDURE or VIEW")
 	}
 
-	mok, _, _ := p.parseKeyword("MATERIALIZED")
-	vok, _, _ := p.parseKeyword("VIEW")
+	temporary, _, _ := p.parseKeyword("TEMPORARY")
+	if !temporary {
+		temporary, _, _ = p.parseKeyword("TEMP")
+	}
+	unlogged, _, _ := p.parseKeyword("UNLOGGED")
+	if ok, table, _ := p.parseKeyword("TABLE"); ok {
+		return p.parseCreateTable(t, table, temporary, unlogged)
+	}
+	if temporary || unlogged {
+		return nil, errors.Errorf("TEMPORARY and UNLOGGED are only supported for TABLE")
+	}
 
-	if mok || vok {
-		p.prevToken()
-		return p.parseCreateView(t)
+	if ok, schema, _ := p.parseKeyword("SCHEMA"); ok {
+		return p.parseCreateSchema(t, schema)
 	}
 
-	iok, _, _ := p.parseKeyword("INDEX")
-	uiok, _, _ := p.parseKeywords("UNIQUE", "INDEX")
+	if ok, sequence, _ := p.parseKeyword("SEQUENCE"); ok {
+		return p.parseCreateSequence(t, sequence)
+	}
 
-	if iok || uiok {
-		return p.parseCreateIndex(uiok)
+	if ok, trigger, _ := p.parseKeyword("TRIGGER"); ok {
+		return p.parseCreateTrigger(t, trigger)
 	}
 
-	log.Panicln("TABLE or VIEW or UNIQUE INDEX or INDEX after create")
+	if ok, index, _ := p.parseKeyword("INDEX"); ok {
+		return p.parseCreateIndex(t, nil, index)
+	}
 
-	return nil, nil
-}
+	if ok, toks, _ := p.parseKeywords("UNIQUE", "INDEX"); ok {
+		return p.parseCreateIndex(t, toks[0], toks[1])
+	}
 
-func (p *Parser) parseCreateTable(create *sqltoken.Token) (sqlast.Stmt, error) {
-	notExists, _, _ := p.parseKeywords("IF", "NOT", "EXISTS")
-	name, err := p.parseObjectName()
-	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	if ok, _, _ := p.parseKeyword("TYPE"); ok {
+		return p.parseCreateType(t)
 	}
 
-	elements, err := p.parseElements()
-	if err != nil {
-		return nil, errors.Errorf("parseElements failed: %w", err)
+	if ok, _, _ := p.parseKeyword("DOMAIN"); ok {
+		return p.parseCreateDomain(t)
 	}
 
-	options, err := p.parseTableOptions()
-	if err != nil {
-		return nil, errors.Errorf("parseTableOptions failed: %w", err)
+	if ok, _, _ := p.parseKeyword("EXTENSION"); ok {
+		return p.parseCreateExtension(t)
 	}
 
-	return &sqlast.CreateTableStmt{
-		NotExists: notExists,
-		Create:    create.From,
-		Name:      name,
-		Elements:  elements,
-		Options:   options,
-	}, nil
+	return nil, errors.Errorf("expect TABLE or VIEW or SCHEMA or SEQUENCE or TRIGGER or UNIQUE INDEX or INDEX or TYPE or DOMAIN or EXTENSION after create")
 }
 
-func (p *Parser) parseCreateView(create *sqltoken.Token) (sqlast.Stmt, error) {
-	materialized, _, _ := p.parseKeyword("MATERIALIZED")
-	p.expectKeyword("VIEW")
+func (p *Parser) parseCreateTable(create, table *sqltoken.Token, temporary, unlogged bool) (sqlast.Stmt, error) {
+	notExists, nfrom, nto := p.parseIfNotExists()
 	name, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
-	p.expectKeyword("AS")
-	q, err := p.parseQuery()
-	if err != nil {
-		return nil, errors.Errorf("parseQuery failed: %w", err)
-	}
-
-	return &sqlast.CreateViewStmt{
-		Create:       create.From,
-		Materialized: materialized,
-		Name:         name,
-		Query:        q,
-	}, nil
 
-}
-
-func (p *Parser) parseCreateIndex(unique bool) (sqlast.Stmt, error) {
-	var indexName *sqlast.Ident
-	ok, _, _ := p.parseKeyword("ON")
-	if !ok {
-		if n, err := p.parseIdentifier(); err != nil {
-			return nil, errors.Errorf("parseIdentifier failed: %w", err)
-		} else {
-			indexName = n
+	var partitionOf *sqlast.ObjectName
+	if ok, _, _ := p.parseKeywords("PARTITION", "OF"); ok {
+		partitionOf, err = p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
-		p.expectKeyword("ON")
 	}
 
-	tableName, err := p.parseObjectName()
-	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	var elements []sqlast.TableElement
+	// CREATE TABLE new_table LIKE old_table (MySQL)
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SQLKeyword && t.Value.(*sqltoken.SQLWord).Keyword == "LIKE" {
+		like, err := p.parseLikeTableElement()
+		if err != nil {
+			return nil, errors.Errorf("parseLikeTableElement failed: %w", err)
+		}
+		elements = append(elements, like)
+	} else {
+		elements, err = p.parseElements(partitionOf != nil)
+		if err != nil {
+			return nil, errors.Errorf("parseElements failed: %w", err)
+		}
 	}
-	var methodName *sqlast.Ident
 
-	if ok, _, _ := p.parseKeyword("USING"); ok {
-		m, err := p.parseIdentifier()
+	var inherits *sqlast.InheritsClause
+	if ok, tok, _ := p.parseKeyword("INHERITS"); ok {
+		inherits, err = p.parseInherits(tok)
 		if err != nil {
-			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			return nil, errors.Errorf("parseInherits failed: %w", err)
 		}
-		methodName = m
 	}
 
-	var columns []*sqlast.Ident
-	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-		columns, err = p.parseColumnNames()
+	var bound *sqlast.PartitionBound
+	if partitionOf != nil {
+		bound, err = p.parsePartitionBound()
 		if err != nil {
-			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+			return nil, errors.Errorf("parsePartitionBound failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
 	}
 
-	var selection sqlast.Node
-	if ok, _, _ := p.parseKeyword("WHERE"); ok {
-		s, err := p.ParseExpr()
+	var partitionBy *sqlast.PartitionSpec
+	if ok, toks, _ := p.parseKeywords("PARTITION", "BY"); ok {
+		partitionBy, err = p.parsePartitionSpec(toks[0])
 		if err != nil {
-			return nil, errors.Errorf("ParseExpr failed: %w", err)
+			return nil, errors.Errorf("parsePartitionSpec failed: %w", err)
 		}
-		selection = s
 	}
 
-	return &sqlast.CreateIndexStmt{
-		IsUnique:    unique,
-		IndexName:   indexName,
-		TableName:   tableName,
-		MethodName:  methodName,
-		ColumnNames: columns,
-		Selection:   selection,
+	options, err := p.parseTableOptions()
+	if err != nil {
+		return nil, errors.Errorf("parseTableOptions failed: %w", err)
+	}
+
+	return &sqlast.CreateTableStmt{
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+		Create:        create.From,
+		Temporary:     temporary,
+		Unlogged:      unlogged,
+		Table:         table.From,
+		Name:          name,
+		Elements:      elements,
+		PartitionOf:   partitionOf,
+		Inherits:      inherits,
+		Bound:         bound,
+		PartitionBy:   partitionBy,
+		Options:       options,
 	}, nil
 }
 
-func (p *Parser) parseElements() ([]sqlast.TableElement, error) {
-	var elements []sqlast.TableElement
-	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
-		return elements, nil
+func (p *Parser) parseLikeTableElement() (*sqlast.LikeTableElement, error) {
+	ok, like, _ := p.parseKeyword("LIKE")
+	if !ok {
+		return nil, errors.Errorf("expected LIKE but %+v", like)
+	}
+	source, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	element := &sqlast.LikeTableElement{
+		Like:   like.From,
+		Source: source,
 	}
 
 	for {
-		tok, _ := p.nextToken()
-		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("parse error after column def %+v", tok)
+		ok, tok, _ := p.parseKeyword("INCLUDING")
+		if !ok {
+			if ok, tok, _ = p.parseKeyword("EXCLUDING"); !ok {
+				break
+			}
 		}
-
-		word := tok.Value.(*sqltoken.SQLWord)
-		switch word.Keyword {
-		case "CONSTRAINT", "PRIMARY", "CHECK", "FOREIGN", "UNIQUE":
-			p.prevToken()
-			constraints, err := p.parseTableConstraints()
-			if err != nil {
-				return nil, errors.Errorf("parseTableConstraints failed: %w", err)
-			}
-			elements = append(elements, constraints)
-
-		default:
-			p.prevToken()
-			def, err := p.parseColumnDef()
-			if err != nil {
-				return nil, errors.Errorf("parseColumnDef failed: %w", err)
-			}
-
-			elements = append(elements, def)
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
 		}
+		element.Options = append(element.Options, &sqlast.LikeOption{
+			From:      tok.From,
+			Including: tok.Value.(*sqltoken.SQLWord).Keyword == "INCLUDING",
+			Name:      name,
+		})
+	}
+	return element, nil
+}
 
-		t, _ := p.nextToken()
-		if t == nil || (t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen) {
-			log.Panicf("Expected ',' or ')' after column definition but %v", t)
-		} else if t.Kind == sqltoken.RParen {
+func (p *Parser) parseInherits(inherits *sqltoken.Token) (*sqlast.InheritsClause, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	clause := &sqlast.InheritsClause{
+		Inherits: inherits.From,
+	}
+	for {
+		parent, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		clause.Parents = append(clause.Parents, parent)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
 			break
 		}
 	}
-
-	return elements, nil
+	rparen, _ := p.nextToken()
+	if rparen == nil || rparen.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", rparen)
+	}
+	clause.RParen = rparen.To
+	return clause, nil
 }
 
-func (p *Parser) parseColumnDef() (*sqlast.ColumnDef, error) {
-	tok := p.mustNextToken()
-	columnName := tok.Value.(*sqltoken.SQLWord)
-
-	dataType, err := p.ParseDataType()
-	if err != nil {
-		return nil, errors.Errorf("ParseDataType failed: %w", err)
+// parsePartitionBound parses { FOR VALUES partition_bound_spec | DEFAULT }
+func (p *Parser) parsePartitionBound() (*sqlast.PartitionBound, error) {
+	if ok, tok, _ := p.parseKeyword("DEFAULT"); ok {
+		return &sqlast.PartitionBound{
+			From:    tok.From,
+			Default: true,
+			To:      tok.To,
+		}, nil
 	}
-
-	def, specs, decorates, err := p.parseColumnDefinition()
-	if err != nil {
-		return nil, errors.Errorf("parseColumnDefinition: %w", err)
+	ok, toks, _ := p.parseKeywords("FOR", "VALUES")
+	if !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected FOR VALUES or DEFAULT but %+v", t)
 	}
-
-	return &sqlast.ColumnDef{
-		Constraints: specs,
-		Name: &sqlast.Ident{
-			From:  tok.From,
-			To:    tok.To,
-			Value: columnName.String(),
-		},
-		MyDataTypeDecoration: decorates,
-		DataType:             dataType,
-		Default:              def,
-	}, nil
-}
-
-func (p *Parser) parseTableConstraints() (*sqlast.TableConstraint, error) {
-	tok, _ := p.peekToken()
-	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
-		return nil, errors.Errorf("parse error after column def")
+	bound := &sqlast.PartitionBound{
+		From: toks[0].From,
 	}
 
-	word, ok := tok.Value.(*sqltoken.SQLWord)
-
-	var constraintPos sqltoken.Pos
-	var name *sqlast.Ident
-	if ok && word.Keyword == "CONSTRAINT" {
-		constraintPos = tok.From
-		p.mustNextToken()
-		i, err := p.parseIdentifier()
+	parseList := func() ([]sqlast.Node, error) {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
+		list, err := p.parseExprList()
 		if err != nil {
-			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			return nil, errors.Errorf("parseExprList failed: %w", err)
 		}
-		name = i
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
+		bound.To = rparen.To
+		return list, nil
 	}
 
-	tok, _ = p.peekToken()
-
-	var spec sqlast.TableConstraintSpec
-	word = tok.Value.(*sqltoken.SQLWord)
-	switch word.Keyword {
-	case "UNIQUE":
-		p.mustNextToken()
-		if _, _, err := p.parseKeyword("KEY"); err != nil {
-			return nil, errors.Errorf("parseKeyword failed: %w", err)
-		}
-		p.expectToken(sqltoken.LParen)
-		columns, err := p.parseColumnNames()
-		if err != nil {
-			return nil, errors.Errorf("parseColumnNames failed: %w", err)
-		}
-		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
-			return nil, errors.Errorf("expected RParen but %+v", r)
+	var err error
+	if ok, _, _ := p.parseKeyword("IN"); ok {
+		if bound.In, err = parseList(); err != nil {
+			return nil, err
 		}
-		spec = &sqlast.UniqueTableConstraint{
-			Unique:  tok.From,
-			RParen:  r.To,
-			Columns: columns,
+	} else if ok, _, _ := p.parseKeyword("FROM"); ok {
+		if bound.RangeFrom, err = parseList(); err != nil {
+			return nil, err
 		}
-	case "PRIMARY":
-		p.mustNextToken()
-		p.expectKeyword("KEY")
-		p.expectToken(sqltoken.LParen)
-		columns, err := p.parseColumnNames()
-		if err != nil {
-			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		if ok, _, _ := p.parseKeyword("TO"); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected TO but %+v", t)
 		}
-		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
-			return nil, errors.Errorf("expected RParen but %+v", r)
+		if bound.RangeTo, err = parseList(); err != nil {
+			return nil, err
 		}
-		spec = &sqlast.UniqueTableConstraint{
-			Primary:   tok.From,
-			RParen:    r.To,
-			IsPrimary: true,
-			Columns:   columns,
+	} else if ok, _, _ := p.parseKeyword("WITH"); ok {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
 		}
-	case "FOREIGN":
-		p.mustNextToken()
-		p.expectKeyword("KEY")
-		p.expectToken(sqltoken.LParen)
-		columns, err := p.parseColumnNames()
-		if err != nil {
-			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		if ok, _, _ := p.parseKeyword("MODULUS"); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected MODULUS but %+v", t)
 		}
-		p.expectToken(sqltoken.RParen)
-		p.expectKeyword("REFERENCES")
-
-		t, _ := p.nextToken()
-		w := t.Value.(*sqltoken.SQLWord)
-		p.expectToken(sqltoken.LParen)
-		refcolumns, err := p.parseColumnNames()
-		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
-			return nil, errors.Errorf("expected RParen but %+v", r)
+		if bound.Modulus, err = p.ParseExpr(); err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
-		keys := &sqlast.ReferenceKeyExpr{
-			TableName: &sqlast.Ident{
-				From:  t.From,
-				To:    t.To,
-				Value: w.String(),
-			},
-			Columns: refcolumns,
-			RParen:  r.To,
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected Comma but %+v", t)
 		}
-
-		spec = &sqlast.ReferentialTableConstraint{
-			Foreign: tok.From,
-			Columns: columns,
-			KeyExpr: keys,
+		if ok, _, _ := p.parseKeyword("REMAINDER"); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected REMAINDER but %+v", t)
 		}
-	case "CHECK":
-		p.mustNextToken()
-		p.expectToken(sqltoken.LParen)
-		expr, err := p.ParseExpr()
-		if err != nil {
+		if bound.Remainder, err = p.ParseExpr(); err != nil {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
-		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
-			return nil, errors.Errorf("expected RParen but %+v", r)
-		}
-		spec = &sqlast.CheckTableConstraint{
-			Expr:   expr,
-			Check:  tok.From,
-			RParen: r.To,
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
 		}
+		bound.To = rparen.To
+	} else {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected IN, FROM or WITH but %+v", t)
+	}
+	return bound, nil
+}
+
+func (p *Parser) parsePartitionSpec(partition *sqltoken.Token) (*sqlast.PartitionSpec, error) {
+	spec := &sqlast.PartitionSpec{
+		Partition: partition.From,
+	}
+	t, _ := p.nextToken()
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("expected RANGE, LIST or HASH but %+v", t)
+	}
+	switch t.Value.(*sqltoken.SQLWord).Keyword {
+	case "RANGE":
+		spec.Strategy = sqlast.RangePartition
+	case "LIST":
+		spec.Strategy = sqlast.ListPartition
+	case "HASH":
+		spec.Strategy = sqlast.HashPartition
 	default:
-		return nil, errors.Errorf("unknown table constraint: %v", word)
+		return nil, errors.Errorf("expected RANGE, LIST or HASH but %+v", t)
 	}
 
-	return &sqlast.TableConstraint{
-		Name:       name,
-		Spec:       spec,
-		Constraint: constraintPos,
-	}, nil
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	columns, err := p.parseExprList()
+	if err != nil {
+		return nil, errors.Errorf("parseExprList failed: %w", err)
+	}
+	rparen, _ := p.nextToken()
+	if rparen == nil || rparen.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", rparen)
+	}
+	spec.Columns = columns
+	spec.RParen = rparen.To
+	return spec, nil
 }
 
-// TODO rethink mysql create table AST
-func (p *Parser) parseColumnDefinition() (sqlast.Node, []*sqlast.ColumnConstraint, []sqlast.MyDataTypeDecoration, error) {
-	var specs []*sqlast.ColumnConstraint
-	var def sqlast.Node
-	var decorates []sqlast.MyDataTypeDecoration
+// parseIfNotExists parses optional `IF NOT EXISTS` and returns its span
+func (p *Parser) parseIfNotExists() (bool, sqltoken.Pos, sqltoken.Pos) {
+	ok, toks, _ := p.parseKeywords("IF", "NOT", "EXISTS")
+	if !ok {
+		return false, sqltoken.Pos{}, sqltoken.Pos{}
+	}
+	return true, toks[0].From, toks[2].To
+}
 
-COLUMN_DEF_LOOP:
-	for {
+func (p *Parser) parseCreateType(create *sqltoken.Token) (sqlast.Stmt, error) {
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	if ok, _, _ := p.parseKeyword("AS"); !ok {
 		t, _ := p.peekToken()
-		if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("expected AS but %+v", t)
+	}
+	stmt := &sqlast.CreateTypeStmt{
+		Create: create.From,
+		Name:   name,
+	}
+	stmt.Enum, _, _ = p.parseKeyword("ENUM")
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	for {
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.RParen {
 			break
 		}
-
-		word := t.Value.(*sqltoken.SQLWord)
-
-		switch word.Keyword {
-		case "DEFAULT":
-			if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
-				d, err := p.parseDefaultExpr(0)
-				if err != nil {
-					return nil, nil, nil, errors.Errorf("parseDefaultExpr failed: %w", err)
-				}
-				def = d
-				continue
+		if stmt.Enum {
+			t, _ := p.nextToken()
+			if t == nil || t.Kind != sqltoken.SingleQuotedString {
+				return nil, errors.Errorf("expected label of ENUM but %+v", t)
 			}
-		case "CONSTRAINT", "NOT", "UNIQUE", "PRIMARY", "REFERENCES", "CHECK":
-			s, err := p.parseColumnConstraints()
+			stmt.Labels = append(stmt.Labels, p.singleQuotedString(t))
+		} else {
+			attr, err := p.parseIdentifier()
 			if err != nil {
-				return nil, nil, nil, errors.Errorf("parseColumnConstraints failed: %w", err)
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
 			}
-			specs = s
-		case "AUTO_INCREMENT":
-			p.mustNextToken()
-			decorates = append(decorates, &sqlast.AutoIncrement{
-				Auto:      t.From,
-				Increment: t.To,
-			})
-		default:
-			break COLUMN_DEF_LOOP
+			tp, err := p.ParseDataType()
+			if err != nil {
+				return nil, errors.Errorf("ParseDataType failed: %w", err)
+			}
+			stmt.Attributes = append(stmt.Attributes, &sqlast.ColumnDef{Name: attr, DataType: tp})
+		}
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
 		}
 	}
-	return def, specs, decorates, nil
+	rparen, _ := p.nextToken()
+	if rparen == nil || rparen.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", rparen)
+	}
+	stmt.RParen = rparen.To
+	return stmt, nil
 }
 
-func (p *Parser) parseColumnConstraints() ([]*sqlast.ColumnConstraint, error) {
-	var constraints []*sqlast.ColumnConstraint
+func (p *Parser) parseCreateDomain(create *sqltoken.Token) (sqlast.Stmt, error) {
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	p.parseKeyword("AS")
+	tp, err := p.ParseDataType()
+	if err != nil {
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
+	}
+	stmt := &sqlast.CreateDomainStmt{
+		Create:   create.From,
+		Name:     name,
+		DataType: tp,
+	}
 
-CONSTRAINT_LOOP:
 	for {
-		tok, err := p.peekToken()
-		if tok == nil {
-			break CONSTRAINT_LOOP
-		}
-		if err != nil {
-			return nil, errors.Errorf("peekToken failed: %w", err)
-		}
-		word, ok := tok.Value.(*sqltoken.SQLWord)
-
-		var name *sqlast.Ident
-		if ok && word.Keyword == "CONSTRAINT" {
-			p.mustNextToken()
-			i, err := p.parseIdentifier()
+		if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
+			d, err := p.parseDefaultExpr(0)
 			if err != nil {
-				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+				return nil, errors.Errorf("parseDefaultExpr failed: %w", err)
 			}
-			name = i
+			stmt.Default = d
+			continue
 		}
-
-		tok, _ = p.peekToken()
-		if tok.Kind != sqltoken.SQLKeyword {
+		constraints, err := p.parseColumnConstraints()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnConstraints failed: %w", err)
+		}
+		if len(constraints) == 0 {
 			break
 		}
+		stmt.Constraints = append(stmt.Constraints, constraints...)
+	}
+	return stmt, nil
+}
+
+func (p *Parser) parseCreateExtension(create *sqltoken.Token) (sqlast.Stmt, error) {
+	notExists, nfrom, nto := p.parseIfNotExists()
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt := &sqlast.CreateExtensionStmt{
+		Create:        create.From,
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+		Name:          name,
+	}
+
+	p.parseKeyword("WITH")
+	if ok, _, _ := p.parseKeyword("SCHEMA"); ok {
+		schema, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		stmt.Schema = schema
+	}
+	if ok, _, _ := p.parseKeyword("VERSION"); ok {
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SingleQuotedString {
+			p.mustNextToken()
+			stmt.Version = p.singleQuotedString(t)
+		} else {
+			version, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			stmt.Version = version
+		}
+	}
+	if ok, c, _ := p.parseKeyword("CASCADE"); ok {
+		stmt.Cascade = true
+		stmt.CascadePos = c.To
+	}
+	return stmt, nil
+}
+
+func (p *Parser) parseCreateSchema(create, schema *sqltoken.Token) (sqlast.Stmt, error) {
+	notExists, nfrom, nto := p.parseIfNotExists()
+	stmt := &sqlast.CreateSchemaStmt{
+		Create:        create.From,
+		Schema:        schema.From,
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+	}
+
+	authorization, _, _ := p.parseKeyword("AUTHORIZATION")
+	if !authorization {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		stmt.Name = name
+		authorization, _, _ = p.parseKeyword("AUTHORIZATION")
+	}
+
+	if authorization {
+		role, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		stmt.Authorization = role
+	}
+
+	// schema elements are statements which follow without delimiters
+	for {
+		tok, _ := p.peekToken()
+		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+			break
+		}
+		if k := tok.Value.(*sqltoken.SQLWord).Keyword; k != "CREATE" && k != "GRANT" {
+			break
+		}
+		element, err := p.ParseStatement()
+		if err != nil {
+			return nil, errors.Errorf("ParseStatement failed: %w", err)
+		}
+		stmt.Elements = append(stmt.Elements, element)
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseCreateSequence(create, sequence *sqltoken.Token) (sqlast.Stmt, error) {
+	notExists, nfrom, nto := p.parseIfNotExists()
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	opts, err := p.parseSequenceOptions()
+	if err != nil {
+		return nil, errors.Errorf("parseSequenceOptions failed: %w", err)
+	}
+
+	return &sqlast.CreateSequenceStmt{
+		Create:        create.From,
+		Sequence:      sequence.From,
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+		Name:          name,
+		Options:       opts,
+	}, nil
+}
+
+func (p *Parser) parseSequenceOptions() ([]sqlast.SequenceOption, error) {
+	var opts []sqlast.SequenceOption
+
+	for {
+		opt, err := p.parseSequenceOption()
+		if err != nil {
+			return nil, errors.Errorf("parseSequenceOption failed: %w", err)
+		}
+		if opt == nil {
+			return opts, nil
+		}
+		opts = append(opts, opt)
+	}
+}
+
+func (p *Parser) parseSequenceOption() (sqlast.SequenceOption, error) {
+	tok, _ := p.peekToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return nil, nil
+	}
+	word := tok.Value.(*sqltoken.SQLWord)
+
+	switch word.Keyword {
+	case "AS":
+		p.mustNextToken()
+		tp, err := p.ParseDataType()
+		if err != nil {
+			return nil, errors.Errorf("ParseDataType failed: %w", err)
+		}
+		return &sqlast.AsSequenceOption{
+			As:       tok.From,
+			DataType: tp,
+		}, nil
+	case "INCREMENT":
+		p.mustNextToken()
+		p.parseKeyword("BY")
+		v, err := p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+		return &sqlast.IncrementBySequenceOption{
+			Increment: tok.From,
+			Value:     v,
+		}, nil
+	case "MINVALUE":
+		p.mustNextToken()
+		v, err := p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+		return &sqlast.MinValueSequenceOption{
+			From:  tok.From,
+			To:    v.To,
+			Value: v,
+		}, nil
+	case "MAXVALUE":
+		p.mustNextToken()
+		v, err := p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+		return &sqlast.MaxValueSequenceOption{
+			From:  tok.From,
+			To:    v.To,
+			Value: v,
+		}, nil
+	case "NO":
+		p.mustNextToken()
+		ok, t, _ := p.parseKeyword("MINVALUE")
+		if ok {
+			return &sqlast.MinValueSequenceOption{
+				From: tok.From,
+				To:   t.To,
+				No:   true,
+			}, nil
+		}
+		ok, t, _ = p.parseKeyword("MAXVALUE")
+		if ok {
+			return &sqlast.MaxValueSequenceOption{
+				From: tok.From,
+				To:   t.To,
+				No:   true,
+			}, nil
+		}
+		ok, t, _ = p.parseKeyword("CYCLE")
+		if ok {
+			return &sqlast.CycleSequenceOption{
+				From: tok.From,
+				To:   t.To,
+				No:   true,
+			}, nil
+		}
+		return nil, errors.Errorf("expected MINVALUE, MAXVALUE or CYCLE after NO but %+v", t)
+	case "START":
+		p.mustNextToken()
+		p.parseKeyword("WITH")
+		v, err := p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+		return &sqlast.StartWithSequenceOption{
+			Start: tok.From,
+			Value: v,
+		}, nil
+	case "RESTART":
+		p.mustNextToken()
+		opt := &sqlast.RestartSequenceOption{
+			From: tok.From,
+			To:   tok.To,
+		}
+		withValue, _, _ := p.parseKeyword("WITH")
+		if next, _ := p.peekToken(); withValue || (next != nil && (next.Kind == sqltoken.Number || next.Kind == sqltoken.Minus)) {
+			v, err := p.parseSignedLong()
+			if err != nil {
+				return nil, errors.Errorf("parseSignedLong failed: %w", err)
+			}
+			opt.Value = v
+			opt.To = v.To
+		}
+		return opt, nil
+	case "CACHE":
+		p.mustNextToken()
+		v, err := p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+		return &sqlast.CacheSequenceOption{
+			Cache: tok.From,
+			Value: v,
+		}, nil
+	case "CYCLE":
+		p.mustNextToken()
+		return &sqlast.CycleSequenceOption{
+			From: tok.From,
+			To:   tok.To,
+		}, nil
+	case "OWNED":
+		p.mustNextToken()
+		if ok, _, _ := p.parseKeyword("BY"); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected BY but %+v", t)
+		}
+		if ok, t, _ := p.parseKeyword("NONE"); ok {
+			return &sqlast.OwnedBySequenceOption{
+				From: tok.From,
+				To:   t.To,
+			}, nil
+		}
+		col, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		return &sqlast.OwnedBySequenceOption{
+			From:   tok.From,
+			To:     col.End(),
+			Column: col,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// parseSignedLong parses an integer literal with an optional leading minus sign.
+func (p *Parser) parseSignedLong() (*sqlast.LongValue, error) {
+	var minus *sqltoken.Token
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Minus {
+		minus = p.mustNextToken()
+	}
+
+	i, tok, err := p.parseLiteralInt()
+	if err != nil {
+		return nil, errors.Errorf("parseLiteralInt failed: %w", err)
+	}
+	from := tok.From
+	if minus != nil {
+		from = minus.From
+		i = -i
+	}
+
+	return &sqlast.LongValue{
+		From: from,
+		To:   tok.To,
+		Long: int64(i),
+	}, nil
+}
+
+func (p *Parser) parseCreateFunction(create *sqltoken.Token, orReplace, isProcedure bool) (sqlast.Stmt, error) {
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	args, rparen, err := p.parseFunctionArgs()
+	if err != nil {
+		return nil, errors.Errorf("parseFunctionArgs failed: %w", err)
+	}
+
+	stmt := &sqlast.CreateFunctionStmt{
+		Create:      create.From,
+		OrReplace:   orReplace,
+		IsProcedure: isProcedure,
+		Name:        name,
+		Args:        args,
+		RParen:      rparen,
+	}
+
+	if ok, ret, _ := p.parseKeyword("RETURNS"); ok {
+		returns, err := p.parseFunctionReturns(ret)
+		if err != nil {
+			return nil, errors.Errorf("parseFunctionReturns failed: %w", err)
+		}
+		stmt.Returns = returns
+	}
+
+	for {
+		tok, _ := p.peekToken()
+		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+			break
+		}
+		word := tok.Value.(*sqltoken.SQLWord)
+
+		switch word.Keyword {
+		case "LANGUAGE":
+			p.mustNextToken()
+			lang, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			stmt.Language = lang
+			continue
+		case "IMMUTABLE", "STABLE", "VOLATILE", "STRICT", "LEAKPROOF":
+			p.mustNextToken()
+			stmt.Behaviors = append(stmt.Behaviors, newIdent(tok, word))
+			continue
+		case "AS":
+			p.mustNextToken()
+			next, _ := p.peekToken()
+			if next == nil || (next.Kind != sqltoken.SingleQuotedString && next.Kind != sqltoken.DollarQuotedString) {
+				return nil, errors.Errorf("expected function body string but %+v", next)
+			}
+			body, err := p.parseValue()
+			if err != nil {
+				return nil, errors.Errorf("parseValue failed: %w", err)
+			}
+			stmt.Body = body
+			continue
+		}
+		break
+	}
+
+	return stmt, nil
+}
+
+// parseFunctionArgs parses argument declarations of CREATE FUNCTION after the left paren.
+func (p *Parser) parseFunctionArgs() ([]*sqlast.FunctionArg, sqltoken.Pos, error) {
+	var args []*sqlast.FunctionArg
+
+	if tok, _ := p.peekToken(); tok != nil && tok.Kind == sqltoken.RParen {
+		p.mustNextToken()
+		return args, tok.To, nil
+	}
+
+	for {
+		arg, err := p.parseFunctionArg()
+		if err != nil {
+			return nil, sqltoken.Pos{}, errors.Errorf("parseFunctionArg failed: %w", err)
+		}
+		args = append(args, arg)
+
+		tok, _ := p.nextToken()
+		if tok == nil {
+			return nil, sqltoken.Pos{}, errors.Errorf("unexpected EOF in function arguments")
+		}
+		if tok.Kind == sqltoken.RParen {
+			return args, tok.To, nil
+		}
+		if tok.Kind != sqltoken.Comma {
+			return nil, sqltoken.Pos{}, errors.Errorf("expected , or ) but %+v", tok)
+		}
+	}
+}
+
+func (p *Parser) parseFunctionArg() (*sqlast.FunctionArg, error) {
+	arg := &sqlast.FunctionArg{}
+
+	modes := map[string]sqlast.ArgMode{
+		"IN":       sqlast.InArgMode,
+		"OUT":      sqlast.OutArgMode,
+		"INOUT":    sqlast.InOutArgMode,
+		"VARIADIC": sqlast.VariadicArgMode,
+	}
+	if tok, _ := p.peekToken(); tok != nil && tok.Kind == sqltoken.SQLKeyword {
+		if mode, ok := modes[tok.Value.(*sqltoken.SQLWord).Keyword]; ok {
+			p.mustNextToken()
+			arg.Mode = mode
+			arg.ModePos = tok.From
+		}
+	}
+
+	// the argument name is optional, so try to parse it as an unnamed type first
+	// and fall back to `name type` if something other than the end of the argument follows.
+	idx := p.index
+	tp, err := p.ParseDataType()
+	if err == nil && p.isEndOfFunctionArg() {
+		arg.DataType = tp
+	} else {
+		p.index = idx
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		tp, err := p.ParseDataType()
+		if err != nil {
+			return nil, errors.Errorf("ParseDataType failed: %w", err)
+		}
+		arg.Name = name
+		arg.DataType = tp
+	}
+
+	ok, _, _ := p.parseKeyword("DEFAULT")
+	if !ok {
+		ok, _ = p.consumeToken(sqltoken.Eq)
+	}
+	if ok {
+		def, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		arg.Default = def
+	}
+
+	return arg, nil
+}
+
+func (p *Parser) isEndOfFunctionArg() bool {
+	tok, _ := p.peekToken()
+	if tok == nil {
+		return true
+	}
+	switch tok.Kind {
+	case sqltoken.Comma, sqltoken.RParen, sqltoken.Eq:
+		return true
+	case sqltoken.SQLKeyword:
+		return tok.Value.(*sqltoken.SQLWord).Keyword == "DEFAULT"
+	}
+	return false
+}
+
+func (p *Parser) parseFunctionReturns(ret *sqltoken.Token) (*sqlast.FunctionReturns, error) {
+	if ok, _, _ := p.parseKeyword("TABLE"); ok {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
+		cols, rparen, err := p.parseFunctionArgs()
+		if err != nil {
+			return nil, errors.Errorf("parseFunctionArgs failed: %w", err)
+		}
+		return &sqlast.FunctionReturns{
+			Returns: ret.From,
+			Columns: cols,
+			RParen:  rparen,
+		}, nil
+	}
+
+	setOf, _, _ := p.parseKeyword("SETOF")
+	tp, err := p.ParseDataType()
+	if err != nil {
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
+	}
+
+	return &sqlast.FunctionReturns{
+		Returns:  ret.From,
+		SetOf:    setOf,
+		DataType: tp,
+	}, nil
+}
+
+func (p *Parser) parseCreateTrigger(create, trigger *sqltoken.Token) (sqlast.Stmt, error) {
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	stmt := &sqlast.CreateTriggerStmt{
+		Create:  create.From,
+		Trigger: trigger.From,
+		Name:    name,
+	}
+
+	if ok, tok, _ := p.parseKeyword("BEFORE"); ok {
+		stmt.Timing = sqlast.BeforeTrigger
+		stmt.TimingPos = tok.From
+	} else if ok, tok, _ := p.parseKeyword("AFTER"); ok {
+		stmt.Timing = sqlast.AfterTrigger
+		stmt.TimingPos = tok.From
+	} else if ok, toks, _ := p.parseKeywords("INSTEAD", "OF"); ok {
+		stmt.Timing = sqlast.InsteadOfTrigger
+		stmt.TimingPos = toks[0].From
+	} else {
+		return nil, errors.Errorf("expected BEFORE, AFTER or INSTEAD OF but %+v", tok)
+	}
+
+	for {
+		event, err := p.parseTriggerEvent()
+		if err != nil {
+			return nil, errors.Errorf("parseTriggerEvent failed: %w", err)
+		}
+		stmt.Events = append(stmt.Events, event)
+		if ok, _, _ := p.parseKeyword("OR"); !ok {
+			break
+		}
+	}
+
+	if ok, _, _ := p.parseKeyword("ON"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected ON but %+v", t)
+	}
+	table, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	stmt.TableName = table
+
+	if ok, toks, _ := p.parseKeywords("FOR", "EACH"); ok {
+		stmt.LevelFrom = toks[0].From
+		if ok, tok, _ := p.parseKeyword("ROW"); ok {
+			stmt.Level = sqlast.RowTriggerLevel
+			stmt.LevelTo = tok.To
+		} else if ok, tok, _ := p.parseKeyword("STATEMENT"); ok {
+			stmt.Level = sqlast.StatementTriggerLevel
+			stmt.LevelTo = tok.To
+		} else {
+			return nil, errors.Errorf("expected ROW or STATEMENT after FOR EACH but %+v", tok)
+		}
+	}
+
+	if ok, _, _ := p.parseKeyword("WHEN"); ok {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
+		cond, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		if ok, _ := p.consumeToken(sqltoken.RParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected RParen but %+v", t)
+		}
+		stmt.When = cond
+	}
+
+	ok, exec, _ := p.parseKeyword("EXECUTE")
+	if !ok {
+		return nil, errors.Errorf("expected EXECUTE but %+v", exec)
+	}
+	stmt.Execute = exec.From
+	if ok, _, _ := p.parseKeyword("PROCEDURE"); ok {
+		stmt.IsProcedure = true
+	} else if ok, _, _ := p.parseKeyword("FUNCTION"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected FUNCTION or PROCEDURE but %+v", t)
+	}
+
+	fn, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	stmt.Function = fn
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	args, err := p.parseOptionalArgs()
+	if err != nil {
+		return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
+	}
+	stmt.Args = args
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+	stmt.RParen = r.To
+
+	return stmt, nil
+}
+
+func (p *Parser) parseTriggerEvent() (*sqlast.TriggerEvent, error) {
+	tok, _ := p.nextToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("expected trigger event but %+v", tok)
+	}
+
+	event := &sqlast.TriggerEvent{
+		From: tok.From,
+		To:   tok.To,
+	}
+
+	switch tok.Value.(*sqltoken.SQLWord).Keyword {
+	case "INSERT":
+		event.Type = sqlast.InsertTriggerEvent
+	case "DELETE":
+		event.Type = sqlast.DeleteTriggerEvent
+	case "TRUNCATE":
+		event.Type = sqlast.TruncateTriggerEvent
+	case "UPDATE":
+		event.Type = sqlast.UpdateTriggerEvent
+		if ok, _, _ := p.parseKeyword("OF"); ok {
+			columns, err := p.parseColumnNames()
+			if err != nil {
+				return nil, errors.Errorf("parseColumnNames failed: %w", err)
+			}
+			event.Columns = columns
+			event.To = columns[len(columns)-1].End()
+		}
+	default:
+		return nil, errors.Errorf("expected INSERT, UPDATE, DELETE or TRUNCATE but %+v", tok)
+	}
+
+	return event, nil
+}
+
+// materialized is nil unless CREATE MATERIALIZED VIEW
+func (p *Parser) parseCreateView(create *sqltoken.Token, orReplace bool, materialized, view *sqltoken.Token) (sqlast.Stmt, error) {
+	notExists, nfrom, nto := p.parseIfNotExists()
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	columns, r, err := p.parseOptionalViewColumns()
+	if err != nil {
+		return nil, errors.Errorf("parseOptionalViewColumns failed: %w", err)
+	}
+	p.expectKeyword("AS")
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, errors.Errorf("parseQuery failed: %w", err)
+	}
+
+	stmt := &sqlast.CreateViewStmt{
+		Create:        create.From,
+		View:          view.From,
+		Name:          name,
+		Query:         q,
+		OrReplace:     orReplace,
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+		Columns:       columns,
+		RParen:        r,
+	}
+	if materialized != nil {
+		stmt.Materialized = true
+		stmt.MaterializedPos = materialized.From
+	}
+
+	return stmt, nil
+
+}
+
+// parseOptionalViewColumns parses optional column alias list of views i.e: (a, b)
+func (p *Parser) parseOptionalViewColumns() ([]*sqlast.Ident, sqltoken.Pos, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		return nil, sqltoken.Pos{}, nil
+	}
+	columns, err := p.parseColumnNames()
+	if err != nil {
+		return nil, sqltoken.Pos{}, errors.Errorf("parseColumnNames failed: %w", err)
+	}
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected RParen but %+v", r)
+	}
+	return columns, r.To, nil
+}
+
+func (p *Parser) parseAlterView(alter *sqltoken.Token) (sqlast.Stmt, error) {
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	if ok, _, _ := p.parseKeywords("RENAME", "TO"); ok {
+		newName, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		return &sqlast.AlterViewStmt{
+			Alter:   alter.From,
+			Name:    name,
+			NewName: newName,
+		}, nil
+	}
+
+	columns, r, err := p.parseOptionalViewColumns()
+	if err != nil {
+		return nil, errors.Errorf("parseOptionalViewColumns failed: %w", err)
+	}
+	if ok, _, _ := p.parseKeyword("AS"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected AS but %+v", t)
+	}
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, errors.Errorf("parseQuery failed: %w", err)
+	}
+
+	return &sqlast.AlterViewStmt{
+		Alter:   alter.From,
+		Name:    name,
+		Columns: columns,
+		RParen:  r,
+		Query:   q,
+	}, nil
+}
+
+func (p *Parser) parseDropView(drop *sqltoken.Token, materialized bool) (sqlast.Stmt, error) {
+	exists, names, err := p.parseDropObjects()
+	if err != nil {
+		return nil, errors.Errorf("parseDropObjects failed: %w", err)
+	}
+	cascade, caspos, restrict, respos := p.parseDropBehavior()
+
+	return &sqlast.DropViewStmt{
+		Drop:         drop.From,
+		Materialized: materialized,
+		ViewNames:    names,
+		Cascade:      cascade,
+		IfExists:     exists,
+		CascadePos:   caspos,
+		Restrict:     restrict,
+		RestrictPos:  respos,
+	}, nil
+}
+
+// unique is nil unless CREATE UNIQUE INDEX
+func (p *Parser) parseCreateIndex(create, unique, index *sqltoken.Token) (sqlast.Stmt, error) {
+	concurrently, ctok, _ := p.parseKeyword("CONCURRENTLY")
+	notExists, nfrom, nto := p.parseIfNotExists()
+
+	var indexName *sqlast.Ident
+	ok, _, _ := p.parseKeyword("ON")
+	if !ok {
+		if n, err := p.parseIdentifier(); err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		} else {
+			indexName = n
+		}
+		p.expectKeyword("ON")
+	}
+
+	tableName, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	var methodName *sqlast.Ident
+
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		m, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		methodName = m
+	}
+
+	var columns []*sqlast.IndexElement
+	var rparen sqltoken.Pos
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		for {
+			e, err := p.parseIndexElement()
+			if err != nil {
+				return nil, errors.Errorf("parseIndexElement failed: %w", err)
+			}
+			columns = append(columns, e)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		rparen = r.To
+	}
+
+	var include []*sqlast.Ident
+	var includeRParen sqltoken.Pos
+	if ok, _, _ := p.parseKeyword("INCLUDE"); ok {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
+		include, err = p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		includeRParen = r.To
+	}
+
+	comment, commentPos, err := p.parseMyIndexComment()
+	if err != nil {
+		return nil, errors.Errorf("parseMyIndexComment failed: %w", err)
+	}
+
+	var selection sqlast.Node
+	if ok, _, _ := p.parseKeyword("WHERE"); ok {
+		s, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		selection = s
+	}
+
+	stmt := &sqlast.CreateIndexStmt{
+		Create:        create.From,
+		Index:         index.From,
+		RParen:        rparen,
+		NotExists:     notExists,
+		NotExistsFrom: nfrom,
+		NotExistsTo:   nto,
+		IndexName:     indexName,
+		TableName:     tableName,
+		MethodName:    methodName,
+		Columns:       columns,
+		Include:       include,
+		IncludeRParen: includeRParen,
+		CommentPos:    commentPos,
+		Comment:       comment,
+		Selection:     selection,
+	}
+	if unique != nil {
+		stmt.IsUnique = true
+		stmt.Unique = unique.From
+	}
+	if concurrently {
+		stmt.Concurrently = true
+		stmt.ConcurrentlyPos = ctok.From
+	}
+
+	return stmt, nil
+}
+
+// parseIndexElement parses `{column | (expression) | function_call} [COLLATE collation] [opclass] [ASC | DESC]`
+func (p *Parser) parseIndexElement() (*sqlast.IndexElement, error) {
+	expr, err := p.ParseExpr()
+	if err != nil {
+		return nil, errors.Errorf("ParseExpr failed: %w", err)
+	}
+	e := &sqlast.IndexElement{
+		Expr: expr,
+	}
+
+	if ok, tok, _ := p.parseKeyword("COLLATE"); ok {
+		collation, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		e.Collation = collation
+		e.CollatePos = tok.From
+	}
+
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SQLKeyword {
+		switch t.Value.(*sqltoken.SQLWord).Keyword {
+		case "ASC", "DESC", "NULLS":
+		default:
+			opclass, err := p.parseObjectName()
+			if err != nil {
+				return nil, errors.Errorf("parseObjectName failed: %w", err)
+			}
+			e.OpClass = opclass
+		}
+	}
+
+	if ok, tok, _ := p.parseKeyword("ASC"); ok {
+		asc := true
+		e.ASC = &asc
+		e.OrderingPos = tok.To
+	} else if ok, tok, _ := p.parseKeyword("DESC"); ok {
+		asc := false
+		e.ASC = &asc
+		e.OrderingPos = tok.To
+	}
+
+	if ok, toks, _ := p.parseKeywords("NULLS", "FIRST"); ok {
+		first := true
+		e.NullsFirst = &first
+		e.NullsPos = toks[1].To
+	} else if ok, toks, _ := p.parseKeywords("NULLS", "LAST"); ok {
+		first := false
+		e.NullsFirst = &first
+		e.NullsPos = toks[1].To
+	}
+
+	return e, nil
+}
+
+// parseElements parses the elements of CREATE TABLE.
+// Columns of PARTITION OF don't have data types if partition is true.
+func (p *Parser) parseElements(partition bool) ([]sqlast.TableElement, error) {
+	var elements []sqlast.TableElement
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		return elements, nil
+	}
+
+	for {
+		tok, _ := p.peekToken()
+		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+			return nil, errors.Errorf("parse error after column def %+v", tok)
+		}
+
+		word := tok.Value.(*sqltoken.SQLWord)
+		switch {
+		case p.isIndexTableElement():
+			index, err := p.parseIndexTableElement()
+			if err != nil {
+				return nil, errors.Errorf("parseIndexTableElement failed: %w", err)
+			}
+			elements = append(elements, index)
+		case word.Keyword == "LIKE":
+			like, err := p.parseLikeTableElement()
+			if err != nil {
+				return nil, errors.Errorf("parseLikeTableElement failed: %w", err)
+			}
+			elements = append(elements, like)
+		case word.Keyword == "CONSTRAINT", word.Keyword == "PRIMARY", word.Keyword == "CHECK", word.Keyword == "FOREIGN", word.Keyword == "UNIQUE":
+			constraints, err := p.ParseTableConstraint()
+			if err != nil {
+				return nil, errors.Errorf("ParseTableConstraint failed: %w", err)
+			}
+			elements = append(elements, constraints)
+
+		case partition:
+			def, err := p.parsePartitionColumnDef()
+			if err != nil {
+				return nil, errors.Errorf("parsePartitionColumnDef failed: %w", err)
+			}
+
+			elements = append(elements, def)
+		default:
+			def, err := p.ParseColumnDef()
+			if err != nil {
+				return nil, errors.Errorf("ParseColumnDef failed: %w", err)
+			}
+
+			elements = append(elements, def)
+		}
+
+		t, _ := p.nextToken()
+		if t == nil || (t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen) {
+			return nil, errors.Errorf("expected ',' or ')' after column definition but %+v", t)
+		} else if t.Kind == sqltoken.RParen {
+			break
+		}
+	}
+
+	return elements, nil
+}
+
+// isIndexTableElement reports whether the next table element is an index definition of MySQL
+// such as `KEY idx (a)`. KEY and INDEX can also be a column name, so the element is regarded as an index
+// only if the name is followed by USING or `(` and an identifier.
+func (p *Parser) isIndexTableElement() bool {
+	idx := p.index
+	defer func() {
+		p.index = idx
+	}()
+
+	unique := false
+	tok, _ := p.nextToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return false
+	}
+	switch tok.Value.(*sqltoken.SQLWord).Keyword {
+	case "UNIQUE":
+		unique = true
+		fallthrough
+	case "FULLTEXT", "SPATIAL":
+		ok, _, _ := p.parseKeyword("KEY")
+		if !ok {
+			ok, _, _ = p.parseKeyword("INDEX")
+		}
+		if !ok && unique {
+			return false
+		}
+	case "KEY", "INDEX":
+	default:
+		return false
+	}
+
+	// UNIQUE [KEY] (columns) without the name is a table constraint
+	if t, _ := p.peekToken(); t == nil || (unique && t.Kind == sqltoken.LParen) {
+		return false
+	}
+
+	if t, _ := p.peekToken(); t.Kind == sqltoken.SQLKeyword && t.Value.(*sqltoken.SQLWord).Keyword != "USING" {
+		p.mustNextToken()
+	}
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		return true
+	}
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		return false
+	}
+	t, _ := p.peekToken()
+	return t != nil && t.Kind == sqltoken.SQLKeyword
+}
+
+// parseIndexTableElement parses
+// [UNIQUE | FULLTEXT | SPATIAL] {KEY | INDEX} [name] [USING type] (key_part, ...) [USING type] [COMMENT 'string'] of MySQL.
+func (p *Parser) parseIndexTableElement() (*sqlast.IndexTableElement, error) {
+	tok := p.mustNextToken()
+	index := &sqlast.IndexTableElement{
+		From: tok.From,
+	}
+
+	kinds := map[string]sqlast.IndexKind{
+		"UNIQUE":   sqlast.UniqueIndex,
+		"FULLTEXT": sqlast.FulltextIndex,
+		"SPATIAL":  sqlast.SpatialIndex,
+	}
+	word := tok.Value.(*sqltoken.SQLWord)
+	if kind, ok := kinds[word.Keyword]; ok {
+		index.Type = kind
+		if ok, _, _ := p.parseKeyword("INDEX"); ok {
+			index.IsIndex = true
+		} else if ok, _, _ := p.parseKeyword("KEY"); !ok && kind == sqlast.UniqueIndex {
+			return nil, errors.Errorf("expected KEY or INDEX after UNIQUE")
+		}
+	} else if word.Keyword == "INDEX" {
+		index.IsIndex = true
+	} else if word.Keyword != "KEY" {
+		return nil, errors.Errorf("expected KEY or INDEX but %+v", word)
+	}
+
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SQLKeyword && t.Value.(*sqltoken.SQLWord).Keyword != "USING" {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		index.Name = name
+	}
+
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		using, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		index.Using = using
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	for {
+		c, err := p.parseIndexColumn()
+		if err != nil {
+			return nil, errors.Errorf("parseIndexColumn failed: %w", err)
+		}
+		index.Columns = append(index.Columns, c)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+	index.RParen = r.To
+
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		using, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		index.Using = using
+	}
+
+	comment, commentPos, err := p.parseMyIndexComment()
+	if err != nil {
+		return nil, errors.Errorf("parseMyIndexComment failed: %w", err)
+	}
+	index.Comment = comment
+	index.CommentPos = commentPos
+
+	return index, nil
+}
+
+// parseIndexColumn parses `name[(length)] [ASC | DESC]`.
+func (p *Parser) parseIndexColumn() (*sqlast.IndexColumn, error) {
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	c := &sqlast.IndexColumn{
+		Name: name,
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		l, _, err := p.parseLiteralInt()
+		if err != nil {
+			return nil, errors.Errorf("parseLiteralInt failed: %w", err)
+		}
+		length := uint(l)
+		c.Length = &length
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		c.RParen = r.To
+	}
+
+	if ok, tok, _ := p.parseKeyword("ASC"); ok {
+		asc := true
+		c.ASC = &asc
+		c.OrderingPos = tok.To
+	} else if ok, tok, _ := p.parseKeyword("DESC"); ok {
+		asc := false
+		c.ASC = &asc
+		c.OrderingPos = tok.To
+	}
+
+	return c, nil
+}
+
+// ParseColumnDef parses a single column definition such as
+// `id int PRIMARY KEY`, as found in CREATE TABLE or ALTER TABLE ADD COLUMN.
+func (p *Parser) ParseColumnDef() (*sqlast.ColumnDef, error) {
+	tok, _ := p.nextToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("expect column name but %+v", tok)
+	}
+	columnName := tok.Value.(*sqltoken.SQLWord)
+
+	dataType, err := p.ParseDataType()
+	if err != nil {
+		return nil, errors.Errorf("ParseDataType failed: %w", err)
+	}
+
+	def, specs, decorates, err := p.parseColumnDefinition()
+	if err != nil {
+		return nil, errors.Errorf("parseColumnDefinition: %w", err)
+	}
+
+	return &sqlast.ColumnDef{
+		Constraints:          specs,
+		Name:                 newIdent(tok, columnName),
+		MyDataTypeDecoration: decorates,
+		DataType:             dataType,
+		Default:              def,
+	}, nil
+}
+
+// parsePartitionColumnDef parses `column_name [WITH OPTIONS] [column_constraint ...]` of PARTITION OF (PostgreSQL).
+func (p *Parser) parsePartitionColumnDef() (*sqlast.ColumnDef, error) {
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	column := &sqlast.ColumnDef{Name: name}
+	if ok, toks, _ := p.parseKeywords("WITH", "OPTIONS"); ok {
+		column.WithOptions = true
+		column.WithOptionsPos = toks[1].To
+	}
+
+	def, specs, decorates, err := p.parseColumnDefinition()
+	if err != nil {
+		return nil, errors.Errorf("parseColumnDefinition: %w", err)
+	}
+	column.Default = def
+	column.Constraints = specs
+	column.MyDataTypeDecoration = decorates
+	return column, nil
+}
+
+// ParseTableConstraint parses a single table constraint such as
+// `CONSTRAINT pk PRIMARY KEY(id)`, as found in CREATE TABLE or ALTER TABLE ADD CONSTRAINT.
+func (p *Parser) ParseTableConstraint() (*sqlast.TableConstraint, error) {
+	tok, _ := p.peekToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("parse error after column def")
+	}
+
+	word, ok := tok.Value.(*sqltoken.SQLWord)
+
+	var constraintPos sqltoken.Pos
+	var name *sqlast.Ident
+	if ok && word.Keyword == "CONSTRAINT" {
+		constraintPos = tok.From
+		p.mustNextToken()
+		i, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		name = i
+	}
+
+	tok, _ = p.peekToken()
+
+	var spec sqlast.TableConstraintSpec
+	word = tok.Value.(*sqltoken.SQLWord)
+	switch word.Keyword {
+	case "UNIQUE":
+		p.mustNextToken()
+		if _, _, err := p.parseKeyword("KEY"); err != nil {
+			return nil, errors.Errorf("parseKeyword failed: %w", err)
+		}
+		nulls, _, err := p.parseNullsDistinct()
+		if err != nil {
+			return nil, errors.Errorf("parseNullsDistinct failed: %w", err)
+		}
+		p.expectToken(sqltoken.LParen)
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		comment, commentPos, err := p.parseMyIndexComment()
+		if err != nil {
+			return nil, errors.Errorf("parseMyIndexComment failed: %w", err)
+		}
+		spec = &sqlast.UniqueTableConstraint{
+			Unique:     tok.From,
+			Nulls:      nulls,
+			RParen:     r.To,
+			Columns:    columns,
+			CommentPos: commentPos,
+			Comment:    comment,
+		}
+	case "PRIMARY":
+		p.mustNextToken()
+		p.expectKeyword("KEY")
+		p.expectToken(sqltoken.LParen)
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		comment, commentPos, err := p.parseMyIndexComment()
+		if err != nil {
+			return nil, errors.Errorf("parseMyIndexComment failed: %w", err)
+		}
+		spec = &sqlast.UniqueTableConstraint{
+			Primary:    tok.From,
+			RParen:     r.To,
+			IsPrimary:  true,
+			Columns:    columns,
+			CommentPos: commentPos,
+			Comment:    comment,
+		}
+	case "FOREIGN":
+		p.mustNextToken()
+		p.expectKeyword("KEY")
+		p.expectToken(sqltoken.LParen)
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		p.expectToken(sqltoken.RParen)
+		p.expectKeyword("REFERENCES")
+
+		t, _ := p.nextToken()
+		w := t.Value.(*sqltoken.SQLWord)
+		p.expectToken(sqltoken.LParen)
+		refcolumns, err := p.parseColumnNames()
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		keys := &sqlast.ReferenceKeyExpr{
+			TableName: newIdent(t, w),
+			Columns:   refcolumns,
+			RParen:    r.To,
+		}
+
+		spec = &sqlast.ReferentialTableConstraint{
+			Foreign: tok.From,
+			Columns: columns,
+			KeyExpr: keys,
+		}
+	case "CHECK":
+		p.mustNextToken()
+		p.expectToken(sqltoken.LParen)
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		enforced, enforcedTo, err := p.parseCheckEnforced()
+		if err != nil {
+			return nil, errors.Errorf("parseCheckEnforced failed: %w", err)
+		}
+		spec = &sqlast.CheckTableConstraint{
+			Expr:       expr,
+			Check:      tok.From,
+			RParen:     r.To,
+			Enforced:   enforced,
+			EnforcedTo: enforcedTo,
+		}
+	default:
+		return nil, errors.Errorf("unknown table constraint: %v", word)
+	}
+
+	return &sqlast.TableConstraint{
+		Name:       name,
+		Spec:       spec,
+		Constraint: constraintPos,
+	}, nil
+}
+
+// parseNullsDistinct parses NULLS [ NOT ] DISTINCT of UNIQUE constraints.
+func (p *Parser) parseNullsDistinct() (sqlast.NullsDistinctOption, sqltoken.Pos, error) {
+	ok, tok, _ := p.parseKeyword("NULLS")
+	if !ok {
+		return sqlast.NullsDistinctOmitted, sqltoken.Pos{}, nil
+	}
+	if !dialect.Supports(p.dialect, dialect.UniqueNullsDistinct) {
+		return sqlast.NullsDistinctOmitted, sqltoken.Pos{}, unsupported("UNIQUE NULLS DISTINCT", tok.From)
+	}
+
+	nulls := sqlast.NullsDistinct
+	if ok, _, _ := p.parseKeyword("NOT"); ok {
+		nulls = sqlast.NullsNotDistinct
+	}
+	ok, dtok, _ := p.parseKeyword("DISTINCT")
+	if !ok {
+		return sqlast.NullsDistinctOmitted, sqltoken.Pos{}, errors.Errorf("expected DISTINCT but %+v", dtok)
+	}
+
+	return nulls, dtok.To, nil
+}
+
+// parseCheckEnforced parses [ NOT ] ENFORCED of CHECK constraints.
+func (p *Parser) parseCheckEnforced() (sqlast.EnforcedOption, sqltoken.Pos, error) {
+	enforced := sqlast.Enforced
+	ok, toks, _ := p.parseKeywords("NOT", "ENFORCED")
+	if !ok {
+		ok, toks, _ = p.parseKeywords("ENFORCED")
+		if !ok {
+			return sqlast.EnforcedOmitted, sqltoken.Pos{}, nil
+		}
+	} else {
+		enforced = sqlast.NotEnforced
+	}
+	if !dialect.Supports(p.dialect, dialect.EnforcedCheckConstraint) {
+		return sqlast.EnforcedOmitted, sqltoken.Pos{}, unsupported("CHECK ENFORCED", toks[0].From)
+	}
+
+	return enforced, toks[len(toks)-1].To, nil
+}
+
+// parseMyIndexComment parses COMMENT 'string' index option of MySQL.
+// It returns nil if the option is omitted.
+func (p *Parser) parseMyIndexComment() (*sqlast.SingleQuotedString, sqltoken.Pos, error) {
+	ok, tok, _ := p.parseKeyword("COMMENT")
+	if !ok {
+		return nil, sqltoken.Pos{}, nil
+	}
+
+	t, _ := p.nextToken()
+	if t == nil || t.Kind != sqltoken.SingleQuotedString {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected comment string but %+v", t)
+	}
+
+	return p.singleQuotedString(t), tok.From, nil
+}
+
+// TODO rethink mysql create table AST
+func (p *Parser) parseColumnDefinition() (sqlast.Node, []*sqlast.ColumnConstraint, []sqlast.MyDataTypeDecoration, error) {
+	var specs []*sqlast.ColumnConstraint
+	var def sqlast.Node
+	var decorates []sqlast.MyDataTypeDecoration
+
+COLUMN_DEF_LOOP:
+	for {
+		t, _ := p.peekToken()
+		if t == nil || t.Kind != sqltoken.SQLKeyword {
+			break
+		}
+
+		word := t.Value.(*sqltoken.SQLWord)
+
+		switch word.Keyword {
+		case "DEFAULT":
+			if ok, _, _ := p.parseKeyword("DEFAULT"); ok {
+				d, err := p.parseDefaultExpr(0)
+				if err != nil {
+					return nil, nil, nil, errors.Errorf("parseDefaultExpr failed: %w", err)
+				}
+				def = d
+				continue
+			}
+		case "CONSTRAINT", "NOT", "UNIQUE", "PRIMARY", "REFERENCES", "CHECK":
+			s, err := p.parseColumnConstraints()
+			if err != nil {
+				return nil, nil, nil, errors.Errorf("parseColumnConstraints failed: %w", err)
+			}
+			specs = s
+		case "AUTO_INCREMENT":
+			p.mustNextToken()
+			decorates = append(decorates, &sqlast.AutoIncrement{
+				Auto:      t.From,
+				Increment: t.To,
+			})
+		default:
+			break COLUMN_DEF_LOOP
+		}
+	}
+	return def, specs, decorates, nil
+}
+
+func (p *Parser) parseColumnConstraints() ([]*sqlast.ColumnConstraint, error) {
+	var constraints []*sqlast.ColumnConstraint
+
+CONSTRAINT_LOOP:
+	for {
+		tok, err := p.peekToken()
+		if tok == nil {
+			break CONSTRAINT_LOOP
+		}
+		if err != nil {
+			return nil, errors.Errorf("peekToken failed: %w", err)
+		}
+		word, ok := tok.Value.(*sqltoken.SQLWord)
+
+		var name *sqlast.Ident
+		if ok && word.Keyword == "CONSTRAINT" {
+			p.mustNextToken()
+			i, err := p.parseIdentifier()
+			if err != nil {
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			name = i
+		}
+
+		tok, _ = p.peekToken()
+		if tok.Kind != sqltoken.SQLKeyword {
+			break
+		}
+
+		var spec sqlast.ColumnConstraintSpec
+
+		word = tok.Value.(*sqltoken.SQLWord)
+		switch word.Keyword {
+		case "NOT":
+			p.mustNextToken()
+			ok, ntok, _ := p.parseKeyword("NULL")
+			if !ok {
+				return nil, errors.Errorf("expected NULL but +%v", ntok)
+			}
+			spec = &sqlast.NotNullColumnSpec{
+				Not:  tok.From,
+				Null: ntok.To,
+			}
+		case "UNIQUE":
+			p.mustNextToken()
+			nulls, nullsTo, err := p.parseNullsDistinct()
+			if err != nil {
+				return nil, errors.Errorf("parseNullsDistinct failed: %w", err)
+			}
+			spec = &sqlast.UniqueColumnSpec{
+				Unique:  tok.From,
+				Nulls:   nulls,
+				NullsTo: nullsTo,
+			}
+		case "PRIMARY":
+			p.mustNextToken()
+			ok, ktok, _ := p.parseKeyword("KEY")
+			if !ok {
+				return nil, errors.Errorf("expected KEY but +%v", ktok)
+			}
+			u := &sqlast.UniqueColumnSpec{IsPrimaryKey: true, Primary: tok.From, Key: ktok.To}
+			if ok, a, _ := p.parseKeyword("AUTOINCREMENT"); ok {
+				u.IsAutoIncrement = true
+				u.AutoIncrement = a.To
+			}
+			spec = u
+		case "REFERENCES":
+			p.mustNextToken()
+			tname, err := p.parseObjectName()
+			if err != nil {
+				return nil, errors.Errorf("parseObjectName failed: %w", err)
+			}
+			p.expectToken(sqltoken.LParen)
+			columns, err := p.parseColumnNames()
+			if err != nil {
+				return nil, errors.Errorf("parseColumnNames failed: %w", err)
+			}
+			r, _ := p.nextToken()
+			if r == nil || r.Kind != sqltoken.RParen {
+				return nil, errors.Errorf("expected RParen but %+v", r)
+			}
+			spec = &sqlast.ReferencesColumnSpec{
+				TableName:  tname,
+				Columns:    columns,
+				References: tok.From,
+				RParen:     r.To,
+			}
+		case "CHECK":
+			p.mustNextToken()
+			p.expectToken(sqltoken.LParen)
+			expr, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			r, _ := p.nextToken()
+			if r == nil || r.Kind != sqltoken.RParen {
+				return nil, errors.Errorf("expected RParen but %+v", r)
+			}
+			enforced, enforcedTo, err := p.parseCheckEnforced()
+			if err != nil {
+				return nil, errors.Errorf("parseCheckEnforced failed: %w", err)
+			}
+			spec = &sqlast.CheckColumnSpec{
+				Check:      tok.From,
+				Expr:       expr,
+				RParen:     r.To,
+				Enforced:   enforced,
+				EnforcedTo: enforcedTo,
+			}
+		default:
+			break CONSTRAINT_LOOP
+		}
+
+		constraints = append(constraints, &sqlast.ColumnConstraint{
+			Name: name,
+			Spec: spec,
+		})
+
+	}
+	return constraints, nil
+}
+
+func (p *Parser) parseTableOptions() ([]sqlast.TableOption, error) {
+	var opts []sqlast.TableOption
+
+	for {
+		tok, err := p.peekToken()
+		if err == EOF {
+			return opts, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if tok.Kind == sqltoken.Comma {
+			p.mustNextToken()
+			tok, _ = p.peekToken()
+		}
+		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+			break
+		}
+		// the next schema element of CREATE SCHEMA
+		if k := tok.Value.(*sqltoken.SQLWord).Keyword; k == "CREATE" || k == "GRANT" {
+			break
+		}
+		opt, err := p.parseTableOption()
+		if err != nil {
+			p.Debug()
+			return nil, errors.Errorf("parseTableOption failed: %w", err)
+		}
+		opts = append(opts, opt)
+	}
+
+	return opts, nil
+}
+
+func (p *Parser) parseTableOption() (sqlast.TableOption, error) {
+	tok, _ := p.peekToken()
+	if tok.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("must be SQLKeyword but: %v", tok)
+	}
+	word, _ := tok.Value.(*sqltoken.SQLWord)
+
+	p.mustNextToken()
+	switch word.Keyword {
+	case "ENGINE":
+		opt := &sqlast.MyEngine{
+			Engine: tok.From,
+		}
+		t, _ := p.peekToken()
+		if t.Kind == sqltoken.Eq {
+			opt.Equal = true
+			p.mustNextToken()
+			t, _ = p.peekToken()
+		}
+
+		if t.Kind != sqltoken.SQLKeyword {
+			return nil, errors.Errorf("expected '=' or 'engine_name' but: %v", t)
+		}
+		name, _ := p.parseIdentifier()
+		opt.Name = name
+		return opt, nil
+	case "DEFAULT":
+		if ok, t, _ := p.parseKeyword("COLLATE"); ok {
+			opt := &sqlast.MyCollate{
+				IsDefault: true,
+				Default:   tok.From,
+				Collate:   t.From,
+			}
+			equal, name, err := p.parseTableOptionValue("collation_name")
+			if err != nil {
+				return nil, err
+			}
+			opt.Equal = equal
+			opt.Name = name
+			return opt, nil
+		}
+
+		opt := &sqlast.MyCharset{
+			IsDefault: true,
+			Default:   tok.From,
+		}
+		ok, t, _ := p.parseKeyword("CHARSET")
+		if !ok {
+			ok, toks, _ := p.parseKeywords("CHARACTER", "SET")
+			if !ok {
+				return nil, errors.Errorf("expected CHARSET, CHARACTER SET or COLLATE but: %v", t)
+			}
+			t = toks[0]
+		}
+		opt.Charset = t.From
+
+		equal, name, err := p.parseTableOptionValue("charset_name")
+		if err != nil {
+			return nil, err
+		}
+		opt.Equal = equal
+		opt.Name = name
+
+		return opt, nil
+	case "CHARSET", "CHARACTER":
+		if word.Keyword == "CHARACTER" {
+			if ok, _, _ := p.parseKeyword("SET"); !ok {
+				t, _ := p.peekToken()
+				return nil, errors.Errorf("expected SET but %+v", t)
+			}
+		}
+		opt := &sqlast.MyCharset{
+			Charset: tok.From,
+		}
+		equal, name, err := p.parseTableOptionValue("charset_name")
+		if err != nil {
+			return nil, err
+		}
+		opt.Equal = equal
+		opt.Name = name
+
+		return opt, nil
+	case "COLLATE":
+		opt := &sqlast.MyCollate{
+			Collate: tok.From,
+		}
+		equal, name, err := p.parseTableOptionValue("collation_name")
+		if err != nil {
+			return nil, err
+		}
+		opt.Equal = equal
+		opt.Name = name
+
+		return opt, nil
+	case "WITHOUT":
+		ok, r, _ := p.parseKeyword("ROWID")
+		if !ok {
+			return nil, errors.Errorf("expected ROWID but: %v", r)
+		}
+		return &sqlast.SQLiteWithoutRowID{
+			Without: tok.From,
+			RowID:   r.To,
+		}, nil
+	default:
+		return nil, unsupported("table option "+word.Keyword, tok.From)
+	}
+}
+
+// parseTableOptionValue parses `[=] name` of table options
+func (p *Parser) parseTableOptionValue(expected string) (bool, *sqlast.Ident, error) {
+	var equal bool
+	t, _ := p.peekToken()
+	if t != nil && t.Kind == sqltoken.Eq {
+		equal = true
+		p.mustNextToken()
+		t, _ = p.peekToken()
+	}
+
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return false, nil, errors.Errorf("expected '=' or '%s' but: %v", expected, t)
+	}
+
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return false, nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	return equal, name, nil
+}
+
+func (p *Parser) parseDelete() (sqlast.Stmt, error) {
+	ok, d, _ := p.parseKeyword("DELETE")
+	if !ok {
+		return nil, errors.Errorf("expect DELETE but %+v", d)
+	}
+	hint := p.parseHint()
+
+	p.expectKeyword("FROM")
+	tableName, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	var using []sqlast.TableReference
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		using, err = p.parseFromClause()
+		if err != nil {
+			return nil, errors.Errorf("parseFromClause failed: %w", err)
+		}
+	}
+
+	var selection sqlast.Node
+	if ok, _, _ := p.parseKeyword("WHERE"); ok {
+		selection, err = p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+	}
+
+	orderBy, limit, err := p.parseOrderByLimit()
+	if err != nil {
+		return nil, errors.Errorf("parseOrderByLimit failed: %w", err)
+	}
+
+	returning, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
+	return &sqlast.DeleteStmt{
+		Delete:    d.From,
+		Hint:      hint,
+		TableName: tableName,
+		Using:     using,
+		Selection: selection,
+		OrderBy:   orderBy,
+		Limit:     limit,
+		Returning: returning,
+	}, nil
+}
+
+func (p *Parser) parseUpdate() (sqlast.Stmt, error) {
+	ok, u, _ := p.parseKeyword("UPDATE")
+	if !ok {
+		return nil, errors.Errorf("expect UPDATE but %+v", ok)
+	}
+	hint := p.parseHint()
+	tableName, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	p.expectKeyword("SET")
+
+	assignments, err := p.parseAssignments()
+	if err != nil {
+		return nil, errors.Errorf("parseAssignments failed: %w", err)
+	}
+
+	var from []sqlast.TableReference
+	if ok, _, _ := p.parseKeyword("FROM"); ok {
+		from, err = p.parseFromClause()
+		if err != nil {
+			return nil, errors.Errorf("parseFromClause failed: %w", err)
+		}
+	}
+
+	var selection sqlast.Node
+	if ok, _, _ := p.parseKeyword("WHERE"); ok {
+		selection, err = p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+	}
+
+	orderBy, limit, err := p.parseOrderByLimit()
+	if err != nil {
+		return nil, errors.Errorf("parseOrderByLimit failed: %w", err)
+	}
+
+	returning, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
+	return &sqlast.UpdateStmt{
+		Update:      u.From,
+		Hint:        hint,
+		TableName:   tableName,
+		Assignments: assignments,
+		FromClause:  from,
+		Selection:   selection,
+		OrderBy:     orderBy,
+		Limit:       limit,
+		Returning:   returning,
+	}, nil
+
+}
+
+// parseValuesRows parses the row list after VALUES keyword.
+func (p *Parser) parseValuesRows() ([]*sqlast.RowValueExpr, error) {
+	var rows []*sqlast.RowValueExpr
+	for {
+		l, _ := p.nextToken()
+		if l == nil || l.Kind != sqltoken.LParen {
+			return nil, errors.Errorf("expected LParen but %+v", l)
+		}
+		v, err := p.parseExprList()
+		if err != nil {
+			return nil, errors.Errorf("parseExprList failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		rows = append(rows, &sqlast.RowValueExpr{
+			Values: v,
+			LParen: l.From,
+			RParen: r.To,
+		})
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+	return rows, nil
+}
+
+// parseOrderByLimit parses ORDER BY and LIMIT clauses of UPDATE and DELETE.
+// They are parsed only when the dialect supports them.
+func (p *Parser) parseOrderByLimit() ([]*sqlast.OrderByExpr, *sqlast.LimitExpr, error) {
+	if !dialect.Supports(p.dialect, dialect.ModifyOrderByLimit) {
+		return nil, nil, nil
+	}
+
+	var orderBy []*sqlast.OrderByExpr
+	if ok, _, _ := p.parseKeywords("ORDER", "BY"); ok {
+		o, err := p.parseOrderByExprList()
+		if err != nil {
+			return nil, nil, errors.Errorf("parseOrderByExprList failed: %w", err)
+		}
+		orderBy = o
+	}
+
+	var limit *sqlast.LimitExpr
+	if ok, _, _ := p.parseKeyword("LIMIT"); ok {
+		l, err := p.parseLimit()
+		if err != nil {
+			return nil, nil, errors.Errorf("invalid limit expression: %w", err)
+		}
+		limit = l
+	}
+
+	return orderBy, limit, nil
+}
+
+// parseReturning parses RETURNING clause of INSERT, UPDATE and DELETE.
+// It returns nil if the clause is omitted.
+func (p *Parser) parseReturning() ([]sqlast.SQLSelectItem, error) {
+	if ok, _, _ := p.parseKeyword("RETURNING"); !ok {
+		return nil, nil
+	}
+
+	items, err := p.parseSelectList()
+	if err != nil {
+		return nil, errors.Errorf("parseSelectList failed: %w", err)
+	}
+	return items, nil
+}
+
+func (p *Parser) parseAssignments() ([]*sqlast.Assignment, error) {
+	var assignments []*sqlast.Assignment
+
+	for {
+		tok, _ := p.nextToken()
+		if tok == nil {
+			p.candidates.addTokens(sqltoken.SQLKeyword)
+		}
+		if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+			return nil, errors.Errorf("should be sqlkeyword but %v", tok)
+		}
+
+		word := tok.Value.(*sqltoken.SQLWord)
+
+		if ok, _ := p.consumeToken(sqltoken.Eq); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected = but %+v", t)
+		}
+
+		val, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+
+		assignments = append(assignments, &sqlast.Assignment{
+			ID:    newIdent(tok, word),
+			Value: val,
+		})
+
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	return assignments, nil
+}
+
+func (p *Parser) parseInsert() (sqlast.Stmt, error) {
+	ok, i, _ := p.parseKeyword("INSERT")
+	if !ok {
+		return nil, errors.Errorf("expected INSERT but %+v", i)
+	}
+
+	p.expectKeyword("INTO")
+	tableName, err := p.parseObjectName()
+
+	if err != nil {
+		return nil, errors.Errorf("invalid table name: %w", err)
+	}
+	var columns []*sqlast.Ident
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		columns, err = p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("invalid column names: %w", err)
+		}
+		p.expectToken(sqltoken.RParen)
+	}
+
+	var insertSrc sqlast.InsertSource
+	if ok, _, _ := p.parseKeyword("VALUES"); !ok {
+		q, err := p.parseQuery()
+		if err != nil {
+			return nil, errors.Errorf("invalid select source: expected query: %w", err)
+		}
+		insertSrc = &sqlast.SubQuerySource{
+			SubQuery: q,
+		}
+	} else {
+		rows, err := p.parseValuesRows()
+		if err != nil {
+			return nil, errors.Errorf("invalid insert value assign: %w", err)
+		}
+		insertSrc = &sqlast.ConstructorSource{
+			Rows: rows,
+		}
+	}
+
+	var onConflict *sqlast.OnConflict
+	if ok, toks, _ := p.parseKeywords("ON", "CONFLICT"); ok {
+		o, err := p.parseOnConflict(toks[0])
+		if err != nil {
+			return nil, errors.Errorf("parseOnConflict failed: %w", err)
+		}
+		onConflict = o
+	}
+
+	var assigns []*sqlast.Assignment
+	if ok, _, _ := p.parseKeywords("ON", "DUPLICATE", "KEY", "UPDATE"); ok {
+		assignments, err := p.parseAssignments()
+		if err != nil {
+			return nil, errors.Errorf("invalid DUPLICATE KEY UPDATE assignments: %w", err)
+		}
+		assigns = assignments
+	}
+
+	returning, err := p.parseReturning()
+	if err != nil {
+		return nil, errors.Errorf("parseReturning failed: %w", err)
+	}
+
+	return &sqlast.InsertStmt{
+		Insert:            i.From,
+		TableName:         tableName,
+		Columns:           columns,
+		Source:            insertSrc,
+		UpdateAssignments: assigns,
+		OnConflict:        onConflict,
+		Returning:         returning,
+	}, nil
+}
+
+func (p *Parser) parseOnConflict(on *sqltoken.Token) (*sqlast.OnConflict, error) {
+	onConflict := &sqlast.OnConflict{
+		On: on.From,
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		onConflict.Columns = columns
+		onConflict.RParen = r.To
+
+		if ok, _, _ := p.parseKeyword("WHERE"); ok {
+			expr, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			onConflict.TargetWhere = expr
+		}
+	} else if ok, _, _ := p.parseKeywords("ON", "CONSTRAINT"); ok {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		onConflict.Constraint = name
+	}
+
+	if ok, _, _ := p.parseKeyword("DO"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected DO but %+v", t)
+	}
+
+	if ok, tok, _ := p.parseKeyword("NOTHING"); ok {
+		onConflict.DoNothing = true
+		onConflict.Nothing = tok.To
+		return onConflict, nil
+	}
+
+	if ok, _, _ := p.parseKeywords("UPDATE", "SET"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected NOTHING or UPDATE SET but %+v", t)
+	}
+
+	assignments, err := p.parseAssignments()
+	if err != nil {
+		return nil, errors.Errorf("parseAssignments failed: %w", err)
+	}
+	onConflict.Assignments = assignments
+
+	if ok, _, _ := p.parseKeyword("WHERE"); ok {
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		onConflict.Selection = expr
+	}
+
+	return onConflict, nil
+}
+
+func (p *Parser) parseAlter() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("ALTER")
+	if !ok {
+		return nil, errors.Errorf("expected ALTER but %s", tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("SEQUENCE"); ok {
+		return p.parseAlterSequence(tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("VIEW"); ok {
+		return p.parseAlterView(tok)
+	}
+
+	p.expectKeyword("TABLE")
+
+	tableName, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	var actions []sqlast.AlterTableAction
+	for {
+		action, err := p.parseAlterTableAction()
+		if err != nil {
+			return nil, errors.Errorf("parseAlterTableAction failed: %w", err)
+		}
+		actions = append(actions, action)
+
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	return &sqlast.AlterTableStmt{
+		Alter:     tok.From,
+		TableName: tableName,
+		Actions:   actions,
+	}, nil
+}
+
+func (p *Parser) parseAlterTableAction() (sqlast.AlterTableAction, error) {
+	if ok, toks, _ := p.parseKeywords("ADD", "COLUMN"); ok {
+		columnDef, err := p.ParseColumnDef()
+		if err != nil {
+			return nil, errors.Errorf("ParseColumnDef failed: %w", err)
+		}
+
+		return &sqlast.AddColumnTableAction{
+			Add:    toks[0].From,
+			Column: columnDef,
+		}, nil
+	}
+
+	if ok, add, _ := p.parseKeyword("ADD"); ok {
+		constraint, err := p.ParseTableConstraint()
+		if err != nil {
+			return nil, errors.Errorf("ParseTableConstraint failed: %w", err)
+		}
+
+		return &sqlast.AddConstraintTableAction{
+			Add:        add.From,
+			Constraint: constraint,
+		}, nil
+	}
+
+	if ok, toks, _ := p.parseKeywords("DROP", "CONSTRAINT"); ok {
+		constraintName, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		var caspos sqltoken.Pos
+		cascade, t, _ := p.parseKeyword("CASCADE")
+		if cascade {
+			caspos = t.To
+		}
+
+		return &sqlast.DropConstraintTableAction{
+			Drop:       toks[0].From,
+			Name:       constraintName,
+			Cascade:    cascade,
+			CascadePos: caspos,
+		}, nil
+	}
+
+	if dialect.Supports(p.dialect, dialect.DropPrimaryKey) {
+		if ok, toks, _ := p.parseKeywords("DROP", "PRIMARY", "KEY"); ok {
+			return &sqlast.MyDropPrimaryKeyTableAction{
+				Drop: toks[0].From,
+				Key:  toks[2].To,
+			}, nil
+		}
+	}
+
+	if ok, toks, _ := p.parseKeywords("DROP", "COLUMN"); ok {
+		constraintName, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		var caspos sqltoken.Pos
+		cascade, t, _ := p.parseKeyword("CASCADE")
+		if cascade {
+			caspos = t.To
+		}
+
+		return &sqlast.RemoveColumnTableAction{
+			Name:       constraintName,
+			Drop:       toks[0].From,
+			Cascade:    cascade,
+			CascadePos: caspos,
+		}, nil
+	}
+
+	if ok, toks, _ := p.parseKeywords("ALTER", "COLUMN"); ok {
+		action, err := p.parseAlterColumn(toks[0])
+		if err != nil {
+			return nil, errors.Errorf("parseAlterColumn failed: %w", err)
+		}
+		return action, nil
+	}
+
+	if ok, rename, _ := p.parseKeyword("RENAME"); ok {
+		return p.parseRenameTableAction(rename)
+	}
 
-		var spec sqlast.ColumnConstraintSpec
+	if ok, toks, _ := p.parseKeywords("ATTACH", "PARTITION"); ok {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		bound, err := p.parsePartitionBound()
+		if err != nil {
+			return nil, errors.Errorf("parsePartitionBound failed: %w", err)
+		}
+		return &sqlast.AttachPartitionTableAction{
+			Attach: toks[0].From,
+			Name:   name,
+			Bound:  bound,
+		}, nil
+	}
 
-		word = tok.Value.(*sqltoken.SQLWord)
-		switch word.Keyword {
-		case "NOT":
-			p.mustNextToken()
-			ok, ntok, _ := p.parseKeyword("NULL")
-			if !ok {
-				return nil, errors.Errorf("expected NULL but +%v", ntok)
-			}
-			spec = &sqlast.NotNullColumnSpec{
-				Not:  tok.From,
-				Null: ntok.To,
-			}
-		case "UNIQUE":
-			p.mustNextToken()
-			spec = &sqlast.UniqueColumnSpec{
-				Unique: tok.From,
-			}
-		case "PRIMARY":
-			p.mustNextToken()
-			ok, ktok, _ := p.parseKeyword("KEY")
-			if !ok {
-				return nil, errors.Errorf("expected KEY but +%v", ktok)
-			}
-			spec = &sqlast.UniqueColumnSpec{IsPrimaryKey: true, Primary: tok.From, Key: ktok.To}
-		case "REFERENCES":
-			p.mustNextToken()
-			tname, err := p.parseObjectName()
+	if ok, toks, _ := p.parseKeywords("DETACH", "PARTITION"); ok {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		action := &sqlast.DetachPartitionTableAction{
+			Detach: toks[0].From,
+			Name:   name,
+			To:     name.End(),
+		}
+		if ok, tok, _ := p.parseKeyword("CONCURRENTLY"); ok {
+			action.Concurrently = true
+			action.To = tok.To
+		} else if ok, tok, _ := p.parseKeyword("FINALIZE"); ok {
+			action.Finalize = true
+			action.To = tok.To
+		}
+		return action, nil
+	}
+
+	// MODIFY and CHANGE are not tried unless the dialect supports them,
+	// so that Complete doesn't suggest them.
+	if dialect.Supports(p.dialect, dialect.ModifyColumn) {
+		if ok, modify, _ := p.parseKeyword("MODIFY"); ok {
+			p.parseKeyword("COLUMN")
+			column, err := p.ParseColumnDef()
 			if err != nil {
-				return nil, errors.Errorf("parseObjectName failed: %w", err)
+				return nil, errors.Errorf("ParseColumnDef failed: %w", err)
 			}
-			p.expectToken(sqltoken.LParen)
-			columns, err := p.parseColumnNames()
+			position, err := p.parseMyColumnPosition()
 			if err != nil {
-				return nil, errors.Errorf("parseColumnNames failed: %w", err)
-			}
-			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
-				return nil, errors.Errorf("expected RParen but %+v", r)
-			}
-			spec = &sqlast.ReferencesColumnSpec{
-				TableName:  tname,
-				Columns:    columns,
-				References: tok.From,
-				RParen:     r.To,
+				return nil, errors.Errorf("parseMyColumnPosition failed: %w", err)
 			}
-		case "CHECK":
-			p.mustNextToken()
-			p.expectToken(sqltoken.LParen)
-			expr, err := p.ParseExpr()
+
+			return &sqlast.MyModifyColumnTableAction{
+				Modify:   modify.From,
+				Column:   column,
+				Position: position,
+			}, nil
+		}
+
+		if ok, change, _ := p.parseKeyword("CHANGE"); ok {
+			p.parseKeyword("COLUMN")
+			oldName, err := p.parseIdentifier()
 			if err != nil {
-				return nil, errors.Errorf("ParseExpr failed: %w", err)
+				return nil, errors.Errorf("parseIdentifier failed: %w", err)
 			}
-			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
-				return nil, errors.Errorf("expected RParen but %+v", r)
+			column, err := p.ParseColumnDef()
+			if err != nil {
+				return nil, errors.Errorf("ParseColumnDef failed: %w", err)
 			}
-			spec = &sqlast.CheckColumnSpec{
-				Check:  tok.From,
-				Expr:   expr,
-				RParen: r.To,
+			position, err := p.parseMyColumnPosition()
+			if err != nil {
+				return nil, errors.Errorf("parseMyColumnPosition failed: %w", err)
 			}
-		default:
-			break CONSTRAINT_LOOP
+
+			return &sqlast.MyChangeColumnTableAction{
+				Change:   change.From,
+				OldName:  oldName,
+				Column:   column,
+				Position: position,
+			}, nil
 		}
+	}
 
-		constraints = append(constraints, &sqlast.ColumnConstraint{
-			Name: name,
-			Spec: spec,
-		})
+	t, _ := p.peekToken()
+	return nil, errors.Errorf("unknown alter operation %v", t)
+}
 
+// parseMyColumnPosition parses optional FIRST | AFTER col_name of MySQL
+func (p *Parser) parseMyColumnPosition() (*sqlast.MyColumnPosition, error) {
+	if ok, first, _ := p.parseKeyword("FIRST"); ok {
+		return &sqlast.MyColumnPosition{
+			From: first.From,
+			To:   first.To,
+		}, nil
 	}
-	return constraints, nil
+
+	if ok, after, _ := p.parseKeyword("AFTER"); ok {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		return &sqlast.MyColumnPosition{
+			From:  after.From,
+			To:    after.To,
+			After: name,
+		}, nil
+	}
+
+	return nil, nil
 }
 
-func (p *Parser) parseTableOptions() ([]sqlast.TableOption, error) {
-	var opts []sqlast.TableOption
+// parseRenameTableAction parses RENAME TO new_name, RENAME [COLUMN] old TO new and RENAME CONSTRAINT old TO new
+// after RENAME keyword
+func (p *Parser) parseRenameTableAction(rename *sqltoken.Token) (sqlast.AlterTableAction, error) {
+	if ok, _, _ := p.parseKeyword("TO"); ok {
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
+		}
+		return &sqlast.RenameTableAction{
+			Rename:  rename.From,
+			NewName: name,
+		}, nil
+	}
+
+	constraint, _, _ := p.parseKeyword("CONSTRAINT")
+	if !constraint {
+		p.parseKeyword("COLUMN")
+	}
+
+	oldName, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	if ok, _, _ := p.parseKeyword("TO"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected TO but %+v", t)
+	}
+	newName, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	if constraint {
+		return &sqlast.RenameConstraintTableAction{
+			Rename:  rename.From,
+			OldName: oldName,
+			NewName: newName,
+		}, nil
+	}
+	return &sqlast.RenameColumnTableAction{
+		Rename:  rename.From,
+		OldName: oldName,
+		NewName: newName,
+	}, nil
+}
+
+func (p *Parser) parseDrop() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DROP")
+	if !ok {
+		return nil, errors.Errorf("expected DROP but %s", tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("SCHEMA"); ok {
+		return p.parseDropSchema(tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("SEQUENCE"); ok {
+		return p.parseDropSequence(tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("TRIGGER"); ok {
+		return p.parseDropTrigger(tok)
+	}
+
+	if ok, _, _ := p.parseKeyword("VIEW"); ok {
+		return p.parseDropView(tok, false)
+	}
+
+	if ok, _, _ := p.parseKeywords("MATERIALIZED", "VIEW"); ok {
+		return p.parseDropView(tok, true)
+	}
+
+	ok, _, _ = p.parseKeyword("TABLE")
+
+	if !ok {
+		p.expectKeyword("INDEX")
+		return p.parseDropIndex(tok)
+	}
+	exists, names, err := p.parseDropObjects()
+	if err != nil {
+		return nil, errors.Errorf("parseDropObjects failed: %w", err)
+	}
+	cascade, caspos, restrict, respos := p.parseDropBehavior()
+
+	return &sqlast.DropTableStmt{
+		Drop:        tok.From,
+		TableNames:  names,
+		Cascade:     cascade,
+		IfExists:    exists,
+		CascadePos:  caspos,
+		Restrict:    restrict,
+		RestrictPos: respos,
+	}, nil
+}
+
+func (p *Parser) parseTruncate() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("TRUNCATE")
+	if !ok {
+		return nil, errors.Errorf("expected TRUNCATE but %s", tok)
+	}
+	p.parseKeyword("TABLE")
+
+	stmt := &sqlast.TruncateStmt{
+		Truncate: tok.From,
+	}
 
 	for {
-		tok, err := p.peekToken()
-		if err == EOF {
-			return opts, nil
-		} else if err != nil {
-			return nil, err
+		name, err := p.parseObjectName()
+		if err != nil {
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
-
-		if tok.Kind == sqltoken.Comma {
-			tok = p.mustNextToken()
+		stmt.TableNames = append(stmt.TableNames, name)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
 		}
-		if tok.Kind != sqltoken.SQLKeyword {
+	}
+
+	if ok, toks, _ := p.parseKeywords("RESTART", "IDENTITY"); ok {
+		stmt.RestartIdentity = true
+		stmt.IdentityPos = toks[1].To
+	}
+
+	if ok, t, _ := p.parseKeyword("CASCADE"); ok {
+		stmt.Cascade = true
+		stmt.CascadePos = t.To
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseSetVariable() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("SET")
+	if !ok {
+		return nil, errors.Errorf("expected SET but %s", tok)
+	}
+
+	stmt := &sqlast.SetVariableStmt{
+		Set: tok.From,
+	}
+
+	for _, scope := range []string{"SESSION", "LOCAL", "GLOBAL"} {
+		if ok, t, _ := p.parseKeyword(scope); ok {
+			stmt.Scope = newIdent(t, t.Value.(*sqltoken.SQLWord))
 			break
 		}
-		opt, err := p.parseTableOption()
+	}
+
+	if ok, _, _ := p.parseKeyword("NAMES"); ok {
+		stmt.Names = true
+		charset, err := p.ParseExpr()
 		if err != nil {
-			p.Debug()
-			return nil, errors.Errorf("parseTableOption failed: %w", err)
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
-		opts = append(opts, opt)
+		stmt.Values = []sqlast.Node{charset}
+		return stmt, nil
 	}
 
-	return opts, nil
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	stmt.Name = name
+
+	if ok, _, _ := p.parseKeyword("TO"); ok {
+		stmt.UseTo = true
+	} else if ok, _ := p.consumeToken(sqltoken.Eq); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected TO or = but %+v", t)
+	}
+
+	values, err := p.parseExprList()
+	if err != nil {
+		return nil, errors.Errorf("parseExprList failed: %w", err)
+	}
+	stmt.Values = values
+
+	return stmt, nil
 }
 
-func (p *Parser) parseTableOption() (sqlast.TableOption, error) {
-	tok, _ := p.peekToken()
-	if tok.Kind != sqltoken.SQLKeyword {
-		return nil, errors.Errorf("must be SQLKeyword but: %v", tok)
+func (p *Parser) parseShow() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("SHOW")
+	if !ok {
+		return nil, errors.Errorf("expected SHOW but %s", tok)
+	}
+
+	for _, kw := range []string{"WARNINGS", "ERRORS"} {
+		if ok, t, _ := p.parseKeyword(kw); ok {
+			return p.parseShowWarnings(tok, t)
+		}
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	return &sqlast.ShowStmt{
+		Show: tok.From,
+		Name: name,
+	}, nil
+}
+
+func (p *Parser) parseShowWarnings(show, kw *sqltoken.Token) (sqlast.Stmt, error) {
+	stmt := &sqlast.ShowWarningsStmt{
+		Show:   show.From,
+		Errors: kw.Value.(*sqltoken.SQLWord).Keyword == "ERRORS",
+		To:     kw.To,
+	}
+
+	if ok, _, _ := p.parseKeyword("LIMIT"); !ok {
+		return stmt, nil
+	}
+
+	limit, err := p.parseSignedLong()
+	if err != nil {
+		return nil, errors.Errorf("parseSignedLong failed: %w", err)
+	}
+	if ok, _ := p.consumeToken(sqltoken.Comma); ok {
+		stmt.Offset = limit
+		limit, err = p.parseSignedLong()
+		if err != nil {
+			return nil, errors.Errorf("parseSignedLong failed: %w", err)
+		}
+	}
+	stmt.Limit = limit
+
+	return stmt, nil
+}
+
+func (p *Parser) parseKill() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("KILL")
+	if !ok {
+		return nil, errors.Errorf("expected KILL but %s", tok)
+	}
+
+	stmt := &sqlast.KillStmt{
+		Kill: tok.From,
+	}
+
+	if ok, _, _ := p.parseKeyword("QUERY"); ok {
+		stmt.Modifier = sqlast.QueryKillModifier
+	} else if ok, _, _ := p.parseKeyword("CONNECTION"); ok {
+		stmt.Modifier = sqlast.ConnectionKillModifier
+	}
+
+	id, err := p.parseSignedLong()
+	if err != nil {
+		return nil, errors.Errorf("parseSignedLong failed: %w", err)
+	}
+	stmt.ID = id
+
+	return stmt, nil
+}
+
+func (p *Parser) parseUse() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("USE")
+	if !ok {
+		return nil, errors.Errorf("expected USE but %s", tok)
+	}
+
+	database, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+
+	return &sqlast.UseStmt{
+		Use:      tok.From,
+		Database: database,
+	}, nil
+}
+
+func (p *Parser) parseCopy() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("COPY")
+	if !ok {
+		return nil, errors.Errorf("expected COPY but %s", tok)
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+
+	stmt := &sqlast.CopyStmt{
+		Copy:      tok.From,
+		TableName: name,
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		columns, err := p.parseColumnNames()
+		if err != nil {
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
+		}
+		if ok, _ := p.consumeToken(sqltoken.RParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected RParen but %+v", t)
+		}
+		stmt.Columns = columns
 	}
-	word, _ := tok.Value.(*sqltoken.SQLWord)
 
-	p.mustNextToken()
-	switch word.Keyword {
-	case "ENGINE":
-		opt := &sqlast.MyEngine{
-			Engine: tok.From,
-		}
+	var stdio string
+	if ok, _, _ := p.parseKeyword("TO"); ok {
+		stmt.To = true
+		stdio = "STDOUT"
+	} else if ok, _, _ := p.parseKeyword("FROM"); ok {
+		stdio = "STDIN"
+	} else {
 		t, _ := p.peekToken()
-		if t.Kind == sqltoken.Eq {
-			opt.Equal = true
-			p.mustNextToken()
-			t, _ = p.peekToken()
-		}
+		return nil, errors.Errorf("expected FROM or TO but %+v", t)
+	}
 
-		if t.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("expected '=' or 'engine_name' but: %v", t)
-		}
-		name, _ := p.parseIdentifier()
-		opt.Name = name
-		return opt, nil
-	case "DEFAULT":
-		opt := &sqlast.MyCharset{
-			IsDefault: true,
-			Default:   tok.From,
-		}
-		ok, t, err := p.parseKeyword("CHARSET")
-		if !ok || err != nil {
-			return nil, errors.Errorf("expected CHARSET but: %v", t)
+	if ok, t, _ := p.parseKeyword(stdio); ok {
+		stmt.StdioPos = t.To
+	} else {
+		t := p.mustNextToken()
+		if t.Kind != sqltoken.SingleQuotedString {
+			return nil, errors.Errorf("expected %s or file name but %+v", stdio, t)
 		}
-		opt.Charset = t.From
+		stmt.File = p.singleQuotedString(t)
+	}
 
-		t, _ = p.peekToken()
-		if t.Kind == sqltoken.Eq {
-			opt.Equal = true
-			p.mustNextToken()
-			t, _ = p.peekToken()
+	p.parseKeyword("WITH")
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		options, rparen, err := p.parseOptions()
+		if err != nil {
+			return nil, errors.Errorf("parseOptions failed: %w", err)
 		}
+		stmt.Options = options
+		stmt.RParen = rparen
+	}
 
-		if t.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("expected '=' or 'charset_name' but: %v", t)
-		}
+	if stmt.To || stmt.File != nil {
+		return stmt, nil
+	}
 
-		name, _ := p.parseIdentifier()
+	// inline data rows follow the statement delimiter
+	i := p.index
+	if ok, _ := p.consumeToken(sqltoken.Semicolon); ok {
+		if t, _ := p.nextToken(); t != nil && t.Kind == sqltoken.CopyData {
+			data := t.Value.(string)
+			stmt.Data = &data
+			stmt.DataEnd = t.To
+			return stmt, nil
+		}
+	}
+	p.index = i
 
-		opt.Name = name
+	return stmt, nil
+}
 
-		return opt, nil
-	case "CHARSET":
-		opt := &sqlast.MyCharset{
-			Charset: tok.From,
+// parseOptions parses options of COPY and EXPLAIN after the left parenthesis, i.e: FORMAT csv, HEADER true)
+func (p *Parser) parseOptions() ([]*sqlast.CopyOption, sqltoken.Pos, error) {
+	var options []*sqlast.CopyOption
+	for {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return nil, sqltoken.Pos{}, errors.Errorf("parseIdentifier failed: %w", err)
 		}
+		option := &sqlast.CopyOption{Name: name}
+
 		t, _ := p.peekToken()
-		if t.Kind == sqltoken.Eq {
-			opt.Equal = true
-			p.mustNextToken()
-			t, _ = p.peekToken()
+		switch {
+		case t == nil:
+			return nil, sqltoken.Pos{}, errors.Errorf("unexpected EOF in options")
+		case t.Kind == sqltoken.SQLKeyword:
+			value, err := p.parseIdentifier()
+			if err != nil {
+				return nil, sqltoken.Pos{}, errors.Errorf("parseIdentifier failed: %w", err)
+			}
+			option.Value = value
+		case t.Kind != sqltoken.Comma && t.Kind != sqltoken.RParen:
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, sqltoken.Pos{}, errors.Errorf("parseValue failed: %w", err)
+			}
+			option.Value = value
 		}
+		options = append(options, option)
 
-		if t.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("expected '=' or 'charset_name' but: %v", t)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
 		}
+	}
+	rparen := p.mustNextToken()
+	if rparen.Kind != sqltoken.RParen {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected RParen but %+v", rparen)
+	}
 
-		name, _ := p.parseIdentifier()
-		opt.Name = name
+	return options, rparen.To, nil
+}
 
-		return opt, nil
-	default:
-		return nil, errors.Errorf("unsupported Table Options: %v", word)
+func (p *Parser) parseExplain() (sqlast.Stmt, error) {
+	tok := p.mustNextToken()
+	stmt := &sqlast.ExplainStmt{
+		Explain:  tok.From,
+		Describe: tok.Value.(*sqltoken.SQLWord).Keyword != "EXPLAIN",
 	}
-}
+	stmt.Analyze, _, _ = p.parseKeyword("ANALYZE")
+	stmt.Verbose, _, _ = p.parseKeyword("VERBOSE")
 
-func (p *Parser) parseDelete() (sqlast.Stmt, error) {
-	ok, d, _ := p.parseKeyword("DELETE")
-	if !ok {
-		return nil, errors.Errorf("expect DELETE but %+v", d)
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		options, _, err := p.parseOptions()
+		if err != nil {
+			return nil, errors.Errorf("parseOptions failed: %w", err)
+		}
+		stmt.Options = options
 	}
 
-	p.expectKeyword("FROM")
-	tableName, err := p.parseObjectName()
-	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	if ok, _, _ := p.parseKeyword("FORMAT"); ok {
+		if ok, _ := p.consumeToken(sqltoken.Eq); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected = after FORMAT but %+v", t)
+		}
+		format, err := p.parseIdentifier()
+		if err != nil {
+			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		stmt.Format = format
 	}
 
-	var selection sqlast.Node
-	if ok, _, _ := p.parseKeyword("WHERE"); ok {
-		selection, err = p.ParseExpr()
+	if t, _ := p.peekToken(); t != nil && !isStatementKeyword(t) {
+		table, err := p.parseObjectName()
 		if err != nil {
-			return nil, errors.Errorf("ParseExpr failed: %w", err)
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
+		stmt.Table = table
+		return stmt, nil
 	}
 
-	return &sqlast.DeleteStmt{
-		Delete:    d.From,
-		TableName: tableName,
-		Selection: selection,
-	}, nil
+	s, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Stmt = s
+	return stmt, nil
 }
 
-func (p *Parser) parseUpdate() (sqlast.Stmt, error) {
-	ok, u, _ := p.parseKeyword("UPDATE")
+func (p *Parser) parsePrepare() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("PREPARE")
 	if !ok {
-		return nil, errors.Errorf("expect UPDATE but %+v", ok)
+		return nil, errors.Errorf("expected PREPARE but %s", tok)
 	}
-	tableName, err := p.parseObjectName()
+	name, err := p.parseIdentifier()
 	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
 	}
-	p.expectKeyword("SET")
-
-	assignments, err := p.parseAssignments()
-	if err != nil {
-		return nil, errors.Errorf("parseAssignments failed: %w", err)
+	stmt := &sqlast.PrepareStmt{
+		Prepare: tok.From,
+		Name:    name,
 	}
 
-	var selection sqlast.Node
-	if ok, _, _ := p.parseKeyword("WHERE"); ok {
-		selection, err = p.ParseExpr()
+	if ok, _, _ := p.parseKeyword("FROM"); ok {
+		from, err := p.ParseExpr()
 		if err != nil {
 			return nil, errors.Errorf("ParseExpr failed: %w", err)
 		}
+		stmt.From = from
+		return stmt, nil
 	}
 
-	return &sqlast.UpdateStmt{
-		Update:      u.From,
-		TableName:   tableName,
-		Assignments: assignments,
-		Selection:   selection,
-	}, nil
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		for {
+			tp, err := p.ParseDataType()
+			if err != nil {
+				return nil, errors.Errorf("ParseDataType failed: %w", err)
+			}
+			stmt.Types = append(stmt.Types, tp)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		if ok, _ := p.consumeToken(sqltoken.RParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected RParen but %+v", t)
+		}
+	}
 
+	if ok, _, _ := p.parseKeyword("AS"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected AS or FROM but %+v", t)
+	}
+	s, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+	stmt.Stmt = s
+	return stmt, nil
 }
 
-func (p *Parser) parseAssignments() ([]*sqlast.Assignment, error) {
-	var assignments []*sqlast.Assignment
+func (p *Parser) parseExecute() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("EXECUTE")
+	if !ok {
+		return nil, errors.Errorf("expected EXECUTE but %s", tok)
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt := &sqlast.ExecuteStmt{
+		Execute: tok.From,
+		Name:    name,
+	}
 
-	for {
-		tok, _ := p.nextToken()
-		if tok.Kind != sqltoken.SQLKeyword {
-			return nil, errors.Errorf("should be sqlkeyword but %v", tok)
+	if ok, _, _ := p.parseKeyword("USING"); ok {
+		args, err := p.parseExprList()
+		if err != nil {
+			return nil, errors.Errorf("parseExprList failed: %w", err)
 		}
-
-		word := tok.Value.(*sqltoken.SQLWord)
-
-		p.expectToken(sqltoken.Eq)
-
-		val, err := p.ParseExpr()
+		stmt.Args = args
+		stmt.Using = true
+	} else if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		args, err := p.parseExprList()
 		if err != nil {
-			return nil, errors.Errorf("ParseExpr failed: %w", err)
+			return nil, errors.Errorf("parseExprList failed: %w", err)
 		}
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
+		stmt.Args = args
+		stmt.RParen = rparen.To
+	}
+	return stmt, nil
+}
 
-		assignments = append(assignments, &sqlast.Assignment{
-			ID:    sqlast.NewIdentWithPos(word.String(), tok.From, tok.To),
-			Value: val,
-		})
+func (p *Parser) parseDeallocate() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("DEALLOCATE")
+	if !ok {
+		return nil, errors.Errorf("expected DEALLOCATE but %s", tok)
+	}
+	stmt := &sqlast.DeallocateStmt{
+		Deallocate: tok.From,
+	}
+	stmt.Prepare, _, _ = p.parseKeyword("PREPARE")
 
-		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
-			break
+	if ok, all, _ := p.parseKeyword("ALL"); ok {
+		stmt.AllPos = all.To
+		return stmt, nil
+	}
+	name, err := p.parseIdentifier()
+	if err != nil {
+		return nil, errors.Errorf("parseIdentifier failed: %w", err)
+	}
+	stmt.Name = name
+	return stmt, nil
+}
+
+func (p *Parser) parseVacuum() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("VACUUM")
+	if !ok {
+		return nil, errors.Errorf("expected VACUUM but %s", tok)
+	}
+	stmt := &sqlast.VacuumStmt{
+		Vacuum: tok.From,
+		To:     tok.To,
+	}
+
+	for _, k := range []struct {
+		keyword string
+		flag    *bool
+	}{
+		{"FULL", &stmt.Full},
+		{"FREEZE", &stmt.Freeze},
+		{"VERBOSE", &stmt.Verbose},
+		{"ANALYZE", &stmt.Analyze},
+	} {
+		if ok, t, _ := p.parseKeyword(k.keyword); ok {
+			*k.flag = true
+			stmt.To = t.To
 		}
 	}
 
-	return assignments, nil
+	options, tables, err := p.parseMaintenanceTargets(&stmt.To)
+	if err != nil {
+		return nil, err
+	}
+	stmt.Options = options
+	stmt.Tables = tables
+	return stmt, nil
 }
 
-func (p *Parser) parseInsert() (sqlast.Stmt, error) {
-	ok, i, _ := p.parseKeyword("INSERT")
+func (p *Parser) parseAnalyze() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("ANALYZE")
 	if !ok {
-		return nil, errors.Errorf("expected INSERT but %+v", i)
+		return nil, errors.Errorf("expected ANALYZE but %s", tok)
+	}
+	stmt := &sqlast.AnalyzeStmt{
+		Analyze: tok.From,
+		To:      tok.To,
+	}
+	if ok, t, _ := p.parseKeyword("VERBOSE"); ok {
+		stmt.Verbose = true
+		stmt.To = t.To
 	}
 
-	p.expectKeyword("INTO")
-	tableName, err := p.parseObjectName()
-
+	options, tables, err := p.parseMaintenanceTargets(&stmt.To)
 	if err != nil {
-		return nil, errors.Errorf("invalid table name: %w", err)
+		return nil, err
 	}
-	var columns []*sqlast.Ident
+	stmt.Options = options
+	stmt.Tables = tables
+	return stmt, nil
+}
 
+// parseMaintenanceTargets parses the options and the tables of VACUUM and ANALYZE.
+// It sets to the end position of the options if they are given.
+func (p *Parser) parseMaintenanceTargets(to *sqltoken.Pos) ([]*sqlast.CopyOption, []*sqlast.VacuumRelation, error) {
+	var options []*sqlast.CopyOption
 	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-		columns, err = p.parseColumnNames()
+		o, rparen, err := p.parseOptions()
 		if err != nil {
-			return nil, errors.Errorf("invalid column names: %w", err)
+			return nil, nil, errors.Errorf("parseOptions failed: %w", err)
 		}
-		p.expectToken(sqltoken.RParen)
+		options = o
+		*to = rparen
 	}
 
-	var insertSrc sqlast.InsertSource
-	if ok, _, _ := p.parseKeyword("VALUES"); !ok {
-		q, err := p.parseQuery()
+	var tables []*sqlast.VacuumRelation
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.SQLKeyword {
+		return options, tables, nil
+	}
+	for {
+		name, err := p.parseObjectName()
 		if err != nil {
-			return nil, errors.Errorf("invalid select source: expected query: %w", err)
-		}
-		insertSrc = &sqlast.SubQuerySource{
-			SubQuery: q,
+			return nil, nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
-	} else {
-		var constSrc sqlast.ConstructorSource
-		for {
-			l, _ := p.nextToken()
-			if l.Kind != sqltoken.LParen {
-				return nil, errors.Errorf("expected LParen but %+v", l)
-			}
-			v, err := p.parseExprList()
+		table := &sqlast.VacuumRelation{Name: name}
+		if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+			columns, err := p.parseColumnNames()
 			if err != nil {
-				return nil, errors.Errorf("invalid insert value assign: %w", err)
-			}
-			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
-				return nil, errors.Errorf("expected RParen but %+v", r)
+				return nil, nil, errors.Errorf("parseColumnNames failed: %w", err)
 			}
-			constSrc.Rows = append(constSrc.Rows, &sqlast.RowValueExpr{
-				Values: v,
-				LParen: l.From,
-				RParen: r.To,
-			})
-			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
-				break
+			rparen, _ := p.nextToken()
+			if rparen == nil || rparen.Kind != sqltoken.RParen {
+				return nil, nil, errors.Errorf("expected RParen but %+v", rparen)
 			}
+			table.Columns = columns
+			table.RParen = rparen.To
 		}
+		tables = append(tables, table)
 
-		insertSrc = &constSrc
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
 	}
+	return options, tables, nil
+}
 
-	var assigns []*sqlast.Assignment
-	if ok, _, _ := p.parseKeywords("ON", "DUPLICATE", "KEY", "UPDATE"); ok {
-		assignments, err := p.parseAssignments()
+func (p *Parser) parseReindex() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("REINDEX")
+	if !ok {
+		return nil, errors.Errorf("expected REINDEX but %s", tok)
+	}
+	stmt := &sqlast.ReindexStmt{
+		Reindex: tok.From,
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+		options, _, err := p.parseOptions()
 		if err != nil {
-			return nil, errors.Errorf("invalid DUPLICATE KEY UPDATE assignments: %w", err)
-		}
-		assigns = assignments
+			return nil, errors.Errorf("parseOptions failed: %w", err)
+		}
+		stmt.Options = options
+	}
+
+	t, _ := p.nextToken()
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return nil, errors.Errorf("expected object type but %+v", t)
+	}
+	switch t.Value.(*sqltoken.SQLWord).Keyword {
+	case "INDEX":
+		stmt.ObjectType = sqlast.IndexReindexObject
+	case "TABLE":
+		stmt.ObjectType = sqlast.TableReindexObject
+	case "SCHEMA":
+		stmt.ObjectType = sqlast.SchemaReindexObject
+	case "DATABASE":
+		stmt.ObjectType = sqlast.DatabaseReindexObject
+	case "SYSTEM":
+		stmt.ObjectType = sqlast.SystemReindexObject
+	default:
+		return nil, errors.Errorf("expected INDEX, TABLE, SCHEMA, DATABASE or SYSTEM but %+v", t)
 	}
+	stmt.Concurrently, _, _ = p.parseKeyword("CONCURRENTLY")
 
-	return &sqlast.InsertStmt{
-		Insert:            i.From,
-		TableName:         tableName,
-		Columns:           columns,
-		Source:            insertSrc,
-		UpdateAssignments: assigns,
-	}, nil
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	stmt.Name = name
+	return stmt, nil
+}
+
+// isStatementKeyword reports whether tok is a keyword at the beginning of statements.
+func isStatementKeyword(tok *sqltoken.Token) bool {
+	word, ok := tok.Value.(*sqltoken.SQLWord)
+	if !ok || word.QuoteStyle != 0 {
+		return false
+	}
+	for _, k := range statementKeywords {
+		if word.Keyword == k {
+			return true
+		}
+	}
+	return false
 }
 
-func (p *Parser) parseAlter() (sqlast.Stmt, error) {
-	ok, tok, _ := p.parseKeyword("ALTER")
+func (p *Parser) parseCommentOn() (sqlast.Stmt, error) {
+	ok, tok, _ := p.parseKeyword("COMMENT")
 	if !ok {
-		return nil, errors.Errorf("expected ALTER but %s", tok)
+		return nil, errors.Errorf("expected COMMENT but %s", tok)
+	}
+	if ok, _, _ := p.parseKeyword("ON"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected ON but %+v", t)
 	}
 
-	p.expectKeyword("TABLE")
+	stmt := &sqlast.CommentOnStmt{
+		Comment: tok.From,
+	}
 
-	tableName, err := p.parseObjectName()
+	t, _ := p.nextToken()
+	if t == nil {
+		return nil, errors.Errorf("expected object type but EOF")
+	}
+	word, ok := t.Value.(*sqltoken.SQLWord)
+	if !ok {
+		return nil, errors.Errorf("expected object type but %+v", t)
+	}
+	switch word.Keyword {
+	case "TABLE":
+		stmt.ObjectType = sqlast.TableCommentObject
+	case "COLUMN":
+		stmt.ObjectType = sqlast.ColumnCommentObject
+	case "VIEW":
+		stmt.ObjectType = sqlast.ViewCommentObject
+	case "INDEX":
+		stmt.ObjectType = sqlast.IndexCommentObject
+	case "SCHEMA":
+		stmt.ObjectType = sqlast.SchemaCommentObject
+	case "SEQUENCE":
+		stmt.ObjectType = sqlast.SequenceCommentObject
+	case "TYPE":
+		stmt.ObjectType = sqlast.TypeCommentObject
+	case "DOMAIN":
+		stmt.ObjectType = sqlast.DomainCommentObject
+	case "EXTENSION":
+		stmt.ObjectType = sqlast.ExtensionCommentObject
+	default:
+		return nil, unsupported("COMMENT ON "+word.Keyword, t.From)
+	}
+
+	name, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
+	if stmt.ObjectType == sqlast.ColumnCommentObject && len(name.Idents) < 2 {
+		return nil, errors.Errorf("column name must be qualified with table name but %s", name.ToSQLString())
+	}
+	stmt.Name = name
 
-	if ok, toks, _ := p.parseKeywords("ADD", "COLUMN"); ok {
-		columnDef, err := p.parseColumnDef()
-		if err != nil {
-			return nil, errors.Errorf("parseColumnDef failed: %w", err)
-		}
+	if ok, _, _ := p.parseKeyword("IS"); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected IS but %+v", t)
+	}
 
-		return &sqlast.AlterTableStmt{
-			TableName: tableName,
-			Alter:     tok.From,
-			Action: &sqlast.AddColumnTableAction{
-				Add:    toks[0].From,
-				Column: columnDef,
-			},
-		}, nil
+	text, err := p.parseValue()
+	if err != nil {
+		return nil, errors.Errorf("parseValue failed: %w", err)
 	}
+	switch text.(type) {
+	case *sqlast.SingleQuotedString, *sqlast.NullValue:
+	default:
+		return nil, errors.Errorf("comment must be string literal or NULL but %s", text.ToSQLString())
+	}
+	stmt.Text = text
 
-	if ok, add, _ := p.parseKeyword("ADD"); ok {
-		constraint, err := p.parseTableConstraints()
-		if err != nil {
-			return nil, errors.Errorf("parseTableConstraints failed: %w", err)
-		}
+	return stmt, nil
+}
 
-		return &sqlast.AlterTableStmt{
-			TableName: tableName,
-			Alter:     tok.From,
-			Action: &sqlast.AddConstraintTableAction{
-				Add:        add.From,
-				Constraint: constraint,
-			},
-		}, nil
-	}
+func (p *Parser) parseDropSchema(drop *sqltoken.Token) (sqlast.Stmt, error) {
+	exists, names, err := p.parseDropObjects()
+	if err != nil {
+		return nil, errors.Errorf("parseDropObjects failed: %w", err)
+	}
+	cascade, caspos, restrict, respos := p.parseDropBehavior()
+
+	return &sqlast.DropSchemaStmt{
+		Drop:        drop.From,
+		SchemaNames: names,
+		Cascade:     cascade,
+		IfExists:    exists,
+		CascadePos:  caspos,
+		Restrict:    restrict,
+		RestrictPos: respos,
+	}, nil
+}
 
-	if ok, toks, _ := p.parseKeywords("DROP", "CONSTRAINT"); ok {
-		constraintName, err := p.parseIdentifier()
+func (p *Parser) parseDropSequence(drop *sqltoken.Token) (sqlast.Stmt, error) {
+	exists, names, err := p.parseDropObjects()
+	if err != nil {
+		return nil, errors.Errorf("parseDropObjects failed: %w", err)
+	}
+	cascade, caspos, restrict, respos := p.parseDropBehavior()
+
+	return &sqlast.DropSequenceStmt{
+		Drop:          drop.From,
+		SequenceNames: names,
+		Cascade:       cascade,
+		IfExists:      exists,
+		CascadePos:    caspos,
+		Restrict:      restrict,
+		RestrictPos:   respos,
+	}, nil
+}
+
+// parseDropObjects parses `[IF EXISTS] name [, ...]`
+func (p *Parser) parseDropObjects() (exists bool, names []*sqlast.ObjectName, err error) {
+	exists, _, _ = p.parseKeywords("IF", "EXISTS")
+
+	for {
+		name, err := p.parseObjectName()
 		if err != nil {
-			return nil, errors.Errorf("parseIdentifier failed: %w", err)
+			return false, nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
-		var caspos sqltoken.Pos
-		cascade, t, _ := p.parseKeyword("CASCADE")
-		if cascade {
-			caspos = t.To
+		names = append(names, name)
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
 		}
+	}
 
-		return &sqlast.AlterTableStmt{
-			TableName: tableName,
-			Alter:     tok.From,
-			Action: &sqlast.DropConstraintTableAction{
-				Drop:       toks[0].From,
-				Name:       constraintName,
-				Cascade:    cascade,
-				CascadePos: caspos,
-			},
-		}, nil
+	return exists, names, nil
+}
+
+// parseDropBehavior parses `[CASCADE | RESTRICT]`
+func (p *Parser) parseDropBehavior() (cascade bool, caspos sqltoken.Pos, restrict bool, respos sqltoken.Pos) {
+	if ok, t, _ := p.parseKeyword("CASCADE"); ok {
+		return true, t.To, false, respos
 	}
+	if ok, t, _ := p.parseKeyword("RESTRICT"); ok {
+		return false, caspos, true, t.To
+	}
+	return false, caspos, false, respos
+}
 
-	if ok, toks, _ := p.parseKeywords("DROP", "COLUMN"); ok {
-		constraintName, err := p.parseIdentifier()
-		if err != nil {
-			return nil, errors.Errorf("parseIdentifier failed: %w", err)
-		}
-		var caspos sqltoken.Pos
-		cascade, t, _ := p.parseKeyword("CASCADE")
-		if cascade {
-			caspos = t.To
-		}
+func (p *Parser) parseDropIndex(drop *sqltoken.Token) (sqlast.Stmt, error) {
+	concurrently, ctok, _ := p.parseKeyword("CONCURRENTLY")
+	exists, names, err := p.parseDropObjects()
+	if err != nil {
+		return nil, errors.Errorf("parseDropObjects failed: %w", err)
+	}
+	cascade, caspos, restrict, respos := p.parseDropBehavior()
 
-		return &sqlast.AlterTableStmt{
-			TableName: tableName,
-			Alter:     tok.From,
-			Action: &sqlast.RemoveColumnTableAction{
-				Name:       constraintName,
-				Drop:       toks[0].From,
-				Cascade:    cascade,
-				CascadePos: caspos,
-			},
-		}, nil
+	stmt := &sqlast.DropIndexStmt{
+		Drop:        drop.From,
+		IndexNames:  names,
+		IfExists:    exists,
+		Cascade:     cascade,
+		CascadePos:  caspos,
+		Restrict:    restrict,
+		RestrictPos: respos,
+	}
+	if concurrently {
+		stmt.Concurrently = true
+		stmt.ConcurrentlyPos = ctok.From
 	}
 
-	if ok, toks, _ := p.parseKeywords("ALTER", "COLUMN"); ok {
-		action, err := p.parseAlterColumn(toks[0])
+	if ok, _, _ := p.parseKeyword("ON"); ok {
+		tableName, err := p.parseObjectName()
 		if err != nil {
-			return nil, errors.Errorf("parseAlterColumn failed: %w", err)
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
-
-		return &sqlast.AlterTableStmt{
-			Alter:     tok.From,
-			TableName: tableName,
-			Action:    action,
-		}, nil
-
+		stmt.TableName = tableName
 	}
 
-	t, _ := p.peekToken()
-	return nil, errors.Errorf("unknown alter operation %v", t)
+	return stmt, nil
 }
 
-func (p *Parser) parseDrop() (sqlast.Stmt, error) {
-	ok, tok, _ := p.parseKeyword("DROP")
-	if !ok {
-		return nil, errors.Errorf("expected DROP but %s", tok)
+func (p *Parser) parseDropTrigger(drop *sqltoken.Token) (sqlast.Stmt, error) {
+	exists, _, _ := p.parseKeywords("IF", "EXISTS")
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
 
-	ok, _, _ = p.parseKeyword("TABLE")
+	stmt := &sqlast.DropTriggerStmt{
+		Drop:     drop.From,
+		IfExists: exists,
+		Name:     name,
+	}
 
-	if !ok {
-		p.expectKeyword("INDEX")
-		idents, err := p.parseColumnNames()
+	if ok, _, _ := p.parseKeyword("ON"); ok {
+		table, err := p.parseObjectName()
 		if err != nil {
-			return nil, errors.Errorf("parseColumnNames of DROP INDEX failed: %w", err)
+			return nil, errors.Errorf("parseObjectName failed: %w", err)
 		}
+		stmt.TableName = table
+	}
 
-		return &sqlast.DropIndexStmt{
-			Drop:       tok.From,
-			IndexNames: idents,
-		}, nil
+	if ok, t, _ := p.parseKeyword("CASCADE"); ok {
+		stmt.Cascade = true
+		stmt.CascadePos = t.To
 	}
+
+	return stmt, nil
+}
+
+func (p *Parser) parseAlterSequence(alter *sqltoken.Token) (sqlast.Stmt, error) {
 	exists, _, _ := p.parseKeywords("IF", "EXISTS")
-	tableName, err := p.parseObjectName()
+	name, err := p.parseObjectName()
 	if err != nil {
 		return nil, errors.Errorf("parseObjectName failed: %w", err)
 	}
 
-	var caspos sqltoken.Pos
-	cascade, t, _ := p.parseKeyword("CASCADE")
-	if cascade {
-		caspos = t.To
+	opts, err := p.parseSequenceOptions()
+	if err != nil {
+		return nil, errors.Errorf("parseSequenceOptions failed: %w", err)
+	}
+	if len(opts) == 0 {
+		return nil, errors.Errorf("ALTER SEQUENCE requires at least one option")
 	}
 
-	return &sqlast.DropTableStmt{
-		Drop:       tok.From,
-		TableNames: []*sqlast.ObjectName{tableName},
-		Cascade:    cascade,
-		IfExists:   exists,
-		CascadePos: caspos,
+	return &sqlast.AlterSequenceStmt{
+		Alter:    alter.From,
+		IfExists: exists,
+		Name:     name,
+		Options:  opts,
 	}, nil
 }
 
@@ -1479,12 +4615,22 @@ func (p *Parser) parseAlterColumn(alt *sqltoken.Token) (*sqlast.AlterColumnTable
 			return nil, errors.Errorf("ParseDataType failed: %w", err)
 		}
 
+		var using sqlast.Node
+		if ok, _, _ := p.parseKeyword("USING"); ok {
+			u, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			using = u
+		}
+
 		return &sqlast.AlterColumnTableAction{
 			ColumnName: columnName,
 			Alter:      alt.From,
 			Action: &sqlast.PGAlterDataTypeColumnAction{
 				Type:     tok.From,
 				DataType: tp,
+				Using:    using,
 			},
 		}, nil
 	default:
@@ -1521,7 +4667,7 @@ func (p *Parser) parseDefaultExpr(precedence uint) (sqlast.Node, error) {
 	return expr, nil
 }
 
-func (p *Parser) parseOptionalAlias(reservedKeywords map[string]struct{}) *sqlast.Ident {
+func (p *Parser) parseOptionalAlias(isReserved func(keyword string) bool) *sqlast.Ident {
 	afterAs, _, _ := p.parseKeyword("AS")
 	maybeAlias, _ := p.nextToken()
 
@@ -1532,12 +4678,8 @@ func (p *Parser) parseOptionalAlias(reservedKeywords map[string]struct{}) *sqlas
 	if maybeAlias.Kind == sqltoken.SQLKeyword {
 
 		word := maybeAlias.Value.(*sqltoken.SQLWord)
-		if afterAs || !containsStr(reservedKeywords, word.Keyword) {
-			return &sqlast.Ident{
-				Value: word.String(),
-				From:  maybeAlias.From,
-				To:    maybeAlias.To,
-			}
+		if afterAs || !isReserved(word.Keyword) || p.isQualifyAlias(word) {
+			return newIdent(maybeAlias, word)
 		}
 	}
 	if afterAs {
@@ -1547,6 +4689,31 @@ func (p *Parser) parseOptionalAlias(reservedKeywords map[string]struct{}) *sqlas
 	return nil
 }
 
+// isQualifyAlias reports whether word is QUALIFY which isn't followed by an expression.
+// QUALIFY is reserved only before its condition so that it remains usable as an alias.
+func (p *Parser) isQualifyAlias(word *sqltoken.SQLWord) bool {
+	if word.Keyword != "QUALIFY" {
+		return false
+	}
+	next, _ := p.peekToken()
+	if next == nil {
+		return true
+	}
+	switch next.Kind {
+	case sqltoken.Semicolon, sqltoken.RParen, sqltoken.Comma:
+		return true
+	case sqltoken.SQLKeyword:
+		w := next.Value.(*sqltoken.SQLWord)
+		for _, k := range prefixKeywords {
+			if w.Keyword == k {
+				return false
+			}
+		}
+		return p.dialect.IsReservedForColumnAlias(w.Keyword)
+	}
+	return false
+}
+
 func (p *Parser) parseCTEList() ([]*sqlast.CTE, error) {
 	var ctes []*sqlast.CTE
 
@@ -1555,17 +4722,39 @@ func (p *Parser) parseCTEList() ([]*sqlast.CTE, error) {
 		if err != nil {
 			return nil, errors.Errorf("parseIdentifier failed: %w", err)
 		}
-		p.expectKeyword("AS")
-		p.expectToken(sqltoken.LParen)
+		var columns []*sqlast.Ident
+		if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+			columns, err = p.parseColumnNames()
+			if err != nil {
+				return nil, errors.Errorf("parseColumnNames failed: %w", err)
+			}
+			if ok, _ := p.consumeToken(sqltoken.RParen); !ok {
+				t, _ := p.peekToken()
+				return nil, errors.Errorf("expected RParen but %+v", t)
+			}
+		}
+		if ok, _, _ := p.parseKeyword("AS"); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected AS but %+v", t)
+		}
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
 		q, err := p.parseQuery()
 		if err != nil {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
 		ctes = append(ctes, &sqlast.CTE{
-			Alias: alias,
-			Query: q,
+			Alias:   alias,
+			Columns: columns,
+			Query:   q,
+			RParen:  r.To,
 		})
-		p.expectToken(sqltoken.RParen)
 		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
 			break
 		}
@@ -1777,130 +4966,506 @@ func (p *Parser) parseJoinType() (*sqlast.JoinType, error) {
 	default:
 		return nil, errors.Errorf("unknown join type: %v", word)
 	}
-}
+}
+
+func (p *Parser) parseJoinSpec() (sqlast.JoinSpec, error) {
+	if ok, tok, _ := p.parseKeyword("ON"); ok {
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("parse join condition failed: %w", err)
+		}
+		return &sqlast.JoinCondition{
+			SearchCondition: expr,
+			On:              tok.From,
+		}, nil
+	}
+
+	ok, _, _ := p.parseKeyword("USING")
+	if !ok {
+		tok, _ := p.nextToken()
+		return nil, errors.Errorf("unknown join spec need USING or ON but: %v", tok)
+	}
+
+	p.expectToken(sqltoken.LParen)
+	idents, err := p.parseListOfIds(sqltoken.Comma)
+	if err != nil {
+		return nil, errors.Errorf("parse named columns join list failed: %w", err)
+	}
+	p.expectToken(sqltoken.RParen)
+
+	return &sqlast.NamedColumnsJoin{
+		ColumnList: idents,
+	}, nil
+}
+
+func (p *Parser) parseTableFactor() (sqlast.TableFactor, error) {
+	isLateral, lateral, _ := p.parseKeyword("LATERAL")
+	var lateralPos sqltoken.Pos
+	if isLateral {
+		lateralPos = lateral.From
+	}
+
+	if lparen, _ := p.peekToken(); lparen != nil && lparen.Kind == sqltoken.LParen {
+		p.mustNextToken()
+		subquery, err := p.parseQuery()
+		if err != nil {
+			return nil, errors.Errorf("parseQuery failed: %w", err)
+		}
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
+		alias := p.parseOptionalAlias(p.dialect.IsReservedForTableAlias)
+		columns, aliasRParen, err := p.parseOptionalColumnAliases(alias)
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalColumnAliases failed: %w", err)
+		}
+		return &sqlast.Derived{
+			Lateral:       isLateral,
+			LateralPos:    lateralPos,
+			LParen:        lparen.From,
+			RParen:        rparen.To,
+			SubQuery:      subquery,
+			Alias:         alias,
+			ColumnAliases: columns,
+			AliasRParen:   aliasRParen,
+		}, nil
+	}
+
+	if ok, toks, _ := p.parseKeywords("ROWS", "FROM"); ok {
+		if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected LParen but %+v", t)
+		}
+		tf := &sqlast.TableFunction{
+			Lateral:     isLateral,
+			LateralPos:  lateralPos,
+			RowsFrom:    true,
+			RowsFromPos: toks[0].From,
+		}
+		for {
+			expr, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			f, ok := expr.(*sqlast.Function)
+			if !ok {
+				return nil, errors.Errorf("expected function call in ROWS FROM but %s", expr.ToSQLString())
+			}
+			tf.Functions = append(tf.Functions, f)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
+		tf.RParen = rparen.To
+		return p.parseTableFunctionSuffix(tf)
+	}
+
+	name, err := p.parseObjectName()
+	if err != nil {
+		return nil, errors.Errorf("parseObjectName failed: %w", err)
+	}
+	var args []sqlast.Node
+	var argsRParen sqltoken.Pos
+	isCall, _ := p.consumeToken(sqltoken.LParen)
+	if isCall {
+		a, err := p.parseOptionalArgs()
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
+		}
+		args = a
+		rparen, _ := p.nextToken()
+		if rparen == nil || rparen.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", rparen)
+		}
+		argsRParen = rparen.To
+	}
+
+	if isLateral && !isCall {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("after lateral expected subquery or function call but %+v", t)
+	}
+	if isCall {
+		idx := p.index
+		ordinality, _, _ := p.parseKeywords("WITH", "ORDINALITY")
+		p.index = idx
+		if isLateral || ordinality {
+			return p.parseTableFunctionSuffix(&sqlast.TableFunction{
+				Lateral:    isLateral,
+				LateralPos: lateralPos,
+				Functions: []*sqlast.Function{{
+					Name:       name,
+					Args:       args,
+					ArgsRParen: argsRParen,
+				}},
+			})
+		}
+	}
+
+	table := &sqlast.Table{
+		Name:       name,
+		Args:       args,
+		ArgsRParen: argsRParen,
+	}
+	if !p.isIndexHint() {
+		table.Alias = p.parseOptionalAlias(p.dialect.IsReservedForTableAlias)
+		columns, rparen, err := p.parseOptionalColumnAliases(table.Alias)
+		if err != nil {
+			return nil, errors.Errorf("parseOptionalColumnAliases failed: %w", err)
+		}
+		table.ColumnAliases = columns
+		table.AliasRParen = rparen
+	}
+
+	for p.isIndexHint() {
+		hint, err := p.parseIndexHint()
+		if err != nil {
+			return nil, errors.Errorf("parseIndexHint failed: %w", err)
+		}
+		table.IndexHints = append(table.IndexHints, hint)
+	}
+
+	if dialect.Supports(p.dialect, dialect.TableHint) {
+		if ok, _, _ := p.parseKeyword("WITH"); ok {
+			if ok, _ := p.consumeToken(sqltoken.LParen); ok {
+				h, err := p.parseExprList()
+				if err != nil {
+					return nil, errors.Errorf("parseExprList failed: %w", err)
+				}
+				rparen, _ := p.nextToken()
+				if rparen == nil || rparen.Kind != sqltoken.RParen {
+					return nil, errors.Errorf("expected RParen but %+v", rparen)
+				}
+				table.WithHints = h
+				table.WithHintsRParen = rparen.To
+			} else {
+				p.prevToken()
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// isIndexHint reports whether the next tokens are { USE | IGNORE | FORCE } { INDEX | KEY }.
+func (p *Parser) isIndexHint() bool {
+	if !dialect.Supports(p.dialect, dialect.IndexHint) {
+		return false
+	}
+	idx := p.index
+	defer func() { p.index = idx }()
+
+	t, _ := p.nextToken()
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return false
+	}
+	switch t.Value.(*sqltoken.SQLWord).Keyword {
+	case "USE", "IGNORE", "FORCE":
+	default:
+		return false
+	}
+	t, _ = p.nextToken()
+	if t == nil || t.Kind != sqltoken.SQLKeyword {
+		return false
+	}
+	k := t.Value.(*sqltoken.SQLWord).Keyword
+	return k == "INDEX" || k == "KEY"
+}
+
+func (p *Parser) parseIndexHint() (*sqlast.IndexHint, error) {
+	t := p.mustNextToken()
+	hint := &sqlast.IndexHint{
+		From: t.From,
+	}
+	switch t.Value.(*sqltoken.SQLWord).Keyword {
+	case "USE":
+		hint.Type = sqlast.UseIndexHint
+	case "IGNORE":
+		hint.Type = sqlast.IgnoreIndexHint
+	case "FORCE":
+		hint.Type = sqlast.ForceIndexHint
+	}
+	hint.IsKey, _, _ = p.parseKeyword("KEY")
+	if !hint.IsKey {
+		p.parseKeyword("INDEX")
+	}
+
+	if ok, _, _ := p.parseKeyword("FOR"); ok {
+		if ok, _, _ := p.parseKeyword("JOIN"); ok {
+			hint.For = "JOIN"
+		} else if ok, _, _ := p.parseKeywords("ORDER", "BY"); ok {
+			hint.For = "ORDER BY"
+		} else if ok, _, _ := p.parseKeywords("GROUP", "BY"); ok {
+			hint.For = "GROUP BY"
+		} else {
+			t, _ := p.peekToken()
+			return nil, errors.Errorf("expected JOIN, ORDER BY or GROUP BY but %+v", t)
+		}
+	}
 
-func (p *Parser) parseJoinSpec() (sqlast.JoinSpec, error) {
-	if ok, tok, _ := p.parseKeyword("ON"); ok {
-		expr, err := p.ParseExpr()
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+	// USE INDEX () means no indexes
+	if t, _ := p.peekToken(); t != nil && t.Kind != sqltoken.RParen {
+		indexes, err := p.parseColumnNames()
 		if err != nil {
-			return nil, errors.Errorf("parse join condition failed: %w", err)
+			return nil, errors.Errorf("parseColumnNames failed: %w", err)
 		}
-		return &sqlast.JoinCondition{
-			SearchCondition: expr,
-			On:              tok.From,
-		}, nil
+		hint.Indexes = indexes
 	}
-
-	ok, _, _ := p.parseKeyword("USING")
-	if !ok {
-		tok, _ := p.nextToken()
-		return nil, errors.Errorf("unknown join spec need USING or ON but: %v", tok)
+	rparen, _ := p.nextToken()
+	if rparen == nil || rparen.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", rparen)
 	}
+	hint.RParen = rparen.To
+	return hint, nil
+}
 
-	p.expectToken(sqltoken.LParen)
-	idents, err := p.parseListOfIds(sqltoken.Comma)
+// parseTableFunctionSuffix parses [WITH ORDINALITY] [AS alias [(column_alias [, ...])]] of tf
+func (p *Parser) parseTableFunctionSuffix(tf *sqlast.TableFunction) (*sqlast.TableFunction, error) {
+	if ok, toks, _ := p.parseKeywords("WITH", "ORDINALITY"); ok {
+		tf.WithOrdinality = true
+		tf.OrdinalityPos = toks[1].To
+	}
+	tf.Alias = p.parseOptionalAlias(p.dialect.IsReservedForTableAlias)
+	columns, rparen, err := p.parseOptionalColumnAliases(tf.Alias)
 	if err != nil {
-		return nil, errors.Errorf("parse named columns join list failed: %w", err)
+		return nil, errors.Errorf("parseOptionalColumnAliases failed: %w", err)
 	}
-	p.expectToken(sqltoken.RParen)
+	tf.ColumnAliases = columns
+	tf.AliasRParen = rparen
+	return tf, nil
+}
 
-	return &sqlast.NamedColumnsJoin{
-		ColumnList: idents,
-	}, nil
+// parseOptionalColumnAliases parses ( column_alias [, ...] ) following the table alias
+func (p *Parser) parseOptionalColumnAliases(alias *sqlast.Ident) ([]*sqlast.Ident, sqltoken.Pos, error) {
+	if alias == nil {
+		return nil, sqltoken.Pos{}, nil
+	}
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		return nil, sqltoken.Pos{}, nil
+	}
+	columns, err := p.parseColumnNames()
+	if err != nil {
+		return nil, sqltoken.Pos{}, errors.Errorf("parseColumnNames failed: %w", err)
+	}
+	rparen, _ := p.nextToken()
+	if rparen == nil || rparen.Kind != sqltoken.RParen {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected RParen but %+v", rparen)
+	}
+	return columns, rparen.To, nil
 }
 
-func (p *Parser) parseTableFactor() (sqlast.TableFactor, error) {
-	isLateral, _, _ := p.parseKeyword("LATERAL")
-	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-		subquery, err := p.parseQuery()
-		if err != nil {
-			return nil, errors.Errorf("parseQuery failed: %w", err)
-		}
-		p.expectToken(sqltoken.RParen)
-		alias := p.parseOptionalAlias(dialect.ReservedForTableAlias)
-		return &sqlast.Derived{
-			Lateral:  isLateral,
-			SubQuery: subquery,
-			Alias:    alias,
-		}, nil
-	} else if isLateral && !ok {
-		t, _ := p.nextToken()
-		return nil, errors.Errorf("after lateral expected %s but %+v", sqltoken.LParen, t)
+func (p *Parser) parseLimit() (*sqlast.LimitExpr, error) {
+	if ok, _, _ := p.parseKeyword("ALL"); ok {
+		return &sqlast.LimitExpr{All: true}, nil
 	}
 
-	name, err := p.parseObjectName()
+	limit, err := p.parseLimitValue()
 	if err != nil {
-		return nil, errors.Errorf("parseObjectName failed: %w", err)
+		return nil, errors.Errorf("invalid limit value: %w", err)
 	}
-	var args []sqlast.Node
-	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-		a, err := p.parseOptionalArgs()
+
+	var offset sqlast.Node
+	if ok, _, _ := p.parseKeyword("OFFSET"); ok {
+		offset, err = p.parseLimitValue()
 		if err != nil {
-			return nil, errors.Errorf("parseOptionalArgs failed: %w", err)
+			return nil, errors.Errorf("invalid offset value: %w", err)
 		}
-		args = a
 	}
-	alias := p.parseOptionalAlias(dialect.ReservedForTableAlias)
 
-	var withHints []sqlast.Node
-	if ok, _, _ := p.parseKeyword("WITH"); ok {
-		if ok, _ := p.consumeToken(sqltoken.LParen); ok {
-			h, err := p.parseExprList()
+	return &sqlast.LimitExpr{
+		LimitValue:  limit,
+		OffsetValue: offset,
+	}, nil
+}
+
+// parseLimitValue parses an integer literal or a placeholder of LIMIT and OFFSET.
+func (p *Parser) parseLimitValue() (sqlast.Node, error) {
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Placeholder {
+		p.mustNextToken()
+		return parsePlaceholder(t)
+	}
+	i, tok, err := p.parseLiteralInt()
+	if err != nil {
+		return nil, errors.Errorf("parseLiteralInt failed: %w", err)
+	}
+	return &sqlast.LongValue{
+		Long: int64(i),
+		From: tok.From,
+		To:   tok.To,
+	}, nil
+}
+
+func (p *Parser) parseOffset(offset *sqltoken.Token) (*sqlast.OffsetExpr, error) {
+	value, err := p.parseLimitValue()
+	if err != nil {
+		return nil, errors.Errorf("invalid offset value: %w", err)
+	}
+	o := &sqlast.OffsetExpr{
+		Offset: offset.From,
+		Value:  value,
+	}
+
+	if ok, r, _ := p.parseKeyword("ROW"); ok {
+		o.To = r.To
+	} else if ok, rs, _ := p.parseKeyword("ROWS"); ok {
+		o.To = rs.To
+	} else {
+		o.RowsOmitted = true
+		o.To = value.End()
+	}
+
+	return o, nil
+}
+
+func (p *Parser) parseLockingClause(f *sqltoken.Token) (*sqlast.LockingClause, error) {
+	l := &sqlast.LockingClause{
+		For: f.From,
+	}
+
+	if ok, tok, _ := p.parseKeyword("UPDATE"); ok {
+		l.Strength = sqlast.UpdateLock
+		l.To = tok.To
+	} else if ok, toks, _ := p.parseKeywords("NO", "KEY", "UPDATE"); ok {
+		l.Strength = sqlast.NoKeyUpdateLock
+		l.To = toks[2].To
+	} else if ok, tok, _ := p.parseKeyword("SHARE"); ok {
+		l.Strength = sqlast.ShareLock
+		l.To = tok.To
+	} else if ok, toks, _ := p.parseKeywords("KEY", "SHARE"); ok {
+		l.Strength = sqlast.KeyShareLock
+		l.To = toks[1].To
+	} else {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected UPDATE, NO KEY UPDATE, SHARE or KEY SHARE but %+v", t)
+	}
+
+	if ok, _, _ := p.parseKeyword("OF"); ok {
+		for {
+			name, err := p.parseObjectName()
 			if err != nil {
-				return nil, errors.Errorf("parseExprList failed: %w", err)
+				return nil, errors.Errorf("parseObjectName failed: %w", err)
+			}
+			l.Of = append(l.Of, name)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
 			}
-			withHints = h
-			p.expectToken(sqltoken.RParen)
-		} else {
-			p.prevToken()
 		}
 	}
 
-	return &sqlast.Table{
-		Name:      name,
-		Args:      args,
-		Alias:     alias,
-		WithHints: withHints,
-	}, nil
+	if ok, tok, _ := p.parseKeyword("NOWAIT"); ok {
+		l.Wait = sqlast.LockNoWait
+		l.To = tok.To
+	} else if ok, toks, _ := p.parseKeywords("SKIP", "LOCKED"); ok {
+		l.Wait = sqlast.LockSkipLocked
+		l.To = toks[1].To
+	}
 
+	return l, nil
 }
 
-func (p *Parser) parseLimit() (*sqlast.LimitExpr, error) {
-	if ok, _, _ := p.parseKeyword("ALL"); ok {
-		return &sqlast.LimitExpr{All: true}, nil
+func (p *Parser) parseFetch(fetch *sqltoken.Token) (*sqlast.FetchExpr, error) {
+	fok, _, _ := p.parseKeyword("FIRST")
+	nok, n, _ := p.parseKeyword("NEXT")
+	if !fok && !nok {
+		return nil, errors.Errorf("expected FIRST or NEXT but %+v", n)
 	}
 
-	i, tok, err := p.parseLiteralInt()
-	if err != nil {
-		return nil, errors.Errorf("invalid limit value: %w", err)
+	f := &sqlast.FetchExpr{
+		Fetch: fetch.From,
+		Next:  nok,
 	}
 
-	var offset *sqlast.LongValue
-	if ok, tok, _ := p.parseKeyword("OFFSET"); ok {
-		o, _, err := p.parseLiteralInt()
+	if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.Number {
+		i, tok, err := p.parseLiteralInt()
 		if err != nil {
-			return nil, errors.Errorf("invalid offset value: %w", err)
+			return nil, errors.Errorf("invalid fetch value: %w", err)
 		}
-		offset = &sqlast.LongValue{
-			Long: int64(o),
+		f.Quantity = &sqlast.LongValue{
+			Long: int64(i),
 			From: tok.From,
 			To:   tok.To,
 		}
+		f.Percent, _, _ = p.parseKeyword("PERCENT")
 	}
 
-	return &sqlast.LimitExpr{
-		LimitValue: &sqlast.LongValue{
+	rok, _, _ := p.parseKeyword("ROW")
+	rsok, rs, _ := p.parseKeyword("ROWS")
+	if !rok && !rsok {
+		return nil, errors.Errorf("expected ROW or ROWS but %+v", rs)
+	}
+
+	if ok, tok, _ := p.parseKeyword("ONLY"); ok {
+		f.To = tok.To
+		return f, nil
+	}
+	ok, toks, _ := p.parseKeywords("WITH", "TIES")
+	if !ok {
+		return nil, errors.Errorf("expected ONLY or WITH TIES")
+	}
+	f.WithTies = true
+	f.To = toks[1].To
+
+	return f, nil
+}
+
+func (p *Parser) parseTop(top *sqltoken.Token) (*sqlast.TopExpr, error) {
+	t := &sqlast.TopExpr{
+		Top: top.From,
+	}
+
+	if l, _ := p.peekToken(); l != nil && l.Kind == sqltoken.LParen {
+		p.mustNextToken()
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		r, _ := p.nextToken()
+		if r == nil || r.Kind != sqltoken.RParen {
+			return nil, errors.Errorf("expected RParen but %+v", r)
+		}
+		t.Expr = &sqlast.Nested{
+			LParen: l.From,
+			RParen: r.To,
+			AST:    expr,
+		}
+		t.To = r.To
+	} else {
+		i, tok, err := p.parseLiteralInt()
+		if err != nil {
+			return nil, errors.Errorf("invalid top value: %w", err)
+		}
+		t.Expr = &sqlast.LongValue{
 			Long: int64(i),
 			From: tok.From,
 			To:   tok.To,
-		},
-		OffsetValue: offset,
-	}, nil
+		}
+		t.To = tok.To
+	}
+
+	if ok, tok, _ := p.parseKeyword("PERCENT"); ok {
+		t.Percent = true
+		t.To = tok.To
+	}
+	if ok, toks, _ := p.parseKeywords("WITH", "TIES"); ok {
+		t.WithTies = true
+		t.To = toks[1].To
+	}
+
+	return t, nil
 }
 
 func (p *Parser) parseIdentifier() (*sqlast.Ident, error) {
 	tok, err := p.nextToken()
 	if err != nil {
+		p.candidates.addTokens(sqltoken.SQLKeyword)
 		return nil, errors.Errorf("nextToken failed: %w", err)
 	}
 	word, ok := tok.Value.(*sqltoken.SQLWord)
@@ -1908,11 +5473,16 @@ func (p *Parser) parseIdentifier() (*sqlast.Ident, error) {
 		return nil, errors.Errorf("expected identifier but %+v", tok)
 	}
 
+	return newIdent(tok, word), nil
+}
+
+func newIdent(tok *sqltoken.Token, word *sqltoken.SQLWord) *sqlast.Ident {
 	return &sqlast.Ident{
-		From:  tok.From,
-		To:    tok.To,
-		Value: word.String(),
-	}, nil
+		From:       tok.From,
+		To:         tok.To,
+		Value:      word.String(),
+		QuoteStyle: word.QuoteStyle,
+	}
 }
 
 func (p *Parser) parseExprList() ([]sqlast.Node, error) {
@@ -1968,6 +5538,7 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 	if err != nil {
 		return nil, errors.Errorf("nextToken failed: %w", err)
 	}
+	opTo := tok.To
 
 	switch tok.Kind {
 	case sqltoken.Eq:
@@ -1992,6 +5563,16 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 		operator = sqlast.Modulus
 	case sqltoken.Div:
 		operator = sqlast.Divide
+	case sqltoken.Arrow:
+		operator = sqlast.JSONGet
+	case sqltoken.LongArrow:
+		operator = sqlast.JSONGetText
+	case sqltoken.HashArrow:
+		operator = sqlast.JSONPathGet
+	case sqltoken.HashLongArrow:
+		operator = sqlast.JSONPathGetText
+	case sqltoken.AtArrow:
+		operator = sqlast.JSONContains
 	case sqltoken.SQLKeyword:
 		word := tok.Value.(*sqltoken.SQLWord)
 		switch word.Keyword {
@@ -2001,25 +5582,66 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 			operator = sqlast.Or
 		case "LIKE":
 			operator = sqlast.Like
+		case "ILIKE":
+			operator = sqlast.ILike
+		case "SIMILAR":
+			if ok, to, _ := p.parseKeyword("TO"); ok {
+				operator = sqlast.SimilarTo
+				opTo = to.To
+			}
 		case "NOT":
-			ok, _, _ := p.parseKeyword("LIKE")
-			if ok {
+			if ok, like, _ := p.parseKeyword("LIKE"); ok {
 				operator = sqlast.NotLike
+				opTo = like.To
+			} else if ok, ilike, _ := p.parseKeyword("ILIKE"); ok {
+				operator = sqlast.NotILike
+				opTo = ilike.To
+			} else if ok, toks, _ := p.parseKeywords("SIMILAR", "TO"); ok {
+				operator = sqlast.NotSimilarTo
+				opTo = toks[1].To
 			}
 		}
+	case sqltoken.Tilde:
+		operator = sqlast.RegexMatch
+	case sqltoken.TildeAsterisk:
+		operator = sqlast.RegexIMatch
+	case sqltoken.NotTilde:
+		operator = sqlast.NotRegexMatch
+	case sqltoken.NotTildeAsterisk:
+		operator = sqlast.NotRegexIMatch
 	}
 
 	if operator != sqlast.None {
+		switch operator {
+		case sqlast.Eq, sqlast.NotEq, sqlast.Gt, sqlast.GtEq, sqlast.Lt, sqlast.LtEq:
+			if q, ok := p.parseQuantifier(); ok {
+				return p.parseQuantifiedComparison(expr, &sqlast.Operator{Type: operator, From: tok.From, To: opTo}, q)
+			}
+		}
+
 		right, err := p.parseSubexpr(precedence)
 		if err != nil {
 			return nil, errors.Errorf("parseSubexpr failed: %w", err)
 		}
 
-		return &sqlast.BinaryExpr{
+		b := &sqlast.BinaryExpr{
 			Left:  expr,
-			Op:    &sqlast.Operator{Type: operator, From: tok.From, To: tok.To},
+			Op:    &sqlast.Operator{Type: operator, From: tok.From, To: opTo},
 			Right: right,
-		}, nil
+		}
+
+		switch operator {
+		case sqlast.Like, sqlast.NotLike, sqlast.ILike, sqlast.NotILike, sqlast.SimilarTo, sqlast.NotSimilarTo:
+			if ok, _, _ := p.parseKeyword("ESCAPE"); ok {
+				escape, err := p.parseSubexpr(precedence)
+				if err != nil {
+					return nil, errors.Errorf("parseSubexpr failed: %w", err)
+				}
+				b.Escape = escape
+			}
+		}
+
+		return b, nil
 	}
 
 	if tok.Kind == sqltoken.SQLKeyword {
@@ -2027,17 +5649,23 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 
 		switch word.Keyword {
 		case "IS":
-			if ok, _, _ := p.parseKeyword("NULL"); ok {
+			if ok, null, _ := p.parseKeyword("NULL"); ok {
 				return &sqlast.IsNull{
-					X: expr,
+					X:  expr,
+					To: null.To,
 				}, nil
 			}
-			if ok, _, _ := p.parseKeywords("NOT", "NULL"); ok {
+			if ok, toks, _ := p.parseKeywords("NOT", "NULL"); ok {
 				return &sqlast.IsNotNull{
-					X: expr,
+					X:  expr,
+					To: toks[1].To,
 				}, nil
 			}
-			return nil, errors.Errorf("NULL or NOT NULL after IS")
+			negated, _, _ := p.parseKeyword("NOT")
+			if ok, _, _ := p.parseKeyword("OF"); ok {
+				return p.parseIsOf(expr, negated)
+			}
+			return nil, errors.Errorf("NULL, NOT NULL or [NOT] OF after IS")
 		case "NOT", "IN", "BETWEEN":
 			p.prevToken()
 			negated, _, _ := p.parseKeyword("NOT")
@@ -2054,10 +5682,121 @@ func (p *Parser) parseInfix(expr sqlast.Node, precedence uint) (sqlast.Node, err
 		return p.parsePGCast(expr)
 	}
 
+	if tok.Kind == sqltoken.LBracket {
+		return p.parseSubscript(expr)
+	}
+
 	log.Panicf("no infix parser for sqltoken %+v", tok)
 	return nil, nil
 }
 
+// parseSubscript is called after `[` is consumed.
+func (p *Parser) parseSubscript(expr sqlast.Node) (sqlast.Node, error) {
+	s := &sqlast.Subscript{
+		Expr: expr,
+	}
+
+	if t, _ := p.peekToken(); t != nil && t.Kind != sqltoken.Colon {
+		index, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		s.Index = index
+	}
+
+	if ok, _ := p.consumeToken(sqltoken.Colon); ok {
+		s.Slice = true
+		if t, _ := p.peekToken(); t != nil && t.Kind != sqltoken.RBracket {
+			upper, err := p.ParseExpr()
+			if err != nil {
+				return nil, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			s.Upper = upper
+		}
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RBracket {
+		return nil, errors.Errorf("expected RBracket but %+v", r)
+	}
+	s.RBracket = r.To
+
+	return s, nil
+}
+
+// parseArrayConstructor is called after `ARRAY[` or `[` of nested array is consumed.
+func (p *Parser) parseArrayConstructor(array, lbracket *sqltoken.Token) (*sqlast.ArrayConstructor, error) {
+	a := &sqlast.ArrayConstructor{
+		Array:    array.From,
+		Bare:     array == lbracket,
+		LBracket: lbracket.From,
+	}
+
+	if t, _ := p.peekToken(); t == nil || t.Kind != sqltoken.RBracket {
+		for {
+			var elem sqlast.Node
+			if l, _ := p.peekToken(); l != nil && l.Kind == sqltoken.LBracket {
+				p.mustNextToken()
+				nested, err := p.parseArrayConstructor(l, l)
+				if err != nil {
+					return nil, errors.Errorf("parseArrayConstructor failed: %w", err)
+				}
+				elem = nested
+			} else {
+				e, err := p.ParseExpr()
+				if err != nil {
+					return nil, errors.Errorf("ParseExpr failed: %w", err)
+				}
+				elem = e
+			}
+			a.Elements = append(a.Elements, elem)
+			if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+				break
+			}
+		}
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RBracket {
+		return nil, errors.Errorf("expected RBracket but %+v", r)
+	}
+	a.RBracket = r.To
+
+	return a, nil
+}
+
+func (p *Parser) parseIsOf(expr sqlast.Node, negated bool) (sqlast.Node, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, errors.Errorf("expected LParen but %+v", t)
+	}
+
+	var types []sqlast.Type
+	for {
+		tp, err := p.ParseDataType()
+		if err != nil {
+			return nil, errors.Errorf("ParseDataType failed: %w", err)
+		}
+		types = append(types, tp)
+
+		tok, _ := p.nextToken()
+		if tok == nil {
+			return nil, errors.Errorf("unexpected EOF in IS OF type list")
+		}
+		if tok.Kind == sqltoken.RParen {
+			return &sqlast.IsOf{
+				X:       expr,
+				Negated: negated,
+				Types:   types,
+				RParen:  tok.To,
+			}, nil
+		}
+		if tok.Kind != sqltoken.Comma {
+			return nil, errors.Errorf("expected , or ) but %+v", tok)
+		}
+	}
+}
+
 // TODO position
 func (p *Parser) parsePGCast(expr sqlast.Node) (sqlast.Node, error) {
 	tp, err := p.ParseDataType()
@@ -2070,19 +5809,88 @@ func (p *Parser) parsePGCast(expr sqlast.Node) (sqlast.Node, error) {
 	}, nil
 }
 
+// parseQuantifier consumes ANY, ALL or SOME only if followed by `(`.
+func (p *Parser) parseQuantifier() (sqlast.Quantifier, bool) {
+	tok, _ := p.peekToken()
+	if tok == nil || tok.Kind != sqltoken.SQLKeyword {
+		return 0, false
+	}
+
+	var q sqlast.Quantifier
+	switch tok.Value.(*sqltoken.SQLWord).Keyword {
+	case "ANY":
+		q = sqlast.AnyQuantifier
+	case "ALL":
+		q = sqlast.AllQuantifier
+	case "SOME":
+		q = sqlast.SomeQuantifier
+	default:
+		return 0, false
+	}
+
+	p.mustNextToken()
+	if l, _ := p.peekToken(); l == nil || l.Kind != sqltoken.LParen {
+		p.prevToken()
+		return 0, false
+	}
+
+	return q, true
+}
+
+// peekQueryStart reports whether the next token is SELECT, WITH, VALUES or TABLE which starts a query.
+// It doesn't consume the token.
+func (p *Parser) peekQueryStart() bool {
+	for _, k := range []string{"SELECT", "WITH", "VALUES", "TABLE"} {
+		if ok, _, _ := p.parseKeyword(k); ok {
+			p.prevToken()
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) parseQuantifiedComparison(left sqlast.Node, op *sqlast.Operator, q sqlast.Quantifier) (sqlast.Node, error) {
+	p.expectToken(sqltoken.LParen)
+
+	var operand sqlast.Node
+	if p.peekQueryStart() {
+		q, err := p.parseQuery()
+		if err != nil {
+			return nil, errors.Errorf("parseQuery failed: %w", err)
+		}
+		operand = q
+	} else {
+		e, err := p.ParseExpr()
+		if err != nil {
+			return nil, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		operand = e
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	return &sqlast.QuantifiedComparison{
+		Left:       left,
+		Op:         op,
+		Quantifier: q,
+		Operand:    operand,
+		RParen:     r.To,
+	}, nil
+}
+
 func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 	p.expectToken(sqltoken.LParen)
-	sok, _, _ := p.parseKeyword("SELECT")
-	wok, _, _ := p.parseKeyword("WITH")
 	var inop sqlast.Node
-	if sok || wok {
-		p.prevToken()
+	if p.peekQueryStart() {
 		q, err := p.parseQuery()
 		if err != nil {
 			return nil, errors.Errorf("parseQuery failed: %w", err)
 		}
 		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
+		if r == nil || r.Kind != sqltoken.RParen {
 			return nil, errors.Errorf("expected RParen but %+v", r)
 		}
 		inop = &sqlast.InSubQuery{
@@ -2092,24 +5900,46 @@ func (p *Parser) parseIn(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 			SubQuery: q,
 		}
 	} else {
-		list, err := p.parseExprList()
-		if err != nil {
-			return nil, errors.Errorf("parseExprList failed: %w", err)
+		in := &sqlast.InList{
+			Expr:    expr,
+			Negated: negated,
+		}
+		if err := p.parseInListItems(in); err != nil {
+			return nil, errors.Errorf("parseInListItems failed: %w", err)
 		}
 		r, _ := p.nextToken()
-		if r.Kind != sqltoken.RParen {
+		if r == nil || r.Kind != sqltoken.RParen {
 			return nil, errors.Errorf("expected RParen but %+v", r)
 		}
-		inop = &sqlast.InList{
-			RParen:  r.To,
-			Expr:    expr,
-			Negated: negated,
-			List:    list,
-		}
+		in.RParen = r.To
+		inop = in
 	}
 	return inop, nil
 }
 
+// parseInListItems parses the items of IN list.
+// Items over maxInListItems are counted in in.Elided but not stored.
+func (p *Parser) parseInListItems(in *sqlast.InList) error {
+	for {
+		item, err := p.ParseExpr()
+		if err != nil {
+			return errors.Errorf("ParseExpr failed: %w", err)
+		}
+		if p.maxInListItems > 0 && len(in.List) >= p.maxInListItems {
+			if in.Elided == 0 {
+				in.ElidedFrom = item.Pos()
+			}
+			in.Elided++
+			in.ElidedTo = item.End()
+		} else {
+			in.List = append(in.List, item)
+		}
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			return nil
+		}
+	}
+}
+
 func (p *Parser) parseBetween(expr sqlast.Node, negated bool) (sqlast.Node, error) {
 	low, err := p.parsePrefix()
 	if err != nil {
@@ -2133,8 +5963,29 @@ func (p *Parser) parseBetween(expr sqlast.Node, negated bool) (sqlast.Node, erro
 func (p *Parser) getNextPrecedence() (uint, error) {
 	tok, _ := p.peekToken()
 	if tok == nil {
+		p.candidates.addKeywords(infixKeywords...)
+		p.candidates.addTokens(infixTokens...)
+		return 0, nil
+	}
+
+	// NOT is an infix operator only as a part of NOT IN, NOT BETWEEN, NOT LIKE, NOT ILIKE and NOT SIMILAR TO,
+	// which bind as tightly as their positive forms. The precedence of NOT itself is for the prefix NOT.
+	if tok.Kind == sqltoken.SQLKeyword && tok.Value.(*sqltoken.SQLWord).Keyword == "NOT" {
+		idx := p.index
+		p.mustNextToken()
+		next, _ := p.peekToken()
+		p.index = idx
+
+		if next == nil || next.Kind != sqltoken.SQLKeyword {
+			return 0, nil
+		}
+		switch next.Value.(*sqltoken.SQLWord).Keyword {
+		case "IN", "BETWEEN", "LIKE", "ILIKE", "SIMILAR":
+			return p.getPrecedence(next), nil
+		}
 		return 0, nil
 	}
+
 	return p.getPrecedence(tok), nil
 }
 
@@ -2155,33 +6006,165 @@ func (p *Parser) getPrecedence(ts *sqltoken.Token) uint {
 			return 20
 		case "BETWEEN":
 			return 20
-		case "LIKE":
+		case "LIKE", "ILIKE", "SIMILAR":
 			return 20
 		default:
 			return 0
 		}
 	case sqltoken.Eq, sqltoken.Lt, sqltoken.LtEq, sqltoken.Neq, sqltoken.Gt, sqltoken.GtEq:
 		return 20
+	case sqltoken.Arrow, sqltoken.LongArrow, sqltoken.HashArrow, sqltoken.HashLongArrow, sqltoken.AtArrow,
+		sqltoken.Tilde, sqltoken.TildeAsterisk, sqltoken.NotTilde, sqltoken.NotTildeAsterisk:
+		return 25
 	case sqltoken.Plus, sqltoken.Minus:
 		return 30
 	case sqltoken.Mult, sqltoken.Div, sqltoken.Mod:
 		return 40
-	case sqltoken.DoubleColon:
+	case sqltoken.DoubleColon, sqltoken.LBracket:
 		return 50
 	default:
 		return 0
 	}
 }
 
+// parsePrefixedInteger parses hexadecimal, octal and binary integers such as 0x1F, 0o17 and 0b101.
+// Integers which overflow int64 are kept as DoubleValue with the original text.
+func parsePrefixedInteger(tok *sqltoken.Token) (sqlast.Node, error) {
+	num := tok.Value.(string)
+	i, err := strconv.ParseInt(num, 0, 64)
+	if err == nil {
+		return &sqlast.LongValue{
+			Long: i,
+			Text: num,
+			From: tok.From,
+			To:   tok.To,
+		}, nil
+	}
+	if !errors.Is(err, strconv.ErrRange) {
+		return nil, errors.Errorf("invalid numeric literal %s at %s", num, tok.From.String())
+	}
+	f, _, err := big.ParseFloat(num, 0, 53, big.ToNearestEven)
+	if err != nil {
+		return nil, errors.Errorf("invalid numeric literal %s at %s", num, tok.From.String())
+	}
+	d, _ := f.Float64()
+	return &sqlast.DoubleValue{
+		From:   tok.From,
+		To:     tok.To,
+		Double: d,
+		Text:   num,
+	}, nil
+}
+
+// isValidDigitGrouping reports whether every underscore in num is placed between digits i.e: 1_000_000
+func isValidDigitGrouping(num string) bool {
+	for i := 0; i < len(num); i++ {
+		if num[i] != '_' {
+			continue
+		}
+		if i == 0 || i == len(num)-1 || !isDigit(num[i-1]) || !isDigit(num[i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+var typedLiteralLayouts = map[string][]string{
+	"DATE": {"2006-01-02"},
+	"TIME": {"15:04:05", "15:04"},
+	"TIMESTAMP": {
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02 15:04:05Z07",
+		"2006-01-02 15:04:05",
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04",
+		"2006-01-02",
+	},
+}
+
+// parseTypedLiteral parses `DATE 'str'`, `TIME 'str'` and `TIMESTAMP 'str'`.
+// Strings which are not in the layouts, such as 'today' and 'infinity' of PostgreSQL, are kept as written
+// since databases accept various special values and formats.
+func parseTypedLiteral(tok, str *sqltoken.Token) sqlast.Node {
+	keyword := tok.Value.(*sqltoken.SQLWord).Keyword
+	value := str.Value.(string)
+
+	var t time.Time
+	var err error
+	for _, layout := range typedLiteralLayouts[keyword] {
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			break
+		}
+	}
+	var text string
+	if err != nil {
+		t, text = time.Time{}, value
+	}
+
+	switch keyword {
+	case "DATE":
+		return &sqlast.DateValue{From: tok.From, To: str.To, Date: t, Text: text}
+	case "TIME":
+		return &sqlast.TimeValue{From: tok.From, To: str.To, Time: t, Text: text}
+	default:
+		return &sqlast.TimestampValue{From: tok.From, To: str.To, Timestamp: t, Text: text}
+	}
+}
+
+func parsePlaceholder(tok *sqltoken.Token) (*sqlast.Placeholder, error) {
+	str := tok.Value.(string)
+	ph := &sqlast.Placeholder{
+		From: tok.From,
+		To:   tok.To,
+	}
+
+	switch str[0] {
+	case '?':
+		ph.Style = sqlast.QuestionPlaceholder
+	case '$':
+		i, err := strconv.Atoi(str[1:])
+		if err != nil {
+			return nil, errors.Errorf("invalid placeholder %s: %w", str, err)
+		}
+		ph.Style = sqlast.DollarPlaceholder
+		ph.Index = i
+	case ':':
+		ph.Style = sqlast.ColonPlaceholder
+		ph.Name = str[1:]
+	case '@':
+		ph.Style = sqlast.AtPlaceholder
+		ph.Name = str[1:]
+	default:
+		return nil, errors.Errorf("unknown placeholder %s", str)
+	}
+
+	return ph, nil
+}
+
 func (p *Parser) parsePrefix() (sqlast.Node, error) {
 	tok, err := p.nextToken()
 	if err != nil {
+		p.candidates.addKeywords(prefixKeywords...)
+		p.candidates.addTokens(prefixTokens...)
 		return nil, errors.Errorf("nextToken error: %w", err)
 	}
 
 	switch tok.Kind {
 	case sqltoken.SQLKeyword:
 		word := tok.Value.(*sqltoken.SQLWord)
+		if t, _ := p.peekToken(); t != nil && t.Kind == sqltoken.SingleQuotedString && word.QuoteStyle == 0 {
+			switch word.Keyword {
+			case "DATE", "TIME", "TIMESTAMP":
+				p.mustNextToken()
+				return parseTypedLiteral(tok, t), nil
+			}
+		}
 		switch word.Keyword {
 		case "TRUE", "FALSE", "NULL":
 			p.prevToken()
@@ -2197,6 +6180,31 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				return nil, errors.Errorf("parseCaseExpression failed: %w", err)
 			}
 			return ast, nil
+		case "ARRAY":
+			if l, _ := p.peekToken(); l != nil && l.Kind == sqltoken.LBracket {
+				p.mustNextToken()
+				return p.parseArrayConstructor(tok, l)
+			}
+			return newIdent(tok, word), nil
+		case "EXTRACT", "POSITION", "SUBSTRING", "TRIM", "OVERLAY":
+			if l, _ := p.peekToken(); l == nil || l.Kind != sqltoken.LParen {
+				return newIdent(tok, word), nil
+			}
+			idx := p.index
+			ast, ok, err := p.parseSpecialFormFunction(tok, word)
+			if err != nil {
+				return nil, errors.Errorf("parseSpecialFormFunction failed: %w", err)
+			}
+			if ok {
+				return ast, nil
+			}
+			// plain function call form i.e: SUBSTRING(str, 1, 2)
+			p.index = idx
+			f, err := p.parseFunction(&sqlast.ObjectName{Idents: []*sqlast.Ident{newIdent(tok, word)}})
+			if err != nil {
+				return nil, errors.Errorf("parseFunction failed: %w", err)
+			}
+			return f, nil
 		case "CAST":
 			p.prevToken()
 			ast, err := p.parseCastExpression()
@@ -2233,19 +6241,16 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 			}
 			return &sqlast.UnaryExpr{
 				From: tok.From,
-				Op:   &sqlast.Operator{Type: sqlast.Not},
+				Op:   &sqlast.Operator{Type: sqlast.Not, From: tok.From, To: tok.To},
 				Expr: expr,
 			}, nil
 		default:
 			t, _ := p.peekToken()
 			if t == nil || (t.Kind != sqltoken.LParen && t.Kind != sqltoken.Period) {
-				return &sqlast.Ident{Value: word.String(),
-					From: tok.From,
-					To:   tok.To,
-				}, nil
+				return newIdent(tok, word), nil
 			}
 			idParts := []*sqlast.Ident{
-				{Value: word.String(), From: tok.From, To: tok.To},
+				newIdent(tok, word),
 			}
 			endWithWildcard := false
 
@@ -2260,10 +6265,7 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 
 				if n.Kind == sqltoken.SQLKeyword {
 					w := n.Value.(*sqltoken.SQLWord)
-					idParts = append(idParts, &sqlast.Ident{Value: w.String(),
-						From: n.From,
-						To:   n.To,
-					})
+					idParts = append(idParts, newIdent(n, w))
 					continue
 				}
 				if n.Kind == sqltoken.Mult {
@@ -2322,27 +6324,26 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 			Op:   &sqlast.Operator{Type: sqlast.Minus, From: tok.From, To: tok.To},
 			Expr: expr,
 		}, nil
-	case sqltoken.Number, sqltoken.SingleQuotedString, sqltoken.NationalStringLiteral:
+	case sqltoken.Placeholder:
+		return parsePlaceholder(tok)
+	case sqltoken.Number, sqltoken.SingleQuotedString, sqltoken.NationalStringLiteral, sqltoken.DollarQuotedString,
+		sqltoken.HexStringLiteral, sqltoken.BitStringLiteral:
 		p.prevToken()
 		v, err := p.parseSQLValue()
 		if err != nil {
-			return nil, errors.Errorf("parseSQLValue failed", err)
+			return nil, errors.Errorf("parseSQLValue failed: %w", err)
 		}
 		return v, nil
 	case sqltoken.LParen:
-		sok, _, _ := p.parseKeyword("SELECT")
-		wok, _, _ := p.parseKeyword("WITH")
-
 		var ast sqlast.Node
 
-		if sok || wok {
-			p.prevToken()
+		if p.peekQueryStart() {
 			expr, err := p.parseQuery()
 			if err != nil {
 				return nil, errors.Errorf("parseQuery failed: %w", err)
 			}
 			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
+			if r == nil || r.Kind != sqltoken.RParen {
 				return nil, errors.Errorf("expected RParen but %+v", r)
 			}
 			ast = &sqlast.SubQuery{
@@ -2356,7 +6357,7 @@ func (p *Parser) parsePrefix() (sqlast.Node, error) {
 				return nil, errors.Errorf("parseQuery failed: %w", err)
 			}
 			r, _ := p.nextToken()
-			if r.Kind != sqltoken.RParen {
+			if r == nil || r.Kind != sqltoken.RParen {
 				return nil, errors.Errorf("expected RParen but %+v", r)
 			}
 			ast = &sqlast.Nested{
@@ -2379,7 +6380,7 @@ func (p *Parser) parseFunction(name *sqlast.ObjectName) (sqlast.Node, error) {
 	}
 
 	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
+	if r == nil || r.Kind != sqltoken.RParen {
 		return nil, errors.Errorf("expected RParen but %+v", r)
 	}
 
@@ -2574,6 +6575,22 @@ func (p *Parser) parseObjectName() (*sqlast.ObjectName, error) {
 	}, nil
 }
 
+// singleQuotedString returns the string literal of the SingleQuotedString token t.
+func (p *Parser) singleQuotedString(t *sqltoken.Token) *sqlast.SingleQuotedString {
+	return &sqlast.SingleQuotedString{
+		From:            t.From,
+		To:              t.To,
+		String:          t.Value.(string),
+		BackslashEscape: p.backslashEscape(),
+	}
+}
+
+// backslashEscape reports whether backslash is an escape character in string literals of the dialect.
+func (p *Parser) backslashEscape() bool {
+	_, ok := p.dialect.UnescapeString('\\')
+	return ok
+}
+
 func (p *Parser) parseSQLValue() (sqlast.Node, error) {
 	return p.parseValue()
 }
@@ -2611,34 +6628,71 @@ func (p *Parser) parseValue() (sqlast.Node, error) {
 		}
 	case sqltoken.Number:
 		num := tok.Value.(string)
-		if strings.Contains(num, ".") {
-			f, err := strconv.ParseFloat(num, 64)
-			if err != nil {
-				return nil, errors.Errorf("parseFloat failed %s", num)
+		if len(num) > 1 && strings.ContainsRune("xXoObB", rune(num[1])) {
+			return parsePrefixedInteger(tok)
+		}
+		if !isValidDigitGrouping(num) {
+			return nil, errors.Errorf("invalid numeric literal %s at %s", num, tok.From.String())
+		}
+		digits := strings.ReplaceAll(num, "_", "")
+		if !strings.ContainsAny(digits, ".eE") {
+			i, err := strconv.ParseInt(digits, 10, 64)
+			if err == nil {
+				return &sqlast.LongValue{
+					Long: i,
+					Text: num,
+					From: tok.From,
+					To:   tok.To,
+				}, nil
 			}
-			return &sqlast.DoubleValue{
-				From:   tok.From,
-				To:     tok.To,
-				Double: f,
-			}, nil
-		} else {
-			i, _ := strconv.Atoi(num)
-			return &sqlast.LongValue{
-				Long: int64(i),
-				From: tok.From,
-				To:   tok.To,
-			}, nil
+			// integers which overflow int64 are kept as DoubleValue with the original text
+			if !errors.Is(err, strconv.ErrRange) {
+				return nil, errors.Errorf("invalid numeric literal %s at %s", num, tok.From.String())
+			}
+		}
+		f, err := strconv.ParseFloat(digits, 64)
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return nil, errors.Errorf("invalid numeric literal %s at %s", num, tok.From.String())
 		}
+		return &sqlast.DoubleValue{
+			From:   tok.From,
+			To:     tok.To,
+			Double: f,
+			Text:   num,
+		}, nil
 	case sqltoken.SingleQuotedString:
-		str := tok.Value.(string)
-		return &sqlast.SingleQuotedString{
+		return p.singleQuotedString(tok), nil
+	case sqltoken.DollarQuotedString:
+		q := tok.Value.(*sqltoken.DollarQuote)
+		return &sqlast.DollarQuotedString{
 			From:   tok.From,
 			To:     tok.To,
-			String: str,
+			Tag:    q.Tag,
+			String: q.Value,
 		}, nil
 	case sqltoken.NationalStringLiteral:
-		str := tok.Value.(string)
 		return &sqlast.NationalStringLiteral{
+			String:          tok.Value.(string),
+			From:            tok.From,
+			To:              tok.To,
+			BackslashEscape: p.backslashEscape(),
+		}, nil
+	case sqltoken.HexStringLiteral:
+		str := tok.Value.(string)
+		if strings.TrimLeft(str, "0123456789abcdefABCDEF") != "" {
+			return nil, errors.Errorf("invalid hexadecimal string X'%s' at %s", str, tok.From.String())
+		}
+		return &sqlast.HexStringLiteral{
+			String: str,
+			From:   tok.From,
+			To:     tok.To,
+		}, nil
+	case sqltoken.BitStringLiteral:
+		str := tok.Value.(string)
+		if strings.TrimLeft(str, "01") != "" {
+			return nil, errors.Errorf("invalid bit string B'%s' at %s", str, tok.From.String())
+		}
+		return &sqlast.BitStringLiteral{
 			String: str,
 			From:   tok.From,
 			To:     tok.To,
@@ -2649,6 +6703,36 @@ func (p *Parser) parseValue() (sqlast.Node, error) {
 
 }
 
+// parseCharsetCollation parses optional `CHARACTER SET charset_name` (or `CHARSET charset_name`)
+// and `COLLATE collation_name` after character string types.
+func (p *Parser) parseCharsetCollation() (sqlast.CharsetCollation, error) {
+	var cc sqlast.CharsetCollation
+
+	ok, toks, _ := p.parseKeywords("CHARACTER", "SET")
+	if !ok {
+		ok, toks, _ = p.parseKeywords("CHARSET")
+	}
+	if ok {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return cc, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		cc.Charset = name
+		cc.CharsetPos = toks[0].From
+	}
+
+	if ok, tok, _ := p.parseKeyword("COLLATE"); ok {
+		name, err := p.parseIdentifier()
+		if err != nil {
+			return cc, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		cc.Collation = name
+		cc.CollatePos = tok.From
+	}
+
+	return cc, nil
+}
+
 func (p *Parser) parseOptionalPrecision() (*uint, sqltoken.Pos, error) {
 	if ok, _ := p.consumeToken(sqltoken.LParen); ok {
 		n, _, err := p.parseLiteralInt()
@@ -2667,6 +6751,78 @@ func (p *Parser) parseOptionalPrecision() (*uint, sqltoken.Pos, error) {
 	}
 }
 
+// parseTimePrecisionAndZone parses optional (precision) and WITH | WITHOUT TIME ZONE of TIME and TIMESTAMP types.
+// the precision is accepted both before and after the time zone clause.
+func (p *Parser) parseTimePrecisionAndZone() (*uint, sqltoken.Pos, bool, sqltoken.Pos, error) {
+	precision, r, err := p.parseOptionalPrecision()
+	if err != nil {
+		return nil, sqltoken.Pos{}, false, sqltoken.Pos{}, errors.Errorf("parsePrecision failed: %w", err)
+	}
+
+	var zone sqltoken.Pos
+	wok, toks, _ := p.parseKeywords("WITH", "TIME", "ZONE")
+	ook := false
+	if wok {
+		zone = toks[2].To
+	} else {
+		ook, _, _ = p.parseKeywords("WITHOUT", "TIME", "ZONE")
+	}
+	if wok || ook {
+		if precision == nil {
+			precision, r, err = p.parseOptionalPrecision()
+			if err != nil {
+				return nil, sqltoken.Pos{}, false, sqltoken.Pos{}, errors.Errorf("parsePrecision failed: %w", err)
+			}
+		}
+	}
+
+	return precision, r, wok, zone, nil
+}
+
+// parseMyEnumValues parses ('value1', 'value2', ...) of ENUM and SET types of MySQL
+func (p *Parser) parseMyEnumValues() ([]*sqlast.SingleQuotedString, sqltoken.Pos, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		t, _ := p.peekToken()
+		return nil, sqltoken.Pos{}, errors.Errorf("expected LParen but %+v", t)
+	}
+
+	var values []*sqlast.SingleQuotedString
+	for {
+		t, _ := p.nextToken()
+		if t == nil || t.Kind != sqltoken.SingleQuotedString {
+			return nil, sqltoken.Pos{}, errors.Errorf("expected string value but %+v", t)
+		}
+		values = append(values, p.singleQuotedString(t))
+		if ok, _ := p.consumeToken(sqltoken.Comma); !ok {
+			break
+		}
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, sqltoken.Pos{}, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	return values, r.To, nil
+}
+
+// parseOptionalPrecisionOrMax parses (n) or (MAX) of MSSQL
+func (p *Parser) parseOptionalPrecisionOrMax() (*uint, bool, sqltoken.Pos, error) {
+	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
+		return nil, false, sqltoken.Pos{}, nil
+	}
+	if ok, _, _ := p.parseKeyword("MAX"); ok {
+		tok, _ := p.nextToken()
+		if tok == nil || tok.Kind != sqltoken.RParen {
+			return nil, false, sqltoken.Pos{}, errors.Errorf("expected RParen but %s", tok)
+		}
+		return nil, true, tok.To, nil
+	}
+	p.prevToken()
+	size, r, err := p.parseOptionalPrecision()
+	return size, false, r, err
+}
+
 func (p *Parser) parseOptionalPrecisionScale() (*uint, *uint, error) {
 	if ok, _ := p.consumeToken(sqltoken.LParen); !ok {
 		return nil, nil, nil
@@ -2691,7 +6847,7 @@ func (p *Parser) parseOptionalPrecisionScale() (*uint, *uint, error) {
 
 func (p *Parser) parseLiteralInt() (int, *sqltoken.Token, error) {
 	tok, _ := p.nextToken()
-	if tok.Kind != sqltoken.Number {
+	if tok == nil || tok.Kind != sqltoken.Number {
 		return 0, nil, errors.Errorf("expect literal int but %v", tok.Kind)
 	}
 	istr := tok.Value.(string)
@@ -2714,12 +6870,7 @@ func (p *Parser) parseListOfIds(separator sqltoken.Kind) ([]*sqlast.Ident, error
 		}
 		if tok.Kind == sqltoken.SQLKeyword && expectIdentifier {
 			expectIdentifier = false
-			word := tok.Value.(*sqltoken.SQLWord)
-			idents = append(idents, &sqlast.Ident{
-				Value: word.String(),
-				From:  tok.From,
-				To:    tok.To,
-			})
+			idents = append(idents, newIdent(tok, tok.Value.(*sqltoken.SQLWord)))
 			continue
 		} else if tok.Kind == separator && !expectIdentifier {
 			expectIdentifier = true
@@ -2796,6 +6947,165 @@ func (p *Parser) parseCaseExpression() (sqlast.Node, error) {
 
 }
 
+// parseSpecialFormFunction parses the functions which take keyword-based arguments
+// such as EXTRACT(YEAR FROM ts). It is called after the function name is consumed.
+// It returns false if the arguments are not written in the special form.
+func (p *Parser) parseSpecialFormFunction(tok *sqltoken.Token, word *sqltoken.SQLWord) (sqlast.Node, bool, error) {
+	p.expectToken(sqltoken.LParen)
+
+	var ast sqlast.Node
+	switch word.Keyword {
+	case "EXTRACT":
+		field, err := p.parseIdentifier()
+		if err != nil {
+			return nil, false, errors.Errorf("parseIdentifier failed: %w", err)
+		}
+		if ok, _, _ := p.parseKeyword("FROM"); !ok {
+			return nil, false, nil
+		}
+		source, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		ast = &sqlast.ExtractExpr{
+			Extract: tok.From,
+			Field:   field,
+			Source:  source,
+		}
+	case "POSITION":
+		// parse until IN which must not be parsed as an infix operator
+		sub, err := p.parseSubexpr(p.getPrecedence(&sqltoken.Token{Kind: sqltoken.SQLKeyword, Value: sqltoken.MakeKeyword("IN", 0)}))
+		if err != nil {
+			return nil, false, errors.Errorf("parseSubexpr failed: %w", err)
+		}
+		if ok, _, _ := p.parseKeyword("IN"); !ok {
+			return nil, false, nil
+		}
+		str, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		ast = &sqlast.PositionExpr{
+			Position:  tok.From,
+			Substring: sub,
+			String:    str,
+		}
+	case "SUBSTRING":
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		s := &sqlast.SubstringExpr{
+			Substring: tok.From,
+			Expr:      expr,
+		}
+		if ok, _, _ := p.parseKeyword("FROM"); ok {
+			from, err := p.ParseExpr()
+			if err != nil {
+				return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			s.From = from
+		}
+		if ok, _, _ := p.parseKeyword("FOR"); ok {
+			f, err := p.ParseExpr()
+			if err != nil {
+				return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			s.For = f
+		}
+		if s.From == nil && s.For == nil {
+			return nil, false, nil
+		}
+		ast = s
+	case "TRIM":
+		t := &sqlast.TrimExpr{
+			Trim: tok.From,
+		}
+		if ok, _, _ := p.parseKeyword("BOTH"); ok {
+			t.Where = sqlast.TrimBoth
+		} else if ok, _, _ := p.parseKeyword("LEADING"); ok {
+			t.Where = sqlast.TrimLeading
+		} else if ok, _, _ := p.parseKeyword("TRAILING"); ok {
+			t.Where = sqlast.TrimTrailing
+		}
+
+		if ok, _, _ := p.parseKeyword("FROM"); !ok {
+			chars, err := p.ParseExpr()
+			if err != nil {
+				return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			if ok, _, _ := p.parseKeyword("FROM"); !ok {
+				if t.Where != sqlast.TrimUnspecified {
+					return nil, false, errors.Errorf("expected FROM after TRIM(%s", t.Where)
+				}
+				return nil, false, nil
+			}
+			t.Chars = chars
+		}
+
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		t.Expr = expr
+		ast = t
+	case "OVERLAY":
+		expr, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		if ok, _, _ := p.parseKeyword("PLACING"); !ok {
+			return nil, false, nil
+		}
+		placing, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		if ok, _, _ := p.parseKeyword("FROM"); !ok {
+			t, _ := p.peekToken()
+			return nil, false, errors.Errorf("expected FROM but %+v", t)
+		}
+		from, err := p.ParseExpr()
+		if err != nil {
+			return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+		}
+		o := &sqlast.OverlayExpr{
+			Overlay: tok.From,
+			Expr:    expr,
+			Placing: placing,
+			From:    from,
+		}
+		if ok, _, _ := p.parseKeyword("FOR"); ok {
+			f, err := p.ParseExpr()
+			if err != nil {
+				return nil, false, errors.Errorf("ParseExpr failed: %w", err)
+			}
+			o.For = f
+		}
+		ast = o
+	}
+
+	r, _ := p.nextToken()
+	if r == nil || r.Kind != sqltoken.RParen {
+		return nil, false, errors.Errorf("expected RParen but %+v", r)
+	}
+
+	switch a := ast.(type) {
+	case *sqlast.ExtractExpr:
+		a.RParen = r.To
+	case *sqlast.PositionExpr:
+		a.RParen = r.To
+	case *sqlast.SubstringExpr:
+		a.RParen = r.To
+	case *sqlast.TrimExpr:
+		a.RParen = r.To
+	case *sqlast.OverlayExpr:
+		a.RParen = r.To
+	}
+
+	return ast, true, nil
+}
+
 func (p *Parser) parseCastExpression() (sqlast.Node, error) {
 	ok, tok, _ := p.parseKeyword("CAST")
 	if !ok {
@@ -2812,7 +7122,7 @@ func (p *Parser) parseCastExpression() (sqlast.Node, error) {
 		return nil, errors.Errorf("ParseDataType")
 	}
 	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
+	if r == nil || r.Kind != sqltoken.RParen {
 		return nil, errors.Errorf("expect RParen but %+v", r)
 	}
 
@@ -2837,7 +7147,7 @@ func (p *Parser) parseExistsExpression(negatedTok *sqltoken.Token) (sqlast.Node,
 	}
 
 	r, _ := p.nextToken()
-	if r.Kind != sqltoken.RParen {
+	if r == nil || r.Kind != sqltoken.RParen {
 		return nil, errors.Errorf("expect RParen but %+v", r)
 	}
 
@@ -2866,13 +7176,28 @@ func (p *Parser) parseMyUnsigned() (bool, sqltoken.Pos) {
 	return false, sqltoken.Pos{}
 }
 
+func (p *Parser) parseMyZerofill() (bool, sqltoken.Pos) {
+	if !dialect.Supports(p.dialect, dialect.DisplayWidth) {
+		return false, sqltoken.Pos{}
+	}
+	if ok, z, _ := p.parseKeyword("ZEROFILL"); ok {
+		return ok, z.To
+	}
+
+	return false, sqltoken.Pos{}
+}
+
+// parseMyDisplayWidth parses optional display width of integer types i.e: int(11)
+func (p *Parser) parseMyDisplayWidth() (*uint, sqltoken.Pos, error) {
+	if !dialect.Supports(p.dialect, dialect.DisplayWidth) {
+		return nil, sqltoken.Pos{}, nil
+	}
+	return p.parseOptionalPrecision()
+}
+
 func (p *Parser) expectKeyword(expected string) *sqltoken.Token {
 	ok, tok, err := p.parseKeyword(expected)
 	if err != nil || !ok {
-		for i := 0; i < int(p.index); i++ {
-			fmt.Printf("%v", p.tokens[i].Value)
-		}
-		fmt.Println()
 		log.Panicf("should be expected keyword: %s err: %v", expected, err)
 	}
 
@@ -2883,11 +7208,6 @@ func (p *Parser) expectToken(expected sqltoken.Kind) {
 	ok, err := p.consumeToken(expected)
 	if err != nil || !ok {
 		tok, _ := p.peekToken()
-
-		for i := 0; i < int(p.index); i++ {
-			fmt.Printf("%v", p.tokens[i].Value)
-		}
-		fmt.Println()
 		log.Panicf("should be %s sqltoken, but %+v,  err: %+v", expected, tok, err)
 	}
 }
@@ -2895,6 +7215,7 @@ func (p *Parser) expectToken(expected sqltoken.Kind) {
 func (p *Parser) consumeToken(expected sqltoken.Kind) (bool, error) {
 	tok, err := p.peekToken()
 	if err != nil {
+		p.candidates.addTokens(expected)
 		return false, err
 	}
 
@@ -2999,7 +7320,7 @@ func (p *Parser) nextTokenWithParseComment() (*sqltoken.Token, error) {
 				continue
 			}
 
-			text := tok.Value.(string)
+			text := tok.Value.(*sqltoken.CommentValue).Text
 			m.List = append(m.List, &sqlast.Comment{
 				From: tok.From,
 				To:   tok.To,
@@ -3025,6 +7346,24 @@ func (p *Parser) nextTokenWithParseComment() (*sqltoken.Token, error) {
 	return t, nil
 }
 
+// parseHint parses an optimizer hint comment immediately after SELECT, UPDATE or DELETE.
+// It returns nil if the next token isn't a hint comment.
+func (p *Parser) parseHint() *sqlast.HintComment {
+	for i := p.index; i < uint(len(p.tokens)); i++ {
+		tok := p.tokens[i]
+		if tok.Kind == sqltoken.Whitespace {
+			continue
+		}
+		c, ok := tok.Value.(*sqltoken.CommentValue)
+		if tok.Kind != sqltoken.Comment || !ok || c.Style != sqltoken.HintComment {
+			return nil
+		}
+		p.index = i + 1
+		return &sqlast.HintComment{Text: c.Text, From: tok.From, To: tok.To}
+	}
+	return nil
+}
+
 var EOF = errors.New("tokens are already consumed")
 
 func (p *Parser) nextTokenNoSkip() (*sqltoken.Token, error) {
@@ -3095,6 +7434,7 @@ func (p *Parser) parseKeywords(keywords ...string) (bool, []*sqltoken.Token, err
 func (p *Parser) parseKeyword(expected string) (bool, *sqltoken.Token, error) {
 	tok, err := p.peekToken()
 	if err != nil {
+		p.candidates.addKeywords(expected)
 		return false, nil, errors.Errorf("parseKeyword %s failed: %w", expected, err)
 	}
 
@@ -3116,8 +7456,3 @@ func (p *Parser) Debug() {
 	}
 	fmt.Println()
 }
-
-func containsStr(strmap map[string]struct{}, t string) bool {
-	_, ok := strmap[t]
-	return ok
-}
@@ -2,7 +2,10 @@ package xsqlparser
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -25,13 +28,13 @@ func TestParser_ParseStatement(t *testing.T) {
 				in:   "SELECT test FROM test_table",
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: sqlast.NewIdentWithPos(
 									"test",
-									sqltoken.NewPos(1, 8),
-									sqltoken.NewPos(1, 12),
+									sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									sqltoken.Pos{Line: 1, Col: 12, Offset: 11},
 								),
 							},
 						},
@@ -41,8 +44,8 @@ func TestParser_ParseStatement(t *testing.T) {
 									Idents: []*sqlast.Ident{
 										sqlast.NewIdentWithPos(
 											"test_table",
-											sqltoken.NewPos(1, 18),
-											sqltoken.NewPos(1, 28),
+											sqltoken.Pos{Line: 1, Col: 18, Offset: 17},
+											sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
 										),
 									},
 								},
@@ -56,13 +59,13 @@ func TestParser_ParseStatement(t *testing.T) {
 				in:   "SELECT test FROM test_table WHERE test_table.column1 = 'test'",
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: sqlast.NewIdentWithPos(
 									"test",
-									sqltoken.NewPos(1, 8),
-									sqltoken.NewPos(1, 12),
+									sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									sqltoken.Pos{Line: 1, Col: 12, Offset: 11},
 								),
 							},
 						},
@@ -72,8 +75,8 @@ func TestParser_ParseStatement(t *testing.T) {
 									Idents: []*sqlast.Ident{
 										sqlast.NewIdentWithPos(
 											"test_table",
-											sqltoken.NewPos(1, 18),
-											sqltoken.NewPos(1, 28),
+											sqltoken.Pos{Line: 1, Col: 18, Offset: 17},
+											sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
 										),
 									},
 								},
@@ -84,24 +87,24 @@ func TestParser_ParseStatement(t *testing.T) {
 								Idents: []*sqlast.Ident{
 									sqlast.NewIdentWithPos(
 										"test_table",
-										sqltoken.NewPos(1, 35),
-										sqltoken.NewPos(1, 45),
+										sqltoken.Pos{Line: 1, Col: 35, Offset: 34},
+										sqltoken.Pos{Line: 1, Col: 45, Offset: 44},
 									),
 									sqlast.NewIdentWithPos(
 										"column1",
-										sqltoken.NewPos(1, 46),
-										sqltoken.NewPos(1, 53),
+										sqltoken.Pos{Line: 1, Col: 46, Offset: 45},
+										sqltoken.Pos{Line: 1, Col: 53, Offset: 52},
 									),
 								},
 							},
 							Op: &sqlast.Operator{
 								Type: sqlast.Eq,
-								From: sqltoken.NewPos(1, 54),
-								To:   sqltoken.NewPos(1, 55),
+								From: sqltoken.Pos{Line: 1, Col: 54, Offset: 53},
+								To:   sqltoken.Pos{Line: 1, Col: 55, Offset: 54},
 							},
 							Right: &sqlast.SingleQuotedString{
-								From:   sqltoken.NewPos(1, 56),
-								To:     sqltoken.NewPos(1, 62),
+								From:   sqltoken.Pos{Line: 1, Col: 56, Offset: 55},
+								To:     sqltoken.Pos{Line: 1, Col: 62, Offset: 61},
 								String: "test",
 							},
 						},
@@ -113,7 +116,7 @@ func TestParser_ParseStatement(t *testing.T) {
 				in:   "SELECT COUNT(t1.id) AS c FROM test_table AS t1 LEFT JOIN test_table2 AS t2 ON t1.id = t2.test_table_id",
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.AliasSelectItem{
 								Expr: &sqlast.Function{
@@ -121,8 +124,8 @@ func TestParser_ParseStatement(t *testing.T) {
 										Idents: []*sqlast.Ident{
 											sqlast.NewIdentWithPos(
 												"COUNT",
-												sqltoken.NewPos(1, 8),
-												sqltoken.NewPos(1, 13),
+												sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+												sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
 											),
 										},
 									},
@@ -130,22 +133,22 @@ func TestParser_ParseStatement(t *testing.T) {
 										Idents: []*sqlast.Ident{
 											sqlast.NewIdentWithPos(
 												"t1",
-												sqltoken.NewPos(1, 14),
-												sqltoken.NewPos(1, 16),
+												sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+												sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
 											),
 											sqlast.NewIdentWithPos(
 												"id",
-												sqltoken.NewPos(1, 17),
-												sqltoken.NewPos(1, 19),
+												sqltoken.Pos{Line: 1, Col: 17, Offset: 16},
+												sqltoken.Pos{Line: 1, Col: 19, Offset: 18},
 											),
 										},
 									}},
-									ArgsRParen: sqltoken.NewPos(1, 20),
+									ArgsRParen: sqltoken.Pos{Line: 1, Col: 20, Offset: 19},
 								},
 								Alias: &sqlast.Ident{
 									Value: "c",
-									From:  sqltoken.NewPos(1, 24),
-									To:    sqltoken.NewPos(1, 25),
+									From:  sqltoken.Pos{Line: 1, Col: 24, Offset: 23},
+									To:    sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
 								},
 							},
 						},
@@ -157,22 +160,22 @@ func TestParser_ParseStatement(t *testing.T) {
 											Idents: []*sqlast.Ident{
 												{
 													Value: "test_table",
-													From:  sqltoken.NewPos(1, 31),
-													To:    sqltoken.NewPos(1, 41),
+													From:  sqltoken.Pos{Line: 1, Col: 31, Offset: 30},
+													To:    sqltoken.Pos{Line: 1, Col: 41, Offset: 40},
 												},
 											},
 										},
 										Alias: &sqlast.Ident{
 											Value: "t1",
-											From:  sqltoken.NewPos(1, 45),
-											To:    sqltoken.NewPos(1, 47),
+											From:  sqltoken.Pos{Line: 1, Col: 45, Offset: 44},
+											To:    sqltoken.Pos{Line: 1, Col: 47, Offset: 46},
 										},
 									},
 								},
 								Type: &sqlast.JoinType{
 									Condition: sqlast.LEFT,
-									From:      sqltoken.NewPos(1, 48),
-									To:        sqltoken.NewPos(1, 52),
+									From:      sqltoken.Pos{Line: 1, Col: 48, Offset: 47},
+									To:        sqltoken.Pos{Line: 1, Col: 52, Offset: 51},
 								},
 								RightElement: &sqlast.TableJoinElement{
 									Ref: &sqlast.Table{
@@ -180,51 +183,51 @@ func TestParser_ParseStatement(t *testing.T) {
 											Idents: []*sqlast.Ident{
 												{
 													Value: "test_table2",
-													From:  sqltoken.NewPos(1, 58),
-													To:    sqltoken.NewPos(1, 69),
+													From:  sqltoken.Pos{Line: 1, Col: 58, Offset: 57},
+													To:    sqltoken.Pos{Line: 1, Col: 69, Offset: 68},
 												},
 											},
 										},
 										Alias: &sqlast.Ident{
 											Value: "t2",
-											From:  sqltoken.NewPos(1, 73),
-											To:    sqltoken.NewPos(1, 75),
+											From:  sqltoken.Pos{Line: 1, Col: 73, Offset: 72},
+											To:    sqltoken.Pos{Line: 1, Col: 75, Offset: 74},
 										},
 									},
 								},
 								Spec: &sqlast.JoinCondition{
-									On: sqltoken.NewPos(1, 76),
+									On: sqltoken.Pos{Line: 1, Col: 76, Offset: 75},
 									SearchCondition: &sqlast.BinaryExpr{
 										Left: &sqlast.CompoundIdent{
 											Idents: []*sqlast.Ident{
 												{
 													Value: "t1",
-													From:  sqltoken.NewPos(1, 79),
-													To:    sqltoken.NewPos(1, 81),
+													From:  sqltoken.Pos{Line: 1, Col: 79, Offset: 78},
+													To:    sqltoken.Pos{Line: 1, Col: 81, Offset: 80},
 												},
 												{
 													Value: "id",
-													From:  sqltoken.NewPos(1, 82),
-													To:    sqltoken.NewPos(1, 84),
+													From:  sqltoken.Pos{Line: 1, Col: 82, Offset: 81},
+													To:    sqltoken.Pos{Line: 1, Col: 84, Offset: 83},
 												},
 											},
 										},
 										Op: &sqlast.Operator{
 											Type: sqlast.Eq,
-											From: sqltoken.NewPos(1, 85),
-											To:   sqltoken.NewPos(1, 86),
+											From: sqltoken.Pos{Line: 1, Col: 85, Offset: 84},
+											To:   sqltoken.Pos{Line: 1, Col: 86, Offset: 85},
 										},
 										Right: &sqlast.CompoundIdent{
 											Idents: []*sqlast.Ident{
 												{
 													Value: "t2",
-													From:  sqltoken.NewPos(1, 87),
-													To:    sqltoken.NewPos(1, 89),
+													From:  sqltoken.Pos{Line: 1, Col: 87, Offset: 86},
+													To:    sqltoken.Pos{Line: 1, Col: 89, Offset: 88},
 												},
 												{
 													Value: "test_table_id",
-													From:  sqltoken.NewPos(1, 90),
-													To:    sqltoken.NewPos(1, 103),
+													From:  sqltoken.Pos{Line: 1, Col: 90, Offset: 89},
+													To:    sqltoken.Pos{Line: 1, Col: 103, Offset: 102},
 												},
 											},
 										},
@@ -240,7 +243,7 @@ func TestParser_ParseStatement(t *testing.T) {
 				in:   "SELECT COUNT(customer_id), country.* FROM customers GROUP BY country",
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: &sqlast.Function{
@@ -248,19 +251,19 @@ func TestParser_ParseStatement(t *testing.T) {
 										Idents: []*sqlast.Ident{
 											{
 												Value: "COUNT",
-												From:  sqltoken.NewPos(1, 8),
-												To:    sqltoken.NewPos(1, 13),
+												From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+												To:    sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
 											},
 										},
 									},
 									Args: []sqlast.Node{
 										&sqlast.Ident{
 											Value: "customer_id",
-											From:  sqltoken.NewPos(1, 14),
-											To:    sqltoken.NewPos(1, 25),
+											From:  sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+											To:    sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
 										},
 									},
-									ArgsRParen: sqltoken.NewPos(1, 26),
+									ArgsRParen: sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
 								},
 							},
 							&sqlast.QualifiedWildcardSelectItem{
@@ -268,8 +271,8 @@ func TestParser_ParseStatement(t *testing.T) {
 									Idents: []*sqlast.Ident{
 										{
 											Value: "country",
-											From:  sqltoken.NewPos(1, 28),
-											To:    sqltoken.NewPos(1, 35),
+											From:  sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+											To:    sqltoken.Pos{Line: 1, Col: 35, Offset: 34},
 										},
 									},
 								},
@@ -281,8 +284,8 @@ func TestParser_ParseStatement(t *testing.T) {
 									Idents: []*sqlast.Ident{
 										{
 											Value: "customers",
-											From:  sqltoken.NewPos(1, 43),
-											To:    sqltoken.NewPos(1, 52),
+											From:  sqltoken.Pos{Line: 1, Col: 43, Offset: 42},
+											To:    sqltoken.Pos{Line: 1, Col: 52, Offset: 51},
 										},
 									},
 								},
@@ -291,8 +294,8 @@ func TestParser_ParseStatement(t *testing.T) {
 						GroupByClause: []sqlast.Node{
 							&sqlast.Ident{
 								Value: "country",
-								From:  sqltoken.NewPos(1, 62),
-								To:    sqltoken.NewPos(1, 69),
+								From:  sqltoken.Pos{Line: 1, Col: 62, Offset: 61},
+								To:    sqltoken.Pos{Line: 1, Col: 69, Offset: 68},
 							},
 						},
 					},
@@ -306,7 +309,7 @@ GROUP BY country
 HAVING COUNT(customer_id) > 3`,
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: &sqlast.Function{
@@ -314,26 +317,26 @@ HAVING COUNT(customer_id) > 3`,
 										Idents: []*sqlast.Ident{
 											{
 												Value: "COUNT",
-												From:  sqltoken.NewPos(1, 8),
-												To:    sqltoken.NewPos(1, 13),
+												From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+												To:    sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
 											},
 										},
 									},
 									Args: []sqlast.Node{
 										&sqlast.Ident{
 											Value: "customer_id",
-											From:  sqltoken.NewPos(1, 14),
-											To:    sqltoken.NewPos(1, 25),
+											From:  sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+											To:    sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
 										},
 									},
-									ArgsRParen: sqltoken.NewPos(1, 26),
+									ArgsRParen: sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
 								},
 							},
 							&sqlast.UnnamedSelectItem{
 								Node: &sqlast.Ident{
 									Value: "country",
-									From:  sqltoken.NewPos(1, 28),
-									To:    sqltoken.NewPos(1, 35),
+									From:  sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+									To:    sqltoken.Pos{Line: 1, Col: 35, Offset: 34},
 								},
 							},
 						},
@@ -343,8 +346,8 @@ HAVING COUNT(customer_id) > 3`,
 									Idents: []*sqlast.Ident{
 										{
 											Value: "customers",
-											From:  sqltoken.NewPos(2, 6),
-											To:    sqltoken.NewPos(2, 15),
+											From:  sqltoken.Pos{Line: 2, Col: 6, Offset: 41},
+											To:    sqltoken.Pos{Line: 2, Col: 15, Offset: 50},
 										},
 									},
 								},
@@ -353,39 +356,239 @@ HAVING COUNT(customer_id) > 3`,
 						GroupByClause: []sqlast.Node{
 							&sqlast.Ident{
 								Value: "country",
-								From:  sqltoken.NewPos(3, 10),
-								To:    sqltoken.NewPos(3, 17),
+								From:  sqltoken.Pos{Line: 3, Col: 10, Offset: 61},
+								To:    sqltoken.Pos{Line: 3, Col: 17, Offset: 68},
 							},
 						},
 						HavingClause: &sqlast.BinaryExpr{
 							Op: &sqlast.Operator{
 								Type: sqlast.Gt,
-								From: sqltoken.NewPos(4, 27),
-								To:   sqltoken.NewPos(4, 28),
+								From: sqltoken.Pos{Line: 4, Col: 27, Offset: 96},
+								To:   sqltoken.Pos{Line: 4, Col: 28, Offset: 97},
 							},
 							Left: &sqlast.Function{
 								Name: &sqlast.ObjectName{
 									Idents: []*sqlast.Ident{
 										{
 											Value: "COUNT",
-											From:  sqltoken.NewPos(4, 8),
-											To:    sqltoken.NewPos(4, 13),
+											From:  sqltoken.Pos{Line: 4, Col: 8, Offset: 77},
+											To:    sqltoken.Pos{Line: 4, Col: 13, Offset: 82},
 										},
 									},
 								},
 								Args: []sqlast.Node{
 									&sqlast.Ident{
 										Value: "customer_id",
-										From:  sqltoken.NewPos(4, 14),
-										To:    sqltoken.NewPos(4, 25),
+										From:  sqltoken.Pos{Line: 4, Col: 14, Offset: 83},
+										To:    sqltoken.Pos{Line: 4, Col: 25, Offset: 94},
 									},
 								},
-								ArgsRParen: sqltoken.NewPos(4, 26),
+								ArgsRParen: sqltoken.Pos{Line: 4, Col: 26, Offset: 95},
 							},
 							Right: &sqlast.LongValue{
-								From: sqltoken.NewPos(4, 29),
-								To:   sqltoken.NewPos(4, 30),
+								From: sqltoken.Pos{Line: 4, Col: 29, Offset: 98},
+								To:   sqltoken.Pos{Line: 4, Col: 30, Offset: 99},
 								Long: 3,
+								Text: "3",
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "placeholder",
+				in:   "SELECT a FROM t WHERE a = $1",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Ident{
+									Value: "a",
+									From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:    sqltoken.Pos{Line: 1, Col: 9, Offset: 8},
+								},
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										{
+											Value: "t",
+											From:  sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+											To:    sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+										},
+									},
+								},
+							},
+						},
+						WhereClause: &sqlast.BinaryExpr{
+							Left: &sqlast.Ident{
+								Value: "a",
+								From:  sqltoken.Pos{Line: 1, Col: 23, Offset: 22},
+								To:    sqltoken.Pos{Line: 1, Col: 24, Offset: 23},
+							},
+							Op: &sqlast.Operator{
+								Type: sqlast.Eq,
+								From: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+								To:   sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+							},
+							Right: &sqlast.Placeholder{
+								Style: sqlast.DollarPlaceholder,
+								Index: 1,
+								From:  sqltoken.Pos{Line: 1, Col: 27, Offset: 26},
+								To:    sqltoken.Pos{Line: 1, Col: 29, Offset: 28},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "not and is null",
+				in: `SELECT a FROM t WHERE NOT a IS  NULL AND b IS NOT
+NULL`,
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Ident{
+									Value: "a",
+									From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:    sqltoken.Pos{Line: 1, Col: 9, Offset: 8},
+								},
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										{
+											Value: "t",
+											From:  sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+											To:    sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+										},
+									},
+								},
+							},
+						},
+						WhereClause: &sqlast.BinaryExpr{
+							Left: &sqlast.UnaryExpr{
+								From: sqltoken.Pos{Line: 1, Col: 23, Offset: 22},
+								Op: &sqlast.Operator{
+									Type: sqlast.Not,
+									From: sqltoken.Pos{Line: 1, Col: 23, Offset: 22},
+									To:   sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+								},
+								Expr: &sqlast.IsNull{
+									X: &sqlast.Ident{
+										Value: "a",
+										From:  sqltoken.Pos{Line: 1, Col: 27, Offset: 26},
+										To:    sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+									},
+									To: sqltoken.Pos{Line: 1, Col: 37, Offset: 36},
+								},
+							},
+							Op: &sqlast.Operator{
+								Type: sqlast.And,
+								From: sqltoken.Pos{Line: 1, Col: 38, Offset: 37},
+								To:   sqltoken.Pos{Line: 1, Col: 41, Offset: 40},
+							},
+							Right: &sqlast.IsNotNull{
+								X: &sqlast.Ident{
+									Value: "b",
+									From:  sqltoken.Pos{Line: 1, Col: 42, Offset: 41},
+									To:    sqltoken.Pos{Line: 1, Col: 43, Offset: 42},
+								},
+								To: sqltoken.Pos{Line: 2, Col: 5, Offset: 54},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "is not of",
+				in:   "SELECT a FROM t WHERE a IS NOT OF (int, text)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 15, Offset: 14}, sqltoken.Pos{Line: 1, Col: 16, Offset: 15}),
+									},
+								},
+							},
+						},
+						WhereClause: &sqlast.IsOf{
+							X:       sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 23, Offset: 22}, sqltoken.Pos{Line: 1, Col: 24, Offset: 23}),
+							Negated: true,
+							Types: []sqlast.Type{
+								&sqlast.Int{
+									From: sqltoken.Pos{Line: 1, Col: 36, Offset: 35},
+									To:   sqltoken.Pos{Line: 1, Col: 39, Offset: 38},
+								},
+								&sqlast.Text{
+									From: sqltoken.Pos{Line: 1, Col: 41, Offset: 40},
+									To:   sqltoken.Pos{Line: 1, Col: 45, Offset: 44},
+								},
+							},
+							RParen: sqltoken.Pos{Line: 1, Col: 46, Offset: 45},
+						},
+					},
+				},
+			},
+			{
+				name: "qualify",
+				in:   "SELECT a FROM t QUALIFY a = 1",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Ident{
+									Value: "a",
+									From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:    sqltoken.Pos{Line: 1, Col: 9, Offset: 8},
+								},
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										{
+											Value: "t",
+											From:  sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+											To:    sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+										},
+									},
+								},
+							},
+						},
+						QualifyClause: &sqlast.BinaryExpr{
+							Left: &sqlast.Ident{
+								Value: "a",
+								From:  sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+								To:    sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+							},
+							Op: &sqlast.Operator{
+								Type: sqlast.Eq,
+								From: sqltoken.Pos{Line: 1, Col: 27, Offset: 26},
+								To:   sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+							},
+							Right: &sqlast.LongValue{
+								From: sqltoken.Pos{Line: 1, Col: 29, Offset: 28},
+								To:   sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
+								Long: 1,
+								Text: "1",
 							},
 						},
 					},
@@ -399,39 +602,39 @@ WHERE region IN (SELECT region FROM top_regions)
 ORDER BY product_units LIMIT 100`,
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: &sqlast.Ident{
 									Value: "product",
-									From:  sqltoken.NewPos(1, 8),
-									To:    sqltoken.NewPos(1, 15),
+									From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:    sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
 								},
 							},
 							&sqlast.AliasSelectItem{
 								Alias: &sqlast.Ident{
 									Value: "product_units",
-									From:  sqltoken.NewPos(1, 34),
-									To:    sqltoken.NewPos(1, 47),
+									From:  sqltoken.Pos{Line: 1, Col: 34, Offset: 33},
+									To:    sqltoken.Pos{Line: 1, Col: 47, Offset: 46},
 								},
 								Expr: &sqlast.Function{
 									Name: &sqlast.ObjectName{
 										Idents: []*sqlast.Ident{
 											{
 												Value: "SUM",
-												From:  sqltoken.NewPos(1, 17),
-												To:    sqltoken.NewPos(1, 20),
+												From:  sqltoken.Pos{Line: 1, Col: 17, Offset: 16},
+												To:    sqltoken.Pos{Line: 1, Col: 20, Offset: 19},
 											},
 										},
 									},
 									Args: []sqlast.Node{
 										&sqlast.Ident{
 											Value: "quantity",
-											From:  sqltoken.NewPos(1, 21),
-											To:    sqltoken.NewPos(1, 29),
+											From:  sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
+											To:    sqltoken.Pos{Line: 1, Col: 29, Offset: 28},
 										},
 									},
-									ArgsRParen: sqltoken.NewPos(1, 30),
+									ArgsRParen: sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
 								},
 							},
 						},
@@ -441,8 +644,8 @@ ORDER BY product_units LIMIT 100`,
 									Idents: []*sqlast.Ident{
 										{
 											Value: "orders",
-											From:  sqltoken.NewPos(2, 6),
-											To:    sqltoken.NewPos(2, 12),
+											From:  sqltoken.Pos{Line: 2, Col: 6, Offset: 52},
+											To:    sqltoken.Pos{Line: 2, Col: 12, Offset: 58},
 										},
 									},
 								},
@@ -451,19 +654,19 @@ ORDER BY product_units LIMIT 100`,
 						WhereClause: &sqlast.InSubQuery{
 							Expr: &sqlast.Ident{
 								Value: "region",
-								From:  sqltoken.NewPos(3, 7),
-								To:    sqltoken.NewPos(3, 13),
+								From:  sqltoken.Pos{Line: 3, Col: 7, Offset: 66},
+								To:    sqltoken.Pos{Line: 3, Col: 13, Offset: 72},
 							},
-							RParen: sqltoken.NewPos(3, 49),
+							RParen: sqltoken.Pos{Line: 3, Col: 49, Offset: 108},
 							SubQuery: &sqlast.QueryStmt{
 								Body: &sqlast.SQLSelect{
-									Select: sqltoken.NewPos(3, 18),
+									Select: sqltoken.Pos{Line: 3, Col: 18, Offset: 77},
 									Projection: []sqlast.SQLSelectItem{
 										&sqlast.UnnamedSelectItem{
 											Node: &sqlast.Ident{
 												Value: "region",
-												From:  sqltoken.NewPos(3, 25),
-												To:    sqltoken.NewPos(3, 31),
+												From:  sqltoken.Pos{Line: 3, Col: 25, Offset: 84},
+												To:    sqltoken.Pos{Line: 3, Col: 31, Offset: 90},
 											},
 										},
 									},
@@ -473,8 +676,8 @@ ORDER BY product_units LIMIT 100`,
 												Idents: []*sqlast.Ident{
 													{
 														Value: "top_regions",
-														From:  sqltoken.NewPos(3, 37),
-														To:    sqltoken.NewPos(3, 48),
+														From:  sqltoken.Pos{Line: 3, Col: 37, Offset: 96},
+														To:    sqltoken.Pos{Line: 3, Col: 48, Offset: 107},
 													},
 												},
 											},
@@ -488,20 +691,253 @@ ORDER BY product_units LIMIT 100`,
 						{
 							Expr: &sqlast.Ident{
 								Value: "product_units",
-								From:  sqltoken.NewPos(4, 10),
-								To:    sqltoken.NewPos(4, 23),
+								From:  sqltoken.Pos{Line: 4, Col: 10, Offset: 119},
+								To:    sqltoken.Pos{Line: 4, Col: 23, Offset: 132},
 							},
 						},
 					},
 					Limit: &sqlast.LimitExpr{
 						LimitValue: &sqlast.LongValue{
-							From: sqltoken.NewPos(4, 30),
-							To:   sqltoken.NewPos(4, 33),
+							From: sqltoken.Pos{Line: 4, Col: 30, Offset: 139},
+							To:   sqltoken.Pos{Line: 4, Col: 33, Offset: 142},
 							Long: 100,
 						},
 					},
 				},
 			},
+			{
+				name: "for update",
+				in:   "SELECT a FROM t FOR UPDATE OF t NOWAIT",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 15, Offset: 14}, sqltoken.Pos{Line: 1, Col: 16, Offset: 15}),
+									},
+								},
+							},
+						},
+					},
+					Locking: []*sqlast.LockingClause{
+						{
+							For:      sqltoken.Pos{Line: 1, Col: 17, Offset: 16},
+							Strength: sqlast.UpdateLock,
+							Of: []*sqlast.ObjectName{
+								{
+									Idents: []*sqlast.Ident{
+										sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 31, Offset: 30}, sqltoken.Pos{Line: 1, Col: 32, Offset: 31}),
+									},
+								},
+							},
+							Wait: sqlast.LockNoWait,
+							To:   sqltoken.Pos{Line: 1, Col: 39, Offset: 38},
+						},
+					},
+				},
+			},
+			{
+				name: "values",
+				in:   "VALUES (1)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.ValuesExpr{
+						Values: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Rows: []*sqlast.RowValueExpr{
+							{
+								Values: []sqlast.Node{
+									&sqlast.LongValue{
+										From: sqltoken.Pos{Line: 1, Col: 9, Offset: 8},
+										To:   sqltoken.Pos{Line: 1, Col: 10, Offset: 9},
+										Long: 1,
+										Text: "1",
+									},
+								},
+								LParen: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+								RParen: sqltoken.Pos{Line: 1, Col: 11, Offset: 10},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "numeric literals",
+				in:   "SELECT 1e10, 99999999999999999999",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.DoubleValue{
+									From:   sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:     sqltoken.Pos{Line: 1, Col: 12, Offset: 11},
+									Double: 1e10,
+									Text:   "1e10",
+								},
+							},
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.DoubleValue{
+									From:   sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+									To:     sqltoken.Pos{Line: 1, Col: 34, Offset: 33},
+									Double: 1e20,
+									Text:   "99999999999999999999",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "typed literal",
+				in:   "SELECT DATE '2020-01-01'",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.DateValue{
+									From: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									To:   sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+									Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "extract",
+				in:   "SELECT EXTRACT(YEAR FROM ts)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.ExtractExpr{
+									Extract: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+									Field:   sqlast.NewIdentWithPos("YEAR", sqltoken.Pos{Line: 1, Col: 16, Offset: 15}, sqltoken.Pos{Line: 1, Col: 20, Offset: 19}),
+									Source:  sqlast.NewIdentWithPos("ts", sqltoken.Pos{Line: 1, Col: 26, Offset: 25}, sqltoken.Pos{Line: 1, Col: 28, Offset: 27}),
+									RParen:  sqltoken.Pos{Line: 1, Col: 29, Offset: 28},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "quantified comparison",
+				in:   "SELECT a = ANY (b)",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.QuantifiedComparison{
+									Left: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+									Op: &sqlast.Operator{
+										Type: sqlast.Eq,
+										From: sqltoken.Pos{Line: 1, Col: 10, Offset: 9},
+										To:   sqltoken.Pos{Line: 1, Col: 11, Offset: 10},
+									},
+									Quantifier: sqlast.AnyQuantifier,
+									Operand:    sqlast.NewIdentWithPos("b", sqltoken.Pos{Line: 1, Col: 17, Offset: 16}, sqltoken.Pos{Line: 1, Col: 18, Offset: 17}),
+									RParen:     sqltoken.Pos{Line: 1, Col: 19, Offset: 18},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "array subscript and constructor",
+				in:   "SELECT a[1:2], ARRAY[[1]]",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Subscript{
+									Expr:     sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+									Index:    &sqlast.LongValue{Long: 1, Text: "1", From: sqltoken.Pos{Line: 1, Col: 10, Offset: 9}, To: sqltoken.Pos{Line: 1, Col: 11, Offset: 10}},
+									Slice:    true,
+									Upper:    &sqlast.LongValue{Long: 2, Text: "2", From: sqltoken.Pos{Line: 1, Col: 12, Offset: 11}, To: sqltoken.Pos{Line: 1, Col: 13, Offset: 12}},
+									RBracket: sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+								},
+							},
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.ArrayConstructor{
+									Array:    sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+									LBracket: sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
+									Elements: []sqlast.Node{
+										&sqlast.ArrayConstructor{
+											Array:    sqltoken.Pos{Line: 1, Col: 22, Offset: 21},
+											Bare:     true,
+											LBracket: sqltoken.Pos{Line: 1, Col: 22, Offset: 21},
+											Elements: []sqlast.Node{
+												&sqlast.LongValue{Long: 1, Text: "1", From: sqltoken.Pos{Line: 1, Col: 23, Offset: 22}, To: sqltoken.Pos{Line: 1, Col: 24, Offset: 23}},
+											},
+											RBracket: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+										},
+									},
+									RBracket: sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "array slice with identifier bounds",
+				in:   "SELECT a[lo:hi] FROM t WHERE b = :b",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.SQLSelect{
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Projection: []sqlast.SQLSelectItem{
+							&sqlast.UnnamedSelectItem{
+								Node: &sqlast.Subscript{
+									Expr:     sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+									Index:    sqlast.NewIdentWithPos("lo", sqltoken.Pos{Line: 1, Col: 10, Offset: 9}, sqltoken.Pos{Line: 1, Col: 12, Offset: 11}),
+									Slice:    true,
+									Upper:    sqlast.NewIdentWithPos("hi", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 15, Offset: 14}),
+									RBracket: sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+								},
+							},
+						},
+						FromClause: []sqlast.TableReference{
+							&sqlast.Table{
+								Name: &sqlast.ObjectName{
+									Idents: []*sqlast.Ident{sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 22, Offset: 21}, sqltoken.Pos{Line: 1, Col: 23, Offset: 22})},
+								},
+							},
+						},
+						WhereClause: &sqlast.BinaryExpr{
+							Left:  sqlast.NewIdentWithPos("b", sqltoken.Pos{Line: 1, Col: 30, Offset: 29}, sqltoken.Pos{Line: 1, Col: 31, Offset: 30}),
+							Op:    &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.Pos{Line: 1, Col: 32, Offset: 31}, To: sqltoken.Pos{Line: 1, Col: 33, Offset: 32}},
+							Right: &sqlast.Placeholder{From: sqltoken.Pos{Line: 1, Col: 34, Offset: 33}, To: sqltoken.Pos{Line: 1, Col: 36, Offset: 35}, Style: sqlast.ColonPlaceholder, Name: "b"},
+						},
+					},
+				},
+			},
+			{
+				name: "table",
+				in:   "TABLE films",
+				out: &sqlast.QueryStmt{
+					Body: &sqlast.TableExpr{
+						Table: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+						Name: &sqlast.ObjectName{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("films", sqltoken.Pos{Line: 1, Col: 7, Offset: 6}, sqltoken.Pos{Line: 1, Col: 12, Offset: 11}),
+							},
+						},
+					},
+				},
+			},
 			{
 				// from https://www.postgresql.jp/document/9.3/html/queries-with.html
 				name: "with cte",
@@ -511,48 +947,49 @@ FROM orders
 WHERE region IN (SELECT region FROM top_regions)
 GROUP BY region, product`,
 				out: &sqlast.QueryStmt{
+					With: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					CTEs: []*sqlast.CTE{
 						{
 							Alias: &sqlast.Ident{
 								Value: "regional_sales",
-								From:  sqltoken.NewPos(1, 6),
-								To:    sqltoken.NewPos(1, 20),
+								From:  sqltoken.Pos{Line: 1, Col: 6, Offset: 5},
+								To:    sqltoken.Pos{Line: 1, Col: 20, Offset: 19},
 							},
 							Query: &sqlast.QueryStmt{
 								Body: &sqlast.SQLSelect{
-									Select: sqltoken.NewPos(1, 25),
+									Select: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
 									Projection: []sqlast.SQLSelectItem{
 										&sqlast.UnnamedSelectItem{
 											Node: &sqlast.Ident{
 												Value: "region",
-												From:  sqltoken.NewPos(1, 32),
-												To:    sqltoken.NewPos(1, 38),
+												From:  sqltoken.Pos{Line: 1, Col: 32, Offset: 31},
+												To:    sqltoken.Pos{Line: 1, Col: 38, Offset: 37},
 											},
 										},
 										&sqlast.AliasSelectItem{
 											Alias: &sqlast.Ident{
 												Value: "total_sales",
-												From:  sqltoken.NewPos(1, 55),
-												To:    sqltoken.NewPos(1, 66),
+												From:  sqltoken.Pos{Line: 1, Col: 55, Offset: 54},
+												To:    sqltoken.Pos{Line: 1, Col: 66, Offset: 65},
 											},
 											Expr: &sqlast.Function{
 												Name: &sqlast.ObjectName{
 													Idents: []*sqlast.Ident{
 														{
 															Value: "SUM",
-															From:  sqltoken.NewPos(1, 40),
-															To:    sqltoken.NewPos(1, 43),
+															From:  sqltoken.Pos{Line: 1, Col: 40, Offset: 39},
+															To:    sqltoken.Pos{Line: 1, Col: 43, Offset: 42},
 														},
 													},
 												},
 												Args: []sqlast.Node{
 													&sqlast.Ident{
 														Value: "amount",
-														From:  sqltoken.NewPos(1, 44),
-														To:    sqltoken.NewPos(1, 50),
+														From:  sqltoken.Pos{Line: 1, Col: 44, Offset: 43},
+														To:    sqltoken.Pos{Line: 1, Col: 50, Offset: 49},
 													},
 												},
-												ArgsRParen: sqltoken.NewPos(1, 51),
+												ArgsRParen: sqltoken.Pos{Line: 1, Col: 51, Offset: 50},
 											},
 										},
 									},
@@ -562,8 +999,8 @@ GROUP BY region, product`,
 												Idents: []*sqlast.Ident{
 													{
 														Value: "orders",
-														From:  sqltoken.NewPos(1, 72),
-														To:    sqltoken.NewPos(1, 78),
+														From:  sqltoken.Pos{Line: 1, Col: 72, Offset: 71},
+														To:    sqltoken.Pos{Line: 1, Col: 78, Offset: 77},
 													},
 												},
 											},
@@ -572,46 +1009,47 @@ GROUP BY region, product`,
 									GroupByClause: []sqlast.Node{
 										&sqlast.Ident{
 											Value: "region",
-											From:  sqltoken.NewPos(1, 88),
-											To:    sqltoken.NewPos(1, 94),
+											From:  sqltoken.Pos{Line: 1, Col: 88, Offset: 87},
+											To:    sqltoken.Pos{Line: 1, Col: 94, Offset: 93},
 										},
 									},
 								},
 							},
+							RParen: sqltoken.Pos{Line: 1, Col: 95, Offset: 94},
 						},
 					},
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(2, 1),
+						Select: sqltoken.Pos{Line: 2, Col: 1, Offset: 95},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{Node: &sqlast.Ident{
 								Value: "product",
-								From:  sqltoken.NewPos(2, 8),
-								To:    sqltoken.NewPos(2, 15),
+								From:  sqltoken.Pos{Line: 2, Col: 8, Offset: 102},
+								To:    sqltoken.Pos{Line: 2, Col: 15, Offset: 109},
 							}},
 							&sqlast.AliasSelectItem{
 								Alias: &sqlast.Ident{
 									Value: "product_units",
-									From:  sqltoken.NewPos(2, 34),
-									To:    sqltoken.NewPos(2, 47),
+									From:  sqltoken.Pos{Line: 2, Col: 34, Offset: 128},
+									To:    sqltoken.Pos{Line: 2, Col: 47, Offset: 141},
 								},
 								Expr: &sqlast.Function{
 									Name: &sqlast.ObjectName{
 										Idents: []*sqlast.Ident{
 											{
 												Value: "SUM",
-												From:  sqltoken.NewPos(2, 17),
-												To:    sqltoken.NewPos(2, 20),
+												From:  sqltoken.Pos{Line: 2, Col: 17, Offset: 111},
+												To:    sqltoken.Pos{Line: 2, Col: 20, Offset: 114},
 											},
 										},
 									},
 									Args: []sqlast.Node{
 										&sqlast.Ident{
 											Value: "quantity",
-											From:  sqltoken.NewPos(2, 21),
-											To:    sqltoken.NewPos(2, 29),
+											From:  sqltoken.Pos{Line: 2, Col: 21, Offset: 115},
+											To:    sqltoken.Pos{Line: 2, Col: 29, Offset: 123},
 										},
 									},
-									ArgsRParen: sqltoken.NewPos(2, 30),
+									ArgsRParen: sqltoken.Pos{Line: 2, Col: 30, Offset: 124},
 								},
 							},
 						},
@@ -621,29 +1059,29 @@ GROUP BY region, product`,
 									Idents: []*sqlast.Ident{
 										{
 											Value: "orders",
-											From:  sqltoken.NewPos(3, 6),
-											To:    sqltoken.NewPos(3, 12),
+											From:  sqltoken.Pos{Line: 3, Col: 6, Offset: 147},
+											To:    sqltoken.Pos{Line: 3, Col: 12, Offset: 153},
 										},
 									},
 								},
 							},
 						},
 						WhereClause: &sqlast.InSubQuery{
-							RParen: sqltoken.NewPos(4, 49),
+							RParen: sqltoken.Pos{Line: 4, Col: 49, Offset: 202},
 							Expr: &sqlast.Ident{
 								Value: "region",
-								From:  sqltoken.NewPos(4, 7),
-								To:    sqltoken.NewPos(4, 13),
+								From:  sqltoken.Pos{Line: 4, Col: 7, Offset: 160},
+								To:    sqltoken.Pos{Line: 4, Col: 13, Offset: 166},
 							},
 							SubQuery: &sqlast.QueryStmt{
 								Body: &sqlast.SQLSelect{
-									Select: sqltoken.NewPos(4, 18),
+									Select: sqltoken.Pos{Line: 4, Col: 18, Offset: 171},
 									Projection: []sqlast.SQLSelectItem{
 										&sqlast.UnnamedSelectItem{
 											Node: &sqlast.Ident{
 												Value: "region",
-												From:  sqltoken.NewPos(4, 25),
-												To:    sqltoken.NewPos(4, 31),
+												From:  sqltoken.Pos{Line: 4, Col: 25, Offset: 178},
+												To:    sqltoken.Pos{Line: 4, Col: 31, Offset: 184},
 											},
 										},
 									},
@@ -653,8 +1091,8 @@ GROUP BY region, product`,
 												Idents: []*sqlast.Ident{
 													{
 														Value: "top_regions",
-														From:  sqltoken.NewPos(4, 37),
-														To:    sqltoken.NewPos(4, 48),
+														From:  sqltoken.Pos{Line: 4, Col: 37, Offset: 190},
+														To:    sqltoken.Pos{Line: 4, Col: 48, Offset: 201},
 													},
 												},
 											},
@@ -666,13 +1104,13 @@ GROUP BY region, product`,
 						GroupByClause: []sqlast.Node{
 							&sqlast.Ident{
 								Value: "region",
-								From:  sqltoken.NewPos(5, 10),
-								To:    sqltoken.NewPos(5, 16),
+								From:  sqltoken.Pos{Line: 5, Col: 10, Offset: 212},
+								To:    sqltoken.Pos{Line: 5, Col: 16, Offset: 218},
 							},
 							&sqlast.Ident{
 								Value: "product",
-								From:  sqltoken.NewPos(5, 18),
-								To:    sqltoken.NewPos(5, 25),
+								From:  sqltoken.Pos{Line: 5, Col: 18, Offset: 220},
+								To:    sqltoken.Pos{Line: 5, Col: 25, Offset: 227},
 							},
 						},
 					},
@@ -686,11 +1124,11 @@ FROM user_sub
 WHERE user.id = user_sub.id AND user_sub.job = 'job');`,
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.UnnamedSelectItem{
 								Node: &sqlast.Wildcard{
-									Wildcard: sqltoken.NewPos(1, 8),
+									Wildcard: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
 								},
 							},
 						},
@@ -700,8 +1138,8 @@ WHERE user.id = user_sub.id AND user_sub.job = 'job');`,
 									Idents: []*sqlast.Ident{
 										{
 											Value: "user",
-											From:  sqltoken.NewPos(1, 15),
-											To:    sqltoken.NewPos(1, 19),
+											From:  sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+											To:    sqltoken.Pos{Line: 1, Col: 19, Offset: 18},
 										},
 									},
 								},
@@ -709,16 +1147,16 @@ WHERE user.id = user_sub.id AND user_sub.job = 'job');`,
 						},
 						WhereClause: &sqlast.Exists{
 							Negated: true,
-							Exists:  sqltoken.NewPos(1, 30),
-							Not:     sqltoken.NewPos(1, 26),
-							RParen:  sqltoken.NewPos(4, 54),
+							Exists:  sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
+							Not:     sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+							RParen:  sqltoken.Pos{Line: 4, Col: 54, Offset: 116},
 							Query: &sqlast.QueryStmt{
 								Body: &sqlast.SQLSelect{
-									Select: sqltoken.NewPos(2, 2),
+									Select: sqltoken.Pos{Line: 2, Col: 2, Offset: 38},
 									Projection: []sqlast.SQLSelectItem{
 										&sqlast.UnnamedSelectItem{
 											Node: &sqlast.Wildcard{
-												Wildcard: sqltoken.NewPos(2, 9),
+												Wildcard: sqltoken.Pos{Line: 2, Col: 9, Offset: 45},
 											},
 										},
 									},
@@ -728,28 +1166,28 @@ WHERE user.id = user_sub.id AND user_sub.job = 'job');`,
 												Idents: []*sqlast.Ident{
 													{
 														Value: "user_sub",
-														From:  sqltoken.NewPos(3, 6),
-														To:    sqltoken.NewPos(3, 14),
+														From:  sqltoken.Pos{Line: 3, Col: 6, Offset: 53},
+														To:    sqltoken.Pos{Line: 3, Col: 14, Offset: 61},
 													},
 												},
 											},
 										},
 									},
 									WhereClause: &sqlast.BinaryExpr{
-										Op: &sqlast.Operator{Type: sqlast.And, From: sqltoken.NewPos(4, 29), To: sqltoken.NewPos(4, 32)},
+										Op: &sqlast.Operator{Type: sqlast.And, From: sqltoken.Pos{Line: 4, Col: 29, Offset: 91}, To: sqltoken.Pos{Line: 4, Col: 32, Offset: 94}},
 										Left: &sqlast.BinaryExpr{
-											Op: &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(4, 15), To: sqltoken.NewPos(4, 16)},
+											Op: &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.Pos{Line: 4, Col: 15, Offset: 77}, To: sqltoken.Pos{Line: 4, Col: 16, Offset: 78}},
 											Left: &sqlast.CompoundIdent{
 												Idents: []*sqlast.Ident{
 													{
 														Value: "user",
-														From:  sqltoken.NewPos(4, 7),
-														To:    sqltoken.NewPos(4, 11),
+														From:  sqltoken.Pos{Line: 4, Col: 7, Offset: 69},
+														To:    sqltoken.Pos{Line: 4, Col: 11, Offset: 73},
 													},
 													{
 														Value: "id",
-														From:  sqltoken.NewPos(4, 12),
-														To:    sqltoken.NewPos(4, 14),
+														From:  sqltoken.Pos{Line: 4, Col: 12, Offset: 74},
+														To:    sqltoken.Pos{Line: 4, Col: 14, Offset: 76},
 													},
 												},
 											},
@@ -757,36 +1195,36 @@ WHERE user.id = user_sub.id AND user_sub.job = 'job');`,
 												Idents: []*sqlast.Ident{
 													{
 														Value: "user_sub",
-														From:  sqltoken.NewPos(4, 17),
-														To:    sqltoken.NewPos(4, 25),
+														From:  sqltoken.Pos{Line: 4, Col: 17, Offset: 79},
+														To:    sqltoken.Pos{Line: 4, Col: 25, Offset: 87},
 													},
 													{
 														Value: "id",
-														From:  sqltoken.NewPos(4, 26),
-														To:    sqltoken.NewPos(4, 28),
+														From:  sqltoken.Pos{Line: 4, Col: 26, Offset: 88},
+														To:    sqltoken.Pos{Line: 4, Col: 28, Offset: 90},
 													},
 												},
 											},
 										},
 										Right: &sqlast.BinaryExpr{
-											Op: &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(4, 46), To: sqltoken.NewPos(4, 47)},
+											Op: &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.Pos{Line: 4, Col: 46, Offset: 108}, To: sqltoken.Pos{Line: 4, Col: 47, Offset: 109}},
 											Left: &sqlast.CompoundIdent{
 												Idents: []*sqlast.Ident{
 													{
 														Value: "user_sub",
-														From:  sqltoken.NewPos(4, 33),
-														To:    sqltoken.NewPos(4, 41),
+														From:  sqltoken.Pos{Line: 4, Col: 33, Offset: 95},
+														To:    sqltoken.Pos{Line: 4, Col: 41, Offset: 103},
 													},
 													{
 														Value: "job",
-														From:  sqltoken.NewPos(4, 42),
-														To:    sqltoken.NewPos(4, 45),
+														From:  sqltoken.Pos{Line: 4, Col: 42, Offset: 104},
+														To:    sqltoken.Pos{Line: 4, Col: 45, Offset: 107},
 													},
 												},
 											},
 											Right: &sqlast.SingleQuotedString{
-												From:   sqltoken.NewPos(4, 48),
-												To:     sqltoken.NewPos(4, 53),
+												From:   sqltoken.Pos{Line: 4, Col: 48, Offset: 110},
+												To:     sqltoken.Pos{Line: 4, Col: 53, Offset: 115},
 												String: "job",
 											},
 										},
@@ -808,70 +1246,70 @@ END AS alias
 FROM user WHERE id BETWEEN 1 AND 2`,
 				out: &sqlast.QueryStmt{
 					Body: &sqlast.SQLSelect{
-						Select: sqltoken.NewPos(1, 1),
+						Select: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 						Projection: []sqlast.SQLSelectItem{
 							&sqlast.AliasSelectItem{
 								Expr: &sqlast.CaseExpr{
-									Case:    sqltoken.NewPos(2, 1),
-									CaseEnd: sqltoken.NewPos(6, 4),
+									Case:    sqltoken.Pos{Line: 2, Col: 1, Offset: 8},
+									CaseEnd: sqltoken.Pos{Line: 6, Col: 4, Offset: 95},
 									Conditions: []sqlast.Node{
 										&sqlast.BinaryExpr{
 											Op: &sqlast.Operator{
 												Type: sqlast.Eq,
-												From: sqltoken.NewPos(3, 13),
-												To:   sqltoken.NewPos(3, 14),
+												From: sqltoken.Pos{Line: 3, Col: 13, Offset: 25},
+												To:   sqltoken.Pos{Line: 3, Col: 14, Offset: 26},
 											},
 											Left: &sqlast.Ident{
 												Value: "expr1",
-												From:  sqltoken.NewPos(3, 7),
-												To:    sqltoken.NewPos(3, 12),
+												From:  sqltoken.Pos{Line: 3, Col: 7, Offset: 19},
+												To:    sqltoken.Pos{Line: 3, Col: 12, Offset: 24},
 											},
 											Right: &sqlast.SingleQuotedString{
-												From:   sqltoken.NewPos(3, 15),
-												To:     sqltoken.NewPos(3, 18),
+												From:   sqltoken.Pos{Line: 3, Col: 15, Offset: 27},
+												To:     sqltoken.Pos{Line: 3, Col: 18, Offset: 30},
 												String: "1",
 											},
 										},
 										&sqlast.BinaryExpr{
 											Op: &sqlast.Operator{
 												Type: sqlast.Eq,
-												From: sqltoken.NewPos(4, 13),
-												To:   sqltoken.NewPos(4, 14),
+												From: sqltoken.Pos{Line: 4, Col: 13, Offset: 57},
+												To:   sqltoken.Pos{Line: 4, Col: 14, Offset: 58},
 											},
 											Left: &sqlast.Ident{
 												Value: "expr2",
-												From:  sqltoken.NewPos(4, 7),
-												To:    sqltoken.NewPos(4, 12),
+												From:  sqltoken.Pos{Line: 4, Col: 7, Offset: 51},
+												To:    sqltoken.Pos{Line: 4, Col: 12, Offset: 56},
 											},
 											Right: &sqlast.SingleQuotedString{
-												From:   sqltoken.NewPos(4, 15),
-												To:     sqltoken.NewPos(4, 18),
+												From:   sqltoken.Pos{Line: 4, Col: 15, Offset: 59},
+												To:     sqltoken.Pos{Line: 4, Col: 18, Offset: 62},
 												String: "2",
 											},
 										},
 									},
 									Results: []sqlast.Node{
 										&sqlast.SingleQuotedString{
-											From:   sqltoken.NewPos(3, 24),
-											To:     sqltoken.NewPos(3, 31),
+											From:   sqltoken.Pos{Line: 3, Col: 24, Offset: 36},
+											To:     sqltoken.Pos{Line: 3, Col: 31, Offset: 43},
 											String: "test1",
 										},
 										&sqlast.SingleQuotedString{
-											From:   sqltoken.NewPos(4, 24),
-											To:     sqltoken.NewPos(4, 31),
+											From:   sqltoken.Pos{Line: 4, Col: 24, Offset: 68},
+											To:     sqltoken.Pos{Line: 4, Col: 31, Offset: 75},
 											String: "test2",
 										},
 									},
 									ElseResult: &sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(5, 7),
-										To:     sqltoken.NewPos(5, 14),
+										From:   sqltoken.Pos{Line: 5, Col: 7, Offset: 83},
+										To:     sqltoken.Pos{Line: 5, Col: 14, Offset: 90},
 										String: "other",
 									},
 								},
 								Alias: &sqlast.Ident{
 									Value: "alias",
-									From:  sqltoken.NewPos(6, 8),
-									To:    sqltoken.NewPos(6, 13),
+									From:  sqltoken.Pos{Line: 6, Col: 8, Offset: 99},
+									To:    sqltoken.Pos{Line: 6, Col: 13, Offset: 104},
 								},
 							},
 						},
@@ -881,8 +1319,8 @@ FROM user WHERE id BETWEEN 1 AND 2`,
 									Idents: []*sqlast.Ident{
 										{
 											Value: "user",
-											From:  sqltoken.NewPos(7, 6),
-											To:    sqltoken.NewPos(7, 10),
+											From:  sqltoken.Pos{Line: 7, Col: 6, Offset: 110},
+											To:    sqltoken.Pos{Line: 7, Col: 10, Offset: 114},
 										},
 									},
 								},
@@ -891,18 +1329,20 @@ FROM user WHERE id BETWEEN 1 AND 2`,
 						WhereClause: &sqlast.Between{
 							Expr: &sqlast.Ident{
 								Value: "id",
-								From:  sqltoken.NewPos(7, 17),
-								To:    sqltoken.NewPos(7, 19),
+								From:  sqltoken.Pos{Line: 7, Col: 17, Offset: 121},
+								To:    sqltoken.Pos{Line: 7, Col: 19, Offset: 123},
 							},
 							High: &sqlast.LongValue{
 								Long: int64(2),
-								From: sqltoken.NewPos(7, 34),
-								To:   sqltoken.NewPos(7, 35),
+								Text: "2",
+								From: sqltoken.Pos{Line: 7, Col: 34, Offset: 138},
+								To:   sqltoken.Pos{Line: 7, Col: 35, Offset: 139},
 							},
 							Low: &sqlast.LongValue{
 								Long: int64(1),
-								From: sqltoken.NewPos(7, 28),
-								To:   sqltoken.NewPos(7, 29),
+								Text: "1",
+								From: sqltoken.Pos{Line: 7, Col: 28, Offset: 132},
+								To:   sqltoken.Pos{Line: 7, Col: 29, Offset: 133},
 							},
 						},
 					},
@@ -949,13 +1389,14 @@ CREATE TABLE persons (
  created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 )`,
 				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(2, 1),
+					Create: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
+					Table:  sqltoken.Pos{Line: 2, Col: 8, Offset: 8},
 					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "persons",
-								From:  sqltoken.NewPos(2, 14),
-								To:    sqltoken.NewPos(2, 21),
+								From:  sqltoken.Pos{Line: 2, Col: 14, Offset: 14},
+								To:    sqltoken.Pos{Line: 2, Col: 21, Offset: 21},
 							},
 						},
 					},
@@ -963,25 +1404,25 @@ CREATE TABLE persons (
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "person_id",
-								From:  sqltoken.NewPos(3, 2),
-								To:    sqltoken.NewPos(3, 11),
+								From:  sqltoken.Pos{Line: 3, Col: 2, Offset: 25},
+								To:    sqltoken.Pos{Line: 3, Col: 11, Offset: 34},
 							},
 							DataType: &sqlast.UUID{
-								From: sqltoken.NewPos(3, 12),
-								To:   sqltoken.NewPos(3, 16),
+								From: sqltoken.Pos{Line: 3, Col: 12, Offset: 35},
+								To:   sqltoken.Pos{Line: 3, Col: 16, Offset: 39},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.UniqueColumnSpec{
 										IsPrimaryKey: true,
-										Primary:      sqltoken.NewPos(3, 17),
-										Key:          sqltoken.NewPos(3, 28),
+										Primary:      sqltoken.Pos{Line: 3, Col: 17, Offset: 40},
+										Key:          sqltoken.Pos{Line: 3, Col: 28, Offset: 51},
 									},
 								},
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(3, 29),
-										Null: sqltoken.NewPos(3, 37),
+										Not:  sqltoken.Pos{Line: 3, Col: 29, Offset: 52},
+										Null: sqltoken.Pos{Line: 3, Col: 37, Offset: 60},
 									},
 								},
 							},
@@ -989,18 +1430,18 @@ CREATE TABLE persons (
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "first_name",
-								From:  sqltoken.NewPos(4, 2),
-								To:    sqltoken.NewPos(4, 12),
+								From:  sqltoken.Pos{Line: 4, Col: 2, Offset: 63},
+								To:    sqltoken.Pos{Line: 4, Col: 12, Offset: 73},
 							},
 							DataType: &sqlast.VarcharType{
 								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(4, 13),
-								RParen:    sqltoken.NewPos(4, 25),
+								Character: sqltoken.Pos{Line: 4, Col: 13, Offset: 74},
+								RParen:    sqltoken.Pos{Line: 4, Col: 25, Offset: 86},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.UniqueColumnSpec{
-										Unique: sqltoken.NewPos(4, 26),
+										Unique: sqltoken.Pos{Line: 4, Col: 26, Offset: 87},
 									},
 								},
 							},
@@ -1008,20 +1449,20 @@ CREATE TABLE persons (
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "last_name",
-								From:  sqltoken.NewPos(5, 2),
-								To:    sqltoken.NewPos(5, 11),
+								From:  sqltoken.Pos{Line: 5, Col: 2, Offset: 96},
+								To:    sqltoken.Pos{Line: 5, Col: 11, Offset: 105},
 							},
 							DataType: &sqlast.VarcharType{
 								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(5, 12),
-								Varying:   sqltoken.NewPos(5, 29),
-								RParen:    sqltoken.NewPos(5, 34),
+								Character: sqltoken.Pos{Line: 5, Col: 12, Offset: 106},
+								Varying:   sqltoken.Pos{Line: 5, Col: 29, Offset: 123},
+								RParen:    sqltoken.Pos{Line: 5, Col: 34, Offset: 128},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(5, 35),
-										Null: sqltoken.NewPos(5, 43),
+										Not:  sqltoken.Pos{Line: 5, Col: 35, Offset: 129},
+										Null: sqltoken.Pos{Line: 5, Col: 43, Offset: 137},
 									},
 								},
 							},
@@ -1029,22 +1470,22 @@ CREATE TABLE persons (
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "created_at",
-								From:  sqltoken.NewPos(6, 2),
-								To:    sqltoken.NewPos(6, 12),
+								From:  sqltoken.Pos{Line: 6, Col: 2, Offset: 140},
+								To:    sqltoken.Pos{Line: 6, Col: 12, Offset: 150},
 							},
 							DataType: &sqlast.Timestamp{
-								Timestamp: sqltoken.NewPos(6, 13),
+								Timestamp: sqltoken.Pos{Line: 6, Col: 13, Offset: 151},
 							},
 							Default: &sqlast.Ident{
 								Value: "CURRENT_TIMESTAMP",
-								From:  sqltoken.NewPos(6, 31),
-								To:    sqltoken.NewPos(6, 48),
+								From:  sqltoken.Pos{Line: 6, Col: 31, Offset: 169},
+								To:    sqltoken.Pos{Line: 6, Col: 48, Offset: 186},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(6, 49),
-										Null: sqltoken.NewPos(6, 57),
+										Not:  sqltoken.Pos{Line: 6, Col: 49, Offset: 187},
+										Null: sqltoken.Pos{Line: 6, Col: 57, Offset: 195},
 									},
 								},
 							},
@@ -1063,13 +1504,14 @@ age int NOT NULL CHECK(age > 0 AND age < 100),
 created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 )`,
 				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(1, 1),
+					Create: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Table:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
 					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "persons",
-								From:  sqltoken.NewPos(1, 14),
-								To:    sqltoken.NewPos(1, 21),
+								From:  sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+								To:    sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
 							},
 						},
 					},
@@ -1077,25 +1519,25 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "person_id",
-								From:  sqltoken.NewPos(2, 1),
-								To:    sqltoken.NewPos(2, 10),
+								From:  sqltoken.Pos{Line: 2, Col: 1, Offset: 23},
+								To:    sqltoken.Pos{Line: 2, Col: 10, Offset: 32},
 							},
 							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(2, 11),
-								To:   sqltoken.NewPos(2, 14),
+								From: sqltoken.Pos{Line: 2, Col: 11, Offset: 33},
+								To:   sqltoken.Pos{Line: 2, Col: 14, Offset: 36},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.UniqueColumnSpec{
 										IsPrimaryKey: true,
-										Primary:      sqltoken.NewPos(2, 15),
-										Key:          sqltoken.NewPos(2, 26),
+										Primary:      sqltoken.Pos{Line: 2, Col: 15, Offset: 37},
+										Key:          sqltoken.Pos{Line: 2, Col: 26, Offset: 48},
 									},
 								},
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(2, 27),
-										Null: sqltoken.NewPos(2, 35),
+										Not:  sqltoken.Pos{Line: 2, Col: 27, Offset: 49},
+										Null: sqltoken.Pos{Line: 2, Col: 35, Offset: 57},
 									},
 								},
 							},
@@ -1103,20 +1545,20 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "last_name",
-								From:  sqltoken.NewPos(3, 1),
-								To:    sqltoken.NewPos(3, 10),
+								From:  sqltoken.Pos{Line: 3, Col: 1, Offset: 59},
+								To:    sqltoken.Pos{Line: 3, Col: 10, Offset: 68},
 							},
 							DataType: &sqlast.VarcharType{
 								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(3, 11),
-								Varying:   sqltoken.NewPos(3, 28),
-								RParen:    sqltoken.NewPos(3, 33),
+								Character: sqltoken.Pos{Line: 3, Col: 11, Offset: 69},
+								Varying:   sqltoken.Pos{Line: 3, Col: 28, Offset: 86},
+								RParen:    sqltoken.Pos{Line: 3, Col: 33, Offset: 91},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(3, 34),
-										Null: sqltoken.NewPos(3, 42),
+										Not:  sqltoken.Pos{Line: 3, Col: 34, Offset: 92},
+										Null: sqltoken.Pos{Line: 3, Col: 42, Offset: 100},
 									},
 								},
 							},
@@ -1124,38 +1566,38 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "test_id",
-								From:  sqltoken.NewPos(4, 1),
-								To:    sqltoken.NewPos(4, 8),
+								From:  sqltoken.Pos{Line: 4, Col: 1, Offset: 102},
+								To:    sqltoken.Pos{Line: 4, Col: 8, Offset: 109},
 							},
 							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(4, 9),
-								To:   sqltoken.NewPos(4, 12),
+								From: sqltoken.Pos{Line: 4, Col: 9, Offset: 110},
+								To:   sqltoken.Pos{Line: 4, Col: 12, Offset: 113},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(4, 13),
-										Null: sqltoken.NewPos(4, 21),
+										Not:  sqltoken.Pos{Line: 4, Col: 13, Offset: 114},
+										Null: sqltoken.Pos{Line: 4, Col: 21, Offset: 122},
 									},
 								},
 								{
 									Spec: &sqlast.ReferencesColumnSpec{
-										References: sqltoken.NewPos(4, 22),
-										RParen:     sqltoken.NewPos(4, 42),
+										References: sqltoken.Pos{Line: 4, Col: 22, Offset: 123},
+										RParen:     sqltoken.Pos{Line: 4, Col: 42, Offset: 143},
 										TableName: &sqlast.ObjectName{
 											Idents: []*sqlast.Ident{
 												{
 													Value: "test",
-													From:  sqltoken.NewPos(4, 33),
-													To:    sqltoken.NewPos(4, 37),
+													From:  sqltoken.Pos{Line: 4, Col: 33, Offset: 134},
+													To:    sqltoken.Pos{Line: 4, Col: 37, Offset: 138},
 												},
 											},
 										},
 										Columns: []*sqlast.Ident{
 											&sqlast.Ident{
 												Value: "id1",
-												From:  sqltoken.NewPos(4, 38),
-												To:    sqltoken.NewPos(4, 41),
+												From:  sqltoken.Pos{Line: 4, Col: 38, Offset: 139},
+												To:    sqltoken.Pos{Line: 4, Col: 41, Offset: 142},
 											},
 										},
 									},
@@ -1165,25 +1607,25 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "email",
-								From:  sqltoken.NewPos(5, 1),
-								To:    sqltoken.NewPos(5, 6),
+								From:  sqltoken.Pos{Line: 5, Col: 1, Offset: 145},
+								To:    sqltoken.Pos{Line: 5, Col: 6, Offset: 150},
 							},
 							DataType: &sqlast.VarcharType{
 								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(5, 7),
-								Varying:   sqltoken.NewPos(5, 24),
-								RParen:    sqltoken.NewPos(5, 29),
+								Character: sqltoken.Pos{Line: 5, Col: 7, Offset: 151},
+								Varying:   sqltoken.Pos{Line: 5, Col: 24, Offset: 168},
+								RParen:    sqltoken.Pos{Line: 5, Col: 29, Offset: 173},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.UniqueColumnSpec{
-										Unique: sqltoken.NewPos(5, 30),
+										Unique: sqltoken.Pos{Line: 5, Col: 30, Offset: 174},
 									},
 								},
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(5, 37),
-										Null: sqltoken.NewPos(5, 45),
+										Not:  sqltoken.Pos{Line: 5, Col: 37, Offset: 181},
+										Null: sqltoken.Pos{Line: 5, Col: 45, Offset: 189},
 									},
 								},
 							},
@@ -1191,62 +1633,64 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "age",
-								From:  sqltoken.NewPos(6, 1),
-								To:    sqltoken.NewPos(6, 4),
+								From:  sqltoken.Pos{Line: 6, Col: 1, Offset: 191},
+								To:    sqltoken.Pos{Line: 6, Col: 4, Offset: 194},
 							},
 							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(6, 5),
-								To:   sqltoken.NewPos(6, 8),
+								From: sqltoken.Pos{Line: 6, Col: 5, Offset: 195},
+								To:   sqltoken.Pos{Line: 6, Col: 8, Offset: 198},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(6, 9),
-										Null: sqltoken.NewPos(6, 17),
+										Not:  sqltoken.Pos{Line: 6, Col: 9, Offset: 199},
+										Null: sqltoken.Pos{Line: 6, Col: 17, Offset: 207},
 									},
 								},
 								{
 									Spec: &sqlast.CheckColumnSpec{
-										Check:  sqltoken.NewPos(6, 18),
-										RParen: sqltoken.NewPos(6, 46),
+										Check:  sqltoken.Pos{Line: 6, Col: 18, Offset: 208},
+										RParen: sqltoken.Pos{Line: 6, Col: 46, Offset: 236},
 										Expr: &sqlast.BinaryExpr{
 											Op: &sqlast.Operator{
 												Type: sqlast.And,
-												From: sqltoken.NewPos(6, 32),
-												To:   sqltoken.NewPos(6, 35),
+												From: sqltoken.Pos{Line: 6, Col: 32, Offset: 222},
+												To:   sqltoken.Pos{Line: 6, Col: 35, Offset: 225},
 											},
 											Left: &sqlast.BinaryExpr{
 												Op: &sqlast.Operator{
 													Type: sqlast.Gt,
-													From: sqltoken.NewPos(6, 28),
-													To:   sqltoken.NewPos(6, 29),
+													From: sqltoken.Pos{Line: 6, Col: 28, Offset: 218},
+													To:   sqltoken.Pos{Line: 6, Col: 29, Offset: 219},
 												},
 												Left: &sqlast.Ident{
 													Value: "age",
-													From:  sqltoken.NewPos(6, 24),
-													To:    sqltoken.NewPos(6, 27),
+													From:  sqltoken.Pos{Line: 6, Col: 24, Offset: 214},
+													To:    sqltoken.Pos{Line: 6, Col: 27, Offset: 217},
 												},
 												Right: &sqlast.LongValue{
-													From: sqltoken.NewPos(6, 30),
-													To:   sqltoken.NewPos(6, 31),
+													From: sqltoken.Pos{Line: 6, Col: 30, Offset: 220},
+													To:   sqltoken.Pos{Line: 6, Col: 31, Offset: 221},
 													Long: 0,
+													Text: "0",
 												},
 											},
 											Right: &sqlast.BinaryExpr{
 												Op: &sqlast.Operator{
 													Type: sqlast.Lt,
-													From: sqltoken.NewPos(6, 40),
-													To:   sqltoken.NewPos(6, 41),
+													From: sqltoken.Pos{Line: 6, Col: 40, Offset: 230},
+													To:   sqltoken.Pos{Line: 6, Col: 41, Offset: 231},
 												},
 												Left: &sqlast.Ident{
 													Value: "age",
-													From:  sqltoken.NewPos(6, 36),
-													To:    sqltoken.NewPos(6, 39),
+													From:  sqltoken.Pos{Line: 6, Col: 36, Offset: 226},
+													To:    sqltoken.Pos{Line: 6, Col: 39, Offset: 229},
 												},
 												Right: &sqlast.LongValue{
-													From: sqltoken.NewPos(6, 42),
-													To:   sqltoken.NewPos(6, 45),
+													From: sqltoken.Pos{Line: 6, Col: 42, Offset: 232},
+													To:   sqltoken.Pos{Line: 6, Col: 45, Offset: 235},
 													Long: 100,
+													Text: "100",
 												},
 											},
 										},
@@ -1257,22 +1701,22 @@ created_at timestamp DEFAULT CURRENT_TIMESTAMP NOT NULL
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "created_at",
-								From:  sqltoken.NewPos(7, 1),
-								To:    sqltoken.NewPos(7, 11),
+								From:  sqltoken.Pos{Line: 7, Col: 1, Offset: 238},
+								To:    sqltoken.Pos{Line: 7, Col: 11, Offset: 248},
 							},
 							DataType: &sqlast.Timestamp{
-								Timestamp: sqltoken.NewPos(7, 12),
+								Timestamp: sqltoken.Pos{Line: 7, Col: 12, Offset: 249},
 							},
 							Default: &sqlast.Ident{
 								Value: "CURRENT_TIMESTAMP",
-								From:  sqltoken.NewPos(7, 30),
-								To:    sqltoken.NewPos(7, 47),
+								From:  sqltoken.Pos{Line: 7, Col: 30, Offset: 267},
+								To:    sqltoken.Pos{Line: 7, Col: 47, Offset: 284},
 							},
 							Constraints: []*sqlast.ColumnConstraint{
 								{
 									Spec: &sqlast.NotNullColumnSpec{
-										Not:  sqltoken.NewPos(7, 48),
-										Null: sqltoken.NewPos(7, 56),
+										Not:  sqltoken.Pos{Line: 7, Col: 48, Offset: 285},
+										Null: sqltoken.Pos{Line: 7, Col: 56, Offset: 293},
 									},
 								},
 							},
@@ -1290,13 +1734,14 @@ CHECK(id > 100),
 FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 )`,
 				out: &sqlast.CreateTableStmt{
-					Create: sqltoken.NewPos(1, 1),
+					Create: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Table:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
 					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "persons",
-								From:  sqltoken.NewPos(1, 14),
-								To:    sqltoken.NewPos(1, 21),
+								From:  sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+								To:    sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
 							},
 						},
 					},
@@ -1304,93 +1749,94 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 						&sqlast.ColumnDef{
 							Name: &sqlast.Ident{
 								Value: "person_id",
-								From:  sqltoken.NewPos(2, 1),
-								To:    sqltoken.NewPos(2, 10),
+								From:  sqltoken.Pos{Line: 2, Col: 1, Offset: 23},
+								To:    sqltoken.Pos{Line: 2, Col: 10, Offset: 32},
 							},
 							DataType: &sqlast.Int{
-								From: sqltoken.NewPos(2, 11),
-								To:   sqltoken.NewPos(2, 14),
+								From: sqltoken.Pos{Line: 2, Col: 11, Offset: 33},
+								To:   sqltoken.Pos{Line: 2, Col: 14, Offset: 36},
 							},
 						},
 						&sqlast.TableConstraint{
-							Constraint: sqltoken.NewPos(3, 1),
+							Constraint: sqltoken.Pos{Line: 3, Col: 1, Offset: 38},
 							Name: &sqlast.Ident{
 								Value: "production",
-								From:  sqltoken.NewPos(3, 12),
-								To:    sqltoken.NewPos(3, 22),
+								From:  sqltoken.Pos{Line: 3, Col: 12, Offset: 49},
+								To:    sqltoken.Pos{Line: 3, Col: 22, Offset: 59},
 							},
 							Spec: &sqlast.UniqueTableConstraint{
-								Unique: sqltoken.NewPos(3, 23),
-								RParen: sqltoken.NewPos(3, 42),
+								Unique: sqltoken.Pos{Line: 3, Col: 23, Offset: 60},
+								RParen: sqltoken.Pos{Line: 3, Col: 42, Offset: 79},
 								Columns: []*sqlast.Ident{&sqlast.Ident{
 									Value: "test_column",
-									From:  sqltoken.NewPos(3, 30),
-									To:    sqltoken.NewPos(3, 41),
+									From:  sqltoken.Pos{Line: 3, Col: 30, Offset: 67},
+									To:    sqltoken.Pos{Line: 3, Col: 41, Offset: 78},
 								}},
 							},
 						},
 						&sqlast.TableConstraint{
 							Spec: &sqlast.UniqueTableConstraint{
-								Primary: sqltoken.NewPos(4, 1),
-								RParen:  sqltoken.NewPos(4, 23),
+								Primary: sqltoken.Pos{Line: 4, Col: 1, Offset: 81},
+								RParen:  sqltoken.Pos{Line: 4, Col: 23, Offset: 103},
 								Columns: []*sqlast.Ident{&sqlast.Ident{
 									Value: "person_id",
-									From:  sqltoken.NewPos(4, 13),
-									To:    sqltoken.NewPos(4, 22),
+									From:  sqltoken.Pos{Line: 4, Col: 13, Offset: 93},
+									To:    sqltoken.Pos{Line: 4, Col: 22, Offset: 102},
 								}},
 								IsPrimary: true,
 							},
 						},
 						&sqlast.TableConstraint{
 							Spec: &sqlast.CheckTableConstraint{
-								Check:  sqltoken.NewPos(5, 1),
-								RParen: sqltoken.NewPos(5, 16),
+								Check:  sqltoken.Pos{Line: 5, Col: 1, Offset: 105},
+								RParen: sqltoken.Pos{Line: 5, Col: 16, Offset: 120},
 								Expr: &sqlast.BinaryExpr{
 									Left: &sqlast.Ident{
 										Value: "id",
-										From:  sqltoken.NewPos(5, 7),
-										To:    sqltoken.NewPos(5, 9),
+										From:  sqltoken.Pos{Line: 5, Col: 7, Offset: 111},
+										To:    sqltoken.Pos{Line: 5, Col: 9, Offset: 113},
 									},
 									Op: &sqlast.Operator{
 										Type: sqlast.Gt,
-										From: sqltoken.NewPos(5, 10),
-										To:   sqltoken.NewPos(5, 11),
+										From: sqltoken.Pos{Line: 5, Col: 10, Offset: 114},
+										To:   sqltoken.Pos{Line: 5, Col: 11, Offset: 115},
 									},
 									Right: &sqlast.LongValue{
-										From: sqltoken.NewPos(5, 12),
-										To:   sqltoken.NewPos(5, 15),
+										From: sqltoken.Pos{Line: 5, Col: 12, Offset: 116},
+										To:   sqltoken.Pos{Line: 5, Col: 15, Offset: 119},
 										Long: 100,
+										Text: "100",
 									},
 								},
 							},
 						},
 						&sqlast.TableConstraint{
 							Spec: &sqlast.ReferentialTableConstraint{
-								Foreign: sqltoken.NewPos(6, 1),
+								Foreign: sqltoken.Pos{Line: 6, Col: 1, Offset: 122},
 								Columns: []*sqlast.Ident{&sqlast.Ident{
 									Value: "test_id",
-									From:  sqltoken.NewPos(6, 13),
-									To:    sqltoken.NewPos(6, 20),
+									From:  sqltoken.Pos{Line: 6, Col: 13, Offset: 134},
+									To:    sqltoken.Pos{Line: 6, Col: 20, Offset: 141},
 								}},
 								KeyExpr: &sqlast.ReferenceKeyExpr{
 									TableName: &sqlast.Ident{
 										Value: "other_table",
-										From:  sqltoken.NewPos(6, 33),
-										To:    sqltoken.NewPos(6, 44),
+										From:  sqltoken.Pos{Line: 6, Col: 33, Offset: 154},
+										To:    sqltoken.Pos{Line: 6, Col: 44, Offset: 165},
 									},
 									Columns: []*sqlast.Ident{
 										&sqlast.Ident{
 											Value: "col1",
-											From:  sqltoken.NewPos(6, 45),
-											To:    sqltoken.NewPos(6, 49),
+											From:  sqltoken.Pos{Line: 6, Col: 45, Offset: 166},
+											To:    sqltoken.Pos{Line: 6, Col: 49, Offset: 170},
 										},
 										&sqlast.Ident{
 											Value: "col2",
-											From:  sqltoken.NewPos(6, 51),
-											To:    sqltoken.NewPos(6, 55),
+											From:  sqltoken.Pos{Line: 6, Col: 51, Offset: 172},
+											To:    sqltoken.Pos{Line: 6, Col: 55, Offset: 176},
 										},
 									},
-									RParen: sqltoken.NewPos(6, 56),
+									RParen: sqltoken.Pos{Line: 6, Col: 56, Offset: 177},
 								},
 							},
 						},
@@ -1401,22 +1847,23 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 				name: "create view",
 				in:   "CREATE VIEW comedies AS SELECT * FROM films WHERE kind = 'Comedy'",
 				out: &sqlast.CreateViewStmt{
-					Create: sqltoken.NewPos(1, 1),
+					Create: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					View:   sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
 					Name: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "comedies",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 21),
+								From:  sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
+								To:    sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
 							},
 						},
 					},
 					Query: &sqlast.QueryStmt{
 						Body: &sqlast.SQLSelect{
-							Select: sqltoken.NewPos(1, 25),
+							Select: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
 							Projection: []sqlast.SQLSelectItem{
 								&sqlast.UnnamedSelectItem{Node: &sqlast.Wildcard{
-									Wildcard: sqltoken.NewPos(1, 32),
+									Wildcard: sqltoken.Pos{Line: 1, Col: 32, Offset: 31},
 								}}},
 							FromClause: []sqlast.TableReference{
 								&sqlast.Table{
@@ -1424,8 +1871,8 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 										Idents: []*sqlast.Ident{
 											{
 												Value: "films",
-												From:  sqltoken.NewPos(1, 39),
-												To:    sqltoken.NewPos(1, 44),
+												From:  sqltoken.Pos{Line: 1, Col: 39, Offset: 38},
+												To:    sqltoken.Pos{Line: 1, Col: 44, Offset: 43},
 											},
 										},
 									},
@@ -1434,13 +1881,13 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 							WhereClause: &sqlast.BinaryExpr{
 								Op: &sqlast.Operator{
 									Type: sqlast.Eq,
-									From: sqltoken.NewPos(1, 56),
-									To:   sqltoken.NewPos(1, 57),
+									From: sqltoken.Pos{Line: 1, Col: 56, Offset: 55},
+									To:   sqltoken.Pos{Line: 1, Col: 57, Offset: 56},
 								},
-								Left: sqlast.NewIdentWithPos("kind", sqltoken.NewPos(1, 51), sqltoken.NewPos(1, 55)),
+								Left: sqlast.NewIdentWithPos("kind", sqltoken.Pos{Line: 1, Col: 51, Offset: 50}, sqltoken.Pos{Line: 1, Col: 55, Offset: 54}),
 								Right: &sqlast.SingleQuotedString{
-									From:   sqltoken.NewPos(1, 58),
-									To:     sqltoken.NewPos(1, 66),
+									From:   sqltoken.Pos{Line: 1, Col: 58, Offset: 57},
+									To:     sqltoken.Pos{Line: 1, Col: 66, Offset: 65},
 									String: "Comedy",
 								},
 							},
@@ -1448,19 +1895,98 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 					},
 				},
 			},
-		}
-
-		for _, c := range cases {
-
-			t.Run(c.name, func(t *testing.T) {
-				if c.skip {
-					t.Skip()
-				}
-				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
-				if err != nil {
-					t.Fatal(err)
-				}
-				ast, err := parser.ParseStatement()
+			{
+				name: "create materialized view positions",
+				in:   "CREATE MATERIALIZED VIEW v AS SELECT a FROM t",
+				out: &sqlast.CreateViewStmt{
+					Create:          sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					View:            sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
+					Materialized:    true,
+					MaterializedPos: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("v", sqltoken.Pos{Line: 1, Col: 26, Offset: 25}, sqltoken.Pos{Line: 1, Col: 27, Offset: 26}),
+						},
+					},
+					Query: &sqlast.QueryStmt{
+						Body: &sqlast.SQLSelect{
+							Select: sqltoken.Pos{Line: 1, Col: 31, Offset: 30},
+							Projection: []sqlast.SQLSelectItem{
+								&sqlast.UnnamedSelectItem{
+									Node: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 38, Offset: 37}, sqltoken.Pos{Line: 1, Col: 39, Offset: 38}),
+								},
+							},
+							FromClause: []sqlast.TableReference{
+								&sqlast.Table{
+									Name: &sqlast.ObjectName{
+										Idents: []*sqlast.Ident{
+											sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 45, Offset: 44}, sqltoken.Pos{Line: 1, Col: 46, Offset: 45}),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "create unique index positions",
+				in:   "CREATE UNIQUE INDEX idx ON t (a)",
+				out: &sqlast.CreateIndexStmt{
+					Create:    sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					IsUnique:  true,
+					Unique:    sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					Index:     sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+					IndexName: sqlast.NewIdentWithPos("idx", sqltoken.Pos{Line: 1, Col: 21, Offset: 20}, sqltoken.Pos{Line: 1, Col: 24, Offset: 23}),
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 28, Offset: 27}, sqltoken.Pos{Line: 1, Col: 29, Offset: 28}),
+						},
+					},
+					Columns: []*sqlast.IndexElement{
+						{Expr: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 31, Offset: 30}, sqltoken.Pos{Line: 1, Col: 32, Offset: 31})},
+					},
+					RParen: sqltoken.Pos{Line: 1, Col: 33, Offset: 32},
+				},
+			},
+			{
+				name: "create table if not exists positions",
+				in:   "CREATE TABLE IF NOT EXISTS t (a int)",
+				out: &sqlast.CreateTableStmt{
+					Create:        sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Table:         sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					NotExists:     true,
+					NotExistsFrom: sqltoken.Pos{Line: 1, Col: 14, Offset: 13},
+					NotExistsTo:   sqltoken.Pos{Line: 1, Col: 27, Offset: 26},
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 28, Offset: 27}, sqltoken.Pos{Line: 1, Col: 29, Offset: 28}),
+						},
+					},
+					Elements: []sqlast.TableElement{
+						&sqlast.ColumnDef{
+							Name: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 31, Offset: 30}, sqltoken.Pos{Line: 1, Col: 32, Offset: 31}),
+							DataType: &sqlast.Int{
+								From: sqltoken.Pos{Line: 1, Col: 33, Offset: 32},
+								To:   sqltoken.Pos{Line: 1, Col: 36, Offset: 35},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		for _, c := range cases {
+
+			t.Run(c.name, func(t *testing.T) {
+				if c.skip {
+					t.Skip()
+				}
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
 				if err != nil {
 					t.Fatalf("%+v", err)
 				}
@@ -1483,27 +2009,28 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 				in:   "DELETE FROM customers WHERE customer_id = 1",
 				name: "simple case",
 				out: &sqlast.DeleteStmt{
-					Delete: sqltoken.NewPos(1, 1),
+					Delete: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "customers",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 22),
+								From:  sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
+								To:    sqltoken.Pos{Line: 1, Col: 22, Offset: 21},
 							},
 						},
 					},
 					Selection: &sqlast.BinaryExpr{
 						Op: &sqlast.Operator{
 							Type: sqlast.Eq,
-							From: sqltoken.NewPos(1, 41),
-							To:   sqltoken.NewPos(1, 42),
+							From: sqltoken.Pos{Line: 1, Col: 41, Offset: 40},
+							To:   sqltoken.Pos{Line: 1, Col: 42, Offset: 41},
 						},
-						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 29), sqltoken.NewPos(1, 40)),
+						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.Pos{Line: 1, Col: 29, Offset: 28}, sqltoken.Pos{Line: 1, Col: 40, Offset: 39}),
 						Right: &sqlast.LongValue{
-							From: sqltoken.NewPos(1, 43),
-							To:   sqltoken.NewPos(1, 44),
+							From: sqltoken.Pos{Line: 1, Col: 43, Offset: 42},
+							To:   sqltoken.Pos{Line: 1, Col: 44, Offset: 43},
 							Long: 1,
+							Text: "1",
 						},
 					},
 				},
@@ -1543,30 +2070,30 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 				in:   "INSERT INTO customers (customer_name, contract_name) VALUES('Cardinal', 'Tom B. Erichsen')",
 				name: "simple case",
 				out: &sqlast.InsertStmt{
-					Insert: sqltoken.NewPos(1, 1),
+					Insert: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 22)),
+							sqlast.NewIdentWithPos("customers", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 22, Offset: 21}),
 						},
 					},
 					Columns: []*sqlast.Ident{
-						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
-						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
+						sqlast.NewIdentWithPos("customer_name", sqltoken.Pos{Line: 1, Col: 24, Offset: 23}, sqltoken.Pos{Line: 1, Col: 37, Offset: 36}),
+						sqlast.NewIdentWithPos("contract_name", sqltoken.Pos{Line: 1, Col: 39, Offset: 38}, sqltoken.Pos{Line: 1, Col: 52, Offset: 51}),
 					},
 					Source: &sqlast.ConstructorSource{
 						Rows: []*sqlast.RowValueExpr{
 							{
-								LParen: sqltoken.NewPos(1, 60),
-								RParen: sqltoken.NewPos(1, 91),
+								LParen: sqltoken.Pos{Line: 1, Col: 60, Offset: 59},
+								RParen: sqltoken.Pos{Line: 1, Col: 91, Offset: 90},
 								Values: []sqlast.Node{
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(1, 61),
-										To:     sqltoken.NewPos(1, 71),
+										From:   sqltoken.Pos{Line: 1, Col: 61, Offset: 60},
+										To:     sqltoken.Pos{Line: 1, Col: 71, Offset: 70},
 										String: "Cardinal",
 									},
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(1, 73),
-										To:     sqltoken.NewPos(1, 90),
+										From:   sqltoken.Pos{Line: 1, Col: 73, Offset: 72},
+										To:     sqltoken.Pos{Line: 1, Col: 90, Offset: 89},
 										String: "Tom B. Erichsen",
 									},
 								},
@@ -1575,56 +2102,111 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 					},
 				},
 			},
+			{
+				name: "quoted and reserved column names",
+				in:   `INSERT INTO t ("order", "a""b", group) VALUES (1, 2, 3)`,
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 14, Offset: 13}),
+						},
+					},
+					Columns: []*sqlast.Ident{
+						{
+							Value:      `"order"`,
+							QuoteStyle: '"',
+							From:       sqltoken.Pos{Line: 1, Col: 16, Offset: 15},
+							To:         sqltoken.Pos{Line: 1, Col: 23, Offset: 22},
+						},
+						{
+							Value:      `"a""b"`,
+							QuoteStyle: '"',
+							From:       sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+							To:         sqltoken.Pos{Line: 1, Col: 31, Offset: 30},
+						},
+						sqlast.NewIdentWithPos("group", sqltoken.Pos{Line: 1, Col: 33, Offset: 32}, sqltoken.Pos{Line: 1, Col: 38, Offset: 37}),
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.Pos{Line: 1, Col: 47, Offset: 46},
+								RParen: sqltoken.Pos{Line: 1, Col: 56, Offset: 55},
+								Values: []sqlast.Node{
+									&sqlast.LongValue{
+										From: sqltoken.Pos{Line: 1, Col: 48, Offset: 47},
+										To:   sqltoken.Pos{Line: 1, Col: 49, Offset: 48},
+										Long: 1,
+										Text: "1",
+									},
+									&sqlast.LongValue{
+										From: sqltoken.Pos{Line: 1, Col: 51, Offset: 50},
+										To:   sqltoken.Pos{Line: 1, Col: 52, Offset: 51},
+										Long: 2,
+										Text: "2",
+									},
+									&sqlast.LongValue{
+										From: sqltoken.Pos{Line: 1, Col: 54, Offset: 53},
+										To:   sqltoken.Pos{Line: 1, Col: 55, Offset: 54},
+										Long: 3,
+										Text: "3",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			{
 				name: "multi record case",
 				in: `INSERT INTO customers (customer_name, contract_name) VALUES
 ('Cardinal', 'Tom B. Erichsen'),
 ('Cardinal', 'Tom B. Erichsen')`,
 				out: &sqlast.InsertStmt{
-					Insert: sqltoken.NewPos(1, 1),
+					Insert: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "customers",
-								From:  sqltoken.NewPos(1, 13),
-								To:    sqltoken.NewPos(1, 22),
+								From:  sqltoken.Pos{Line: 1, Col: 13, Offset: 12},
+								To:    sqltoken.Pos{Line: 1, Col: 22, Offset: 21},
 							},
 						},
 					},
 					Columns: []*sqlast.Ident{
-						sqlast.NewIdentWithPos("customer_name", sqltoken.NewPos(1, 24), sqltoken.NewPos(1, 37)),
-						sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 39), sqltoken.NewPos(1, 52)),
+						sqlast.NewIdentWithPos("customer_name", sqltoken.Pos{Line: 1, Col: 24, Offset: 23}, sqltoken.Pos{Line: 1, Col: 37, Offset: 36}),
+						sqlast.NewIdentWithPos("contract_name", sqltoken.Pos{Line: 1, Col: 39, Offset: 38}, sqltoken.Pos{Line: 1, Col: 52, Offset: 51}),
 					},
 					Source: &sqlast.ConstructorSource{
 						Rows: []*sqlast.RowValueExpr{
 							{
-								LParen: sqltoken.NewPos(2, 1),
-								RParen: sqltoken.NewPos(2, 32),
+								LParen: sqltoken.Pos{Line: 2, Col: 1, Offset: 60},
+								RParen: sqltoken.Pos{Line: 2, Col: 32, Offset: 91},
 								Values: []sqlast.Node{
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(2, 2),
-										To:     sqltoken.NewPos(2, 12),
+										From:   sqltoken.Pos{Line: 2, Col: 2, Offset: 61},
+										To:     sqltoken.Pos{Line: 2, Col: 12, Offset: 71},
 										String: "Cardinal",
 									},
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(2, 14),
-										To:     sqltoken.NewPos(2, 31),
+										From:   sqltoken.Pos{Line: 2, Col: 14, Offset: 73},
+										To:     sqltoken.Pos{Line: 2, Col: 31, Offset: 90},
 										String: "Tom B. Erichsen",
 									},
 								},
 							},
 							{
-								LParen: sqltoken.NewPos(3, 1),
-								RParen: sqltoken.NewPos(3, 32),
+								LParen: sqltoken.Pos{Line: 3, Col: 1, Offset: 93},
+								RParen: sqltoken.Pos{Line: 3, Col: 32, Offset: 124},
 								Values: []sqlast.Node{
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(3, 2),
-										To:     sqltoken.NewPos(3, 12),
+										From:   sqltoken.Pos{Line: 3, Col: 2, Offset: 94},
+										To:     sqltoken.Pos{Line: 3, Col: 12, Offset: 104},
 										String: "Cardinal",
 									},
 									&sqlast.SingleQuotedString{
-										From:   sqltoken.NewPos(3, 14),
-										To:     sqltoken.NewPos(3, 31),
+										From:   sqltoken.Pos{Line: 3, Col: 14, Offset: 106},
+										To:     sqltoken.Pos{Line: 3, Col: 31, Offset: 123},
 										String: "Tom B. Erichsen",
 									},
 								},
@@ -1633,6 +2215,46 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 					},
 				},
 			},
+			{
+				name: "on conflict do nothing",
+				in:   "INSERT INTO t (a) VALUES (1) ON CONFLICT (a) DO NOTHING",
+				out: &sqlast.InsertStmt{
+					Insert: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 14, Offset: 13}),
+						},
+					},
+					Columns: []*sqlast.Ident{
+						sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 16, Offset: 15}, sqltoken.Pos{Line: 1, Col: 17, Offset: 16}),
+					},
+					Source: &sqlast.ConstructorSource{
+						Rows: []*sqlast.RowValueExpr{
+							{
+								LParen: sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+								RParen: sqltoken.Pos{Line: 1, Col: 29, Offset: 28},
+								Values: []sqlast.Node{
+									&sqlast.LongValue{
+										From: sqltoken.Pos{Line: 1, Col: 27, Offset: 26},
+										To:   sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+										Long: 1,
+										Text: "1",
+									},
+								},
+							},
+						},
+					},
+					OnConflict: &sqlast.OnConflict{
+						On: sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
+						Columns: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 43, Offset: 42}, sqltoken.Pos{Line: 1, Col: 44, Offset: 43}),
+						},
+						RParen:    sqltoken.Pos{Line: 1, Col: 45, Offset: 44},
+						DoNothing: true,
+						Nothing:   sqltoken.Pos{Line: 1, Col: 56, Offset: 55},
+					},
+				},
+			},
 		}
 
 		for _, c := range cases {
@@ -1670,21 +2292,23 @@ FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)
 ALTER TABLE customers
 ADD COLUMN email character varying(255)`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(2, 1),
+					Alter: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("customers", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 22)),
+							sqlast.NewIdentWithPos("customers", sqltoken.Pos{Line: 2, Col: 13, Offset: 13}, sqltoken.Pos{Line: 2, Col: 22, Offset: 22}),
 						},
 					},
-					Action: &sqlast.AddColumnTableAction{
-						Add: sqltoken.NewPos(3, 1),
-						Column: &sqlast.ColumnDef{
-							Name: sqlast.NewIdentWithPos("email", sqltoken.NewPos(3, 12), sqltoken.NewPos(3, 17)),
-							DataType: &sqlast.VarcharType{
-								Size:      sqlast.NewSize(255),
-								Character: sqltoken.NewPos(3, 18),
-								Varying:   sqltoken.NewPos(3, 35),
-								RParen:    sqltoken.NewPos(3, 40),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.AddColumnTableAction{
+							Add: sqltoken.Pos{Line: 3, Col: 1, Offset: 23},
+							Column: &sqlast.ColumnDef{
+								Name: sqlast.NewIdentWithPos("email", sqltoken.Pos{Line: 3, Col: 12, Offset: 34}, sqltoken.Pos{Line: 3, Col: 17, Offset: 39}),
+								DataType: &sqlast.VarcharType{
+									Size:      sqlast.NewSize(255),
+									Character: sqltoken.Pos{Line: 3, Col: 18, Offset: 40},
+									Varying:   sqltoken.Pos{Line: 3, Col: 35, Offset: 57},
+									RParen:    sqltoken.Pos{Line: 3, Col: 40, Offset: 62},
+								},
 							},
 						},
 					},
@@ -1696,27 +2320,29 @@ ADD COLUMN email character varying(255)`,
 ALTER TABLE products
 ADD FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(2, 1),
+					Alter: sqltoken.Pos{Line: 2, Col: 1, Offset: 1},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 21)),
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 2, Col: 13, Offset: 13}, sqltoken.Pos{Line: 2, Col: 21, Offset: 21}),
 						},
 					},
-					Action: &sqlast.AddConstraintTableAction{
-						Add: sqltoken.NewPos(3, 1),
-						Constraint: &sqlast.TableConstraint{
-							Spec: &sqlast.ReferentialTableConstraint{
-								Foreign: sqltoken.NewPos(3, 5),
-								Columns: []*sqlast.Ident{
-									sqlast.NewIdentWithPos("test_id", sqltoken.NewPos(3, 17), sqltoken.NewPos(3, 24)),
-								},
-								KeyExpr: &sqlast.ReferenceKeyExpr{
-									TableName: sqlast.NewIdentWithPos("other_table", sqltoken.NewPos(3, 37), sqltoken.NewPos(3, 48)),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.AddConstraintTableAction{
+							Add: sqltoken.Pos{Line: 3, Col: 1, Offset: 22},
+							Constraint: &sqlast.TableConstraint{
+								Spec: &sqlast.ReferentialTableConstraint{
+									Foreign: sqltoken.Pos{Line: 3, Col: 5, Offset: 26},
 									Columns: []*sqlast.Ident{
-										sqlast.NewIdentWithPos("col1", sqltoken.NewPos(3, 49), sqltoken.NewPos(3, 53)),
-										sqlast.NewIdentWithPos("col2", sqltoken.NewPos(3, 55), sqltoken.NewPos(3, 59)),
+										sqlast.NewIdentWithPos("test_id", sqltoken.Pos{Line: 3, Col: 17, Offset: 38}, sqltoken.Pos{Line: 3, Col: 24, Offset: 45}),
+									},
+									KeyExpr: &sqlast.ReferenceKeyExpr{
+										TableName: sqlast.NewIdentWithPos("other_table", sqltoken.Pos{Line: 3, Col: 37, Offset: 58}, sqltoken.Pos{Line: 3, Col: 48, Offset: 69}),
+										Columns: []*sqlast.Ident{
+											sqlast.NewIdentWithPos("col1", sqltoken.Pos{Line: 3, Col: 49, Offset: 70}, sqltoken.Pos{Line: 3, Col: 53, Offset: 74}),
+											sqlast.NewIdentWithPos("col2", sqltoken.Pos{Line: 3, Col: 55, Offset: 76}, sqltoken.Pos{Line: 3, Col: 59, Offset: 80}),
+										},
+										RParen: sqltoken.Pos{Line: 3, Col: 60, Offset: 81},
 									},
-									RParen: sqltoken.NewPos(3, 60),
 								},
 							},
 						},
@@ -1728,17 +2354,19 @@ ADD FOREIGN KEY(test_id) REFERENCES other_table(col1, col2)`,
 				in: `ALTER TABLE products
 DROP CONSTRAINT fk CASCADE`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
+					Alter: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 21, Offset: 20}),
 						},
 					},
-					Action: &sqlast.DropConstraintTableAction{
-						Drop:       sqltoken.NewPos(2, 1),
-						Name:       sqlast.NewIdentWithPos("fk", sqltoken.NewPos(2, 17), sqltoken.NewPos(2, 19)),
-						Cascade:    true,
-						CascadePos: sqltoken.NewPos(2, 27),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.DropConstraintTableAction{
+							Drop:       sqltoken.Pos{Line: 2, Col: 1, Offset: 21},
+							Name:       sqlast.NewIdentWithPos("fk", sqltoken.Pos{Line: 2, Col: 17, Offset: 37}, sqltoken.Pos{Line: 2, Col: 19, Offset: 39}),
+							Cascade:    true,
+							CascadePos: sqltoken.Pos{Line: 2, Col: 27, Offset: 47},
+						},
 					},
 				},
 			},
@@ -1747,17 +2375,19 @@ DROP CONSTRAINT fk CASCADE`,
 				in: `ALTER TABLE products
 DROP COLUMN description CASCADE`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
+					Alter: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 21, Offset: 20}),
 						},
 					},
-					Action: &sqlast.RemoveColumnTableAction{
-						Drop:       sqltoken.NewPos(2, 1),
-						Name:       sqlast.NewIdentWithPos("description", sqltoken.NewPos(2, 13), sqltoken.NewPos(2, 24)),
-						Cascade:    true,
-						CascadePos: sqltoken.NewPos(2, 32),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.RemoveColumnTableAction{
+							Drop:       sqltoken.Pos{Line: 2, Col: 1, Offset: 21},
+							Name:       sqlast.NewIdentWithPos("description", sqltoken.Pos{Line: 2, Col: 13, Offset: 33}, sqltoken.Pos{Line: 2, Col: 24, Offset: 44}),
+							Cascade:    true,
+							CascadePos: sqltoken.Pos{Line: 2, Col: 32, Offset: 52},
+						},
 					},
 				},
 			},
@@ -1766,18 +2396,20 @@ DROP COLUMN description CASCADE`,
 				in: `ALTER TABLE products
 ALTER COLUMN created_at SET DEFAULT current_timestamp`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
+					Alter: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 21, Offset: 20}),
 						},
 					},
-					Action: &sqlast.AlterColumnTableAction{
-						Alter:      sqltoken.NewPos(2, 1),
-						ColumnName: sqlast.NewIdentWithPos("created_at", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 24)),
-						Action: &sqlast.SetDefaultColumnAction{
-							Set:     sqltoken.NewPos(2, 25),
-							Default: sqlast.NewIdentWithPos("current_timestamp", sqltoken.NewPos(2, 37), sqltoken.NewPos(2, 54)),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.AlterColumnTableAction{
+							Alter:      sqltoken.Pos{Line: 2, Col: 1, Offset: 21},
+							ColumnName: sqlast.NewIdentWithPos("created_at", sqltoken.Pos{Line: 2, Col: 14, Offset: 34}, sqltoken.Pos{Line: 2, Col: 24, Offset: 44}),
+							Action: &sqlast.SetDefaultColumnAction{
+								Set:     sqltoken.Pos{Line: 2, Col: 25, Offset: 45},
+								Default: sqlast.NewIdentWithPos("current_timestamp", sqltoken.Pos{Line: 2, Col: 37, Offset: 57}, sqltoken.Pos{Line: 2, Col: 54, Offset: 74}),
+							},
 						},
 					},
 				},
@@ -1787,22 +2419,57 @@ ALTER COLUMN created_at SET DEFAULT current_timestamp`,
 				in: `ALTER TABLE products
 ALTER COLUMN number TYPE numeric(255,10)`,
 				out: &sqlast.AlterTableStmt{
-					Alter: sqltoken.NewPos(1, 1),
+					Alter: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 21, Offset: 20}),
+						},
+					},
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.AlterColumnTableAction{
+							Alter:      sqltoken.Pos{Line: 2, Col: 1, Offset: 21},
+							ColumnName: sqlast.NewIdentWithPos("number", sqltoken.Pos{Line: 2, Col: 14, Offset: 34}, sqltoken.Pos{Line: 2, Col: 20, Offset: 40}),
+							Action: &sqlast.PGAlterDataTypeColumnAction{
+								Type: sqltoken.Pos{Line: 2, Col: 21, Offset: 41},
+								DataType: &sqlast.Decimal{
+									Scale:     sqlast.NewSize(10),
+									Precision: sqlast.NewSize(255),
+									Numeric:   sqltoken.Pos{Line: 2, Col: 26, Offset: 46},
+									RParen:    sqltoken.Pos{Line: 2, Col: 41, Offset: 61},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				name: "pg change type using",
+				in: `ALTER TABLE products
+ALTER COLUMN price TYPE int USING price::int`,
+				out: &sqlast.AlterTableStmt{
+					Alter: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
-							sqlast.NewIdentWithPos("products", sqltoken.NewPos(1, 13), sqltoken.NewPos(1, 21)),
+							sqlast.NewIdentWithPos("products", sqltoken.Pos{Line: 1, Col: 13, Offset: 12}, sqltoken.Pos{Line: 1, Col: 21, Offset: 20}),
 						},
 					},
-					Action: &sqlast.AlterColumnTableAction{
-						Alter:      sqltoken.NewPos(2, 1),
-						ColumnName: sqlast.NewIdentWithPos("number", sqltoken.NewPos(2, 14), sqltoken.NewPos(2, 20)),
-						Action: &sqlast.PGAlterDataTypeColumnAction{
-							Type: sqltoken.NewPos(2, 21),
-							DataType: &sqlast.Decimal{
-								Scale:     sqlast.NewSize(10),
-								Precision: sqlast.NewSize(255),
-								Numeric:   sqltoken.NewPos(2, 26),
-								RParen:    sqltoken.NewPos(2, 41),
+					Actions: []sqlast.AlterTableAction{
+						&sqlast.AlterColumnTableAction{
+							Alter:      sqltoken.Pos{Line: 2, Col: 1, Offset: 21},
+							ColumnName: sqlast.NewIdentWithPos("price", sqltoken.Pos{Line: 2, Col: 14, Offset: 34}, sqltoken.Pos{Line: 2, Col: 19, Offset: 39}),
+							Action: &sqlast.PGAlterDataTypeColumnAction{
+								Type: sqltoken.Pos{Line: 2, Col: 20, Offset: 40},
+								DataType: &sqlast.Int{
+									From: sqltoken.Pos{Line: 2, Col: 25, Offset: 45},
+									To:   sqltoken.Pos{Line: 2, Col: 28, Offset: 48},
+								},
+								Using: &sqlast.Cast{
+									Expr: sqlast.NewIdentWithPos("price", sqltoken.Pos{Line: 2, Col: 35, Offset: 55}, sqltoken.Pos{Line: 2, Col: 40, Offset: 60}),
+									DataType: &sqlast.Int{
+										From: sqltoken.Pos{Line: 2, Col: 42, Offset: 62},
+										To:   sqltoken.Pos{Line: 2, Col: 45, Offset: 65},
+									},
+								},
 							},
 						},
 					},
@@ -1843,37 +2510,70 @@ ALTER COLUMN number TYPE numeric(255,10)`,
 				name: "simple case",
 				in:   "UPDATE customers SET contract_name = 'Alfred Schmidt', city = 'Frankfurt' WHERE customer_id = 1",
 				out: &sqlast.UpdateStmt{
-					Update: sqltoken.NewPos(1, 1),
+					Update: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
 					TableName: &sqlast.ObjectName{
 						Idents: []*sqlast.Ident{
 							{
 								Value: "customers",
-								From:  sqltoken.NewPos(1, 8),
-								To:    sqltoken.NewPos(1, 17),
+								From:  sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+								To:    sqltoken.Pos{Line: 1, Col: 17, Offset: 16},
 							},
 						},
 					},
 					Assignments: []*sqlast.Assignment{
 						{
-							ID: sqlast.NewIdentWithPos("contract_name", sqltoken.NewPos(1, 22), sqltoken.NewPos(1, 35)),
+							ID: sqlast.NewIdentWithPos("contract_name", sqltoken.Pos{Line: 1, Col: 22, Offset: 21}, sqltoken.Pos{Line: 1, Col: 35, Offset: 34}),
 							Value: &sqlast.SingleQuotedString{
-								From:   sqltoken.NewPos(1, 38),
-								To:     sqltoken.NewPos(1, 54),
+								From:   sqltoken.Pos{Line: 1, Col: 38, Offset: 37},
+								To:     sqltoken.Pos{Line: 1, Col: 54, Offset: 53},
 								String: "Alfred Schmidt",
 							},
 						},
 						{
-							ID:    sqlast.NewIdentWithPos("city", sqltoken.NewPos(1, 56), sqltoken.NewPos(1, 60)),
-							Value: &sqlast.SingleQuotedString{String: "Frankfurt", From: sqltoken.NewPos(1, 63), To: sqltoken.NewPos(1, 74)},
+							ID:    sqlast.NewIdentWithPos("city", sqltoken.Pos{Line: 1, Col: 56, Offset: 55}, sqltoken.Pos{Line: 1, Col: 60, Offset: 59}),
+							Value: &sqlast.SingleQuotedString{String: "Frankfurt", From: sqltoken.Pos{Line: 1, Col: 63, Offset: 62}, To: sqltoken.Pos{Line: 1, Col: 74, Offset: 73}},
 						},
 					},
 					Selection: &sqlast.BinaryExpr{
-						Op:   &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.NewPos(1, 93), To: sqltoken.NewPos(1, 94)},
-						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.NewPos(1, 81), sqltoken.NewPos(1, 92)),
+						Op:   &sqlast.Operator{Type: sqlast.Eq, From: sqltoken.Pos{Line: 1, Col: 93, Offset: 92}, To: sqltoken.Pos{Line: 1, Col: 94, Offset: 93}},
+						Left: sqlast.NewIdentWithPos("customer_id", sqltoken.Pos{Line: 1, Col: 81, Offset: 80}, sqltoken.Pos{Line: 1, Col: 92, Offset: 91}),
 						Right: &sqlast.LongValue{
-							From: sqltoken.NewPos(1, 95),
-							To:   sqltoken.NewPos(1, 96),
+							From: sqltoken.Pos{Line: 1, Col: 95, Offset: 94},
+							To:   sqltoken.Pos{Line: 1, Col: 96, Offset: 95},
 							Long: 1,
+							Text: "1",
+						},
+					},
+				},
+			},
+			{
+				name: "from clause",
+				in:   "UPDATE t SET a = 1 FROM u",
+				out: &sqlast.UpdateStmt{
+					Update: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 8, Offset: 7}, sqltoken.Pos{Line: 1, Col: 9, Offset: 8}),
+						},
+					},
+					Assignments: []*sqlast.Assignment{
+						{
+							ID: sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 14, Offset: 13}, sqltoken.Pos{Line: 1, Col: 15, Offset: 14}),
+							Value: &sqlast.LongValue{
+								From: sqltoken.Pos{Line: 1, Col: 18, Offset: 17},
+								To:   sqltoken.Pos{Line: 1, Col: 19, Offset: 18},
+								Long: 1,
+								Text: "1",
+							},
+						},
+					},
+					FromClause: []sqlast.TableReference{
+						&sqlast.Table{
+							Name: &sqlast.ObjectName{
+								Idents: []*sqlast.Ident{
+									sqlast.NewIdentWithPos("u", sqltoken.Pos{Line: 1, Col: 25, Offset: 24}, sqltoken.Pos{Line: 1, Col: 26, Offset: 25}),
+								},
+							},
 						},
 					},
 				},
@@ -1901,6 +2601,206 @@ ALTER COLUMN number TYPE numeric(255,10)`,
 			})
 		}
 	})
+
+	t.Run("ddl", func(t *testing.T) {
+		cases := []struct {
+			name string
+			in   string
+			out  sqlast.Stmt
+		}{
+			{
+				name: "create schema",
+				in:   "CREATE SCHEMA IF NOT EXISTS sales AUTHORIZATION joe",
+				out: &sqlast.CreateSchemaStmt{
+					Create:        sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Schema:        sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					NotExists:     true,
+					NotExistsFrom: sqltoken.Pos{Line: 1, Col: 15, Offset: 14},
+					NotExistsTo:   sqltoken.Pos{Line: 1, Col: 28, Offset: 27},
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("sales", sqltoken.Pos{Line: 1, Col: 29, Offset: 28}, sqltoken.Pos{Line: 1, Col: 34, Offset: 33}),
+						},
+					},
+					Authorization: sqlast.NewIdentWithPos("joe", sqltoken.Pos{Line: 1, Col: 49, Offset: 48}, sqltoken.Pos{Line: 1, Col: 52, Offset: 51}),
+				},
+			},
+			{
+				name: "create schema authorization only",
+				in:   "CREATE SCHEMA AUTHORIZATION joe",
+				out: &sqlast.CreateSchemaStmt{
+					Create:        sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Schema:        sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					Authorization: sqlast.NewIdentWithPos("joe", sqltoken.Pos{Line: 1, Col: 29, Offset: 28}, sqltoken.Pos{Line: 1, Col: 32, Offset: 31}),
+				},
+			},
+			{
+				name: "create sequence",
+				in:   "CREATE SEQUENCE seq START WITH -1 NO CYCLE",
+				out: &sqlast.CreateSequenceStmt{
+					Create:   sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Sequence: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("seq", sqltoken.Pos{Line: 1, Col: 17, Offset: 16}, sqltoken.Pos{Line: 1, Col: 20, Offset: 19}),
+						},
+					},
+					Options: []sqlast.SequenceOption{
+						&sqlast.StartWithSequenceOption{
+							Start: sqltoken.Pos{Line: 1, Col: 21, Offset: 20},
+							Value: &sqlast.LongValue{
+								From: sqltoken.Pos{Line: 1, Col: 32, Offset: 31},
+								To:   sqltoken.Pos{Line: 1, Col: 34, Offset: 33},
+								Long: -1,
+							},
+						},
+						&sqlast.CycleSequenceOption{
+							From: sqltoken.Pos{Line: 1, Col: 35, Offset: 34},
+							To:   sqltoken.Pos{Line: 1, Col: 43, Offset: 42},
+							No:   true,
+						},
+					},
+				},
+			},
+			{
+				name: "create trigger",
+				in:   "CREATE TRIGGER tr AFTER DELETE ON t EXECUTE FUNCTION f()",
+				out: &sqlast.CreateTriggerStmt{
+					Create:  sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					Trigger: sqltoken.Pos{Line: 1, Col: 8, Offset: 7},
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("tr", sqltoken.Pos{Line: 1, Col: 16, Offset: 15}, sqltoken.Pos{Line: 1, Col: 18, Offset: 17}),
+						},
+					},
+					Timing:    sqlast.AfterTrigger,
+					TimingPos: sqltoken.Pos{Line: 1, Col: 19, Offset: 18},
+					Events: []*sqlast.TriggerEvent{
+						{
+							Type: sqlast.DeleteTriggerEvent,
+							From: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+							To:   sqltoken.Pos{Line: 1, Col: 31, Offset: 30},
+						},
+					},
+					TableName: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 35, Offset: 34}, sqltoken.Pos{Line: 1, Col: 36, Offset: 35}),
+						},
+					},
+					Execute: sqltoken.Pos{Line: 1, Col: 37, Offset: 36},
+					Function: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("f", sqltoken.Pos{Line: 1, Col: 54, Offset: 53}, sqltoken.Pos{Line: 1, Col: 55, Offset: 54}),
+						},
+					},
+					RParen: sqltoken.Pos{Line: 1, Col: 57, Offset: 56},
+				},
+			},
+			{
+				name: "truncate",
+				in:   "TRUNCATE TABLE a, b RESTART IDENTITY",
+				out: &sqlast.TruncateStmt{
+					Truncate: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableNames: []*sqlast.ObjectName{
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 16, Offset: 15}, sqltoken.Pos{Line: 1, Col: 17, Offset: 16}),
+							},
+						},
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("b", sqltoken.Pos{Line: 1, Col: 19, Offset: 18}, sqltoken.Pos{Line: 1, Col: 20, Offset: 19}),
+							},
+						},
+					},
+					RestartIdentity: true,
+					IdentityPos:     sqltoken.Pos{Line: 1, Col: 37, Offset: 36},
+				},
+			},
+			{
+				name: "comment on column",
+				in:   "COMMENT ON COLUMN t.c IS 'id'",
+				out: &sqlast.CommentOnStmt{
+					Comment:    sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					ObjectType: sqlast.ColumnCommentObject,
+					Name: &sqlast.ObjectName{
+						Idents: []*sqlast.Ident{
+							sqlast.NewIdentWithPos("t", sqltoken.Pos{Line: 1, Col: 19, Offset: 18}, sqltoken.Pos{Line: 1, Col: 20, Offset: 19}),
+							sqlast.NewIdentWithPos("c", sqltoken.Pos{Line: 1, Col: 21, Offset: 20}, sqltoken.Pos{Line: 1, Col: 22, Offset: 21}),
+						},
+					},
+					Text: &sqlast.SingleQuotedString{
+						From:   sqltoken.Pos{Line: 1, Col: 26, Offset: 25},
+						To:     sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
+						String: "id",
+					},
+				},
+			},
+			{
+				name: "drop schema",
+				in:   "DROP SCHEMA IF EXISTS sales, hr CASCADE",
+				out: &sqlast.DropSchemaStmt{
+					Drop:     sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					IfExists: true,
+					SchemaNames: []*sqlast.ObjectName{
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("sales", sqltoken.Pos{Line: 1, Col: 23, Offset: 22}, sqltoken.Pos{Line: 1, Col: 28, Offset: 27}),
+							},
+						},
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("hr", sqltoken.Pos{Line: 1, Col: 30, Offset: 29}, sqltoken.Pos{Line: 1, Col: 32, Offset: 31}),
+							},
+						},
+					},
+					Cascade:    true,
+					CascadePos: sqltoken.Pos{Line: 1, Col: 40, Offset: 39},
+				},
+			},
+			{
+				name: "drop multiple tables",
+				in:   "DROP TABLE a, b RESTRICT",
+				out: &sqlast.DropTableStmt{
+					Drop: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+					TableNames: []*sqlast.ObjectName{
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("a", sqltoken.Pos{Line: 1, Col: 12, Offset: 11}, sqltoken.Pos{Line: 1, Col: 13, Offset: 12}),
+							},
+						},
+						{
+							Idents: []*sqlast.Ident{
+								sqlast.NewIdentWithPos("b", sqltoken.Pos{Line: 1, Col: 15, Offset: 14}, sqltoken.Pos{Line: 1, Col: 16, Offset: 15}),
+							},
+						},
+					},
+					Restrict:    true,
+					RestrictPos: sqltoken.Pos{Line: 1, Col: 25, Offset: 24},
+				},
+			},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				ast, err := parser.ParseStatement()
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+
+				if diff := CompareWithoutMarker(c.out, ast); diff != "" {
+					t.Errorf("diff %s", diff)
+				}
+				if ast.ToSQLString() != c.in {
+					t.Errorf("expected %s but %s", c.in, ast.ToSQLString())
+				}
+			})
+		}
+	})
 }
 
 func TestParser_ParseSQL(t *testing.T) {
@@ -1941,6 +2841,1082 @@ create table item (
 	}
 }
 
+func TestParser_ParseSQL_CopyData(t *testing.T) {
+	in := "COPY category (category_id, name) FROM stdin;\n1\tbooks\n2\t\\N\n\\.\n\nSELECT name FROM category;\n"
+
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	stmts, err := parser.ParseSQL()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if len(stmts) != 2 {
+		t.Fatal("must be 2 stmts")
+	}
+
+	copyStmt, ok := stmts[0].(*sqlast.CopyStmt)
+	if !ok {
+		t.Fatalf("must be CopyStmt but %T", stmts[0])
+	}
+	if copyStmt.Data == nil || *copyStmt.Data != "1\tbooks\n2\t\\N\n" {
+		t.Errorf("unexpected data %v", copyStmt.Data)
+	}
+	if end := (sqltoken.Pos{Line: 4, Col: 3, Offset: 61}); copyStmt.End() != end {
+		t.Errorf("must end at %+v but %+v", end, copyStmt.End())
+	}
+}
+
+func TestParser_ParseSQL_PgDump(t *testing.T) {
+	in := `--
+-- PostgreSQL database dump
+--
+
+SET statement_timeout = 0;
+SET client_encoding = 'UTF8';
+SET standard_conforming_strings = on;
+SELECT pg_catalog.set_config('search_path', '', false);
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public;
+
+COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement and index searching based on trigrams';
+
+CREATE TYPE public.mood AS ENUM (
+    'sad',
+    'ok',
+    'happy'
+);
+
+CREATE DOMAIN public.posint AS integer
+	CONSTRAINT posint_check CHECK ((VALUE > 0));
+
+CREATE TABLE public.person (
+    id public.posint NOT NULL,
+    current_mood public.mood
+);
+`
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.PostgresqlDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	stmts, err := parser.ParseSQL()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	var types []string
+	for _, stmt := range stmts {
+		types = append(types, fmt.Sprintf("%T", stmt))
+	}
+	exp := []string{
+		"*sqlast.SetVariableStmt", "*sqlast.SetVariableStmt", "*sqlast.SetVariableStmt", "*sqlast.QueryStmt",
+		"*sqlast.CreateExtensionStmt", "*sqlast.CommentOnStmt", "*sqlast.CreateTypeStmt", "*sqlast.CreateDomainStmt",
+		"*sqlast.CreateTableStmt",
+	}
+	if diff := cmp.Diff(exp, types); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+}
+
+func TestParser_ParseColumnDef(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{in: "id int primary key", out: "id int PRIMARY KEY"},
+		{in: "name varchar(255) not null default 'foo'", out: "name character varying(255) DEFAULT 'foo' NOT NULL"},
+		{in: "category_id int references category(category_id)", out: "category_id int REFERENCES category(category_id)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			def, err := parser.ParseColumnDef()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act := def.ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+
+	t.Run("not a column name", func(t *testing.T) {
+		parser, err := NewParser(bytes.NewBufferString("(id int)"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := parser.ParseColumnDef(); err == nil {
+			t.Error("must be error")
+		}
+	})
+
+	t.Run("serial types", func(t *testing.T) {
+		cases := map[string]sqlast.NodeKind{
+			"id smallserial": sqlast.KindSmallSerial,
+			"id serial":      sqlast.KindSerial,
+			"id bigserial":   sqlast.KindBigSerial,
+		}
+		for in, kind := range cases {
+			parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			def, err := parser.ParseColumnDef()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act := sqlast.KindOf(def.DataType); act != kind {
+				t.Errorf("%s: must be %s but %s", in, kind, act)
+			}
+			if act := def.ToSQLString(); act != in {
+				t.Errorf("must be %s but %s", in, act)
+			}
+		}
+	})
+}
+
+func TestParser_ParseTableConstraint(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{in: "primary key (id)", out: "PRIMARY KEY(id)"},
+		{in: "constraint uq unique (a, b)", out: "CONSTRAINT uq UNIQUE(a, b)"},
+		{in: "foreign key (category_id) references category(id)", out: "FOREIGN KEY(category_id) REFERENCES category(id)"},
+		{in: "check (price > 0)", out: "CHECK(price > 0)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			constraint, err := parser.ParseTableConstraint()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act := constraint.ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+}
+
+func TestParser_Dialect(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect
+		in      string
+		out     string
+	}{
+		{
+			name:    "generic qualify",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t QUALIFY a = 1",
+			out:     "SELECT a FROM t QUALIFY a = 1",
+		},
+		{
+			name:    "generic qualify as alias",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a qualify, b FROM t qualify WHERE a = 1",
+			out:     "SELECT a AS qualify, b FROM t AS qualify WHERE a = 1",
+		},
+		{
+			name:    "generic qualify as table alias at the end",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t qualify",
+			out:     "SELECT a FROM t AS qualify",
+		},
+		{
+			name:    "mysql qualify as alias",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT a FROM t qualify",
+			out:     "SELECT a FROM t AS qualify",
+		},
+		{
+			name:    "prefixed integers",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT 0x1F, 0o17, 0b101, 0xFFFFFFFFFFFFFFFFFF",
+			out:     "SELECT 0x1F, 0o17, 0b101, 0xFFFFFFFFFFFFFFFFFF",
+		},
+		{
+			name:    "generic doubled quote",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT 'it''s' FROM t",
+			out:     "SELECT 'it''s' FROM t",
+		},
+		{
+			name:    "postgres create function",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "create function f(x int = 0) returns setof t as $$ select 1 $$ language sql",
+			out:     "CREATE FUNCTION f(x int DEFAULT 0) RETURNS SETOF t LANGUAGE sql AS $$ select 1 $$",
+		},
+		{
+			name:    "mysql column charset and collation",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (a char(36) CHARSET latin1 COLLATE latin1_bin NOT NULL) DEFAULT COLLATE utf8mb4_bin",
+			out:     "CREATE TABLE t (a char(36) CHARACTER SET latin1 COLLATE latin1_bin NOT NULL) DEFAULT COLLATE utf8mb4_bin",
+		},
+		{
+			name:    "mysql backslash escape",
+			dialect: &dialect.MySQLDialect{},
+			in:      `SELECT 'it\'s' FROM t`,
+			out:     "SELECT 'it''s' FROM t",
+		},
+		{
+			name:    "mysql display width and zerofill",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (a int(11) unsigned zerofill, b tinyint(1), c bigint(20) UNSIGNED, d numeric(10, 2) zerofill)",
+			out:     "CREATE TABLE t (a int(11) unsigned zerofill, b tinyint(1), c bigint(20) unsigned, d numeric(10,2) zerofill)",
+		},
+		{
+			name:    "postgres dollar quoted string",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT $fn$ it's $ $fn$ FROM t",
+			out:     "SELECT $fn$ it's $ $fn$ FROM t",
+		},
+		{
+			name:    "sqlite create table",
+			dialect: &dialect.SQLiteDialect{},
+			in:      `CREATE TABLE t ([id] INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT, ` + "`age`" + ` INT) WITHOUT ROWID`,
+			out:     `CREATE TABLE t ([id] integer PRIMARY KEY AUTOINCREMENT, "name" text, ` + "`age`" + ` int) WITHOUT ROWID`,
+		},
+		{
+			name:    "mssql top",
+			dialect: &dialect.MSSQLDialect{},
+			in:      "SELECT DISTINCT TOP (10) PERCENT WITH TIES [a b], [c]]d] FROM t",
+			out:     "SELECT DISTINCT TOP (10) PERCENT WITH TIES [a b], [c]]d] FROM t",
+		},
+		{
+			name:    "mssql nvarchar max",
+			dialect: &dialect.MSSQLDialect{},
+			in:      "CREATE TABLE t (a NVARCHAR(MAX), b NVARCHAR(10), c VARCHAR(MAX))",
+			out:     "CREATE TABLE t (a nvarchar(max), b nvarchar(10), c varchar(max))",
+		},
+		{
+			name:    "generic top as column and alias",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT top, a top FROM t",
+			out:     "SELECT top, a AS top FROM t",
+		},
+		{
+			name:    "postgres top as column",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT top FROM t",
+			out:     "SELECT top FROM t",
+		},
+		{
+			name:    "offset and fetch",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t ORDER BY a OFFSET 10 ROW FETCH NEXT 5 PERCENT ROWS WITH TIES",
+			out:     "SELECT a FROM t ORDER BY a OFFSET 10 ROWS FETCH NEXT 5 PERCENT ROWS WITH TIES",
+		},
+		{
+			name:    "fetch a row",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t FETCH FIRST ROW ONLY",
+			out:     "SELECT a FROM t FETCH FIRST ROW ONLY",
+		},
+		{
+			name:    "postgres backslash",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      `SELECT 'a\b' FROM t`,
+			out:     `SELECT 'a\b' FROM t`,
+		},
+		{
+			name:    "mysql escaped backslash",
+			dialect: &dialect.MySQLDialect{},
+			in:      `SELECT 'a\\b', N'c\\d', 'e\nf' FROM t`,
+			out:     "SELECT 'a\\\\b', N'c\\\\d', 'e\nf' FROM t",
+		},
+		{
+			name:    "mysql offset as alias",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT a offset FROM t returning",
+			out:     "SELECT a AS offset FROM t AS returning",
+		},
+		{
+			name:    "generic offset",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t offset 1 ROWS",
+			out:     "SELECT a FROM t OFFSET 1 ROWS",
+		},
+		{
+			name:    "generic limit placeholder",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t LIMIT ?",
+			out:     "SELECT a FROM t LIMIT ?",
+		},
+		{
+			name:    "generic offset placeholder",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t ORDER BY a OFFSET ? ROWS FETCH NEXT 5 ROWS ONLY",
+			out:     "SELECT a FROM t ORDER BY a OFFSET ? ROWS FETCH NEXT 5 ROWS ONLY",
+		},
+		{
+			name:    "postgres limit offset placeholders",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT a FROM t LIMIT $1 OFFSET $2",
+			out:     "SELECT a FROM t LIMIT $1 OFFSET $2",
+		},
+		{
+			name:    "postgres index nulls order",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE INDEX i ON t (a NULLS FIRST, b DESC NULLS LAST, c text_pattern_ops nulls last)",
+			out:     "CREATE INDEX i ON t (a NULLS FIRST, b DESC NULLS LAST, c text_pattern_ops NULLS LAST)",
+		},
+		{
+			name:    "table query in expressions",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t WHERE a IN (TABLE u) AND b = ANY (TABLE v) AND EXISTS (TABLE w) AND c = (TABLE x)",
+			out:     "SELECT a FROM t WHERE a IN (TABLE u) AND b = ANY (TABLE v) AND EXISTS (TABLE w) AND c = (TABLE x)",
+		},
+		{
+			name:    "postgres offset without rows",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT a FROM t ORDER BY a OFFSET 5 FETCH NEXT 3 ROWS ONLY",
+			out:     "SELECT a FROM t ORDER BY a OFFSET 5 FETCH NEXT 3 ROWS ONLY",
+		},
+		{
+			name:    "mssql limit as alias",
+			dialect: &dialect.MSSQLDialect{},
+			in:      "SELECT a limit FROM t",
+			out:     "SELECT a AS limit FROM t",
+		},
+		{
+			name:    "postgres set search_path",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      `set local search_path to "$user", public`,
+			out:     `SET local search_path TO "$user", public`,
+		},
+		{
+			name:    "mysql set names",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SET NAMES utf8mb4",
+			out:     "SET NAMES utf8mb4",
+		},
+		{
+			name:    "mysql show warnings",
+			dialect: &dialect.MySQLDialect{},
+			in:      "show warnings limit 10, 5",
+			out:     "SHOW WARNINGS LIMIT 10, 5",
+		},
+		{
+			name:    "mysql kill query",
+			dialect: &dialect.MySQLDialect{},
+			in:      "kill query 42",
+			out:     "KILL QUERY 42",
+		},
+		{
+			name:    "mysql index comment",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (id int, a int, PRIMARY KEY (id) COMMENT 'pk', CONSTRAINT uq UNIQUE KEY (a) COMMENT 'covering')",
+			out:     "CREATE TABLE t (id int, a int, PRIMARY KEY(id) COMMENT 'pk', CONSTRAINT uq UNIQUE(a) COMMENT 'covering')",
+		},
+		{
+			name:    "postgres 15 unique nulls not distinct",
+			dialect: &dialect.PostgresqlDialect{Version: dialect.Version{Major: 15}},
+			in:      "CREATE TABLE t (a int UNIQUE NULLS NOT DISTINCT, b int, UNIQUE NULLS DISTINCT (b))",
+			out:     "CREATE TABLE t (a int UNIQUE NULLS NOT DISTINCT, b int, UNIQUE NULLS DISTINCT(b))",
+		},
+		{
+			name:    "mysql 8.0.16 check enforced",
+			dialect: &dialect.MySQLDialect{Version: dialect.Version{Major: 8, Patch: 16}},
+			in:      "CREATE TABLE t (a int CHECK (a > 0) NOT ENFORCED NOT NULL, CHECK (a < 10) ENFORCED)",
+			out:     "CREATE TABLE t (a int CHECK(a > 0) NOT ENFORCED NOT NULL, CHECK(a < 10) ENFORCED)",
+		},
+		{
+			name:    "postgres returning",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "UPDATE t SET a = 1 WHERE b = 2 RETURNING a, b AS c",
+			out:     "UPDATE t SET a = 1 WHERE b = 2 RETURNING a, b AS c",
+		},
+		{
+			name:    "postgres delete returning",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "DELETE FROM t RETURNING *",
+			out:     "DELETE FROM t RETURNING *",
+		},
+		{
+			name:    "postgres update from",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "UPDATE t SET a = o.a FROM other AS o, third WHERE t.id = o.id",
+			out:     "UPDATE t SET a = o.a FROM other AS o, third WHERE t.id = o.id",
+		},
+		{
+			name:    "postgres delete using",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "DELETE FROM t USING other o WHERE t.id = o.id RETURNING t.id",
+			out:     "DELETE FROM t USING other AS o WHERE t.id = o.id RETURNING t.id",
+		},
+		{
+			name:    "mysql delete order by limit",
+			dialect: &dialect.MySQLDialect{},
+			in:      "DELETE FROM t WHERE a = 1 ORDER BY created_at DESC LIMIT 100",
+			out:     "DELETE FROM t WHERE a = 1 ORDER BY created_at DESC LIMIT 100",
+		},
+		{
+			name:    "mysql update order by limit",
+			dialect: &dialect.MySQLDialect{},
+			in:      "UPDATE t SET a = a + 1 ORDER BY id LIMIT 10",
+			out:     "UPDATE t SET a = a + 1 ORDER BY id LIMIT 10",
+		},
+		{
+			name:    "mysql drop primary key",
+			dialect: &dialect.MySQLDialect{},
+			in:      "ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (a, b)",
+			out:     "ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY(a, b)",
+		},
+		{
+			name:    "mysql delete limit placeholder",
+			dialect: &dialect.MySQLDialect{},
+			in:      "DELETE FROM t WHERE a = ? LIMIT ?",
+			out:     "DELETE FROM t WHERE a = ? LIMIT ?",
+		},
+		{
+			name:    "mysql select hint",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT /*+ MAX_EXECUTION_TIME(1000) */ DISTINCT a FROM t",
+			out:     "SELECT /*+ MAX_EXECUTION_TIME(1000) */ DISTINCT a FROM t",
+		},
+		{
+			name:    "mysql update and delete hint",
+			dialect: &dialect.MySQLDialect{},
+			in:      "UPDATE /*+ NO_MERGE(t) */ t SET a = (SELECT /*+ BKA(u) */ b FROM u) WHERE a IN (SELECT a FROM v)",
+			out:     "UPDATE /*+ NO_MERGE(t) */ t SET a = (SELECT /*+ BKA(u) */ b FROM u) WHERE a IN (SELECT a FROM v)",
+		},
+		{
+			name:    "mysql comment not hint",
+			dialect: &dialect.MySQLDialect{},
+			in:      "DELETE /* comment */ FROM t WHERE a = /*+ not a hint */ 1",
+			out:     "DELETE FROM t WHERE a = 1",
+		},
+		{
+			name:    "explain",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "EXPLAIN SELECT a FROM t",
+			out:     "EXPLAIN SELECT a FROM t",
+		},
+		{
+			name:    "postgres explain analyze verbose",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "explain analyze verbose update t set a = 1",
+			out:     "EXPLAIN ANALYZE VERBOSE UPDATE t SET a = 1",
+		},
+		{
+			name:    "postgres explain options",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "EXPLAIN (ANALYZE, BUFFERS false, FORMAT JSON) SELECT a FROM t",
+			out:     "EXPLAIN (ANALYZE, BUFFERS false, FORMAT JSON) SELECT a FROM t",
+		},
+		{
+			name:    "mysql explain format",
+			dialect: &dialect.MySQLDialect{},
+			in:      "EXPLAIN ANALYZE FORMAT = TREE SELECT a FROM t",
+			out:     "EXPLAIN ANALYZE FORMAT=TREE SELECT a FROM t",
+		},
+		{
+			name:    "mysql describe statement",
+			dialect: &dialect.MySQLDialect{},
+			in:      "DESCRIBE FORMAT=JSON DELETE FROM t WHERE a = 1",
+			out:     "DESCRIBE FORMAT=JSON DELETE FROM t WHERE a = 1",
+		},
+		{
+			name:    "mysql describe table",
+			dialect: &dialect.MySQLDialect{},
+			in:      "desc db.t",
+			out:     "DESCRIBE db.t",
+		},
+		{
+			name:    "postgres prepare",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "prepare ins (int, varchar(10)) as insert into t values ($1, $2)",
+			out:     "PREPARE ins (int, character varying(10)) AS INSERT INTO t VALUES ($1, $2)",
+		},
+		{
+			name:    "postgres execute",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "EXECUTE ins (1 + 1, 'a')",
+			out:     "EXECUTE ins (1 + 1, 'a')",
+		},
+		{
+			name:    "postgres deallocate",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "DEALLOCATE PREPARE ALL",
+			out:     "DEALLOCATE PREPARE ALL",
+		},
+		{
+			name:    "mysql prepare",
+			dialect: &dialect.MySQLDialect{},
+			in:      "PREPARE stmt1 FROM 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse'",
+			out:     "PREPARE stmt1 FROM 'SELECT SQRT(POW(?,2) + POW(?,2)) AS hypotenuse'",
+		},
+		{
+			name:    "mysql execute using",
+			dialect: &dialect.MySQLDialect{},
+			in:      "EXECUTE stmt1 USING @a, @b",
+			out:     "EXECUTE stmt1 USING @a, @b",
+		},
+		{
+			name:    "mysql deallocate",
+			dialect: &dialect.MySQLDialect{},
+			in:      "DEALLOCATE PREPARE stmt1",
+			out:     "DEALLOCATE PREPARE stmt1",
+		},
+		{
+			name:    "postgres vacuum",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "vacuum full verbose analyze",
+			out:     "VACUUM FULL VERBOSE ANALYZE",
+		},
+		{
+			name:    "postgres vacuum options",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "VACUUM (FULL, ANALYZE, PARALLEL 4) public.orders (customer_id, amount), customers",
+			out:     "VACUUM (FULL, ANALYZE, PARALLEL 4) public.orders (customer_id, amount), customers",
+		},
+		{
+			name:    "postgres analyze",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "ANALYZE VERBOSE orders(amount)",
+			out:     "ANALYZE VERBOSE orders (amount)",
+		},
+		{
+			name:    "postgres reindex",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "REINDEX (VERBOSE) TABLE CONCURRENTLY orders",
+			out:     "REINDEX (VERBOSE) TABLE CONCURRENTLY orders",
+		},
+		{
+			name:    "postgres reindex index",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "reindex index orders_pkey",
+			out:     "REINDEX INDEX orders_pkey",
+		},
+		{
+			name:    "postgres create enum type",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy')",
+			out:     "CREATE TYPE public.mood AS ENUM ('sad', 'ok', 'happy')",
+		},
+		{
+			name:    "postgres create composite type",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "create type address as (street varchar(80), city text)",
+			out:     "CREATE TYPE address AS (street character varying(80), city text)",
+		},
+		{
+			name:    "postgres create domain",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE DOMAIN public.posint integer NOT NULL DEFAULT 1 CONSTRAINT posint_check CHECK ((VALUE > 0))",
+			out:     "CREATE DOMAIN public.posint AS integer DEFAULT 1 NOT NULL CONSTRAINT posint_check CHECK((VALUE > 0))",
+		},
+		{
+			name:    "postgres create extension",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public VERSION '1.6' CASCADE",
+			out:     "CREATE EXTENSION IF NOT EXISTS pg_trgm WITH SCHEMA public VERSION '1.6' CASCADE",
+		},
+		{
+			name:    "postgres comment on extension",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement'",
+			out:     "COMMENT ON EXTENSION pg_trgm IS 'text similarity measurement'",
+		},
+		{
+			name:    "postgres temporary table",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TEMP TABLE t (a int)",
+			out:     "CREATE TEMPORARY TABLE t (a int)",
+		},
+		{
+			name:    "postgres unlogged table",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE UNLOGGED TABLE IF NOT EXISTS t (a int)",
+			out:     "CREATE UNLOGGED TABLE IF NOT EXISTS t (a int)",
+		},
+		{
+			name:    "postgres like table element",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE t (LIKE s INCLUDING ALL EXCLUDING INDEXES, b int)",
+			out:     "CREATE TABLE t (LIKE s INCLUDING ALL EXCLUDING INDEXES, b int)",
+		},
+		{
+			name:    "postgres partition by",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE m (a int, logdate date) PARTITION BY RANGE (logdate, a)",
+			out:     "CREATE TABLE m (a int, logdate date) PARTITION BY RANGE (logdate, a)",
+		},
+		{
+			name:    "mysql create table like",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t LIKE s",
+			out:     "CREATE TABLE t (LIKE s)",
+		},
+		{
+			name:    "postgres inherits",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE capitals (state char(2)) INHERITS (cities, public.places)",
+			out:     "CREATE TABLE capitals (state char(2)) INHERITS (cities, public.places)",
+		},
+		{
+			name:    "postgres partition of range",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE m_2024 PARTITION OF m FOR VALUES FROM ('2024-01-01', MINVALUE) TO ('2025-01-01', MAXVALUE)",
+			out:     "CREATE TABLE m_2024 PARTITION OF m FOR VALUES FROM ('2024-01-01', MINVALUE) TO ('2025-01-01', MAXVALUE)",
+		},
+		{
+			name:    "postgres partition of list with constraint",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE c_jp PARTITION OF c (CONSTRAINT pk PRIMARY KEY (id)) FOR VALUES IN ('jp', 'JP') PARTITION BY HASH (id)",
+			out:     "CREATE TABLE c_jp PARTITION OF c (CONSTRAINT pk PRIMARY KEY(id)) FOR VALUES IN ('jp', 'JP') PARTITION BY HASH (id)",
+		},
+		{
+			name:    "postgres special date time values",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT DATE 'today', TIME 'allballs', TIMESTAMP 'infinity', TIMESTAMP '2020-01-01 10:00:00', DATE 'it''s'",
+			out:     "SELECT DATE 'today', TIME 'allballs', TIMESTAMP 'infinity', TIMESTAMP '2020-01-01 10:00:00', DATE 'it''s'",
+		},
+		{
+			name:    "postgres partition of with column constraints",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE p1 PARTITION OF p (a NOT NULL, b WITH OPTIONS DEFAULT 0 CHECK (b > 0), PRIMARY KEY (a)) FOR VALUES IN (1)",
+			out:     "CREATE TABLE p1 PARTITION OF p (a NOT NULL, b WITH OPTIONS DEFAULT 0 CHECK(b > 0), PRIMARY KEY(a)) FOR VALUES IN (1)",
+		},
+		{
+			name:    "postgres partition of hash",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE h0 PARTITION OF h FOR VALUES WITH (MODULUS 4, REMAINDER 0)",
+			out:     "CREATE TABLE h0 PARTITION OF h FOR VALUES WITH (MODULUS 4, REMAINDER 0)",
+		},
+		{
+			name:    "postgres default partition",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TABLE c_other PARTITION OF c DEFAULT",
+			out:     "CREATE TABLE c_other PARTITION OF c DEFAULT",
+		},
+		{
+			name:    "postgres attach and detach partition",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "ALTER TABLE m ATTACH PARTITION m_2024 FOR VALUES FROM (1) TO (10), DETACH PARTITION m_2023 CONCURRENTLY",
+			out:     "ALTER TABLE m ATTACH PARTITION m_2024 FOR VALUES FROM (1) TO (10), DETACH PARTITION m_2023 CONCURRENTLY",
+		},
+		{
+			name:    "postgres lateral function with column aliases",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT * FROM a, LATERAL jsonb_each(a.doc) t(k, v)",
+			out:     "SELECT * FROM a, LATERAL jsonb_each(a.doc) AS t(k, v)",
+		},
+		{
+			name:    "postgres rows from with ordinality",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT * FROM LATERAL ROWS FROM (f(1), g(x, y)) WITH ORDINALITY AS r",
+			out:     "SELECT * FROM LATERAL ROWS FROM (f(1), g(x, y)) WITH ORDINALITY AS r",
+		},
+		{
+			name:    "postgres derived table column aliases",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT * FROM (SELECT 1, 2) AS d (a, b)",
+			out:     "SELECT * FROM (SELECT 1, 2) AS d(a, b)",
+		},
+		{
+			name:    "union distinct",
+			dialect: &dialect.GenericSQLDialect{},
+			in:      "SELECT a FROM t UNION DISTINCT SELECT b FROM u",
+			out:     "SELECT a FROM t UNION DISTINCT SELECT b FROM u",
+		},
+		{
+			name:    "parenthesized set operation branches",
+			dialect: &dialect.MySQLDialect{},
+			in:      "(SELECT a FROM t ORDER BY a LIMIT 1) UNION ALL (SELECT b FROM u ORDER BY b LIMIT 2) LIMIT 3",
+			out:     "(SELECT a FROM t ORDER BY a LIMIT 1) UNION ALL (SELECT b FROM u ORDER BY b LIMIT 2) LIMIT 3",
+		},
+		{
+			name:    "parenthesized set operation grouping",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "(SELECT 1 EXCEPT SELECT 2) INTERSECT (SELECT 3)",
+			out:     "(SELECT 1 EXCEPT SELECT 2) INTERSECT (SELECT 3)",
+		},
+		{
+			name:    "mysql index hints",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT * FROM t1 AS a USE INDEX (i1, i2) IGNORE KEY FOR ORDER BY (i3) JOIN t2 FORCE INDEX FOR JOIN (PRIMARY) ON a.id = t2.id",
+			out:     "SELECT * FROM t1 AS a USE INDEX (i1, i2) IGNORE KEY FOR ORDER BY (i3) JOIN t2 FORCE INDEX FOR JOIN (PRIMARY) ON a.id = t2.id",
+		},
+		{
+			name:    "mysql empty use index",
+			dialect: &dialect.MySQLDialect{},
+			in:      "SELECT * FROM t USE INDEX ()",
+			out:     "SELECT * FROM t USE INDEX ()",
+		},
+		{
+			name:    "mssql table hints",
+			dialect: &dialect.MSSQLDialect{},
+			in:      "SELECT * FROM t AS x WITH (NOLOCK) JOIN u WITH (INDEX(ix_u), NOWAIT) ON x.id = u.id",
+			out:     "SELECT * FROM t AS x WITH (NOLOCK) JOIN u WITH (INDEX(ix_u), NOWAIT) ON x.id = u.id",
+		},
+		{
+			name:    "table alias with column list",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT x.a FROM t AS x (a, b, c) JOIN generate_series(1, 3) g(n) ON x.a = g.n",
+			out:     "SELECT x.a FROM t AS x(a, b, c) JOIN generate_series(1, 3) AS g(n) ON x.a = g.n",
+		},
+		{
+			name:    "postgres hint as comment",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "SELECT /*+ SeqScan(t) */ a FROM t",
+			out:     "SELECT a FROM t",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), c.dialect)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			ast, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if act := ast.ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+}
+
+func TestParser_Hint(t *testing.T) {
+	src := "DELETE\n  /*+ BKA(t) */ FROM t -- comment\n;"
+	parser, err := NewParser(bytes.NewBufferString(src), &dialect.MySQLDialect{}, ParseComment())
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	f, err := parser.ParseFile()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	exp := &sqlast.HintComment{
+		Text: " BKA(t) ",
+		From: sqltoken.Pos{Line: 2, Col: 3, Offset: 9},
+		To:   sqltoken.Pos{Line: 2, Col: 16, Offset: 22},
+	}
+	if diff := cmp.Diff(exp, f.Stmts[0].(*sqlast.DeleteStmt).Hint); diff != "" {
+		t.Errorf("diff %s", diff)
+	}
+	if len(f.Comments) != 1 || f.Comments[0].ToSQLString() != " comment" {
+		t.Errorf("hint must not be a comment but %+v", f.Comments)
+	}
+}
+
+func TestParser_UnsupportedFeature(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect // GenericSQLDialect if nil
+		in      string
+		feature string
+		pos     sqltoken.Pos
+	}{
+		{
+			name:    "statement",
+			in:      "MERGE INTO t USING s ON t.id = s.id",
+			feature: "MERGE statement",
+			pos:     sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+		},
+		{
+			name:    "table option",
+			in:      "CREATE TABLE t (a int) TABLESPACE ts",
+			feature: "table option TABLESPACE",
+			pos:     sqltoken.Pos{Line: 1, Col: 24, Offset: 23},
+		},
+		{
+			name:    "unique nulls not distinct before postgres 15",
+			dialect: &dialect.PostgresqlDialect{Version: dialect.Version{Major: 14}},
+			in:      "CREATE TABLE t (a int UNIQUE NULLS NOT DISTINCT)",
+			feature: "UNIQUE NULLS DISTINCT",
+			pos:     sqltoken.Pos{Line: 1, Col: 30, Offset: 29},
+		},
+		{
+			name:    "check enforced before mysql 8.0.16",
+			dialect: &dialect.MySQLDialect{Version: dialect.Version{Major: 5, Minor: 7}},
+			in:      "CREATE TABLE t (a int CHECK (a > 0) NOT ENFORCED)",
+			feature: "CHECK ENFORCED",
+			pos:     sqltoken.Pos{Line: 1, Col: 37, Offset: 36},
+		},
+		{
+			name:    "statement in create schema",
+			in:      "CREATE SCHEMA s GRANT ALL ON t TO u",
+			feature: "GRANT statement",
+			pos:     sqltoken.Pos{Line: 1, Col: 17, Offset: 16},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			_, err = parser.ParseSQL()
+			if !errors.Is(err, ErrUnsupportedFeature) {
+				t.Fatalf("must be ErrUnsupportedFeature but %+v", err)
+			}
+			var uerr *UnsupportedFeatureError
+			if !errors.As(err, &uerr) {
+				t.Fatalf("must be UnsupportedFeatureError but %+v", err)
+			}
+			if uerr.Feature != c.feature || uerr.Pos != c.pos {
+				t.Errorf("must be %s at %+v but %s at %+v", c.feature, c.pos, uerr.Feature, uerr.Pos)
+			}
+		})
+	}
+}
+
+func TestParser_MaxInListItems(t *testing.T) {
+	in := "SELECT * FROM t WHERE id IN (1, 2, 3, 4,\n5)"
+	parser, err := NewParser(bytes.NewBufferString(in), &dialect.GenericSQLDialect{}, MaxInListItems(2))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	list := stmt.(*sqlast.QueryStmt).Body.(*sqlast.SQLSelect).WhereClause.(*sqlast.InList)
+	if len(list.List) != 2 || list.Elided != 3 {
+		t.Errorf("must store 2 items and elide 3 but %d and %d", len(list.List), list.Elided)
+	}
+	if from, to := (sqltoken.Pos{Line: 1, Col: 36, Offset: 35}), (sqltoken.Pos{Line: 2, Col: 2, Offset: 42}); list.ElidedFrom != from || list.ElidedTo != to {
+		t.Errorf("must elide %+v-%+v but %+v-%+v", from, to, list.ElidedFrom, list.ElidedTo)
+	}
+	if act, expect := stmt.ToSQLString(), "SELECT * FROM t WHERE id IN (1, 2 /* 3 more */)"; act != expect {
+		t.Errorf("must be %s but %s", expect, act)
+	}
+}
+
+func TestParser_UnterminatedToken(t *testing.T) {
+	_, err := NewParser(bytes.NewBufferString("SELECT a FROM t\nWHERE b = 'unclosed"), &dialect.GenericSQLDialect{})
+
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("must be ParseError but %+v", err)
+	}
+	if pos := (sqltoken.Pos{Line: 2, Col: 11, Offset: 26}); perr.Pos != pos {
+		t.Errorf("must be at %+v but %+v", pos, perr.Pos)
+	}
+	var uerr *sqltoken.UnterminatedError
+	if !errors.As(err, &uerr) || uerr.Kind != sqltoken.SingleQuotedString {
+		t.Errorf("must wrap UnterminatedError but %+v", err)
+	}
+}
+
+func TestParser_SyntaxError(t *testing.T) {
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect // GenericSQLDialect if nil
+		in      string
+	}{
+		{
+			name: "on conflict without do",
+			in:   "INSERT INTO t (a) VALUES (1) ON CONFLICT (a) NOTHING",
+		},
+		{
+			name: "on conflict do update without set",
+			in:   "INSERT INTO t (a) VALUES (1) ON CONFLICT (a) DO UPDATE a = 1",
+		},
+		{
+			name: "invalid hex integer",
+			in:   "SELECT 0xZZ",
+		},
+		{
+			name: "hex prefix without digits",
+			in:   "SELECT 0x",
+		},
+		{
+			name: "comment on without is",
+			in:   "COMMENT ON TABLE t 'x'",
+		},
+		{
+			name: "comment on without object",
+			in:   "COMMENT ON",
+		},
+		{
+			name: "cte column list with as",
+			in:   "WITH x (a AS (SELECT 1) SELECT * FROM x",
+		},
+		{
+			name: "column definition without comma",
+			in:   "CREATE TABLE t (a int b int)",
+		},
+		{
+			name: "cte without parentheses",
+			in:   "WITH x AS SELECT 1 SELECT * FROM x",
+		},
+		{
+			name: "rename column without to",
+			in:   "ALTER TABLE t RENAME COLUMN a b",
+		},
+		{
+			name: "set without to",
+			in:   "SET x 1",
+		},
+		{
+			name: "is of without parentheses",
+			in:   "SELECT a IS OF int FROM t",
+		},
+		{
+			name:    "mysql enum without parentheses",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (a enum 'x')",
+		},
+		{
+			name: "trigger without function",
+			in:   "CREATE TRIGGER tr AFTER DELETE ON t EXECUTE f()",
+		},
+		{
+			name: "trigger without on",
+			in:   "CREATE TRIGGER tr AFTER DELETE t EXECUTE FUNCTION f()",
+		},
+		{
+			name: "sequence owned without by",
+			in:   "CREATE SEQUENCE s OWNED NONE",
+		},
+		{
+			name: "function without arguments",
+			in:   "CREATE FUNCTION f RETURNS int AS 'x' LANGUAGE sql",
+		},
+		{
+			name: "function returns table without columns",
+			in:   "CREATE FUNCTION f() RETURNS TABLE int AS 'x' LANGUAGE sql",
+		},
+		{
+			name:    "table option character without set",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (a int) CHARACTER utf8",
+		},
+		{
+			name: "copy without from",
+			in:   "COPY t (a) STDIN",
+		},
+		{
+			name: "overlay without from",
+			in:   "SELECT OVERLAY(a PLACING b) FROM t",
+		},
+		{
+			name:    "mysql index element without columns",
+			dialect: &dialect.MySQLDialect{},
+			in:      "CREATE TABLE t (a int, KEY idx USING btree a)",
+		},
+		{
+			name: "with time zone without zone",
+			in:   "SELECT CAST(a AS timestamp WITH TIME) FROM t",
+		},
+		{
+			name:    "create type without as",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE TYPE mood ENUM ('sad')",
+		},
+		{
+			name:    "include without parens",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "CREATE INDEX i ON t (a) INCLUDE b",
+		},
+		{
+			name:    "postgres modify column",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "ALTER TABLE t MODIFY a int",
+		},
+		{
+			name:    "alter view without as",
+			dialect: &dialect.PostgresqlDialect{},
+			in:      "ALTER VIEW v (a) SELECT 1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			d := c.dialect
+			if d == nil {
+				d = &dialect.GenericSQLDialect{}
+			}
+			parser, err := NewParser(bytes.NewBufferString(c.in), d)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if _, err := parser.ParseStatement(); err == nil {
+				t.Error("must be error")
+			}
+		})
+	}
+}
+
+func TestParser_NotPrecedence(t *testing.T) {
+	// parenthesize writes node with explicit parentheses around every unary and binary expression.
+	var parenthesize func(node sqlast.Node) string
+	parenthesize = func(node sqlast.Node) string {
+		switch n := node.(type) {
+		case *sqlast.BinaryExpr:
+			return "(" + parenthesize(n.Left) + " " + n.Op.ToSQLString() + " " + parenthesize(n.Right) + ")"
+		case *sqlast.UnaryExpr:
+			return "(" + n.Op.ToSQLString() + " " + parenthesize(n.Expr) + ")"
+		}
+		return node.ToSQLString()
+	}
+
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{in: "NOT a = 1 AND b = 2", out: "((NOT (a = 1)) AND (b = 2))"},
+		{in: "a = 1 AND NOT b = 2 OR c", out: "(((a = 1) AND (NOT (b = 2))) OR c)"},
+		{in: "NOT a OR b AND NOT c", out: "((NOT a) OR (b AND (NOT c)))"},
+		{in: "NOT NOT a", out: "(NOT (NOT a))"},
+		{in: "NOT a IS NULL", out: "(NOT a IS NULL)"},
+		{in: "NOT a IN (1, 2) AND b", out: "((NOT a IN (1, 2)) AND b)"},
+		{in: "NOT a NOT IN (1, 2)", out: "(NOT a NOT IN (1, 2))"},
+		{in: "NOT a BETWEEN 1 AND 2 AND c", out: "((NOT a BETWEEN 1 AND 2) AND c)"},
+		{in: "NOT a NOT BETWEEN 1 AND 2 OR c", out: "((NOT a NOT BETWEEN 1 AND 2) OR c)"},
+		{in: "NOT a LIKE 'x%' OR b", out: "((NOT (a LIKE 'x%')) OR b)"},
+		{in: "NOT a NOT LIKE 'x%'", out: "(NOT (a NOT LIKE 'x%'))"},
+		{in: "a = 1 AND b NOT LIKE 'x%' AND c", out: "(((a = 1) AND (b NOT LIKE 'x%')) AND c)"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			parser, err := NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			expr, err := parser.ParseExpr()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if act := parenthesize(expr); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+		})
+	}
+}
+
 func TestParser_ParseFile(t *testing.T) {
 
 	cases := []struct {
@@ -1958,8 +3934,8 @@ select 1 from test;`,
 					List: []*sqlast.Comment{
 						{
 							Text: "comment",
-							From: sqltoken.NewPos(1, 1),
-							To:   sqltoken.NewPos(1, 10),
+							From: sqltoken.Pos{Line: 1, Col: 1, Offset: 0},
+							To:   sqltoken.Pos{Line: 1, Col: 10, Offset: 9},
 						},
 					},
 				},
@@ -1990,8 +3966,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "aaa",
-							From: sqltoken.NewPos(3, 37),
-							To:   sqltoken.NewPos(3, 42),
+							From: sqltoken.Pos{Line: 3, Col: 37, Offset: 60},
+							To:   sqltoken.Pos{Line: 3, Col: 42, Offset: 65},
 						},
 					},
 				},
@@ -1999,8 +3975,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "bbb",
-							From: sqltoken.NewPos(4, 5),
-							To:   sqltoken.NewPos(4, 12),
+							From: sqltoken.Pos{Line: 4, Col: 5, Offset: 67},
+							To:   sqltoken.Pos{Line: 4, Col: 12, Offset: 74},
 						},
 					},
 				},
@@ -2008,8 +3984,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "ccc",
-							From: sqltoken.NewPos(6, 11),
-							To:   sqltoken.NewPos(6, 18),
+							From: sqltoken.Pos{Line: 6, Col: 11, Offset: 117},
+							To:   sqltoken.Pos{Line: 6, Col: 18, Offset: 124},
 						},
 					},
 				},
@@ -2017,8 +3993,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "ddd",
-							From: sqltoken.NewPos(6, 48),
-							To:   sqltoken.NewPos(6, 53),
+							From: sqltoken.Pos{Line: 6, Col: 48, Offset: 154},
+							To:   sqltoken.Pos{Line: 6, Col: 53, Offset: 159},
 						},
 					},
 				},
@@ -2026,13 +4002,13 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "eee",
-							From: sqltoken.NewPos(9, 1),
-							To:   sqltoken.NewPos(9, 6),
+							From: sqltoken.Pos{Line: 9, Col: 1, Offset: 164},
+							To:   sqltoken.Pos{Line: 9, Col: 6, Offset: 169},
 						},
 						{
 							Text: "fff\nggg\n",
-							From: sqltoken.NewPos(11, 1),
-							To:   sqltoken.NewPos(13, 3),
+							From: sqltoken.Pos{Line: 11, Col: 1, Offset: 171},
+							To:   sqltoken.Pos{Line: 13, Col: 3, Offset: 183},
 						},
 					},
 				},
@@ -2040,8 +4016,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "hhh",
-							From: sqltoken.NewPos(14, 21),
-							To:   sqltoken.NewPos(14, 26),
+							From: sqltoken.Pos{Line: 14, Col: 21, Offset: 204},
+							To:   sqltoken.Pos{Line: 14, Col: 26, Offset: 209},
 						},
 					},
 				},
@@ -2049,13 +4025,13 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "jjj",
-							From: sqltoken.NewPos(15, 1),
-							To:   sqltoken.NewPos(15, 8),
+							From: sqltoken.Pos{Line: 15, Col: 1, Offset: 210},
+							To:   sqltoken.Pos{Line: 15, Col: 8, Offset: 217},
 						},
 						{
 							Text: "kkk",
-							From: sqltoken.NewPos(15, 9),
-							To:   sqltoken.NewPos(15, 14),
+							From: sqltoken.Pos{Line: 15, Col: 9, Offset: 218},
+							To:   sqltoken.Pos{Line: 15, Col: 14, Offset: 223},
 						},
 					},
 				},
@@ -2063,13 +4039,13 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "lll",
-							From: sqltoken.NewPos(16, 21),
-							To:   sqltoken.NewPos(16, 28),
+							From: sqltoken.Pos{Line: 16, Col: 21, Offset: 244},
+							To:   sqltoken.Pos{Line: 16, Col: 28, Offset: 251},
 						},
 						{
 							Text: "mmm",
-							From: sqltoken.NewPos(16, 29),
-							To:   sqltoken.NewPos(16, 34),
+							From: sqltoken.Pos{Line: 16, Col: 29, Offset: 252},
+							To:   sqltoken.Pos{Line: 16, Col: 34, Offset: 257},
 						},
 					},
 				},
@@ -2077,8 +4053,8 @@ select 1 from test; /*lll*/ --mmm
 					List: []*sqlast.Comment{
 						{
 							Text: "nnn",
-							From: sqltoken.NewPos(17, 1),
-							To:   sqltoken.NewPos(17, 6),
+							From: sqltoken.Pos{Line: 17, Col: 1, Offset: 258},
+							To:   sqltoken.Pos{Line: 17, Col: 6, Offset: 263},
 						},
 					},
 				},
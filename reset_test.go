@@ -0,0 +1,126 @@
+package xsqlparser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestParser_Reset(t *testing.T) {
+	cases := []struct {
+		src      string
+		out      string
+		comments int
+	}{
+		{src: "SELECT a FROM t WHERE b = 1;", out: "SELECT a FROM t WHERE b = 1"},
+		{src: "-- comment\nINSERT INTO t (a) VALUES (1);", out: "INSERT INTO t (a) VALUES (1)", comments: 1},
+		{src: "UPDATE t SET a = 2;", out: "UPDATE t SET a = 2"},
+	}
+
+	t.Run("tokenized", func(t *testing.T) {
+		parser, err := NewParser(strings.NewReader("DELETE FROM t;"), &dialect.GenericSQLDialect{}, ParseComment())
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		first, err := parser.ParseFile()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+
+		for _, c := range cases {
+			if err := parser.Reset(strings.NewReader(c.src)); err != nil {
+				t.Fatalf("%+v", err)
+			}
+			file, err := parser.ParseFile()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if len(file.Stmts) != 1 {
+				t.Fatalf("must be 1 statement but %d", len(file.Stmts))
+			}
+			if act := file.Stmts[0].ToSQLString(); act != c.out {
+				t.Errorf("must be %s but %s", c.out, act)
+			}
+			if len(file.Comments) != c.comments {
+				t.Errorf("must be %d comments but %d", c.comments, len(file.Comments))
+			}
+		}
+
+		// nodes parsed before Reset are not changed
+		if act := first.Stmts[0].ToSQLString(); act != "DELETE FROM t" {
+			t.Errorf("must be DELETE FROM t but %s", act)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		parser := NewStreamParser(strings.NewReader("SELECT 1; SELECT 2;"), &dialect.GenericSQLDialect{})
+		if _, err := parser.Next(); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if err := parser.Reset(strings.NewReader("UPDATE t SET a = 2")); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmt, err := parser.Next()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if act := stmt.ToSQLString(); act != "UPDATE t SET a = 2" {
+			t.Errorf("must be UPDATE t SET a = 2 but %s", act)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		parser, err := NewParser(strings.NewReader("SELECT 1;"), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		err = parser.Reset(strings.NewReader("SELECT 'a"))
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Fatalf("must be ParseError but %+v", err)
+		}
+
+		// the parser can be reset after the error
+		if err := parser.Reset(strings.NewReader("SELECT 2;")); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if _, err := parser.ParseSQL(); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	})
+}
+
+const benchmarkQuery = "SELECT id, name FROM users WHERE id = $1 AND deleted_at IS NULL ORDER BY name LIMIT 10;"
+
+func BenchmarkNewParser(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		parser, err := NewParser(strings.NewReader(benchmarkQuery), &dialect.PostgresqlDialect{})
+		if err != nil {
+			b.Fatalf("%+v", err)
+		}
+		if _, err := parser.ParseSQL(); err != nil {
+			b.Fatalf("%+v", err)
+		}
+	}
+}
+
+func BenchmarkParser_Reset(b *testing.B) {
+	b.ReportAllocs()
+	parser, err := NewParser(strings.NewReader(""), &dialect.PostgresqlDialect{})
+	if err != nil {
+		b.Fatalf("%+v", err)
+	}
+	r := strings.NewReader(benchmarkQuery)
+	for i := 0; i < b.N; i++ {
+		r.Reset(benchmarkQuery)
+		if err := parser.Reset(r); err != nil {
+			b.Fatalf("%+v", err)
+		}
+		if _, err := parser.ParseSQL(); err != nil {
+			b.Fatalf("%+v", err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package schema
+
+import (
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Build returns the schema defined by CREATE TABLE, CREATE INDEX and CREATE VIEW
+// statements of file. Other statements are ignored.
+// It is an error to define the same table, view or index twice without IF NOT EXISTS,
+// or to create an index on an undefined table.
+func Build(file *sqlast.File) (*Schema, error) {
+	s := &Schema{}
+	for _, stmt := range file.Stmts {
+		if err := s.add(stmt); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *Schema) add(stmt sqlast.Stmt) error {
+	switch stmt := stmt.(type) {
+	case *sqlast.CreateTableStmt:
+		name := stmt.Name.ToSQLString()
+		if s.Table(name) != nil {
+			if stmt.NotExists {
+				return nil
+			}
+			return errors.Errorf("table %s is already defined", name)
+		}
+		t, err := buildTable(stmt)
+		if err != nil {
+			return errors.Errorf("buildTable %s failed: %w", name, err)
+		}
+		s.Tables = append(s.Tables, t)
+	case *sqlast.CreateIndexStmt:
+		name := stmt.TableName.ToSQLString()
+		t := s.Table(name)
+		if t == nil {
+			return errors.Errorf("table %s of index %s is not defined", name, identName(stmt.IndexName))
+		}
+		if stmt.IndexName != nil && t.Index(identName(stmt.IndexName)) != nil {
+			if stmt.NotExists {
+				return nil
+			}
+			return errors.Errorf("index %s is already defined", identName(stmt.IndexName))
+		}
+		t.Indexes = append(t.Indexes, buildIndex(stmt))
+	case *sqlast.CreateViewStmt:
+		name := stmt.Name.ToSQLString()
+		if s.View(name) != nil {
+			switch {
+			case stmt.NotExists:
+				return nil
+			case stmt.OrReplace:
+				s.removeView(name)
+			default:
+				return errors.Errorf("view %s is already defined", name)
+			}
+		}
+		s.Views = append(s.Views, &View{
+			Name:         name,
+			Columns:      identNames(stmt.Columns),
+			Materialized: stmt.Materialized,
+			Query:        stmt.Query,
+			Stmt:         stmt,
+		})
+	}
+	return nil
+}
+
+func (s *Schema) removeView(name string) {
+	for i, v := range s.Views {
+		if v.Name == name {
+			s.Views = append(s.Views[:i], s.Views[i+1:]...)
+			return
+		}
+	}
+}
+
+func buildTable(stmt *sqlast.CreateTableStmt) (*Table, error) {
+	t := &Table{Name: stmt.Name.ToSQLString(), Stmt: stmt}
+
+	for _, e := range stmt.Elements {
+		switch e := e.(type) {
+		case *sqlast.ColumnDef:
+			if t.Column(e.Name.ToSQLString()) != nil {
+				return nil, errors.Errorf("column %s is already defined", e.Name.ToSQLString())
+			}
+			if err := t.addColumn(e); err != nil {
+				return nil, err
+			}
+		case *sqlast.TableConstraint:
+			if err := t.addConstraint(identName(e.Name), e.Spec); err != nil {
+				return nil, err
+			}
+		case *sqlast.IndexTableElement:
+			index := &Index{
+				Name:   identName(e.Name),
+				Unique: e.Type == sqlast.UniqueIndex,
+				Kind:   e.Type,
+				Method: identName(e.Using),
+				Stmt:   e,
+			}
+			for _, c := range e.Columns {
+				index.Columns = append(index.Columns, c.ToSQLString())
+			}
+			t.Indexes = append(t.Indexes, index)
+		}
+	}
+
+	if t.PrimaryKey != nil {
+		for _, name := range t.PrimaryKey.Columns {
+			c := t.Column(name)
+			if c == nil {
+				return nil, errors.Errorf("column %s of primary key is not defined", name)
+			}
+			c.NotNull = true
+		}
+	}
+	return t, nil
+}
+
+func (t *Table) addColumn(def *sqlast.ColumnDef) error {
+	c := &Column{
+		Name:    def.Name.ToSQLString(),
+		Type:    def.DataType,
+		Default: def.Default,
+		Def:     def,
+	}
+	for _, d := range def.MyDataTypeDecoration {
+		if _, ok := d.(*sqlast.AutoIncrement); ok {
+			c.AutoIncrement = true
+		}
+	}
+	t.Columns = append(t.Columns, c)
+
+	for _, constraint := range def.Constraints {
+		name := identName(constraint.Name)
+		switch spec := constraint.Spec.(type) {
+		case *sqlast.NotNullColumnSpec:
+			c.NotNull = true
+		case *sqlast.UniqueColumnSpec:
+			key := &Key{Name: name, Columns: []string{c.Name}, Column: c.Name}
+			if !spec.IsPrimaryKey {
+				t.UniqueKeys = append(t.UniqueKeys, key)
+				continue
+			}
+			if t.PrimaryKey != nil {
+				return errors.Errorf("multiple primary keys are defined")
+			}
+			t.PrimaryKey = key
+			c.AutoIncrement = c.AutoIncrement || spec.IsAutoIncrement
+		case *sqlast.ReferencesColumnSpec:
+			t.ForeignKeys = append(t.ForeignKeys, &ForeignKey{
+				Name:       name,
+				Columns:    []string{c.Name},
+				RefTable:   spec.TableName.ToSQLString(),
+				RefColumns: identNames(spec.Columns),
+				Column:     c.Name,
+			})
+		case *sqlast.CheckColumnSpec:
+			t.Checks = append(t.Checks, &Check{Name: name, Expr: spec.Expr, Column: c.Name})
+		}
+	}
+	return nil
+}
+
+func (t *Table) addConstraint(name string, spec sqlast.TableConstraintSpec) error {
+	switch spec := spec.(type) {
+	case *sqlast.UniqueTableConstraint:
+		key := &Key{Name: name, Columns: identNames(spec.Columns)}
+		if !spec.IsPrimary {
+			t.UniqueKeys = append(t.UniqueKeys, key)
+			return nil
+		}
+		if t.PrimaryKey != nil {
+			return errors.Errorf("multiple primary keys are defined")
+		}
+		t.PrimaryKey = key
+	case *sqlast.ReferentialTableConstraint:
+		t.ForeignKeys = append(t.ForeignKeys, &ForeignKey{
+			Name:       name,
+			Columns:    identNames(spec.Columns),
+			RefTable:   spec.KeyExpr.TableName.ToSQLString(),
+			RefColumns: identNames(spec.KeyExpr.Columns),
+		})
+	case *sqlast.CheckTableConstraint:
+		t.Checks = append(t.Checks, &Check{Name: name, Expr: spec.Expr})
+	}
+	return nil
+}
+
+func buildIndex(stmt *sqlast.CreateIndexStmt) *Index {
+	index := &Index{
+		Name:   identName(stmt.IndexName),
+		Unique: stmt.IsUnique,
+		Method: identName(stmt.MethodName),
+		Stmt:   stmt,
+	}
+	if stmt.IsUnique {
+		index.Kind = sqlast.UniqueIndex
+	}
+	for _, e := range stmt.Columns {
+		index.Columns = append(index.Columns, e.ToSQLString())
+	}
+	return index
+}
+
+// identName returns the name of ident, or empty string if ident is nil.
+func identName(ident *sqlast.Ident) string {
+	if ident == nil {
+		return ""
+	}
+	return ident.ToSQLString()
+}
+
+func identNames(idents []*sqlast.Ident) []string {
+	var names []string
+	for _, i := range idents {
+		names = append(names, i.ToSQLString())
+	}
+	return names
+}
@@ -0,0 +1,188 @@
+package schema
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+func parseFile(t *testing.T, src string) *sqlast.File {
+	t.Helper()
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	file, err := parser.ParseFile()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return file
+}
+
+func TestBuild(t *testing.T) {
+	file := parseFile(t, `
+CREATE TABLE users (
+  id int PRIMARY KEY,
+  email varchar(255) NOT NULL UNIQUE,
+  name text DEFAULT 'anonymous',
+  age int CHECK (age >= 0)
+);
+CREATE TABLE orders (
+  user_id int REFERENCES users(id),
+  item_id int,
+  quantity int NOT NULL,
+  CONSTRAINT orders_pk PRIMARY KEY (user_id, item_id),
+  CONSTRAINT orders_user_fk FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE UNIQUE INDEX users_lower_email ON users (lower(email));
+CREATE INDEX orders_item ON orders USING btree (item_id DESC);
+CREATE VIEW heavy_users (id, total) AS SELECT user_id, SUM(quantity) FROM orders GROUP BY user_id;
+INSERT INTO users (id, email) VALUES (1, 'a@example.com');
+`)
+
+	s, err := Build(file)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	users := s.Table("users")
+	if users == nil {
+		t.Fatal("users must be defined")
+	}
+	if s.Table("unknown") != nil {
+		t.Error("unknown must not be defined")
+	}
+
+	type column struct {
+		Name    string
+		Type    string
+		NotNull bool
+		Default string
+	}
+	var columns []column
+	for _, c := range users.Columns {
+		col := column{Name: c.Name, Type: c.Type.ToSQLString(), NotNull: c.NotNull}
+		if c.Default != nil {
+			col.Default = c.Default.ToSQLString()
+		}
+		columns = append(columns, col)
+	}
+	if diff := cmp.Diff([]column{
+		{Name: "id", Type: "int", NotNull: true},
+		{Name: "email", Type: "character varying(255)", NotNull: true},
+		{Name: "name", Type: "text", Default: "'anonymous'"},
+		{Name: "age", Type: "int"},
+	}, columns); diff != "" {
+		t.Errorf("columns differ: %s", diff)
+	}
+
+	ignore := cmpopts.IgnoreFields(Index{}, "Stmt")
+	if diff := cmp.Diff(&Key{Columns: []string{"id"}, Column: "id"}, users.PrimaryKey); diff != "" {
+		t.Errorf("primary key differs: %s", diff)
+	}
+	if diff := cmp.Diff([]*Key{{Columns: []string{"email"}, Column: "email"}}, users.UniqueKeys); diff != "" {
+		t.Errorf("unique keys differ: %s", diff)
+	}
+	if len(users.Checks) != 1 || users.Checks[0].Expr.ToSQLString() != "age >= 0" {
+		t.Errorf("check must be age >= 0 but %+v", users.Checks)
+	}
+	if diff := cmp.Diff([]*Index{
+		{Name: "users_lower_email", Unique: true, Kind: sqlast.UniqueIndex, Columns: []string{"lower(email)"}},
+	}, users.Indexes, ignore); diff != "" {
+		t.Errorf("indexes differ: %s", diff)
+	}
+
+	orders := s.Table("orders")
+	if diff := cmp.Diff(&Key{Name: "orders_pk", Columns: []string{"user_id", "item_id"}}, orders.PrimaryKey); diff != "" {
+		t.Errorf("primary key differs: %s", diff)
+	}
+	if !orders.Column("item_id").NotNull {
+		t.Error("column of primary key must be NOT NULL")
+	}
+	if diff := cmp.Diff([]*ForeignKey{
+		{Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}, Column: "user_id"},
+		{Name: "orders_user_fk", Columns: []string{"user_id"}, RefTable: "users", RefColumns: []string{"id"}},
+	}, orders.ForeignKeys); diff != "" {
+		t.Errorf("foreign keys differ: %s", diff)
+	}
+	if diff := cmp.Diff([]*Index{
+		{Name: "orders_item", Method: "btree", Columns: []string{"item_id DESC"}},
+	}, orders.Indexes, ignore); diff != "" {
+		t.Errorf("indexes differ: %s", diff)
+	}
+
+	v := s.View("heavy_users")
+	if v == nil {
+		t.Fatal("heavy_users must be defined")
+	}
+	if diff := cmp.Diff([]string{"id", "total"}, v.Columns); diff != "" {
+		t.Errorf("view columns differ: %s", diff)
+	}
+}
+
+func TestBuild_MySQLIndex(t *testing.T) {
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(
+		"CREATE TABLE t (id int AUTO_INCREMENT, name varchar(10), PRIMARY KEY (id), UNIQUE KEY name_key (name(5)), KEY (name));",
+	), &dialect.MySQLDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	file, err := parser.ParseFile()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	s, err := Build(file)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	table := s.Table("t")
+	if !table.Column("id").AutoIncrement || !table.Column("id").NotNull {
+		t.Errorf("id must be AUTO_INCREMENT and NOT NULL: %+v", table.Column("id"))
+	}
+	if diff := cmp.Diff([]*Index{
+		{Name: "name_key", Unique: true, Kind: sqlast.UniqueIndex, Columns: []string{"name(5)"}},
+		{Columns: []string{"name"}},
+	}, table.Indexes, cmpopts.IgnoreFields(Index{}, "Stmt")); diff != "" {
+		t.Errorf("indexes differ: %s", diff)
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+	}{
+		{name: "duplicate table", src: "CREATE TABLE t (a int); CREATE TABLE t (b int);"},
+		{name: "duplicate column", src: "CREATE TABLE t (a int, a int);"},
+		{name: "multiple primary keys", src: "CREATE TABLE t (a int PRIMARY KEY, b int, PRIMARY KEY (b));"},
+		{name: "unknown primary key column", src: "CREATE TABLE t (a int, PRIMARY KEY (b));"},
+		{name: "index of undefined table", src: "CREATE INDEX i ON t (a);"},
+		{name: "duplicate view", src: "CREATE VIEW v AS SELECT 1; CREATE VIEW v AS SELECT 2;"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := Build(parseFile(t, c.src)); err == nil {
+				t.Error("must be error")
+			}
+		})
+	}
+
+	s, err := Build(parseFile(t, "CREATE TABLE t (a int); CREATE TABLE IF NOT EXISTS t (b int); CREATE VIEW v AS SELECT 1; CREATE OR REPLACE VIEW v AS SELECT 2;"))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if s.Table("t").Column("a") == nil {
+		t.Error("IF NOT EXISTS must keep the existing table")
+	}
+	if len(s.Views) != 1 || s.Views[0].Query.ToSQLString() != "SELECT 2" {
+		t.Error("OR REPLACE must replace the existing view")
+	}
+}
@@ -0,0 +1,341 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Diff returns the statements which migrate the schema from to the schema to, in order of execution:
+// DROP VIEW, DROP INDEX, DROP TABLE, CREATE TABLE, ALTER TABLE, CREATE INDEX and CREATE VIEW.
+//
+// Changed views and indexes are dropped and created again.
+// Columns are changed with MODIFY COLUMN if d supports dialect.ModifyColumn,
+// otherwise with ALTER COLUMN actions.
+// Constraints and indexes are identified by their names, or by their definitions if unnamed.
+// Unnamed constraints and indexes can't be dropped, so removing them is not included,
+// except for primary keys which are dropped with DROP PRIMARY KEY if d supports dialect.DropPrimaryKey,
+// otherwise with DROP CONSTRAINT table_pkey, the name PostgreSQL gives.
+// Renaming is written as dropping the old one and adding the new one.
+func Diff(from, to *Schema, d dialect.Dialect) []sqlast.Stmt {
+	var (
+		drops, creates, alters, createIndexes []sqlast.Stmt
+		dropViews, createViews                []sqlast.Stmt
+	)
+
+	for _, ov := range from.Views {
+		if nv := to.View(ov.Name); nv == nil || !sameView(ov, nv) {
+			dropViews = append(dropViews, &sqlast.DropViewStmt{
+				Materialized: ov.Materialized,
+				ViewNames:    []*sqlast.ObjectName{sqlast.NewObjectName(ov.Name)},
+			})
+		}
+	}
+	for _, nv := range to.Views {
+		if ov := from.View(nv.Name); ov == nil || !sameView(ov, nv) {
+			createViews = append(createViews, nv.Stmt)
+		}
+	}
+
+	for i := len(from.Tables) - 1; i >= 0; i-- {
+		if ot := from.Tables[i]; to.Table(ot.Name) == nil {
+			drops = append(drops, &sqlast.DropTableStmt{
+				TableNames: []*sqlast.ObjectName{sqlast.NewObjectName(ot.Name)},
+			})
+		}
+	}
+
+	var dropIndexes []sqlast.Stmt
+	for _, nt := range to.Tables {
+		ot := from.Table(nt.Name)
+		if ot == nil {
+			creates = append(creates, nt.Stmt)
+			for _, index := range nt.Indexes {
+				if stmt, ok := index.Stmt.(*sqlast.CreateIndexStmt); ok {
+					createIndexes = append(createIndexes, stmt)
+				}
+			}
+			continue
+		}
+
+		if alter := alterTable(ot, nt, d); alter != nil {
+			alters = append(alters, alter)
+		}
+
+		for _, i := range removed(indexKeys(ot.Indexes), indexKeys(nt.Indexes)) {
+			if index := ot.Indexes[i]; index.Name != "" {
+				dropIndexes = append(dropIndexes, dropIndex(ot, index, d))
+			}
+		}
+		for _, i := range removed(indexKeys(nt.Indexes), indexKeys(ot.Indexes)) {
+			createIndexes = append(createIndexes, createIndex(nt, nt.Indexes[i]))
+		}
+	}
+
+	var stmts []sqlast.Stmt
+	for _, list := range [][]sqlast.Stmt{dropViews, dropIndexes, drops, creates, alters, createIndexes, createViews} {
+		stmts = append(stmts, list...)
+	}
+	return stmts
+}
+
+func sameView(a, b *View) bool {
+	return a.Materialized == b.Materialized &&
+		strings.Join(a.Columns, ",") == strings.Join(b.Columns, ",") &&
+		a.Query.ToSQLString() == b.Query.ToSQLString()
+}
+
+// key identifies a constraint or an index.
+type key struct {
+	name string // empty if unnamed
+	def  string
+}
+
+// removed returns indices of keys of a which are not in b.
+// Keys are the same if both of their names and definitions are the same.
+func removed(a, b []key) []int {
+	defs := make(map[key]bool)
+	for _, k := range b {
+		defs[k] = true
+	}
+	var indices []int
+	for i, k := range a {
+		if !defs[k] {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func indexKeys(indexes []*Index) []key {
+	var keys []key
+	for _, i := range indexes {
+		keys = append(keys, key{name: i.Name, def: indexDef(i)})
+	}
+	return keys
+}
+
+// indexDef returns the SQL of index without options which don't change the index,
+// so that changes of WHERE and INCLUDE are detected.
+func indexDef(index *Index) string {
+	if stmt, ok := index.Stmt.(*sqlast.CreateIndexStmt); ok {
+		s := *stmt
+		s.Concurrently = false
+		s.NotExists = false
+		return s.ToSQLString()
+	}
+	if index.Stmt != nil {
+		return index.Stmt.ToSQLString()
+	}
+	return fmt.Sprintf("%v %d %s (%s)", index.Unique, index.Kind, index.Method, strings.Join(index.Columns, ", "))
+}
+
+func dropIndex(t *Table, index *Index, d dialect.Dialect) sqlast.Stmt {
+	stmt := &sqlast.DropIndexStmt{
+		IndexNames: []*sqlast.ObjectName{sqlast.NewObjectName(index.Name)},
+	}
+	if dialect.Supports(d, dialect.DropIndexOnTable) {
+		stmt.TableName = sqlast.NewObjectName(t.Name)
+	}
+	return stmt
+}
+
+func createIndex(t *Table, index *Index) sqlast.Stmt {
+	if stmt, ok := index.Stmt.(*sqlast.CreateIndexStmt); ok {
+		return stmt
+	}
+
+	// KEY / INDEX element of CREATE TABLE
+	e := index.Stmt.(*sqlast.IndexTableElement)
+	stmt := &sqlast.CreateIndexStmt{
+		TableName:  sqlast.NewObjectName(t.Name),
+		IsUnique:   index.Unique,
+		IndexName:  e.Name,
+		MethodName: e.Using,
+		Comment:    e.Comment,
+	}
+	for _, c := range e.Columns {
+		stmt.Columns = append(stmt.Columns, &sqlast.IndexElement{Expr: c})
+	}
+	return stmt
+}
+
+// constraint is a constraint of the schema model with its definition.
+type constraint struct {
+	def    *sqlast.TableConstraint
+	column string // column whose definition has the constraint
+}
+
+func constraints(t *Table) []*constraint {
+	var cs []*constraint
+	add := func(name, column string, spec sqlast.TableConstraintSpec) {
+		c := &sqlast.TableConstraint{Spec: spec}
+		if name != "" {
+			c.Name = sqlast.NewIdent(name)
+		}
+		cs = append(cs, &constraint{def: c, column: column})
+	}
+
+	if pk := t.PrimaryKey; pk != nil {
+		add(pk.Name, pk.Column, &sqlast.UniqueTableConstraint{IsPrimary: true, Columns: idents(pk.Columns)})
+	}
+	for _, u := range t.UniqueKeys {
+		add(u.Name, u.Column, &sqlast.UniqueTableConstraint{Columns: idents(u.Columns)})
+	}
+	for _, fk := range t.ForeignKeys {
+		add(fk.Name, fk.Column, &sqlast.ReferentialTableConstraint{
+			Columns: idents(fk.Columns),
+			KeyExpr: &sqlast.ReferenceKeyExpr{
+				TableName: sqlast.NewIdent(fk.RefTable),
+				Columns:   idents(fk.RefColumns),
+			},
+		})
+	}
+	for _, c := range t.Checks {
+		add(c.Name, c.Column, &sqlast.CheckTableConstraint{Expr: c.Expr})
+	}
+	return cs
+}
+
+func constraintKeys(cs []*constraint) []key {
+	var keys []key
+	for _, c := range cs {
+		name := ""
+		if c.def.Name != nil {
+			name = c.def.Name.ToSQLString()
+		}
+		keys = append(keys, key{name: name, def: c.def.Spec.ToSQLString()})
+	}
+	return keys
+}
+
+func alterTable(ot, nt *Table, d dialect.Dialect) *sqlast.AlterTableStmt {
+	var actions []sqlast.AlterTableAction
+
+	ocs, ncs := constraints(ot), constraints(nt)
+	for _, i := range removed(constraintKeys(ocs), constraintKeys(ncs)) {
+		if a := dropConstraint(ot, ocs[i], d); a != nil {
+			actions = append(actions, a)
+		}
+	}
+
+	for _, oc := range ot.Columns {
+		if nt.Column(oc.Name) == nil {
+			actions = append(actions, &sqlast.RemoveColumnTableAction{Name: sqlast.NewIdent(oc.Name)})
+		}
+	}
+
+	added := make(map[string]bool)
+	for _, nc := range nt.Columns {
+		oc := ot.Column(nc.Name)
+		if oc == nil {
+			added[nc.Name] = true
+			actions = append(actions, &sqlast.AddColumnTableAction{Column: nc.Def})
+			continue
+		}
+		actions = append(actions, alterColumn(oc, nc, d)...)
+	}
+
+	for _, i := range removed(constraintKeys(ncs), constraintKeys(ocs)) {
+		// constraints of added columns are added with their definitions
+		if c := ncs[i]; !added[c.column] {
+			actions = append(actions, &sqlast.AddConstraintTableAction{Constraint: c.def})
+		}
+	}
+
+	if len(actions) == 0 {
+		return nil
+	}
+	return &sqlast.AlterTableStmt{
+		TableName: sqlast.NewObjectName(nt.Name),
+		Actions:   actions,
+	}
+}
+
+// dropConstraint returns the action which drops c, or nil if c can't be dropped.
+func dropConstraint(t *Table, c *constraint, d dialect.Dialect) sqlast.AlterTableAction {
+	if u, ok := c.def.Spec.(*sqlast.UniqueTableConstraint); ok && u.IsPrimary {
+		if dialect.Supports(d, dialect.DropPrimaryKey) {
+			return &sqlast.MyDropPrimaryKeyTableAction{}
+		}
+		if c.def.Name == nil {
+			name := t.Name[strings.LastIndex(t.Name, ".")+1:]
+			if q := strings.TrimSuffix(name, `"`); q != name {
+				return &sqlast.DropConstraintTableAction{Name: &sqlast.Ident{Value: q + `_pkey"`, QuoteStyle: '"'}}
+			}
+			return &sqlast.DropConstraintTableAction{Name: sqlast.NewIdent(name + "_pkey")}
+		}
+	}
+	if c.def.Name == nil {
+		return nil
+	}
+	return &sqlast.DropConstraintTableAction{Name: c.def.Name}
+}
+
+func alterColumn(oc, nc *Column, d dialect.Dialect) []sqlast.AlterTableAction {
+	typeChanged := nodeSQL(oc.Type) != nodeSQL(nc.Type)
+	defaultChanged := nodeSQL(oc.Default) != nodeSQL(nc.Default)
+	notNullChanged := oc.NotNull != nc.NotNull
+	if !typeChanged && !defaultChanged && !notNullChanged && oc.AutoIncrement == nc.AutoIncrement {
+		return nil
+	}
+
+	if dialect.Supports(d, dialect.ModifyColumn) {
+		// constraints other than NOT NULL are not changed by MODIFY COLUMN
+		def := &sqlast.ColumnDef{
+			Name:                 nc.Def.Name,
+			DataType:             nc.Type,
+			Default:              nc.Default,
+			MyDataTypeDecoration: nc.Def.MyDataTypeDecoration,
+		}
+		if nc.NotNull {
+			def.Constraints = []*sqlast.ColumnConstraint{{Spec: &sqlast.NotNullColumnSpec{}}}
+		}
+		return []sqlast.AlterTableAction{&sqlast.MyModifyColumnTableAction{Column: def}}
+	}
+
+	var actions []sqlast.AlterColumnAction
+	if typeChanged {
+		actions = append(actions, &sqlast.PGAlterDataTypeColumnAction{DataType: nc.Type})
+	}
+	if defaultChanged {
+		if nc.Default != nil {
+			actions = append(actions, &sqlast.SetDefaultColumnAction{Default: nc.Default})
+		} else {
+			actions = append(actions, &sqlast.DropDefaultColumnAction{})
+		}
+	}
+	if notNullChanged {
+		if nc.NotNull {
+			actions = append(actions, &sqlast.PGSetNotNullColumnAction{})
+		} else {
+			actions = append(actions, &sqlast.PGDropNotNullColumnAction{})
+		}
+	}
+
+	var tableActions []sqlast.AlterTableAction
+	for _, a := range actions {
+		tableActions = append(tableActions, &sqlast.AlterColumnTableAction{
+			ColumnName: sqlast.NewIdent(nc.Name),
+			Action:     a,
+		})
+	}
+	return tableActions
+}
+
+func nodeSQL(n sqlast.Node) string {
+	if n == nil {
+		return ""
+	}
+	return n.ToSQLString()
+}
+
+func idents(names []string) []*sqlast.Ident {
+	var ids []*sqlast.Ident
+	for _, n := range names {
+		ids = append(ids, sqlast.NewIdent(n))
+	}
+	return ids
+}
@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestDiff(t *testing.T) {
+	from := `
+CREATE TABLE users (
+  id int PRIMARY KEY,
+  email varchar(255),
+  name text,
+  legacy int
+);
+CREATE TABLE sessions (id int);
+CREATE TABLE tags (id int PRIMARY KEY, name text NOT NULL);
+CREATE TABLE orders (
+  id int,
+  user_id int,
+  CONSTRAINT orders_user_fk FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE INDEX users_name ON users (name);
+CREATE INDEX orders_user ON orders (user_id);
+CREATE INDEX orders_id ON orders (id) WHERE user_id IS NOT NULL;
+CREATE VIEW active_users AS SELECT id FROM users;
+`
+	to := `
+CREATE TABLE users (
+  id int PRIMARY KEY,
+  email varchar(320) NOT NULL,
+  name text DEFAULT 'anonymous',
+  created_at timestamp NOT NULL UNIQUE,
+  CONSTRAINT users_email_key UNIQUE (email)
+);
+CREATE TABLE orders (
+  id int,
+  user_id int,
+  CONSTRAINT orders_user_fk FOREIGN KEY (user_id) REFERENCES users(id)
+);
+CREATE TABLE tags (id int NOT NULL, name text NOT NULL, PRIMARY KEY (id, name));
+CREATE TABLE items (id int PRIMARY KEY);
+CREATE INDEX users_name ON users (lower(name));
+CREATE INDEX orders_user ON orders (user_id);
+CREATE INDEX orders_id ON orders (id) WHERE user_id IS NULL;
+CREATE INDEX items_id ON items (id);
+CREATE VIEW active_users AS SELECT id, email FROM users;
+`
+
+	cases := []struct {
+		name    string
+		dialect dialect.Dialect
+		out     []string
+	}{
+		{
+			name:    "postgresql",
+			dialect: &dialect.PostgresqlDialect{},
+			out: []string{
+				"DROP VIEW active_users",
+				"DROP INDEX users_name",
+				"DROP INDEX orders_id",
+				"DROP TABLE sessions",
+				"CREATE TABLE items (id int PRIMARY KEY)",
+				"ALTER TABLE users DROP COLUMN legacy, " +
+					"ALTER COLUMN email TYPE character varying(320), " +
+					"ALTER COLUMN email SET NOT NULL, " +
+					"ALTER COLUMN name SET DEFAULT 'anonymous', " +
+					"ADD COLUMN created_at timestamp NOT NULL UNIQUE, " +
+					"ADD CONSTRAINT users_email_key UNIQUE(email)",
+				"ALTER TABLE tags DROP CONSTRAINT tags_pkey, ADD PRIMARY KEY(id, name)",
+				"CREATE INDEX users_name ON users (lower(name))",
+				"CREATE INDEX orders_id ON orders (id) WHERE user_id IS NULL",
+				"CREATE INDEX items_id ON items (id)",
+				"CREATE VIEW active_users AS SELECT id, email FROM users",
+			},
+		},
+		{
+			name:    "mysql",
+			dialect: &dialect.MySQLDialect{},
+			out: []string{
+				"DROP VIEW active_users",
+				"DROP INDEX users_name ON users",
+				"DROP INDEX orders_id ON orders",
+				"DROP TABLE sessions",
+				"CREATE TABLE items (id int PRIMARY KEY)",
+				"ALTER TABLE users DROP COLUMN legacy, " +
+					"MODIFY COLUMN email character varying(320) NOT NULL, " +
+					"MODIFY COLUMN name text DEFAULT 'anonymous', " +
+					"ADD COLUMN created_at timestamp NOT NULL UNIQUE, " +
+					"ADD CONSTRAINT users_email_key UNIQUE(email)",
+				"ALTER TABLE tags DROP PRIMARY KEY, ADD PRIMARY KEY(id, name)",
+				"CREATE INDEX users_name ON users (lower(name))",
+				"CREATE INDEX orders_id ON orders (id) WHERE user_id IS NULL",
+				"CREATE INDEX items_id ON items (id)",
+				"CREATE VIEW active_users AS SELECT id, email FROM users",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fromSchema, err := Build(parseFile(t, from))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			toSchema, err := Build(parseFile(t, to))
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var act []string
+			for _, stmt := range Diff(fromSchema, toSchema, c.dialect) {
+				sql := stmt.ToSQLString()
+				parser, err := xsqlparser.NewParser(bytes.NewBufferString(sql), c.dialect)
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if _, err := parser.ParseStatement(); err != nil {
+					t.Errorf("%s must be parsed: %+v", sql, err)
+				}
+				act = append(act, sql)
+			}
+			if diff := cmp.Diff(c.out, act); diff != "" {
+				t.Errorf("diff: %s", diff)
+			}
+
+			if stmts := Diff(toSchema, toSchema, c.dialect); len(stmts) != 0 {
+				t.Errorf("same schemas must have no diff but %d statements", len(stmts))
+			}
+		})
+	}
+}
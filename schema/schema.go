@@ -0,0 +1,115 @@
+// Package schema builds a model of tables, columns, constraints, indexes and views
+// from DDL statements.
+package schema
+
+import (
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Schema is a set of tables and views defined by DDL statements.
+// Tables and views are in order of definition.
+// Names are compared as written in SQL, including the schema name and quotes.
+type Schema struct {
+	Tables []*Table
+	Views  []*View
+}
+
+// Table returns the table named name, or nil if not defined.
+func (s *Schema) Table(name string) *Table {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// View returns the view named name, or nil if not defined.
+func (s *Schema) View(name string) *View {
+	for _, v := range s.Views {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+type Table struct {
+	Name        string
+	Columns     []*Column
+	PrimaryKey  *Key // nil if the table doesn't have a primary key
+	UniqueKeys  []*Key
+	ForeignKeys []*ForeignKey
+	Checks      []*Check
+	Indexes     []*Index // CREATE INDEX and KEY / INDEX elements of CREATE TABLE (MySQL)
+	Stmt        *sqlast.CreateTableStmt
+}
+
+// Column returns the column named name, or nil if the table doesn't have it.
+func (t *Table) Column(name string) *Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// Index returns the index named name, or nil if the table doesn't have it.
+func (t *Table) Index(name string) *Index {
+	for _, i := range t.Indexes {
+		if i.Name == name {
+			return i
+		}
+	}
+	return nil
+}
+
+type Column struct {
+	Name          string
+	Type          sqlast.Type
+	NotNull       bool        // NOT NULL or PRIMARY KEY
+	Default       sqlast.Node // nil if omitted
+	AutoIncrement bool        // AUTO_INCREMENT (MySQL) or PRIMARY KEY AUTOINCREMENT (SQLite)
+	Def           *sqlast.ColumnDef
+}
+
+// Key is a PRIMARY KEY or UNIQUE constraint.
+type Key struct {
+	Name    string // empty if the constraint isn't named
+	Columns []string
+	Column  string // column whose definition has the constraint. empty if a table constraint
+}
+
+// ForeignKey is a FOREIGN KEY constraint or REFERENCES of a column.
+type ForeignKey struct {
+	Name       string // empty if the constraint isn't named
+	Columns    []string
+	RefTable   string
+	RefColumns []string // empty if the referenced columns are omitted
+	Column     string   // column whose definition has the constraint. empty if a table constraint
+}
+
+// Check is a CHECK constraint of a table or a column.
+type Check struct {
+	Name   string // empty if the constraint isn't named
+	Expr   sqlast.Node
+	Column string // column whose definition has the constraint. empty if a table constraint
+}
+
+type Index struct {
+	Name    string // empty if the index isn't named
+	Unique  bool
+	Kind    sqlast.IndexKind
+	Method  string      // USING method. empty if omitted
+	Columns []string    // SQL of each column or expression i.e: email, lower(name) DESC
+	Stmt    sqlast.Node // *sqlast.CreateIndexStmt or *sqlast.IndexTableElement
+}
+
+type View struct {
+	Name         string
+	Columns      []string // empty if the column list is omitted
+	Materialized bool
+	Query        *sqlast.QueryStmt
+	Stmt         *sqlast.CreateViewStmt
+}
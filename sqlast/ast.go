@@ -22,6 +22,21 @@ type Node interface {
 	WriteTo(w io.Writer) (n int64, err error)
 }
 
+//go:generate genkind
+
+// NodeKind identifies the concrete type of a Node without reflection.
+// Every node type in this package has a Kind method which returns its own NodeKind.
+type NodeKind int
+
+// KindOf returns the kind of node.
+// It returns KindInvalid for nil and node types defined outside of this package.
+func KindOf(node Node) NodeKind {
+	if k, ok := node.(interface{ Kind() NodeKind }); ok {
+		return k.Kind()
+	}
+	return KindInvalid
+}
+
 type File struct {
 	Stmts    []Stmt
 	Comments []*CommentGroup
@@ -53,7 +68,7 @@ func (f *File) ToSQLString() string {
 }
 
 func (f *File) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	for i, stmt := range f.Stmts {
 		sw.JoinNewLine(i, stmt)
 	}
@@ -62,8 +77,9 @@ func (f *File) WriteTo(w io.Writer) (int64, error) {
 
 // Identifier
 type Ident struct {
-	Value    string
-	From, To sqltoken.Pos
+	Value      string // as written in SQL, including quotes
+	QuoteStyle rune   // quote character of delimited identifier, 0 if not quoted
+	From, To   sqltoken.Pos
 }
 
 func NewIdent(str string) *Ident {
@@ -110,8 +126,9 @@ func (s *Wildcard) Pos() sqltoken.Pos {
 
 func (s *Wildcard) End() sqltoken.Pos {
 	return sqltoken.Pos{
-		Line: s.Wildcard.Line,
-		Col:  s.Wildcard.Col + 1,
+		Line:   s.Wildcard.Line,
+		Col:    s.Wildcard.Col + 1,
+		Offset: s.Wildcard.Offset + 1,
 	}
 }
 
@@ -141,7 +158,7 @@ func (s *QualifiedWildcard) ToSQLString() string {
 }
 
 func (s *QualifiedWildcard) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Idents(s.Idents, []byte(".")).Bytes([]byte(".*")).End()
+	return NewSQLWriter(w).Idents(s.Idents, []byte(".")).Bytes([]byte(".*")).End()
 }
 
 // table.column / schema.table.column
@@ -162,12 +179,59 @@ func (s *CompoundIdent) ToSQLString() string {
 }
 
 func (s *CompoundIdent) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Idents(s.Idents, []byte(".")).End()
+	return NewSQLWriter(w).Idents(s.Idents, []byte(".")).End()
+}
+
+// PlaceholderStyle is the syntax of a bind parameter.
+type PlaceholderStyle int
+
+const (
+	// `?`
+	QuestionPlaceholder PlaceholderStyle = iota
+	// `$1`
+	DollarPlaceholder
+	// `:name`
+	ColonPlaceholder
+	// `@name`
+	AtPlaceholder
+)
+
+// bind parameter of prepared statements
+type Placeholder struct {
+	From, To sqltoken.Pos
+	Style    PlaceholderStyle
+	Index    int    // DollarPlaceholder only
+	Name     string // ColonPlaceholder and AtPlaceholder only
+}
+
+func (s *Placeholder) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *Placeholder) End() sqltoken.Pos {
+	return s.To
+}
+
+func (s *Placeholder) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *Placeholder) WriteTo(w io.Writer) (int64, error) {
+	switch s.Style {
+	case DollarPlaceholder:
+		return NewSQLWriter(w).Bytes([]byte("$")).Int(s.Index).End()
+	case ColonPlaceholder:
+		return writeSingleString(w, ":"+s.Name)
+	case AtPlaceholder:
+		return writeSingleString(w, "@"+s.Name)
+	}
+	return writeSingleBytes(w, []byte("?"))
 }
 
 // ` X IS NULL`
 type IsNull struct {
-	X Node
+	X  Node
+	To sqltoken.Pos // end position of NULL
 }
 
 func (s *IsNull) Pos() sqltoken.Pos {
@@ -175,10 +239,7 @@ func (s *IsNull) Pos() sqltoken.Pos {
 }
 
 func (s *IsNull) End() sqltoken.Pos {
-	return sqltoken.Pos{
-		Line: s.X.End().Line,
-		Col:  s.X.End().Col + 8,
-	}
+	return s.To
 }
 
 func (s *IsNull) ToSQLString() string {
@@ -186,12 +247,13 @@ func (s *IsNull) ToSQLString() string {
 }
 
 func (s *IsNull) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.X).Bytes([]byte(" IS NULL")).End()
+	return NewSQLWriter(w).Node(s.X).Bytes([]byte(" IS NULL")).End()
 }
 
 // `X IS NOT NULL`
 type IsNotNull struct {
-	X Node
+	X  Node
+	To sqltoken.Pos // end position of NULL
 }
 
 func (s *IsNotNull) Pos() sqltoken.Pos {
@@ -199,10 +261,7 @@ func (s *IsNotNull) Pos() sqltoken.Pos {
 }
 
 func (s *IsNotNull) End() sqltoken.Pos {
-	return sqltoken.Pos{
-		Line: s.X.End().Line,
-		Col:  s.X.End().Col + 12,
-	}
+	return s.To
 }
 
 func (s *IsNotNull) ToSQLString() string {
@@ -210,7 +269,36 @@ func (s *IsNotNull) ToSQLString() string {
 }
 
 func (s *IsNotNull) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.X).Bytes([]byte(" IS NOT NULL")).End()
+	return NewSQLWriter(w).Node(s.X).Bytes([]byte(" IS NOT NULL")).End()
+}
+
+// `X IS [NOT] OF (Types...)`
+type IsOf struct {
+	X       Node
+	Negated bool
+	Types   []Type
+	RParen  sqltoken.Pos
+}
+
+func (s *IsOf) Pos() sqltoken.Pos {
+	return s.X.Pos()
+}
+
+func (s *IsOf) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *IsOf) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *IsOf) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(s.X).Bytes([]byte(" IS ")).Negated(s.Negated).Bytes([]byte("OF ")).LParen()
+	for i, t := range s.Types {
+		sw.JoinComma(i, t)
+	}
+	return sw.RParen().End()
 }
 
 // `Expr IN (List...)`
@@ -219,6 +307,11 @@ type InList struct {
 	List    []Node
 	Negated bool
 	RParen  sqltoken.Pos
+	// Elided is the number of items which follow List but aren't stored
+	// because of MaxInListItems parser option.
+	// ElidedFrom and ElidedTo are the span of them.
+	Elided               int
+	ElidedFrom, ElidedTo sqltoken.Pos
 }
 
 func (s *InList) Pos() sqltoken.Pos {
@@ -233,11 +326,16 @@ func (s *InList) ToSQLString() string {
 	return toSQLString(s)
 }
 
+// WriteTo writes elided items as a comment i.e: a IN (1, 2 /* 9998 more */).
 func (s *InList) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.Expr).Space().
+	sw := NewSQLWriter(w)
+	sw.Node(s.Expr).Space().
 		Negated(s.Negated).
-		Bytes([]byte("IN ")).LParen().Nodes(s.List).RParen().
-		End()
+		Bytes([]byte("IN ")).LParen().Nodes(s.List)
+	if s.Elided > 0 {
+		sw.Bytes([]byte(" /* ")).Int(s.Elided).Bytes([]byte(" more */"))
+	}
+	return sw.RParen().End()
 }
 
 // `Expr [ NOT ] IN SubQuery`
@@ -261,12 +359,60 @@ func (s *InSubQuery) ToSQLString() string {
 }
 
 func (s *InSubQuery) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.Expr).Space().
+	return NewSQLWriter(w).Node(s.Expr).Space().
 		Negated(s.Negated).
 		Bytes([]byte("IN ")).LParen().Node(s.SubQuery).RParen().
 		End()
 }
 
+// `Left Op Quantifier (Operand)` i.e: x = ANY (SELECT ...), x > ALL (ARRAY[1, 2])
+type QuantifiedComparison struct {
+	Left       Node
+	Op         *Operator
+	Quantifier Quantifier
+	Operand    Node // *QueryStmt or expression
+	RParen     sqltoken.Pos
+}
+
+func (s *QuantifiedComparison) Pos() sqltoken.Pos {
+	return s.Left.Pos()
+}
+
+func (s *QuantifiedComparison) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *QuantifiedComparison) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *QuantifiedComparison) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Node(s.Left).Space().Node(s.Op).Space().
+		Bytes([]byte(s.Quantifier.String())).Space().
+		LParen().Node(s.Operand).RParen().
+		End()
+}
+
+type Quantifier int
+
+const (
+	AnyQuantifier Quantifier = iota
+	AllQuantifier
+	SomeQuantifier
+)
+
+func (q Quantifier) String() string {
+	switch q {
+	case AnyQuantifier:
+		return "ANY"
+	case AllQuantifier:
+		return "ALL"
+	case SomeQuantifier:
+		return "SOME"
+	}
+	return ""
+}
+
 // `Expr [ NOT ] BETWEEN [ LOW expr ] AND [ HIGH expr]`
 type Between struct {
 	Expr    Node
@@ -288,7 +434,7 @@ func (s *Between) ToSQLString() string {
 }
 
 func (s *Between) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.Expr).Space().
+	return NewSQLWriter(w).Node(s.Expr).Space().
 		Negated(s.Negated).
 		Bytes([]byte("BETWEEN ")).Node(s.Low).Bytes([]byte(" AND ")).Node(s.High).
 		End()
@@ -296,9 +442,10 @@ func (s *Between) WriteTo(w io.Writer) (int64, error) {
 
 // `Left Op Right`
 type BinaryExpr struct {
-	Left  Node
-	Op    *Operator
-	Right Node
+	Left   Node
+	Op     *Operator
+	Right  Node
+	Escape Node // ESCAPE of LIKE, ILIKE and SIMILAR TO. nil if omitted
 }
 
 func (s *BinaryExpr) Pos() sqltoken.Pos {
@@ -306,6 +453,9 @@ func (s *BinaryExpr) Pos() sqltoken.Pos {
 }
 
 func (s *BinaryExpr) End() sqltoken.Pos {
+	if s.Escape != nil {
+		return s.Escape.End()
+	}
 	return s.Right.End()
 }
 
@@ -314,15 +464,10 @@ func (s *BinaryExpr) ToSQLString() string {
 }
 
 func (s *BinaryExpr) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Direct(s.Left.WriteTo(w))
-	sw.Space()
-	if sw.Err() == nil {
-		sw.Direct(s.Op.WriteTo(w))
-	}
-	sw.Space()
-	if sw.Err() == nil {
-		sw.Direct(s.Right.WriteTo(w))
+	sw := NewSQLWriter(w)
+	sw.Node(s.Left).Space().Node(s.Op).Space().Node(s.Right)
+	if s.Escape != nil {
+		sw.Bytes([]byte(" ESCAPE ")).Node(s.Escape)
 	}
 	return sw.End()
 }
@@ -348,7 +493,7 @@ func (s *Cast) ToSQLString() string {
 }
 
 func (s *Cast) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Bytes([]byte("CAST")).
 		LParen().
 		Node(s.Expr).As().Node(s.DataType).
@@ -356,6 +501,249 @@ func (s *Cast) WriteTo(w io.Writer) (int64, error) {
 		End()
 }
 
+// `EXTRACT(Field FROM Source)`
+type ExtractExpr struct {
+	Extract sqltoken.Pos // first position of EXTRACT token
+	Field   *Ident
+	Source  Node
+	RParen  sqltoken.Pos
+}
+
+func (s *ExtractExpr) Pos() sqltoken.Pos {
+	return s.Extract
+}
+
+func (s *ExtractExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *ExtractExpr) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *ExtractExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).
+		Bytes([]byte("EXTRACT")).
+		LParen().
+		Node(s.Field).Bytes([]byte(" FROM ")).Node(s.Source).
+		RParen().
+		End()
+}
+
+// `POSITION(Substring IN String)`
+type PositionExpr struct {
+	Position  sqltoken.Pos // first position of POSITION token
+	Substring Node
+	String    Node
+	RParen    sqltoken.Pos
+}
+
+func (s *PositionExpr) Pos() sqltoken.Pos {
+	return s.Position
+}
+
+func (s *PositionExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *PositionExpr) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *PositionExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).
+		Bytes([]byte("POSITION")).
+		LParen().
+		Node(s.Substring).Bytes([]byte(" IN ")).Node(s.String).
+		RParen().
+		End()
+}
+
+// `SUBSTRING(Expr [FROM From] [FOR For])`
+type SubstringExpr struct {
+	Substring sqltoken.Pos // first position of SUBSTRING token
+	Expr      Node
+	From      Node // nil if omitted
+	For       Node // nil if omitted
+	RParen    sqltoken.Pos
+}
+
+func (s *SubstringExpr) Pos() sqltoken.Pos {
+	return s.Substring
+}
+
+func (s *SubstringExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *SubstringExpr) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *SubstringExpr) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("SUBSTRING")).LParen().Node(s.Expr)
+	if s.From != nil {
+		sw.Bytes([]byte(" FROM ")).Node(s.From)
+	}
+	if s.For != nil {
+		sw.Bytes([]byte(" FOR ")).Node(s.For)
+	}
+	return sw.RParen().End()
+}
+
+type TrimWhere int
+
+const (
+	TrimUnspecified TrimWhere = iota
+	TrimBoth
+	TrimLeading
+	TrimTrailing
+)
+
+func (t TrimWhere) String() string {
+	switch t {
+	case TrimBoth:
+		return "BOTH"
+	case TrimLeading:
+		return "LEADING"
+	case TrimTrailing:
+		return "TRAILING"
+	}
+	return ""
+}
+
+// `TRIM([Where] [Chars] FROM Expr)`
+type TrimExpr struct {
+	Trim   sqltoken.Pos // first position of TRIM token
+	Where  TrimWhere
+	Chars  Node // nil if omitted
+	Expr   Node
+	RParen sqltoken.Pos
+}
+
+func (s *TrimExpr) Pos() sqltoken.Pos {
+	return s.Trim
+}
+
+func (s *TrimExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *TrimExpr) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *TrimExpr) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("TRIM")).LParen()
+	if s.Where != TrimUnspecified {
+		sw.Bytes([]byte(s.Where.String())).Space()
+	}
+	if s.Chars != nil {
+		sw.Node(s.Chars).Space()
+	}
+	return sw.Bytes([]byte("FROM ")).Node(s.Expr).RParen().End()
+}
+
+// `OVERLAY(Expr PLACING Placing FROM From [FOR For])`
+type OverlayExpr struct {
+	Overlay sqltoken.Pos // first position of OVERLAY token
+	Expr    Node
+	Placing Node
+	From    Node
+	For     Node // nil if omitted
+	RParen  sqltoken.Pos
+}
+
+func (s *OverlayExpr) Pos() sqltoken.Pos {
+	return s.Overlay
+}
+
+func (s *OverlayExpr) End() sqltoken.Pos {
+	return s.RParen
+}
+
+func (s *OverlayExpr) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *OverlayExpr) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("OVERLAY")).LParen().
+		Node(s.Expr).Bytes([]byte(" PLACING ")).Node(s.Placing).
+		Bytes([]byte(" FROM ")).Node(s.From)
+	if s.For != nil {
+		sw.Bytes([]byte(" FOR ")).Node(s.For)
+	}
+	return sw.RParen().End()
+}
+
+// `Expr[Index]` or `Expr[Index:Upper]` (PostgreSQL)
+type Subscript struct {
+	Expr     Node
+	Index    Node // lower bound if Slice. nil if omitted
+	Slice    bool
+	Upper    Node // nil if omitted
+	RBracket sqltoken.Pos
+}
+
+func (s *Subscript) Pos() sqltoken.Pos {
+	return s.Expr.Pos()
+}
+
+func (s *Subscript) End() sqltoken.Pos {
+	return s.RBracket
+}
+
+func (s *Subscript) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *Subscript) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(s.Expr).Bytes([]byte("["))
+	if s.Index != nil {
+		sw.Node(s.Index)
+	}
+	if s.Slice {
+		sw.Bytes([]byte(":"))
+		if s.Upper != nil {
+			sw.Node(s.Upper)
+		}
+	}
+	return sw.Bytes([]byte("]")).End()
+}
+
+// `ARRAY[Elements...]` (PostgreSQL)
+type ArrayConstructor struct {
+	Array    sqltoken.Pos // first position of ARRAY. same as LBracket if Bare
+	Bare     bool         // nested in the other ArrayConstructor without ARRAY keyword i.e: ARRAY[[1, 2], [3, 4]]
+	LBracket sqltoken.Pos
+	Elements []Node
+	RBracket sqltoken.Pos
+}
+
+func (a *ArrayConstructor) Pos() sqltoken.Pos {
+	return a.Array
+}
+
+func (a *ArrayConstructor) End() sqltoken.Pos {
+	return a.RBracket
+}
+
+func (a *ArrayConstructor) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *ArrayConstructor) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if !a.Bare {
+		sw.Bytes([]byte("ARRAY"))
+	}
+	return sw.Bytes([]byte("[")).Nodes(a.Elements).Bytes([]byte("]")).End()
+}
+
 // (AST)
 type Nested struct {
 	AST            Node
@@ -375,7 +763,7 @@ func (s *Nested) ToSQLString() string {
 }
 
 func (s *Nested) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).LParen().Node(s.AST).RParen().End()
+	return NewSQLWriter(w).LParen().Node(s.AST).RParen().End()
 }
 
 // Op Expr
@@ -398,7 +786,7 @@ func (s *UnaryExpr) ToSQLString() string {
 }
 
 func (s *UnaryExpr) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(s.Op).Space().Node(s.Expr).End()
+	return NewSQLWriter(w).Node(s.Op).Space().Node(s.Expr).End()
 }
 
 // Name(Args...) [OVER (Over)]
@@ -426,7 +814,7 @@ func (s *Function) ToSQLString() string {
 }
 
 func (s *Function) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Node(s.Name).LParen().Nodes(s.Args).RParen()
 	if s.Over != nil {
 		sw.Bytes([]byte(" OVER ")).LParen().Node(s.Over).RParen()
@@ -457,7 +845,7 @@ func (s *CaseExpr) ToSQLString() string {
 }
 
 func (s *CaseExpr) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("CASE"))
 	if s.Operand != nil {
 		sw.Space().Node(s.Operand)
@@ -498,7 +886,7 @@ func (s *Exists) ToSQLString() string {
 }
 
 func (s *Exists) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Negated(s.Negated).Bytes([]byte("EXISTS ")).LParen().Node(s.Query).RParen().
 		End()
 }
@@ -522,7 +910,7 @@ func (s *SubQuery) ToSQLString() string {
 }
 
 func (s *SubQuery) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).LParen().Node(s.Query).RParen().End()
+	return NewSQLWriter(w).LParen().Node(s.Query).RParen().End()
 }
 
 // Table Names (ex public.table_name)
@@ -555,7 +943,7 @@ func (s *ObjectName) ToSQLString() string {
 }
 
 func (s *ObjectName) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Idents(s.Idents, dotBytes).End()
+	return NewSQLWriter(w).Idents(s.Idents, dotBytes).End()
 }
 
 type WindowSpec struct {
@@ -593,7 +981,7 @@ func (s *WindowSpec) ToSQLString() string {
 }
 
 func (s *WindowSpec) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	space := false
 	if len(s.PartitionBy) != 0 {
 		space = true
@@ -642,7 +1030,7 @@ func (s *WindowFrame) ToSQLString() string {
 }
 
 func (s *WindowFrame) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	if s.EndBound != nil {
 		return sw.Node(s.Units).Bytes([]byte(" BETWEEN ")).
 			Node(s.StartBound).Bytes([]byte(" AND ")).Node(s.EndBound).
@@ -803,7 +1191,7 @@ func (p *Preceding) ToSQLString() string {
 }
 
 func (p *Preceding) WriteTo(w io.Writer) (n int64, err error) {
-	return newSQLWriter(w).Int(int(*p.Bound)).Bytes([]byte(" PRECEDING")).End()
+	return NewSQLWriter(w).Int(int(*p.Bound)).Bytes([]byte(" PRECEDING")).End()
 }
 
 // `Bound FOLLOWING`
@@ -827,5 +1215,5 @@ func (f *Following) ToSQLString() string {
 }
 
 func (f *Following) WriteTo(w io.Writer) (n int64, err error) {
-	return newSQLWriter(w).Int(int(*f.Bound)).Bytes([]byte(" FOLLOWING")).End()
+	return NewSQLWriter(w).Int(int(*f.Bound)).Bytes([]byte(" FOLLOWING")).End()
 }
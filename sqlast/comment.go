@@ -7,15 +7,25 @@ import (
 )
 
 type CommentGroup struct {
-	List []*Comment
+	List      []*Comment
+	Placement CommentPlacement // relative to the node associated by CommentMap
 }
 
+// CommentPlacement is the placement of a comment group relative to the node associated with it.
+type CommentPlacement int
+
+const (
+	LeadingComment  CommentPlacement = iota // before the node, e.g. on the lines above a statement
+	TrailingComment                         // after the node, e.g. at the end of the line
+	InlineComment                           // inside the node, e.g. between keywords of a statement
+)
+
 func (c *CommentGroup) ToSQLString() string {
 	return toSQLString(c)
 }
 
 func (c *CommentGroup) WriteTo(w io.Writer) (n int64, err error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	for i, comment := range c.List {
 		sw.JoinNewLine(i, comment)
 	}
@@ -50,3 +60,26 @@ func (c *Comment) Pos() sqltoken.Pos {
 func (c *Comment) End() sqltoken.Pos {
 	return c.To
 }
+
+// HintComment is an optimizer hint comment after SELECT, UPDATE or DELETE i.e: /*+ MAX_EXECUTION_TIME(1000) */ (MySQL)
+// Unlike other comments it is a part of the statement.
+type HintComment struct {
+	Text     string // without /*+ and */
+	From, To sqltoken.Pos
+}
+
+func (h *HintComment) ToSQLString() string {
+	return toSQLString(h)
+}
+
+func (h *HintComment) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleString(w, "/*+"+h.Text+"*/")
+}
+
+func (h *HintComment) Pos() sqltoken.Pos {
+	return h.From
+}
+
+func (h *HintComment) End() sqltoken.Pos {
+	return h.To
+}
@@ -2,6 +2,7 @@ package sqlast
 
 import (
 	"log"
+	"sort"
 
 	"github.com/akito0107/xsqlparser/sqltoken"
 )
@@ -9,6 +10,15 @@ import (
 type CommentMap map[Node][]*CommentGroup
 
 func (cmap CommentMap) addComment(n Node, c *CommentGroup) {
+	switch {
+	case sqltoken.ComparePos(c.End(), n.Pos()) <= 0:
+		c.Placement = LeadingComment
+	case sqltoken.ComparePos(c.Pos(), n.End()) >= 0:
+		c.Placement = TrailingComment
+	default:
+		c.Placement = InlineComment
+	}
+
 	list := cmap[n]
 
 	if len(list) == 0 {
@@ -142,13 +152,77 @@ func NewCommentMap(file *File) CommentMap {
 
 		switch q.(type) {
 		// Stmts
-		case *QueryStmt, *InsertStmt, *UpdateStmt, *DeleteStmt, *CreateViewStmt, *CreateTableStmt, *AlterTableStmt, *DropTableStmt, *CreateIndexStmt, *DropIndexStmt, *ExplainStmt:
+		case *QueryStmt, *InsertStmt, *CopyStmt, *UpdateStmt, *DeleteStmt, *CreateViewStmt, *AlterViewStmt, *DropViewStmt, *CreateTableStmt, *AlterTableStmt, *DropTableStmt, *CreateSchemaStmt, *DropSchemaStmt, *CreateSequenceStmt, *AlterSequenceStmt, *DropSequenceStmt, *CreateFunctionStmt, *CreateTriggerStmt, *DropTriggerStmt, *TruncateStmt, *SetVariableStmt, *ShowStmt, *ShowWarningsStmt, *KillStmt, *UseStmt, *CommentOnStmt, *CreateIndexStmt, *DropIndexStmt, *ExplainStmt, *PrepareStmt, *ExecuteStmt, *DeallocateStmt, *VacuumStmt, *AnalyzeStmt, *ReindexStmt, *CreateTypeStmt, *CreateDomainStmt, *CreateExtensionStmt:
 			stack.push(q)
 		// table element
-		case *ColumnDef, *TableConstraint:
+		case *ColumnDef, *TableConstraint, *IndexTableElement, *LikeTableElement:
 			stack.push(q)
 		}
 	}
 
 	return cmap
 }
+
+// Filter returns the comment groups of n placed at placement.
+func (cmap CommentMap) Filter(n Node, placement CommentPlacement) []*CommentGroup {
+	var list []*CommentGroup
+	for _, c := range cmap[n] {
+		if c.Placement == placement {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+// Update associates the comment groups of old with new, i.e. when old is replaced by new, and returns new.
+// The placements of the comment groups are kept.
+func (cmap CommentMap) Update(old, new Node) Node {
+	if list := cmap[old]; len(list) > 0 {
+		delete(cmap, old)
+		cmap[new] = append(cmap[new], list...)
+	}
+	return new
+}
+
+// Attach associates list with n, i.e. when n is inserted, placing them at placement.
+func (cmap CommentMap) Attach(n Node, placement CommentPlacement, list ...*CommentGroup) {
+	for _, c := range list {
+		c.Placement = placement
+		cmap[n] = append(cmap[n], c)
+	}
+}
+
+// Detach removes the comment groups of n and its descendants, i.e. when n is removed,
+// and returns them in order of position.
+func (cmap CommentMap) Detach(n Node) []*CommentGroup {
+	var list []*CommentGroup
+	Inspect(n, func(node Node) bool {
+		if node == nil {
+			return false
+		}
+		if l, ok := cmap[node]; ok {
+			list = append(list, l...)
+			delete(cmap, node)
+		}
+		return true
+	})
+	sortComments(list)
+	return list
+}
+
+// Comments returns all comment groups in cmap in order of position,
+// i.e. to update File.Comments after rewriting.
+func (cmap CommentMap) Comments() []*CommentGroup {
+	var list []*CommentGroup
+	for _, l := range cmap {
+		list = append(list, l...)
+	}
+	sortComments(list)
+	return list
+}
+
+func sortComments(list []*CommentGroup) {
+	sort.SliceStable(list, func(i, j int) bool {
+		return sqltoken.ComparePos(list[i].Pos(), list[j].Pos()) < 0
+	})
+}
@@ -0,0 +1,407 @@
+package sqlast
+
+import (
+	"io"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// KeywordCase specifies the letter case of keywords written by Format.
+type KeywordCase int
+
+const (
+	KeywordCaseAsIs  KeywordCase = iota // as written by WriteTo
+	KeywordCaseUpper                    // SELECT a FROM t
+	KeywordCaseLower                    // select a from t
+)
+
+// CommaStyle specifies where Format puts commas of lists broken into lines.
+type CommaStyle int
+
+const (
+	TrailingComma CommaStyle = iota // `a,` on the end of each line
+	LeadingComma                    // `, a` on the beginning of each line
+)
+
+// FormatOptions configures Format.
+type FormatOptions struct {
+	Indent      string // indentation of each level. two spaces if empty
+	MaxWidth    int    // lists and conditions longer than MaxWidth are broken into lines. 80 if 0
+	KeywordCase KeywordCase
+	CommaStyle  CommaStyle
+	// QuoteIdentifiers quotes every identifier with the delimiter of the dialect if not nil.
+	// See PrintOptions.
+	QuoteIdentifiers dialect.Dialect
+}
+
+const (
+	defaultIndent   = "  "
+	defaultMaxWidth = 80
+)
+
+// Format returns the multi-line, indented SQL of node.
+// Clauses of queries, subqueries which don't fit in a line and
+// elements of CREATE TABLE are written in their own lines.
+// Nodes which Format doesn't lay out are written as ToSQLString does.
+func Format(node Node, opts FormatOptions) string {
+	if opts.Indent == "" {
+		opts.Indent = defaultIndent
+	}
+	if opts.MaxWidth <= 0 {
+		opts.MaxWidth = defaultMaxWidth
+	}
+
+	var b strings.Builder
+	w := &formatWriter{w: &b, keywordCase: opts.KeywordCase}
+	f := &formatter{
+		opts: opts,
+		w:    w,
+		printer: newPrinter(w, PrintOptions{
+			KeywordCase:      opts.KeywordCase,
+			QuoteIdentifiers: opts.QuoteIdentifiers,
+		}),
+	}
+	_, _ = WriteWithHook(f.w, node, f.hook)
+	return b.String()
+}
+
+// formatWriter tracks the current column and converts the case of keywords.
+type formatWriter struct {
+	w           io.Writer
+	col         int
+	keywordCase KeywordCase
+	raw         int // > 0 while writing identifiers and literals
+}
+
+func (w *formatWriter) Write(b []byte) (int, error) {
+	if i := strings.LastIndexByte(string(b), '\n'); i >= 0 {
+		w.col = len(b) - i - 1
+	} else {
+		w.col += len(b)
+	}
+	if w.raw > 0 || w.keywordCase == KeywordCaseAsIs {
+		return w.w.Write(b)
+	}
+	s := string(b)
+	if w.keywordCase == KeywordCaseUpper {
+		s = strings.ToUpper(s)
+	} else {
+		s = strings.ToLower(s)
+	}
+	return io.WriteString(w.w, s)
+}
+
+type formatter struct {
+	*printer
+	opts   FormatOptions
+	w      *formatWriter
+	depth  int
+	inline bool // write nodes in a line as Print does
+}
+
+func (f *formatter) hook(sw *SQLWriter, n Node) bool {
+	if f.printer.hook(sw, n) {
+		return true
+	}
+
+	if f.inline {
+		return false
+	}
+
+	switch n := n.(type) {
+	case *File:
+		for i, stmt := range n.Stmts {
+			if i > 0 {
+				sw.Bytes([]byte("\n\n"))
+			}
+			sw.Node(stmt).Bytes([]byte(";"))
+		}
+	case *QueryStmt:
+		f.query(sw, n)
+	case *SQLSelect:
+		f.selectBody(sw, n)
+	case *SetOperationExpr:
+		sw.Node(n.Left)
+		f.newline(sw)
+		sw.Node(n.Op).If(n.All, []byte(" ALL")).If(n.Distinct, []byte(" DISTINCT"))
+		f.newline(sw)
+		sw.Node(n.Right)
+	case *CTE:
+		sw.Node(n.Alias)
+		if len(n.Columns) != 0 {
+			sw.LParen()
+			for i, col := range n.Columns {
+				sw.JoinComma(i, col)
+			}
+			sw.RParen()
+		}
+		sw.As()
+		f.paren(sw, n.Query)
+	case *QueryExpr:
+		f.paren(sw, n.Query)
+	case *SubQuery:
+		f.paren(sw, n.Query)
+	case *Exists:
+		sw.Negated(n.Negated).Bytes([]byte("EXISTS "))
+		f.paren(sw, n.Query)
+	case *InSubQuery:
+		sw.Node(n.Expr).Space().Negated(n.Negated).Bytes([]byte("IN "))
+		f.paren(sw, n.SubQuery)
+	case *Derived:
+		sw.If(n.Lateral, []byte("LATERAL "))
+		f.paren(sw, n.SubQuery)
+		sw.TableAlias(n.Alias, n.ColumnAliases)
+	case *QualifiedJoin:
+		sw.Node(n.LeftElement)
+		f.newline(sw)
+		sw.Node(n.Type).Bytes([]byte("JOIN ")).Node(n.RightElement).Space().Node(n.Spec)
+	case *NaturalJoin:
+		sw.Node(n.LeftElement)
+		f.newline(sw)
+		sw.Bytes([]byte("NATURAL ")).Node(n.Type).Bytes([]byte("JOIN ")).Node(n.RightElement)
+	case *CrossJoin:
+		sw.Node(n.Reference)
+		f.newline(sw)
+		sw.Bytes([]byte("CROSS JOIN ")).Node(n.Factor)
+	case *CreateTableStmt:
+		f.createTable(sw, n)
+	default:
+		return false
+	}
+	return true
+}
+
+func (f *formatter) newline(sw *SQLWriter) {
+	sw.Bytes([]byte("\n" + strings.Repeat(f.opts.Indent, f.depth)))
+}
+
+// fits reports whether s can be written in the current line.
+func (f *formatter) fits(s string) bool {
+	return !strings.Contains(s, "\n") && f.w.col+len(s) <= f.opts.MaxWidth
+}
+
+// inlineString returns n written in a line with the identifiers quoted as the output.
+func (f *formatter) inlineString(n Node) string {
+	return PrintString(n, f.printer.opts)
+}
+
+func (f *formatter) writeInline(sw *SQLWriter, n Node) {
+	saved := f.inline
+	f.inline = true
+	sw.Node(n)
+	f.inline = saved
+}
+
+func (f *formatter) query(sw *SQLWriter, q *QueryStmt) {
+	if len(q.CTEs) != 0 {
+		sw.Bytes([]byte("WITH "))
+		if q.Recursive {
+			sw.Bytes([]byte("RECURSIVE "))
+		}
+		for i, cte := range q.CTEs {
+			sw.JoinComma(i, cte)
+		}
+		f.newline(sw)
+	}
+	sw.Node(q.Body)
+	if len(q.OrderBy) != 0 {
+		f.newline(sw)
+		nodes := make([]Node, 0, len(q.OrderBy))
+		for _, o := range q.OrderBy {
+			nodes = append(nodes, o)
+		}
+		f.list(sw, "ORDER BY", nodes)
+	}
+	if q.Limit != nil {
+		f.newline(sw)
+		sw.Node(q.Limit)
+	}
+	if q.Offset != nil {
+		f.newline(sw)
+		sw.Node(q.Offset)
+	}
+	if q.Fetch != nil {
+		f.newline(sw)
+		sw.Node(q.Fetch)
+	}
+	for _, l := range q.Locking {
+		f.newline(sw)
+		sw.Node(l)
+	}
+}
+
+func (f *formatter) selectBody(sw *SQLWriter, s *SQLSelect) {
+	keyword := "SELECT"
+	if s.Hint != nil {
+		keyword += " " + s.Hint.ToSQLString()
+	}
+	if s.Distinct {
+		keyword += " DISTINCT"
+	}
+	if s.Top != nil {
+		keyword += " " + s.Top.ToSQLString()
+	}
+	nodes := make([]Node, 0, len(s.Projection))
+	for _, p := range s.Projection {
+		nodes = append(nodes, p)
+	}
+	f.list(sw, keyword, nodes)
+
+	if len(s.FromClause) != 0 {
+		f.newline(sw)
+		nodes := make([]Node, 0, len(s.FromClause))
+		for _, from := range s.FromClause {
+			nodes = append(nodes, from)
+		}
+		f.list(sw, "FROM", nodes)
+	}
+	if s.WhereClause != nil {
+		f.newline(sw)
+		f.condition(sw, "WHERE", s.WhereClause)
+	}
+	if len(s.GroupByClause) != 0 {
+		f.newline(sw)
+		f.list(sw, "GROUP BY", s.GroupByClause)
+	}
+	if s.HavingClause != nil {
+		f.newline(sw)
+		f.condition(sw, "HAVING", s.HavingClause)
+	}
+	if s.QualifyClause != nil {
+		f.newline(sw)
+		f.condition(sw, "QUALIFY", s.QualifyClause)
+	}
+}
+
+// list writes `keyword node, ...` in a line if it fits, otherwise each node in its own line.
+func (f *formatter) list(sw *SQLWriter, keyword string, nodes []Node) {
+	var b strings.Builder
+	b.WriteString(keyword)
+	for i, n := range nodes {
+		if i == 0 {
+			b.WriteString(" ")
+		} else {
+			b.WriteString(", ")
+		}
+		b.WriteString(f.inlineString(n))
+	}
+	if f.fits(b.String()) {
+		sw.Bytes([]byte(keyword))
+		for i, n := range nodes {
+			if i == 0 {
+				sw.Space()
+			} else {
+				sw.Bytes([]byte(", "))
+			}
+			f.writeInline(sw, n)
+		}
+		return
+	}
+
+	sw.Bytes([]byte(keyword))
+	f.depth++
+	f.items(sw, nodes)
+	f.depth--
+}
+
+// items writes each node in its own line separated by comma.
+func (f *formatter) items(sw *SQLWriter, nodes []Node) {
+	for i, n := range nodes {
+		if f.opts.CommaStyle == LeadingComma {
+			f.newline(sw)
+			sw.If(i > 0, []byte(", "))
+		} else {
+			sw.If(i > 0, []byte(","))
+			f.newline(sw)
+		}
+		sw.Node(n)
+	}
+}
+
+// condition writes `keyword cond` in a line if it fits,
+// otherwise each operand of top level AND / OR in its own line.
+func (f *formatter) condition(sw *SQLWriter, keyword string, cond Node) {
+	if s := keyword + " " + f.inlineString(cond); f.fits(s) {
+		sw.Bytes([]byte(keyword)).Space()
+		f.writeInline(sw, cond)
+		return
+	}
+
+	sw.Bytes([]byte(keyword))
+	f.depth++
+	f.newline(sw)
+	if b, ok := cond.(*BinaryExpr); ok && (b.Op.Type == And || b.Op.Type == Or) {
+		f.operands(sw, b, b.Op.Type)
+	} else {
+		sw.Node(cond)
+	}
+	f.depth--
+}
+
+func (f *formatter) operands(sw *SQLWriter, n Node, op OperatorType) {
+	b, ok := n.(*BinaryExpr)
+	if !ok || b.Op.Type != op {
+		sw.Node(n)
+		return
+	}
+	f.operands(sw, b.Left, op)
+	f.newline(sw)
+	sw.Node(b.Op).Space()
+	f.operands(sw, b.Right, op)
+}
+
+// paren writes (q) in a line if it fits, otherwise q is written in indented lines.
+func (f *formatter) paren(sw *SQLWriter, q *QueryStmt) {
+	sw.LParen()
+	if s := f.inlineString(q) + ")"; f.fits(s) {
+		f.writeInline(sw, q)
+		sw.RParen()
+		return
+	}
+
+	f.depth++
+	f.newline(sw)
+	sw.Node(q)
+	f.depth--
+	f.newline(sw)
+	sw.RParen()
+}
+
+func (f *formatter) createTable(sw *SQLWriter, c *CreateTableStmt) {
+	sw.Bytes([]byte("CREATE "))
+	sw.If(c.Temporary, []byte("TEMPORARY ")).If(c.Unlogged, []byte("UNLOGGED "))
+	sw.Bytes([]byte("TABLE "))
+	sw.If(c.NotExists, []byte("IF NOT EXISTS "))
+	sw.Node(c.Name)
+	if c.PartitionOf != nil {
+		sw.Bytes([]byte(" PARTITION OF ")).Node(c.PartitionOf)
+	}
+	if len(c.Elements) != 0 || c.PartitionOf == nil {
+		sw.Space().LParen()
+		f.depth++
+		nodes := make([]Node, 0, len(c.Elements))
+		for _, e := range c.Elements {
+			nodes = append(nodes, e)
+		}
+		f.items(sw, nodes)
+		f.depth--
+		f.newline(sw)
+		sw.RParen()
+	}
+	if c.Inherits != nil {
+		sw.Space().Node(c.Inherits)
+	}
+	if c.Bound != nil {
+		sw.Space().Node(c.Bound)
+	}
+	if c.PartitionBy != nil {
+		sw.Space().Node(c.PartitionBy)
+	}
+	if len(c.Options) != 0 {
+		sw.Space()
+		for i, option := range c.Options {
+			sw.JoinComma(i, option)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package sqlast
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestFormat(t *testing.T) {
+	query := &QueryStmt{
+		Body: &SQLSelect{
+			Projection: []SQLSelectItem{
+				&UnnamedSelectItem{Node: NewIdent("customer_name")},
+				&AliasSelectItem{Expr: NewIdent("contract_name"), Alias: NewIdent("name")},
+			},
+			FromClause: []TableReference{
+				&Table{Name: NewObjectName("customers")},
+			},
+			WhereClause: &BinaryExpr{
+				Left: &BinaryExpr{
+					Op:    &Operator{Type: Eq},
+					Left:  NewIdent("country"),
+					Right: NewSingleQuotedString("Brazil"),
+				},
+				Op: &Operator{Type: And},
+				Right: &InSubQuery{
+					Expr: NewIdent("id"),
+					SubQuery: &QueryStmt{
+						Body: &SQLSelect{
+							Projection: []SQLSelectItem{
+								&UnnamedSelectItem{Node: NewIdent("customer_id")},
+							},
+							FromClause: []TableReference{
+								&Table{Name: NewObjectName("contracts")},
+							},
+						},
+					},
+				},
+			},
+		},
+		OrderBy: []*OrderByExpr{
+			{Expr: NewIdent("customer_name")},
+		},
+	}
+
+	cases := []struct {
+		name string
+		in   Node
+		opts FormatOptions
+		out  string
+	}{
+		{
+			name: "default",
+			in:   query,
+			out: "SELECT customer_name, contract_name AS name\n" +
+				"FROM customers\n" +
+				"WHERE country = 'Brazil' AND id IN (SELECT customer_id FROM contracts)\n" +
+				"ORDER BY customer_name",
+		},
+		{
+			name: "narrow",
+			in:   query,
+			opts: FormatOptions{MaxWidth: 30},
+			out: "SELECT\n" +
+				"  customer_name,\n" +
+				"  contract_name AS name\n" +
+				"FROM customers\n" +
+				"WHERE\n" +
+				"  country = 'Brazil'\n" +
+				"  AND id IN (\n" +
+				"    SELECT customer_id\n" +
+				"    FROM contracts\n" +
+				"  )\n" +
+				"ORDER BY customer_name",
+		},
+		{
+			name: "leading comma and lower case keywords",
+			in:   query,
+			opts: FormatOptions{Indent: "\t", MaxWidth: 30, KeywordCase: KeywordCaseLower, CommaStyle: LeadingComma},
+			out: "select\n" +
+				"\tcustomer_name\n" +
+				"\t, contract_name as name\n" +
+				"from customers\n" +
+				"where\n" +
+				"\tcountry = 'Brazil'\n" +
+				"\tand id in (\n" +
+				"\t\tselect customer_id\n" +
+				"\t\tfrom contracts\n" +
+				"\t)\n" +
+				"order by customer_name",
+		},
+		{
+			name: "quote identifiers",
+			in:   query,
+			opts: FormatOptions{MaxWidth: 50, QuoteIdentifiers: &dialect.MySQLDialect{}},
+			out: "SELECT `customer_name`, `contract_name` AS `name`\n" +
+				"FROM `customers`\n" +
+				"WHERE\n" +
+				"  `country` = 'Brazil'\n" +
+				"  AND `id` IN (\n" +
+				"    SELECT `customer_id`\n" +
+				"    FROM `contracts`\n" +
+				"  )\n" +
+				"ORDER BY `customer_name`",
+		},
+		{
+			name: "create table",
+			in: &CreateTableStmt{
+				Name: NewObjectName("persons"),
+				Elements: []TableElement{
+					&ColumnDef{Name: NewIdent("person_id"), DataType: &Int{}},
+					&ColumnDef{Name: NewIdent("Name"), DataType: &Text{}},
+				},
+			},
+			opts: FormatOptions{KeywordCase: KeywordCaseUpper},
+			out: "CREATE TABLE persons (\n" +
+				"  person_id INT,\n" +
+				"  Name TEXT\n" +
+				")",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if act := Format(c.in, c.opts); act != c.out {
+				t.Errorf("should be \n%s\nbut \n%s", c.out, act)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package sqlast
+
+import "testing"
+
+func TestKindOf(t *testing.T) {
+	cases := []struct {
+		node   Node
+		kind   NodeKind
+		String string
+	}{
+		{node: &QueryStmt{}, kind: KindQueryStmt, String: "QueryStmt"},
+		{node: NewIdent("a"), kind: KindIdent, String: "Ident"},
+		{node: &SQLSelect{}, kind: KindSQLSelect, String: "SQLSelect"},
+		{node: &customNode{}, kind: KindInvalid, String: "Invalid"},
+		{node: nil, kind: KindInvalid, String: "Invalid"},
+	}
+
+	for _, c := range cases {
+		k := KindOf(c.node)
+		if k != c.kind {
+			t.Errorf("must be %v but %v", c.kind, k)
+		}
+		if k.String() != c.String {
+			t.Errorf("must be %s but %s", c.String, k.String())
+		}
+	}
+
+	if s := NodeKind(-1).String(); s != "NodeKind(-1)" {
+		t.Errorf("unexpected string %s", s)
+	}
+}
+
+func TestNodeKind_New(t *testing.T) {
+	for _, k := range []NodeKind{KindQueryStmt, KindIdent, KindSQLSelect} {
+		n := k.New()
+		if KindOf(n) != k {
+			t.Errorf("must be %v but %v", k, KindOf(n))
+		}
+	}
+
+	if n := KindInvalid.New(); n != nil {
+		t.Errorf("must be nil but %v", n)
+	}
+}
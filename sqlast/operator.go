@@ -38,6 +38,19 @@ const (
 	Not
 	Like
 	NotLike
+	JSONGet         // ->
+	JSONGetText     // ->>
+	JSONPathGet     // #>
+	JSONPathGetText // #>>
+	JSONContains    // @>
+	ILike
+	NotILike
+	SimilarTo
+	NotSimilarTo
+	RegexMatch     // ~
+	RegexIMatch    // ~*
+	NotRegexMatch  // !~
+	NotRegexIMatch // !~*
 	None
 )
 
@@ -75,6 +88,32 @@ func (o *Operator) ToSQLString() string {
 		return "LIKE"
 	case NotLike:
 		return "NOT LIKE"
+	case JSONGet:
+		return "->"
+	case JSONGetText:
+		return "->>"
+	case JSONPathGet:
+		return "#>"
+	case JSONPathGetText:
+		return "#>>"
+	case JSONContains:
+		return "@>"
+	case ILike:
+		return "ILIKE"
+	case NotILike:
+		return "NOT ILIKE"
+	case SimilarTo:
+		return "SIMILAR TO"
+	case NotSimilarTo:
+		return "NOT SIMILAR TO"
+	case RegexMatch:
+		return "~"
+	case RegexIMatch:
+		return "~*"
+	case NotRegexMatch:
+		return "!~"
+	case NotRegexIMatch:
+		return "!~*"
 	}
 	return ""
 }
@@ -113,6 +152,32 @@ func (o *Operator) WriteTo(w io.Writer) (int64, error) {
 		return writeSingleBytes(w, []byte("LIKE"))
 	case NotLike:
 		return writeSingleBytes(w, []byte("NOT LIKE"))
+	case JSONGet:
+		return writeSingleBytes(w, []byte("->"))
+	case JSONGetText:
+		return writeSingleBytes(w, []byte("->>"))
+	case JSONPathGet:
+		return writeSingleBytes(w, []byte("#>"))
+	case JSONPathGetText:
+		return writeSingleBytes(w, []byte("#>>"))
+	case JSONContains:
+		return writeSingleBytes(w, []byte("@>"))
+	case ILike:
+		return writeSingleBytes(w, []byte("ILIKE"))
+	case NotILike:
+		return writeSingleBytes(w, []byte("NOT ILIKE"))
+	case SimilarTo:
+		return writeSingleBytes(w, []byte("SIMILAR TO"))
+	case NotSimilarTo:
+		return writeSingleBytes(w, []byte("NOT SIMILAR TO"))
+	case RegexMatch:
+		return writeSingleBytes(w, []byte("~"))
+	case RegexIMatch:
+		return writeSingleBytes(w, []byte("~*"))
+	case NotRegexMatch:
+		return writeSingleBytes(w, []byte("!~"))
+	case NotRegexIMatch:
+		return writeSingleBytes(w, []byte("!~*"))
 	}
 	return 0, nil
 }
\ No newline at end of file
@@ -0,0 +1,164 @@
+package sqlast
+
+import (
+	"io"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+// PrintOptions configures Print.
+type PrintOptions struct {
+	KeywordCase KeywordCase
+	// QuoteIdentifiers quotes every identifier with the delimiter of the dialect if not nil.
+	// i.e: `a` for MySQL, "a" for PostgreSQL, [a] for MSSQL.
+	// Words which are not names of objects, such as function names, fields of EXTRACT, options of EXPLAIN,
+	// scopes of SET, @variables and EXCLUDED of ON CONFLICT, are written as they are.
+	QuoteIdentifiers dialect.Dialect
+}
+
+// Print writes node to w in a line as WriteTo does, with the letter case of keywords
+// and the quotes of identifiers specified by opts.
+func Print(w io.Writer, node Node, opts PrintOptions) (int64, error) {
+	p := newPrinter(&formatWriter{w: w, keywordCase: opts.KeywordCase}, opts)
+	return WriteWithHook(p.w, node, p.hook)
+}
+
+// PrintString returns the SQL of node written by Print.
+func PrintString(node Node, opts PrintOptions) string {
+	var b strings.Builder
+	_, _ = Print(&b, node, opts)
+	return b.String()
+}
+
+type printer struct {
+	w    *formatWriter
+	opts PrintOptions
+	asIs map[*Ident]struct{}
+}
+
+func newPrinter(w *formatWriter, opts PrintOptions) *printer {
+	return &printer{w: w, opts: opts, asIs: make(map[*Ident]struct{})}
+}
+
+// hook writes identifiers and literals with their case preserved.
+func (p *printer) hook(sw *SQLWriter, n Node) bool {
+	switch n := n.(type) {
+	case *Function:
+		p.keepAsIs(n.Name.Idents...)
+	case *ExtractExpr:
+		p.keepAsIs(n.Field)
+	case *SetVariableStmt:
+		p.keepAsIs(n.Scope)
+	case *ExplainStmt:
+		p.keepAsIs(n.Format)
+	case *CopyOption:
+		p.keepAsIs(n.Name)
+		if v, ok := n.Value.(*Ident); ok {
+			p.keepAsIs(v)
+		}
+	case *ShowStmt:
+		p.keepAsIs(n.Name.Idents...)
+	case *CreateFunctionStmt:
+		p.keepAsIs(n.Language)
+		p.keepAsIs(n.Behaviors...)
+	case *CreateDomainStmt:
+		// VALUE refers to the value being checked
+		for _, c := range n.Constraints {
+			Inspect(c, func(node Node) bool {
+				if id, ok := node.(*Ident); ok && id.QuoteStyle == 0 && strings.EqualFold(id.Value, "VALUE") {
+					p.keepAsIs(id)
+				}
+				return true
+			})
+		}
+	case *IndexTableElement:
+		p.keepAsIs(n.Using)
+	case *CreateIndexStmt:
+		p.keepAsIs(n.MethodName)
+	case *MyEngine:
+		p.keepAsIs(n.Name)
+	case *CompoundIdent:
+		// pseudo tables of ON CONFLICT and triggers
+		if q := n.Idents[0]; len(n.Idents) > 1 && q.QuoteStyle == 0 {
+			switch strings.ToUpper(q.Value) {
+			case "EXCLUDED", "NEW", "OLD":
+				p.keepAsIs(q)
+			}
+		}
+	case *Ident:
+		if _, ok := p.asIs[n]; !ok && p.opts.QuoteIdentifiers != nil && !isKeywordIdent(n) {
+			p.raw(sw, []byte(p.opts.QuoteIdentifiers.QuoteIdentifier(identName(n))))
+			return true
+		}
+		if p.opts.KeywordCase != KeywordCaseAsIs {
+			p.raw(sw, []byte(n.Value))
+			return true
+		}
+	}
+
+	if p.opts.KeywordCase == KeywordCaseAsIs {
+		return false
+	}
+
+	switch n := n.(type) {
+	case *NullValue, *BooleanValue:
+		// keywords
+	case *Placeholder, Value:
+		p.w.raw++
+		sw.Direct(n.WriteTo(sw.Writer()))
+		p.w.raw--
+		return true
+	case *CopyStmt:
+		if n.Data != nil {
+			c := *n
+			c.Data = nil
+			sw.Node(&c)
+			p.raw(sw, []byte(";\n"+*n.Data+"\\."))
+			return true
+		}
+	}
+	return false
+}
+
+func (p *printer) keepAsIs(ids ...*Ident) {
+	for _, id := range ids {
+		if id != nil {
+			p.asIs[id] = struct{}{}
+		}
+	}
+}
+
+func (p *printer) raw(sw *SQLWriter, b []byte) {
+	p.w.raw++
+	sw.Bytes(b)
+	p.w.raw--
+}
+
+// isKeywordIdent reports whether id is written as an identifier but isn't a name,
+// such as @variables and CURRENT_TIMESTAMP.
+func isKeywordIdent(id *Ident) bool {
+	if strings.HasPrefix(id.Value, "@") {
+		return true
+	}
+	if id.QuoteStyle != 0 {
+		return false
+	}
+	switch strings.ToUpper(id.Value) {
+	case "CURRENT_DATE", "CURRENT_TIME", "CURRENT_TIMESTAMP", "LOCALTIME", "LOCALTIMESTAMP":
+		return true
+	}
+	return false
+}
+
+// identName returns the name of id without quotes.
+func identName(id *Ident) string {
+	if id.QuoteStyle == 0 || len(id.Value) < 2 {
+		return id.Value
+	}
+	end := string(id.QuoteStyle)
+	if id.QuoteStyle == '[' {
+		end = "]"
+	}
+	return strings.ReplaceAll(id.Value[1:len(id.Value)-1], end+end, end)
+}
@@ -0,0 +1,107 @@
+package sqlast
+
+import (
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestPrint(t *testing.T) {
+	stmt := &QueryStmt{
+		Body: &SQLSelect{
+			Projection: []SQLSelectItem{
+				&UnnamedSelectItem{Node: &Function{
+					Name: NewObjectName("COUNT"),
+					Args: []Node{&CompoundIdent{Idents: []*Ident{NewIdent("u"), {Value: `"User ""ID"""`, QuoteStyle: '"'}}}},
+				}},
+				&UnnamedSelectItem{Node: &ExtractExpr{Field: NewIdent("YEAR"), Source: NewIdent("Ts")}},
+			},
+			FromClause: []TableReference{
+				&Table{Name: NewObjectName("Users"), Alias: NewIdent("u")},
+			},
+			WhereClause: &BinaryExpr{
+				Op:    &Operator{Type: And},
+				Left:  &IsNull{X: &Ident{Value: "[Deleted At]", QuoteStyle: '['}},
+				Right: &BinaryExpr{Op: &Operator{Type: Eq}, Left: NewIdent("Name"), Right: NewSingleQuotedString("Alice")},
+			},
+		},
+	}
+
+	cases := []struct {
+		name string
+		opts PrintOptions
+		out  string
+	}{
+		{
+			name: "as is",
+			out:  `SELECT COUNT(u."User ""ID"""), EXTRACT(YEAR FROM Ts) FROM Users AS u WHERE [Deleted At] IS NULL AND Name = 'Alice'`,
+		},
+		{
+			name: "lower case",
+			opts: PrintOptions{KeywordCase: KeywordCaseLower},
+			out:  `select COUNT(u."User ""ID"""), extract(YEAR from Ts) from Users as u where [Deleted At] is null and Name = 'Alice'`,
+		},
+		{
+			name: "mysql",
+			opts: PrintOptions{KeywordCase: KeywordCaseUpper, QuoteIdentifiers: &dialect.MySQLDialect{}},
+			out:  "SELECT COUNT(`u`.`User \"ID\"`), EXTRACT(YEAR FROM `Ts`) FROM `Users` AS `u` WHERE `Deleted At` IS NULL AND `Name` = 'Alice'",
+		},
+		{
+			name: "postgresql",
+			opts: PrintOptions{QuoteIdentifiers: &dialect.PostgresqlDialect{}},
+			out:  `SELECT COUNT("u"."User ""ID"""), EXTRACT(YEAR FROM "Ts") FROM "Users" AS "u" WHERE "Deleted At" IS NULL AND "Name" = 'Alice'`,
+		},
+		{
+			name: "mssql",
+			opts: PrintOptions{QuoteIdentifiers: &dialect.MSSQLDialect{}},
+			out:  `SELECT COUNT([u].[User "ID"]), EXTRACT(YEAR FROM [Ts]) FROM [Users] AS [u] WHERE [Deleted At] IS NULL AND [Name] = 'Alice'`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if act := PrintString(stmt, c.opts); act != c.out {
+				t.Errorf("should be \n%s\nbut \n%s", c.out, act)
+			}
+		})
+	}
+}
+
+func TestPrint_KeywordIdents(t *testing.T) {
+	cases := []struct {
+		name string
+		node Node
+		out  string
+	}{
+		{
+			name: "set scope",
+			node: &SetVariableStmt{Scope: NewIdent("SESSION"), Name: NewObjectName("sql_mode"), Values: []Node{NewSingleQuotedString("")}},
+			out:  `SET SESSION "sql_mode" = ''`,
+		},
+		{
+			name: "explain options",
+			node: &ExplainStmt{
+				Options: []*CopyOption{{Name: NewIdent("ANALYZE")}, {Name: NewIdent("FORMAT"), Value: NewIdent("JSON")}},
+				Stmt:    &ShowStmt{Name: NewObjectName("TABLES")},
+			},
+			out: `EXPLAIN (ANALYZE, FORMAT JSON) SHOW TABLES`,
+		},
+		{
+			name: "variables and pseudo tables",
+			node: &BinaryExpr{
+				Op:    &Operator{Type: Eq},
+				Left:  &CompoundIdent{Idents: []*Ident{NewIdent("EXCLUDED"), NewIdent("a")}},
+				Right: &Function{Name: NewObjectName("coalesce"), Args: []Node{NewIdent("@x"), NewIdent("current_timestamp")}},
+			},
+			out: `EXCLUDED."a" = coalesce(@x, current_timestamp)`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if act := PrintString(c.node, PrintOptions{QuoteIdentifiers: &dialect.PostgresqlDialect{}}); act != c.out {
+				t.Errorf("should be \n%s\nbut \n%s", c.out, act)
+			}
+		})
+	}
+}
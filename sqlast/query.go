@@ -10,11 +10,15 @@ import (
 // QueryStmt stmt
 type QueryStmt struct {
 	stmt
-	With    sqltoken.Pos // first char position of WITH if CTEs is not blank
-	CTEs    []*CTE
-	Body    SQLSetExpr
-	OrderBy []*OrderByExpr
-	Limit   *LimitExpr
+	With      sqltoken.Pos // first char position of WITH if CTEs is not blank
+	Recursive bool
+	CTEs      []*CTE
+	Body      SQLSetExpr
+	OrderBy   []*OrderByExpr
+	Limit     *LimitExpr
+	Offset    *OffsetExpr
+	Fetch     *FetchExpr
+	Locking   []*LockingClause
 }
 
 func (q *QueryStmt) Pos() sqltoken.Pos {
@@ -26,6 +30,18 @@ func (q *QueryStmt) Pos() sqltoken.Pos {
 }
 
 func (q *QueryStmt) End() sqltoken.Pos {
+	if len(q.Locking) != 0 {
+		return q.Locking[len(q.Locking)-1].End()
+	}
+
+	if q.Fetch != nil {
+		return q.Fetch.End()
+	}
+
+	if q.Offset != nil {
+		return q.Offset.End()
+	}
+
 	if q.Limit != nil {
 		return q.Limit.End()
 	}
@@ -42,17 +58,18 @@ func (q *QueryStmt) ToSQLString() string {
 }
 
 func (q *QueryStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	if len(q.CTEs) != 0 {
 		sw.Bytes([]byte("WITH "))
+		if q.Recursive {
+			sw.Bytes([]byte("RECURSIVE "))
+		}
 		for i, cte := range q.CTEs {
 			sw.JoinComma(i, cte)
 		}
 		sw.Space()
 	}
-	if sw.Err() == nil {
-		sw.Direct(q.Body.WriteTo(w))
-	}
+	sw.Node(q.Body)
 	if len(q.OrderBy) != 0 {
 		sw.Bytes([]byte(" ORDER BY "))
 		for i, col := range q.OrderBy {
@@ -62,14 +79,24 @@ func (q *QueryStmt) WriteTo(w io.Writer) (int64, error) {
 	if q.Limit != nil {
 		sw.Space().Node(q.Limit)
 	}
+	if q.Offset != nil {
+		sw.Space().Node(q.Offset)
+	}
+	if q.Fetch != nil {
+		sw.Space().Node(q.Fetch)
+	}
+	for _, l := range q.Locking {
+		sw.Space().Node(l)
+	}
 	return sw.End()
 }
 
 // CTE
 type CTE struct {
-	Alias  *Ident
-	Query  *QueryStmt
-	RParen sqltoken.Pos
+	Alias   *Ident
+	Columns []*Ident
+	Query   *QueryStmt
+	RParen  sqltoken.Pos
 }
 
 func (c *CTE) Pos() sqltoken.Pos {
@@ -85,9 +112,16 @@ func (c *CTE) ToSQLString() string {
 }
 
 func (c *CTE) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
-		Node(c.Alias).As().LParen().Node(c.Query).RParen().
-		End()
+	sw := NewSQLWriter(w)
+	sw.Node(c.Alias)
+	if len(c.Columns) != 0 {
+		sw.LParen()
+		for i, col := range c.Columns {
+			sw.JoinComma(i, col)
+		}
+		sw.RParen()
+	}
+	return sw.As().LParen().Node(c.Query).RParen().End()
 }
 
 //go:generate genmark -t SQLSetExpr -e Node
@@ -111,7 +145,7 @@ func (s *SelectExpr) ToSQLString() string {
 }
 
 func (s *SelectExpr) WriteTo(w io.Writer) (int64, error) {
-	return s.Select.WriteTo(w)
+	return NewSQLWriter(w).Node(s.Select).End()
 }
 
 // (QueryStmt)
@@ -134,15 +168,67 @@ func (q *QueryExpr) ToSQLString() string {
 }
 
 func (q *QueryExpr) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).LParen().Node(q.Query).RParen().End()
+	return NewSQLWriter(w).LParen().Node(q.Query).RParen().End()
+}
+
+// VALUES (expr, ...), ...
+type ValuesExpr struct {
+	sqlSetExpr
+	Values sqltoken.Pos
+	Rows   []*RowValueExpr
+}
+
+func (v *ValuesExpr) Pos() sqltoken.Pos {
+	return v.Values
+}
+
+func (v *ValuesExpr) End() sqltoken.Pos {
+	return v.Rows[len(v.Rows)-1].End()
+}
+
+func (v *ValuesExpr) ToSQLString() string {
+	return toSQLString(v)
+}
+
+func (v *ValuesExpr) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("VALUES "))
+	for i, row := range v.Rows {
+		sw.JoinComma(i, row)
+	}
+	return sw.End()
+}
+
+// TABLE name
+type TableExpr struct {
+	sqlSetExpr
+	Table sqltoken.Pos
+	Name  *ObjectName
+}
+
+func (t *TableExpr) Pos() sqltoken.Pos {
+	return t.Table
+}
+
+func (t *TableExpr) End() sqltoken.Pos {
+	return t.Name.End()
+}
+
+func (t *TableExpr) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TableExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("TABLE ")).Node(t.Name).End()
 }
 
 type SetOperationExpr struct {
 	sqlSetExpr
-	Op    SQLSetOperator
-	All   bool
-	Left  SQLSetExpr
-	Right SQLSetExpr
+	Op       SQLSetOperator
+	All      bool
+	Distinct bool // DISTINCT is written explicitly
+	Left     SQLSetExpr
+	Right    SQLSetExpr
 }
 
 func (s *SetOperationExpr) Pos() sqltoken.Pos {
@@ -158,8 +244,8 @@ func (s *SetOperationExpr) ToSQLString() string {
 }
 
 func (s *SetOperationExpr) WriteTo(w io.Writer) (n int64, err error) {
-	return newSQLWriter(w).
-		Node(s.Left).Space().Node(s.Op).If(s.All, []byte(" ALL")).Space().Node(s.Right).
+	return NewSQLWriter(w).
+		Node(s.Left).Space().Node(s.Op).If(s.All, []byte(" ALL")).If(s.Distinct, []byte(" DISTINCT")).Space().Node(s.Right).
 		End()
 }
 
@@ -230,12 +316,15 @@ func (i *IntersectOperator) WriteTo(w io.Writer) (n int64, err error) {
 
 type SQLSelect struct {
 	sqlSetExpr
+	Hint          *HintComment // MySQL only
 	Distinct      bool
+	Top           *TopExpr // MSSQL only
 	Projection    []SQLSelectItem
 	FromClause    []TableReference
 	WhereClause   Node
 	GroupByClause []Node
 	HavingClause  Node
+	QualifyClause Node         // Snowflake, BigQuery only
 	Select        sqltoken.Pos // first position of SELECT
 }
 
@@ -244,6 +333,10 @@ func (s *SQLSelect) Pos() sqltoken.Pos {
 }
 
 func (s *SQLSelect) End() sqltoken.Pos {
+	if s.QualifyClause != nil {
+		return s.QualifyClause.End()
+	}
+
 	if s.HavingClause != nil {
 		return s.HavingClause.End()
 	}
@@ -268,11 +361,17 @@ func (s *SQLSelect) ToSQLString() string {
 }
 
 func (s *SQLSelect) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes(selectBytes)
+	if s.Hint != nil {
+		sw.Node(s.Hint).Space()
+	}
 	if s.Distinct {
 		sw.Bytes([]byte("DISTINCT "))
 	}
+	if s.Top != nil {
+		sw.Node(s.Top).Space()
+	}
 	for i, projection := range s.Projection {
 		sw.JoinComma(i, projection)
 	}
@@ -284,9 +383,7 @@ func (s *SQLSelect) WriteTo(w io.Writer) (int64, error) {
 	}
 	if s.WhereClause != nil {
 		sw.Bytes(whereBytes)
-		if sw.Err() == nil {
-			sw.Direct(s.WhereClause.WriteTo(w))
-		}
+		sw.Node(s.WhereClause)
 	}
 	if len(s.GroupByClause) != 0 {
 		sw.Bytes([]byte(" GROUP BY ")).Nodes(s.GroupByClause)
@@ -294,9 +391,40 @@ func (s *SQLSelect) WriteTo(w io.Writer) (int64, error) {
 	if s.HavingClause != nil {
 		sw.Bytes([]byte(" HAVING ")).Node(s.HavingClause)
 	}
+	if s.QualifyClause != nil {
+		sw.Bytes([]byte(" QUALIFY ")).Node(s.QualifyClause)
+	}
 	return sw.End()
 }
 
+// TOP n [PERCENT] [WITH TIES] (MSSQL)
+type TopExpr struct {
+	Top      sqltoken.Pos // first position of TOP
+	Expr     Node         // *LongValue or *Nested
+	Percent  bool
+	WithTies bool
+	To       sqltoken.Pos
+}
+
+func (t *TopExpr) Pos() sqltoken.Pos {
+	return t.Top
+}
+
+func (t *TopExpr) End() sqltoken.Pos {
+	return t.To
+}
+
+func (t *TopExpr) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TopExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("TOP ")).Node(t.Expr).
+		If(t.Percent, []byte(" PERCENT")).
+		If(t.WithTies, []byte(" WITH TIES")).
+		End()
+}
+
 //go:generate genmark -t TableReference -e Node
 
 //go:generate genmark -t TableFactor -e TableReference
@@ -307,9 +435,12 @@ type Table struct {
 	tableReference
 	Name            *ObjectName
 	Alias           *Ident
+	ColumnAliases   []*Ident // i.e: AS t(a, b)
+	AliasRParen     sqltoken.Pos
 	Args            []Node
 	ArgsRParen      sqltoken.Pos
-	WithHints       []Node
+	IndexHints      []*IndexHint // MySQL only
+	WithHints       []Node       // MSSQL only
 	WithHintsRParen sqltoken.Pos
 }
 
@@ -322,6 +453,14 @@ func (t *Table) End() sqltoken.Pos {
 		return t.WithHintsRParen
 	}
 
+	if len(t.IndexHints) != 0 {
+		return t.IndexHints[len(t.IndexHints)-1].End()
+	}
+
+	if len(t.ColumnAliases) != 0 {
+		return t.AliasRParen
+	}
+
 	if t.Alias != nil {
 		return t.Alias.End()
 	}
@@ -338,13 +477,14 @@ func (t *Table) ToSQLString() string {
 }
 
 func (t *Table) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Node(t.Name)
 	if len(t.Args) != 0 {
 		sw.LParen().Nodes(t.Args).RParen()
 	}
-	if t.Alias != nil {
-		sw.As().Node(t.Alias)
+	sw.TableAlias(t.Alias, t.ColumnAliases)
+	for _, h := range t.IndexHints {
+		sw.Space().Node(h)
 	}
 	if len(t.WithHints) != 0 {
 		sw.Bytes([]byte(" WITH ")).LParen().Nodes(t.WithHints).RParen()
@@ -352,15 +492,73 @@ func (t *Table) WriteTo(w io.Writer) (int64, error) {
 	return sw.End()
 }
 
+type IndexHintType int
+
+const (
+	UseIndexHint IndexHintType = iota
+	IgnoreIndexHint
+	ForceIndexHint
+)
+
+func (i IndexHintType) String() string {
+	switch i {
+	case UseIndexHint:
+		return "USE"
+	case IgnoreIndexHint:
+		return "IGNORE"
+	case ForceIndexHint:
+		return "FORCE"
+	}
+	return ""
+}
+
+// { USE | IGNORE | FORCE } { INDEX | KEY } [FOR { JOIN | ORDER BY | GROUP BY }] ( [index_name [, ...]] )
+type IndexHint struct {
+	From    sqltoken.Pos // first position of USE, IGNORE or FORCE
+	Type    IndexHintType
+	IsKey   bool   // written as KEY instead of INDEX
+	For     string // JOIN, ORDER BY or GROUP BY. empty if omitted
+	Indexes []*Ident
+	RParen  sqltoken.Pos
+}
+
+func (i *IndexHint) Pos() sqltoken.Pos {
+	return i.From
+}
+
+func (i *IndexHint) End() sqltoken.Pos {
+	return i.RParen
+}
+
+func (i *IndexHint) ToSQLString() string {
+	return toSQLString(i)
+}
+
+func (i *IndexHint) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte(i.Type.String()))
+	if i.IsKey {
+		sw.Bytes([]byte(" KEY"))
+	} else {
+		sw.Bytes([]byte(" INDEX"))
+	}
+	if i.For != "" {
+		sw.Bytes([]byte(" FOR ")).Bytes([]byte(i.For))
+	}
+	return sw.Bytes([]byte(" (")).Idents(i.Indexes, []byte(", ")).RParen().End()
+}
+
 type Derived struct {
 	tableFactor
 	tableReference
-	Lateral    bool
-	LateralPos sqltoken.Pos // last position of LATERAL keyword if Lateral is true
-	LParen     sqltoken.Pos
-	RParen     sqltoken.Pos
-	SubQuery   *QueryStmt
-	Alias      *Ident
+	Lateral       bool
+	LateralPos    sqltoken.Pos // first position of LATERAL keyword if Lateral is true
+	LParen        sqltoken.Pos
+	RParen        sqltoken.Pos
+	SubQuery      *QueryStmt
+	Alias         *Ident
+	ColumnAliases []*Ident // i.e: AS t(a, b)
+	AliasRParen   sqltoken.Pos
 }
 
 func (d *Derived) Pos() sqltoken.Pos {
@@ -371,11 +569,14 @@ func (d *Derived) Pos() sqltoken.Pos {
 }
 
 func (d *Derived) End() sqltoken.Pos {
+	if len(d.ColumnAliases) != 0 {
+		return d.AliasRParen
+	}
 	if d.Alias != nil {
 		return d.Alias.End()
 	}
 
-	return d.LParen
+	return d.RParen
 }
 
 func (d *Derived) ToSQLString() string {
@@ -383,12 +584,76 @@ func (d *Derived) ToSQLString() string {
 }
 
 func (d *Derived) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.If(d.Lateral, []byte("LATERAL "))
 	sw.LParen().Node(d.SubQuery).RParen()
-	if d.Alias != nil {
-		sw.As().Node(d.Alias)
+	sw.TableAlias(d.Alias, d.ColumnAliases)
+	return sw.End()
+}
+
+// [LATERAL] function_call [WITH ORDINALITY] [AS alias [(column_alias [, ...])]]
+// [LATERAL] ROWS FROM (function_call [, ...]) [WITH ORDINALITY] [AS alias [(column_alias [, ...])]]
+// Function calls without LATERAL, ROWS FROM and WITH ORDINALITY are parsed as Table.
+type TableFunction struct {
+	tableFactor
+	tableReference
+	Lateral        bool
+	LateralPos     sqltoken.Pos // first position of LATERAL keyword if Lateral is true
+	RowsFrom       bool
+	RowsFromPos    sqltoken.Pos // first position of ROWS keyword if RowsFrom is true
+	RParen         sqltoken.Pos // RParen of ROWS FROM
+	Functions      []*Function  // has exactly one element unless RowsFrom is true
+	WithOrdinality bool
+	OrdinalityPos  sqltoken.Pos // last position of ORDINALITY keyword if WithOrdinality is true
+	Alias          *Ident
+	ColumnAliases  []*Ident
+	AliasRParen    sqltoken.Pos
+}
+
+func (t *TableFunction) Pos() sqltoken.Pos {
+	if t.Lateral {
+		return t.LateralPos
+	}
+	if t.RowsFrom {
+		return t.RowsFromPos
+	}
+	return t.Functions[0].Pos()
+}
+
+func (t *TableFunction) End() sqltoken.Pos {
+	if len(t.ColumnAliases) != 0 {
+		return t.AliasRParen
+	}
+	if t.Alias != nil {
+		return t.Alias.End()
+	}
+	if t.WithOrdinality {
+		return t.OrdinalityPos
 	}
+	if t.RowsFrom {
+		return t.RParen
+	}
+	return t.Functions[0].End()
+}
+
+func (t *TableFunction) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TableFunction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.If(t.Lateral, []byte("LATERAL "))
+	if t.RowsFrom {
+		sw.Bytes([]byte("ROWS FROM ("))
+		for i, f := range t.Functions {
+			sw.JoinComma(i, f)
+		}
+		sw.RParen()
+	} else {
+		sw.Node(t.Functions[0])
+	}
+	sw.If(t.WithOrdinality, []byte(" WITH ORDINALITY"))
+	sw.TableAlias(t.Alias, t.ColumnAliases)
 	return sw.End()
 }
 
@@ -412,7 +677,7 @@ func (u *UnnamedSelectItem) ToSQLString() string {
 }
 
 func (u *UnnamedSelectItem) WriteTo(w io.Writer) (int64, error) {
-	return u.Node.WriteTo(w)
+	return NewSQLWriter(w).Node(u.Node).End()
 }
 
 type AliasSelectItem struct {
@@ -434,7 +699,7 @@ func (a *AliasSelectItem) ToSQLString() string {
 }
 
 func (a *AliasSelectItem) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(a.Expr).As().Node(a.Alias).End()
+	return NewSQLWriter(w).Node(a.Expr).As().Node(a.Alias).End()
 }
 
 // schema.*
@@ -448,9 +713,11 @@ func (q *QualifiedWildcardSelectItem) Pos() sqltoken.Pos {
 }
 
 func (q *QualifiedWildcardSelectItem) End() sqltoken.Pos {
+	end := q.Prefix.End()
 	return sqltoken.Pos{
-		Line: q.Prefix.End().Line,
-		Col:  q.Prefix.End().Col + 2,
+		Line:   end.Line,
+		Col:    end.Col + 2,
+		Offset: end.Offset + 2,
 	}
 }
 
@@ -459,7 +726,7 @@ func (q *QualifiedWildcardSelectItem) ToSQLString() string {
 }
 
 func (q *QualifiedWildcardSelectItem) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(q.Prefix).Bytes([]byte(".*")).End()
+	return NewSQLWriter(w).Node(q.Prefix).Bytes([]byte(".*")).End()
 }
 
 type WildcardSelectItem struct {
@@ -502,7 +769,7 @@ func (c *CrossJoin) ToSQLString() string {
 }
 
 func (c *CrossJoin) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Node(c.Reference).Bytes([]byte(" CROSS JOIN ")).Node(c.Factor).
 		End()
 }
@@ -527,7 +794,7 @@ func (t *TableJoinElement) ToSQLString() string {
 }
 
 func (t *TableJoinElement) WriteTo(w io.Writer) (int64, error) {
-	return t.Ref.WriteTo(w)
+	return NewSQLWriter(w).Node(t.Ref).End()
 }
 
 type PartitionedJoinTable struct {
@@ -551,7 +818,7 @@ func (p *PartitionedJoinTable) ToSQLString() string {
 }
 
 func (p *PartitionedJoinTable) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Node(p.Factor).Bytes([]byte(" PARTITION BY ")).
 		LParen().Idents(p.ColumnList, []byte(", ")).RParen().
 		End()
@@ -578,7 +845,7 @@ func (q *QualifiedJoin) ToSQLString() string {
 }
 
 func (q *QualifiedJoin) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Node(q.LeftElement).Space().
 		Node(q.Type).Bytes([]byte("JOIN ")).
 		Node(q.RightElement).Space().Node(q.Spec).
@@ -605,7 +872,7 @@ func (n *NaturalJoin) ToSQLString() string {
 }
 
 func (n *NaturalJoin) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Node(n.LeftElement).
 		Bytes([]byte(" NATURAL ")).Node(n.Type).Bytes([]byte("JOIN ")).
 		Node(n.RightElement).
@@ -634,7 +901,7 @@ func (n *NamedColumnsJoin) ToSQLString() string {
 }
 
 func (n *NamedColumnsJoin) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	return NewSQLWriter(w).
 		Bytes([]byte("USING ")).
 		LParen().Idents(n.ColumnList, []byte(", ")).RParen().
 		End()
@@ -659,7 +926,7 @@ func (j *JoinCondition) ToSQLString() string {
 }
 
 func (j *JoinCondition) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Bytes([]byte("ON ")).Node(j.SearchCondition).End()
+	return NewSQLWriter(w).Bytes([]byte("ON ")).Node(j.SearchCondition).End()
 }
 
 type JoinType struct {
@@ -740,7 +1007,7 @@ func (o *OrderByExpr) ToSQLString() string {
 }
 
 func (o *OrderByExpr) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Node(o.Expr)
 	if o.ASC != nil {
 		if *o.ASC {
@@ -757,8 +1024,8 @@ type LimitExpr struct {
 	All         bool
 	AllPos      sqltoken.Pos // ALL keyword position if All is true
 	Limit       sqltoken.Pos // Limit keyword position
-	LimitValue  *LongValue
-	OffsetValue *LongValue
+	LimitValue  Node         // *LongValue or *Placeholder
+	OffsetValue Node         // *LongValue or *Placeholder
 }
 
 func (l *LimitExpr) Pos() sqltoken.Pos {
@@ -771,9 +1038,9 @@ func (l *LimitExpr) End() sqltoken.Pos {
 	}
 
 	if l.OffsetValue != nil {
-		return l.OffsetValue.To
+		return l.OffsetValue.End()
 	}
-	return l.LimitValue.To
+	return l.LimitValue.End()
 }
 
 func (l *LimitExpr) ToSQLString() string {
@@ -781,7 +1048,7 @@ func (l *LimitExpr) ToSQLString() string {
 }
 
 func (l *LimitExpr) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("LIMIT "))
 	if l.All {
 		sw.Bytes([]byte("ALL"))
@@ -793,3 +1060,149 @@ func (l *LimitExpr) WriteTo(w io.Writer) (int64, error) {
 	}
 	return sw.End()
 }
+
+// OFFSET n [ROW | ROWS]
+type OffsetExpr struct {
+	Offset      sqltoken.Pos // first position of OFFSET
+	Value       Node         // *LongValue or *Placeholder
+	RowsOmitted bool         // OFFSET n without ROW or ROWS (PostgreSQL)
+	To          sqltoken.Pos // end position of ROW or ROWS, or of n if omitted
+}
+
+func (o *OffsetExpr) Pos() sqltoken.Pos {
+	return o.Offset
+}
+
+func (o *OffsetExpr) End() sqltoken.Pos {
+	return o.To
+}
+
+func (o *OffsetExpr) ToSQLString() string {
+	return toSQLString(o)
+}
+
+func (o *OffsetExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("OFFSET ")).Node(o.Value).If(!o.RowsOmitted, []byte(" ROWS")).End()
+}
+
+// FETCH {FIRST | NEXT} [n [PERCENT]] {ROW | ROWS} {ONLY | WITH TIES}
+type FetchExpr struct {
+	Fetch    sqltoken.Pos // first position of FETCH
+	Next     bool         // FETCH NEXT instead of FETCH FIRST
+	Quantity *LongValue   // nil means a row
+	Percent  bool
+	WithTies bool
+	To       sqltoken.Pos // end position of ONLY or TIES
+}
+
+func (f *FetchExpr) Pos() sqltoken.Pos {
+	return f.Fetch
+}
+
+func (f *FetchExpr) End() sqltoken.Pos {
+	return f.To
+}
+
+func (f *FetchExpr) ToSQLString() string {
+	return toSQLString(f)
+}
+
+func (f *FetchExpr) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if f.Next {
+		sw.Bytes([]byte("FETCH NEXT "))
+	} else {
+		sw.Bytes([]byte("FETCH FIRST "))
+	}
+	if f.Quantity != nil {
+		sw.Node(f.Quantity).If(f.Percent, []byte(" PERCENT")).Bytes([]byte(" ROWS"))
+	} else {
+		sw.Bytes([]byte("ROW"))
+	}
+	if f.WithTies {
+		sw.Bytes([]byte(" WITH TIES"))
+	} else {
+		sw.Bytes([]byte(" ONLY"))
+	}
+	return sw.End()
+}
+
+type LockStrength int
+
+const (
+	UpdateLock LockStrength = iota
+	NoKeyUpdateLock
+	ShareLock
+	KeyShareLock
+)
+
+func (l LockStrength) String() string {
+	switch l {
+	case UpdateLock:
+		return "UPDATE"
+	case NoKeyUpdateLock:
+		return "NO KEY UPDATE"
+	case ShareLock:
+		return "SHARE"
+	case KeyShareLock:
+		return "KEY SHARE"
+	}
+	return ""
+}
+
+type LockWaitPolicy int
+
+const (
+	LockWait LockWaitPolicy = iota
+	LockNoWait
+	LockSkipLocked
+)
+
+func (l LockWaitPolicy) String() string {
+	switch l {
+	case LockNoWait:
+		return "NOWAIT"
+	case LockSkipLocked:
+		return "SKIP LOCKED"
+	}
+	return ""
+}
+
+// FOR { UPDATE | NO KEY UPDATE | SHARE | KEY SHARE } [ OF table_name [, ...] ] [ NOWAIT | SKIP LOCKED ]
+type LockingClause struct {
+	For      sqltoken.Pos // first position of FOR
+	Strength LockStrength
+	Of       []*ObjectName
+	Wait     LockWaitPolicy
+	To       sqltoken.Pos // end position of the last keyword
+}
+
+func (l *LockingClause) Pos() sqltoken.Pos {
+	return l.For
+}
+
+func (l *LockingClause) End() sqltoken.Pos {
+	if l.Wait == LockWait && len(l.Of) != 0 {
+		return l.Of[len(l.Of)-1].End()
+	}
+	return l.To
+}
+
+func (l *LockingClause) ToSQLString() string {
+	return toSQLString(l)
+}
+
+func (l *LockingClause) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("FOR ")).Bytes([]byte(l.Strength.String()))
+	if len(l.Of) != 0 {
+		sw.Bytes([]byte(" OF "))
+		for i, o := range l.Of {
+			sw.JoinComma(i, o)
+		}
+	}
+	if l.Wait != LockWait {
+		sw.Space().Bytes([]byte(l.Wait.String()))
+	}
+	return sw.End()
+}
@@ -184,6 +184,37 @@ func TestSQLSelect_ToSQLString(t *testing.T) {
 			},
 			out: "SELECT COUNT(customer_id), country FROM customers GROUP BY country HAVING COUNT(customer_id) > 3",
 		},
+		{
+			name: "alias with column list",
+			in: &SQLSelect{
+				Projection: []SQLSelectItem{
+					&UnnamedSelectItem{
+						Node: &CompoundIdent{
+							Idents: []*Ident{NewIdent("x"), NewIdent("a")},
+						},
+					},
+				},
+				FromClause: []TableReference{
+					&Table{
+						Name:          NewObjectName("t"),
+						Alias:         NewIdent("x"),
+						ColumnAliases: []*Ident{NewIdent("a"), NewIdent("b")},
+					},
+					&Derived{
+						SubQuery: &QueryStmt{
+							Body: &SQLSelect{
+								Projection: []SQLSelectItem{
+									&UnnamedSelectItem{Node: NewLongValue(1)},
+								},
+							},
+						},
+						Alias:         NewIdent("y"),
+						ColumnAliases: []*Ident{NewIdent("c")},
+					},
+				},
+			},
+			out: "SELECT x.a FROM t AS x(a, b), (SELECT 1) AS y(c)",
+		},
 	}
 
 	for _, c := range cases {
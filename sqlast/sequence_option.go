@@ -0,0 +1,238 @@
+package sqlast
+
+import (
+	"io"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+//go:generate genmark -t SequenceOption -e Node
+
+// AS data_type
+type AsSequenceOption struct {
+	sequenceOption
+	As       sqltoken.Pos
+	DataType Type
+}
+
+func (a *AsSequenceOption) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *AsSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("AS ")).Node(a.DataType).End()
+}
+
+func (a *AsSequenceOption) Pos() sqltoken.Pos {
+	return a.As
+}
+
+func (a *AsSequenceOption) End() sqltoken.Pos {
+	return a.DataType.End()
+}
+
+// INCREMENT [ BY ] increment
+type IncrementBySequenceOption struct {
+	sequenceOption
+	Increment sqltoken.Pos
+	Value     *LongValue
+}
+
+func (i *IncrementBySequenceOption) ToSQLString() string {
+	return toSQLString(i)
+}
+
+func (i *IncrementBySequenceOption) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("INCREMENT BY ")).Node(i.Value).End()
+}
+
+func (i *IncrementBySequenceOption) Pos() sqltoken.Pos {
+	return i.Increment
+}
+
+func (i *IncrementBySequenceOption) End() sqltoken.Pos {
+	return i.Value.End()
+}
+
+// MINVALUE minvalue | NO MINVALUE
+type MinValueSequenceOption struct {
+	sequenceOption
+	From, To sqltoken.Pos
+	No       bool
+	Value    *LongValue // nil if NO MINVALUE
+}
+
+func (m *MinValueSequenceOption) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MinValueSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	if m.No {
+		return writeSingleBytes(w, []byte("NO MINVALUE"))
+	}
+	return NewSQLWriter(w).Bytes([]byte("MINVALUE ")).Node(m.Value).End()
+}
+
+func (m *MinValueSequenceOption) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MinValueSequenceOption) End() sqltoken.Pos {
+	return m.To
+}
+
+// MAXVALUE maxvalue | NO MAXVALUE
+type MaxValueSequenceOption struct {
+	sequenceOption
+	From, To sqltoken.Pos
+	No       bool
+	Value    *LongValue // nil if NO MAXVALUE
+}
+
+func (m *MaxValueSequenceOption) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MaxValueSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	if m.No {
+		return writeSingleBytes(w, []byte("NO MAXVALUE"))
+	}
+	return NewSQLWriter(w).Bytes([]byte("MAXVALUE ")).Node(m.Value).End()
+}
+
+func (m *MaxValueSequenceOption) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MaxValueSequenceOption) End() sqltoken.Pos {
+	return m.To
+}
+
+// START [ WITH ] start
+type StartWithSequenceOption struct {
+	sequenceOption
+	Start sqltoken.Pos
+	Value *LongValue
+}
+
+func (s *StartWithSequenceOption) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *StartWithSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("START WITH ")).Node(s.Value).End()
+}
+
+func (s *StartWithSequenceOption) Pos() sqltoken.Pos {
+	return s.Start
+}
+
+func (s *StartWithSequenceOption) End() sqltoken.Pos {
+	return s.Value.End()
+}
+
+// RESTART [ [ WITH ] restart ] (ALTER SEQUENCE only)
+type RestartSequenceOption struct {
+	sequenceOption
+	From, To sqltoken.Pos
+	Value    *LongValue // nil if restart value is omitted
+}
+
+func (r *RestartSequenceOption) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *RestartSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("RESTART"))
+	if r.Value != nil {
+		sw.Bytes([]byte(" WITH ")).Node(r.Value)
+	}
+	return sw.End()
+}
+
+func (r *RestartSequenceOption) Pos() sqltoken.Pos {
+	return r.From
+}
+
+func (r *RestartSequenceOption) End() sqltoken.Pos {
+	return r.To
+}
+
+// CACHE cache
+type CacheSequenceOption struct {
+	sequenceOption
+	Cache sqltoken.Pos
+	Value *LongValue
+}
+
+func (c *CacheSequenceOption) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CacheSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("CACHE ")).Node(c.Value).End()
+}
+
+func (c *CacheSequenceOption) Pos() sqltoken.Pos {
+	return c.Cache
+}
+
+func (c *CacheSequenceOption) End() sqltoken.Pos {
+	return c.Value.End()
+}
+
+// [ NO ] CYCLE
+type CycleSequenceOption struct {
+	sequenceOption
+	From, To sqltoken.Pos
+	No       bool
+}
+
+func (c *CycleSequenceOption) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CycleSequenceOption) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.If(c.No, []byte("NO ")).Bytes([]byte("CYCLE"))
+	return sw.End()
+}
+
+func (c *CycleSequenceOption) Pos() sqltoken.Pos {
+	return c.From
+}
+
+func (c *CycleSequenceOption) End() sqltoken.Pos {
+	return c.To
+}
+
+// OWNED BY { table_name.column_name | NONE }
+type OwnedBySequenceOption struct {
+	sequenceOption
+	From, To sqltoken.Pos
+	Column   *ObjectName // nil if OWNED BY NONE
+}
+
+func (o *OwnedBySequenceOption) ToSQLString() string {
+	return toSQLString(o)
+}
+
+func (o *OwnedBySequenceOption) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("OWNED BY "))
+	if o.Column == nil {
+		sw.Bytes([]byte("NONE"))
+	} else {
+		sw.Node(o.Column)
+	}
+	return sw.End()
+}
+
+func (o *OwnedBySequenceOption) Pos() sqltoken.Pos {
+	return o.From
+}
+
+func (o *OwnedBySequenceOption) End() sqltoken.Pos {
+	return o.To
+}
@@ -15,8 +15,10 @@ type InsertStmt struct {
 	Insert            sqltoken.Pos // first position of INSERT keyword
 	TableName         *ObjectName
 	Columns           []*Ident
-	Source            InsertSource  // Insert Source [SubQuery or Constructor]
-	UpdateAssignments []*Assignment // MySQL only (ON DUPLICATED KEYS)
+	Source            InsertSource    // Insert Source [SubQuery or Constructor]
+	UpdateAssignments []*Assignment   // MySQL only (ON DUPLICATED KEYS)
+	OnConflict        *OnConflict     // PostgreSQL only
+	Returning         []SQLSelectItem // PostgreSQL only
 }
 
 func (i *InsertStmt) Pos() sqltoken.Pos {
@@ -24,6 +26,12 @@ func (i *InsertStmt) Pos() sqltoken.Pos {
 }
 
 func (i *InsertStmt) End() sqltoken.Pos {
+	if len(i.Returning) != 0 {
+		return i.Returning[len(i.Returning)-1].End()
+	}
+	if i.OnConflict != nil {
+		return i.OnConflict.End()
+	}
 	if len(i.UpdateAssignments) != 0 {
 		return i.UpdateAssignments[len(i.UpdateAssignments)-1].End()
 	}
@@ -36,7 +44,7 @@ func (i *InsertStmt) ToSQLString() string {
 }
 
 func (i *InsertStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("INSERT INTO ")).Node(i.TableName).Space()
 	if len(i.Columns) != 0 {
 		sw.LParen().Idents(i.Columns, []byte(", ")).RParen().Space()
@@ -48,6 +56,69 @@ func (i *InsertStmt) WriteTo(w io.Writer) (int64, error) {
 			sw.JoinComma(i, assignment)
 		}
 	}
+	if i.OnConflict != nil {
+		sw.Space().Node(i.OnConflict)
+	}
+	writeReturning(sw, i.Returning)
+	return sw.End()
+}
+
+// ON CONFLICT [ ( column_name [, ...] ) [ WHERE index_predicate ] | ON CONSTRAINT constraint_name ]
+//
+//	{ DO NOTHING | DO UPDATE SET column_name = expression [, ...] [ WHERE condition ] }
+type OnConflict struct {
+	On          sqltoken.Pos
+	Columns     []*Ident // conflict target columns
+	RParen      sqltoken.Pos
+	TargetWhere Node   // index predicate of the conflict target
+	Constraint  *Ident // ON CONSTRAINT constraint_name
+	DoNothing   bool
+	Nothing     sqltoken.Pos // end position of DO NOTHING
+	Assignments []*Assignment
+	Selection   Node // WHERE condition of DO UPDATE
+}
+
+func (o *OnConflict) Pos() sqltoken.Pos {
+	return o.On
+}
+
+func (o *OnConflict) End() sqltoken.Pos {
+	if o.DoNothing {
+		return o.Nothing
+	}
+	if o.Selection != nil {
+		return o.Selection.End()
+	}
+	return o.Assignments[len(o.Assignments)-1].End()
+}
+
+func (o *OnConflict) ToSQLString() string {
+	return toSQLString(o)
+}
+
+func (o *OnConflict) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ON CONFLICT"))
+	if len(o.Columns) != 0 {
+		sw.Bytes([]byte(" (")).Idents(o.Columns, []byte(", ")).RParen()
+		if o.TargetWhere != nil {
+			sw.Bytes(whereBytes).Node(o.TargetWhere)
+		}
+	}
+	if o.Constraint != nil {
+		sw.Bytes([]byte(" ON CONSTRAINT ")).Node(o.Constraint)
+	}
+	if o.DoNothing {
+		sw.Bytes([]byte(" DO NOTHING"))
+		return sw.End()
+	}
+	sw.Bytes([]byte(" DO UPDATE SET "))
+	for i, assignment := range o.Assignments {
+		sw.JoinComma(i, assignment)
+	}
+	if o.Selection != nil {
+		sw.Bytes(whereBytes).Node(o.Selection)
+	}
 	return sw.End()
 }
 
@@ -72,7 +143,7 @@ func (s *SubQuerySource) ToSQLString() string {
 }
 
 func (s *SubQuerySource) WriteTo(w io.Writer) (int64, error) {
-	return s.SubQuery.WriteTo(w)
+	return NewSQLWriter(w).Node(s.SubQuery).End()
 }
 
 type ConstructorSource struct {
@@ -94,7 +165,7 @@ func (c *ConstructorSource) ToSQLString() string {
 }
 
 func (c *ConstructorSource) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("VALUES "))
 	for i, row := range c.Rows {
 		sw.JoinComma(i, row)
@@ -120,7 +191,7 @@ func (r *RowValueExpr) ToSQLString() string {
 }
 
 func (r *RowValueExpr) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.LParen()
 	for i, val := range r.Values {
 		sw.JoinComma(i, val)
@@ -129,22 +200,38 @@ func (r *RowValueExpr) WriteTo(w io.Writer) (int64, error) {
 	return sw.End()
 }
 
-// TODO Remove CopyStmt
+// COPY table_name [ ( column_name [, ...] ) ]
+//
+//	{ FROM | TO } { 'filename' | STDIN | STDOUT } [ [ WITH ] ( option [, ...] ) ]
 type CopyStmt struct {
 	stmt
 	Copy      sqltoken.Pos
 	TableName *ObjectName
 	Columns   []*Ident
-	Values    []*string
+	To        bool                // COPY TO instead of COPY FROM
+	File      *SingleQuotedString // nil if STDIN or STDOUT
+	StdioPos  sqltoken.Pos        // end position of STDIN or STDOUT
+	Options   []*CopyOption
+	RParen    sqltoken.Pos // position of ) of options
+	Data      *string      // inline data rows of COPY FROM STDIN; nil if omitted
+	DataEnd   sqltoken.Pos // end position of \. which terminates the data
 }
 
 func (c *CopyStmt) Pos() sqltoken.Pos {
 	return c.Copy
 }
 
-// TODO
 func (c *CopyStmt) End() sqltoken.Pos {
-	panic("not implemented")
+	if c.Data != nil {
+		return c.DataEnd
+	}
+	if len(c.Options) != 0 {
+		return c.RParen
+	}
+	if c.File != nil {
+		return c.File.End()
+	}
+	return c.StdioPos
 }
 
 func (c *CopyStmt) ToSQLString() string {
@@ -152,35 +239,76 @@ func (c *CopyStmt) ToSQLString() string {
 }
 
 func (c *CopyStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("COPY ")).Node(c.TableName)
 	if len(c.Columns) != 0 {
 		sw.Space().LParen().Idents(c.Columns, []byte(", ")).RParen()
 	}
-	sw.Bytes([]byte(" FROM stdin; "))
-	if len(c.Values) != 0 {
-		sw.Bytes([]byte("\n"))
-		for i, val := range c.Values {
-			if i > 0 {
-				sw.Bytes([]byte("\t"))
-			}
-			if val == nil {
-				sw.Bytes([]byte("\\N"))
-			} else {
-				sw.Bytes([]byte(*val))
-			}
+	switch {
+	case c.To && c.File == nil:
+		sw.Bytes([]byte(" TO STDOUT"))
+	case c.To:
+		sw.Bytes([]byte(" TO ")).Node(c.File)
+	case c.File == nil:
+		sw.Bytes([]byte(" FROM STDIN"))
+	default:
+		sw.Bytes([]byte(" FROM ")).Node(c.File)
+	}
+	if len(c.Options) != 0 {
+		sw.Bytes([]byte(" WITH ("))
+		for i, o := range c.Options {
+			sw.JoinComma(i, o)
 		}
+		sw.RParen()
+	}
+	if c.Data != nil {
+		// the data follows the statement delimiter
+		sw.Bytes([]byte(";\n")).Bytes([]byte(*c.Data)).Bytes([]byte("\\."))
+	}
+	return sw.End()
+}
+
+// option_name [ value ] of COPY
+type CopyOption struct {
+	Name  *Ident
+	Value Node // nil if omitted
+}
+
+func (c *CopyOption) Pos() sqltoken.Pos {
+	return c.Name.Pos()
+}
+
+func (c *CopyOption) End() sqltoken.Pos {
+	if c.Value != nil {
+		return c.Value.End()
+	}
+	return c.Name.End()
+}
+
+func (c *CopyOption) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CopyOption) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(c.Name)
+	if c.Value != nil {
+		sw.Space().Node(c.Value)
 	}
-	sw.Bytes([]byte("\n\\."))
 	return sw.End()
 }
 
 type UpdateStmt struct {
 	stmt
 	Update      sqltoken.Pos
+	Hint        *HintComment // MySQL only
 	TableName   *ObjectName
 	Assignments []*Assignment
+	FromClause  []TableReference // PostgreSQL only
 	Selection   Node
+	OrderBy     []*OrderByExpr  // MySQL only
+	Limit       *LimitExpr      // MySQL only
+	Returning   []SQLSelectItem // PostgreSQL only
 }
 
 func (u *UpdateStmt) Pos() sqltoken.Pos {
@@ -188,9 +316,21 @@ func (u *UpdateStmt) Pos() sqltoken.Pos {
 }
 
 func (u *UpdateStmt) End() sqltoken.Pos {
+	if len(u.Returning) != 0 {
+		return u.Returning[len(u.Returning)-1].End()
+	}
+	if u.Limit != nil {
+		return u.Limit.End()
+	}
+	if len(u.OrderBy) != 0 {
+		return u.OrderBy[len(u.OrderBy)-1].End()
+	}
 	if u.Selection != nil {
 		return u.Selection.End()
 	}
+	if len(u.FromClause) != 0 {
+		return u.FromClause[len(u.FromClause)-1].End()
+	}
 
 	return u.Assignments[len(u.Assignments)-1].End()
 }
@@ -200,24 +340,41 @@ func (u *UpdateStmt) ToSQLString() string {
 }
 
 func (u *UpdateStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("UPDATE ")).Node(u.TableName).Bytes([]byte(" SET "))
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("UPDATE "))
+	if u.Hint != nil {
+		sw.Node(u.Hint).Space()
+	}
+	sw.Node(u.TableName).Bytes([]byte(" SET "))
 	if u.Assignments != nil {
 		for i, assignment := range u.Assignments {
 			sw.JoinComma(i, assignment)
 		}
 	}
+	if len(u.FromClause) != 0 {
+		sw.Bytes([]byte(" FROM "))
+		for i, from := range u.FromClause {
+			sw.JoinComma(i, from)
+		}
+	}
 	if u.Selection != nil {
 		sw.Bytes([]byte(" WHERE ")).Node(u.Selection)
 	}
+	writeOrderByLimit(sw, u.OrderBy, u.Limit)
+	writeReturning(sw, u.Returning)
 	return sw.End()
 }
 
 type DeleteStmt struct {
 	stmt
 	Delete    sqltoken.Pos
+	Hint      *HintComment // MySQL only
 	TableName *ObjectName
+	Using     []TableReference // PostgreSQL only
 	Selection Node
+	OrderBy   []*OrderByExpr  // MySQL only
+	Limit     *LimitExpr      // MySQL only
+	Returning []SQLSelectItem // PostgreSQL only
 }
 
 func (d *DeleteStmt) Pos() sqltoken.Pos {
@@ -225,9 +382,21 @@ func (d *DeleteStmt) Pos() sqltoken.Pos {
 }
 
 func (d *DeleteStmt) End() sqltoken.Pos {
+	if len(d.Returning) != 0 {
+		return d.Returning[len(d.Returning)-1].End()
+	}
+	if d.Limit != nil {
+		return d.Limit.End()
+	}
+	if len(d.OrderBy) != 0 {
+		return d.OrderBy[len(d.OrderBy)-1].End()
+	}
 	if d.Selection != nil {
 		return d.Selection.End()
 	}
+	if len(d.Using) != 0 {
+		return d.Using[len(d.Using)-1].End()
+	}
 
 	return d.TableName.End()
 }
@@ -237,20 +406,62 @@ func (d *DeleteStmt) ToSQLString() string {
 }
 
 func (d *DeleteStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("DELETE FROM ")).Node(d.TableName)
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DELETE "))
+	if d.Hint != nil {
+		sw.Node(d.Hint).Space()
+	}
+	sw.Bytes([]byte("FROM ")).Node(d.TableName)
+	if len(d.Using) != 0 {
+		sw.Bytes([]byte(" USING "))
+		for i, using := range d.Using {
+			sw.JoinComma(i, using)
+		}
+	}
 	if d.Selection != nil {
 		sw.Bytes([]byte(" WHERE ")).Node(d.Selection)
 	}
+	writeOrderByLimit(sw, d.OrderBy, d.Limit)
+	writeReturning(sw, d.Returning)
 	return sw.End()
 }
 
+func writeOrderByLimit(sw *SQLWriter, orderBy []*OrderByExpr, limit *LimitExpr) {
+	if len(orderBy) != 0 {
+		sw.Bytes([]byte(" ORDER BY "))
+		for i, o := range orderBy {
+			sw.JoinComma(i, o)
+		}
+	}
+	if limit != nil {
+		sw.Space().Node(limit)
+	}
+}
+
+func writeReturning(sw *SQLWriter, returning []SQLSelectItem) {
+	if len(returning) == 0 {
+		return
+	}
+	sw.Bytes([]byte(" RETURNING "))
+	for i, item := range returning {
+		sw.JoinComma(i, item)
+	}
+}
+
 type CreateViewStmt struct {
 	stmt
-	Create       sqltoken.Pos
-	Name         *ObjectName
-	Query        *QueryStmt
-	Materialized bool
+	Create          sqltoken.Pos
+	View            sqltoken.Pos
+	Name            *ObjectName
+	Query           *QueryStmt
+	Materialized    bool
+	MaterializedPos sqltoken.Pos
+	OrReplace       bool
+	NotExists       bool
+	NotExistsFrom   sqltoken.Pos // start position of IF NOT EXISTS
+	NotExistsTo     sqltoken.Pos // end position of IF NOT EXISTS
+	Columns         []*Ident     // column alias list. nil if omitted
+	RParen          sqltoken.Pos
 }
 
 func (c *CreateViewStmt) Pos() sqltoken.Pos {
@@ -266,21 +477,125 @@ func (c *CreateViewStmt) ToSQLString() string {
 }
 
 func (c *CreateViewStmt) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
+	sw := NewSQLWriter(w).
 		Bytes([]byte("CREATE")).
+		If(c.OrReplace, []byte(" OR REPLACE")).
 		If(c.Materialized, []byte(" MATERIALIZED")).
-		Bytes([]byte(" VIEW ")).Node(c.Name).As().Node(c.Query).
-		End()
+		Bytes([]byte(" VIEW ")).
+		If(c.NotExists, []byte("IF NOT EXISTS ")).
+		Node(c.Name)
+	writeViewColumns(sw, c.Columns)
+	return sw.As().Node(c.Query).End()
+}
+
+func writeViewColumns(sw *SQLWriter, columns []*Ident) {
+	if len(columns) == 0 {
+		return
+	}
+	sw.Space().LParen()
+	for i, c := range columns {
+		sw.JoinComma(i, c)
+	}
+	sw.RParen()
+}
+
+// ALTER VIEW Name [(Columns)] AS Query
+// or ALTER VIEW Name RENAME TO NewName (PostgreSQL)
+type AlterViewStmt struct {
+	stmt
+	Alter   sqltoken.Pos
+	Name    *ObjectName
+	Columns []*Ident // column alias list. nil if omitted
+	RParen  sqltoken.Pos
+	Query   *QueryStmt  // nil if RENAME TO
+	NewName *ObjectName // nil unless RENAME TO
+}
+
+func (a *AlterViewStmt) Pos() sqltoken.Pos {
+	return a.Alter
+}
+
+func (a *AlterViewStmt) End() sqltoken.Pos {
+	if a.NewName != nil {
+		return a.NewName.End()
+	}
+	return a.Query.End()
+}
+
+func (a *AlterViewStmt) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *AlterViewStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ALTER VIEW ")).Node(a.Name)
+	if a.NewName != nil {
+		return sw.Bytes([]byte(" RENAME TO ")).Node(a.NewName).End()
+	}
+	writeViewColumns(sw, a.Columns)
+	return sw.As().Node(a.Query).End()
+}
+
+// DROP [MATERIALIZED] VIEW [IF EXISTS] ViewNames... [CASCADE | RESTRICT]
+type DropViewStmt struct {
+	stmt
+	Drop         sqltoken.Pos
+	Materialized bool
+	ViewNames    []*ObjectName
+	Cascade      bool
+	CascadePos   sqltoken.Pos
+	Restrict     bool
+	RestrictPos  sqltoken.Pos
+	IfExists     bool
+}
+
+func (d *DropViewStmt) Pos() sqltoken.Pos {
+	return d.Drop
+}
+
+func (d *DropViewStmt) End() sqltoken.Pos {
+	if d.Cascade {
+		return d.CascadePos
+	}
+	if d.Restrict {
+		return d.RestrictPos
+	}
+
+	return d.ViewNames[len(d.ViewNames)-1].End()
+}
+
+func (d *DropViewStmt) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DropViewStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DROP ")).If(d.Materialized, []byte("MATERIALIZED ")).Bytes([]byte("VIEW "))
+	sw.If(d.IfExists, []byte("IF EXISTS "))
+	for i, v := range d.ViewNames {
+		sw.JoinComma(i, v)
+	}
+	sw.If(d.Cascade, []byte(" CASCADE")).If(d.Restrict, []byte(" RESTRICT"))
+	return sw.End()
 }
 
 type CreateTableStmt struct {
 	stmt
-	Create    sqltoken.Pos
-	Name      *ObjectName
-	Elements  []TableElement
-	Location  *string
-	NotExists bool
-	Options   []TableOption
+	Create        sqltoken.Pos
+	Temporary     bool // TEMPORARY or TEMP
+	Unlogged      bool // PostgreSQL only
+	Table         sqltoken.Pos
+	Name          *ObjectName
+	Elements      []TableElement
+	Location      *string
+	NotExists     bool
+	NotExistsFrom sqltoken.Pos    // start position of IF NOT EXISTS
+	NotExistsTo   sqltoken.Pos    // end position of IF NOT EXISTS
+	PartitionOf   *ObjectName     // parent table of PARTITION OF. PostgreSQL only
+	Inherits      *InheritsClause // PostgreSQL only
+	Bound         *PartitionBound // required when PartitionOf is set
+	PartitionBy   *PartitionSpec  // PostgreSQL only
+	Options       []TableOption
 }
 
 func (c *CreateTableStmt) Pos() sqltoken.Pos {
@@ -288,6 +603,21 @@ func (c *CreateTableStmt) Pos() sqltoken.Pos {
 }
 
 func (c *CreateTableStmt) End() sqltoken.Pos {
+	if len(c.Options) != 0 {
+		return c.Options[len(c.Options)-1].End()
+	}
+	if c.PartitionBy != nil {
+		return c.PartitionBy.End()
+	}
+	if c.Bound != nil {
+		return c.Bound.End()
+	}
+	if c.Inherits != nil {
+		return c.Inherits.End()
+	}
+	if len(c.Elements) == 0 {
+		return c.Name.End()
+	}
 	return c.Elements[len(c.Elements)-1].End()
 }
 
@@ -296,15 +626,33 @@ func (c *CreateTableStmt) ToSQLString() string {
 }
 
 func (c *CreateTableStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("CREATE TABLE "))
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE "))
+	sw.If(c.Temporary, []byte("TEMPORARY ")).If(c.Unlogged, []byte("UNLOGGED "))
+	sw.Bytes([]byte("TABLE "))
 	sw.If(c.NotExists, []byte("IF NOT EXISTS "))
-	sw.Node(c.Name).Space().LParen()
-	for i, element := range c.Elements {
-		sw.JoinComma(i, element)
+	sw.Node(c.Name)
+	if c.PartitionOf != nil {
+		sw.Bytes([]byte(" PARTITION OF ")).Node(c.PartitionOf)
+	}
+	if len(c.Elements) != 0 || c.PartitionOf == nil {
+		sw.Space().LParen()
+		for i, element := range c.Elements {
+			sw.JoinComma(i, element)
+		}
+		sw.RParen()
+	}
+	if c.Inherits != nil {
+		sw.Space().Node(c.Inherits)
+	}
+	if c.Bound != nil {
+		sw.Space().Node(c.Bound)
+	}
+	if c.PartitionBy != nil {
+		sw.Space().Node(c.PartitionBy)
 	}
-	sw.RParen()
 	if len(c.Options) != 0 {
+		sw.Space()
 		for i, option := range c.Options {
 			sw.JoinComma(i, option)
 		}
@@ -330,7 +678,7 @@ func (a *Assignment) ToSQLString() string {
 }
 
 func (a *Assignment) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(a.ID).Bytes([]byte(" = ")).Node(a.Value).End()
+	return NewSQLWriter(w).Node(a.ID).Bytes([]byte(" = ")).Node(a.Value).End()
 }
 
 //go:generate genmark -t TableElement -e Node
@@ -358,7 +706,7 @@ func (t *TableConstraint) ToSQLString() string {
 }
 
 func (t *TableConstraint) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	if t.Name != nil {
 		sw.Bytes([]byte("CONSTRAINT ")).Node(t.Name).Space()
 	}
@@ -366,189 +714,586 @@ func (t *TableConstraint) WriteTo(w io.Writer) (int64, error) {
 	return sw.End()
 }
 
-//go:generate genmark -t TableConstraintSpec -e Node
+type IndexKind int
 
-type UniqueTableConstraint struct {
-	tableConstraintSpec
-	IsPrimary       bool
-	Primary, Unique sqltoken.Pos
-	RParen          sqltoken.Pos
-	Columns         []*Ident
-}
+const (
+	PlainIndex IndexKind = iota
+	UniqueIndex
+	FulltextIndex
+	SpatialIndex
+)
 
-func (u *UniqueTableConstraint) Pos() sqltoken.Pos {
-	if u.IsPrimary {
-		return u.Primary
+func (k IndexKind) String() string {
+	switch k {
+	case UniqueIndex:
+		return "UNIQUE"
+	case FulltextIndex:
+		return "FULLTEXT"
+	case SpatialIndex:
+		return "SPATIAL"
 	}
-	return u.Unique
+	return ""
 }
 
-func (u *UniqueTableConstraint) End() sqltoken.Pos {
-	return u.RParen
+// [UNIQUE | FULLTEXT | SPATIAL] {KEY | INDEX} [Name] (Columns...) [USING Using] [COMMENT 'string'] (MySQL)
+// LIKE source_table [ { INCLUDING | EXCLUDING } option ... ]
+type LikeTableElement struct {
+	tableElement
+	Like    sqltoken.Pos
+	Source  *ObjectName
+	Options []*LikeOption // PostgreSQL only
 }
 
-func (u *UniqueTableConstraint) ToSQLString() string {
-	return toSQLString(u)
+func (l *LikeTableElement) Pos() sqltoken.Pos {
+	return l.Like
 }
 
-func (u *UniqueTableConstraint) WriteTo(w io.Writer) (n int64, err error) {
-	sw := newSQLWriter(w)
-	if u.IsPrimary {
-		sw.Bytes([]byte("PRIMARY KEY"))
-	} else {
-		sw.Bytes([]byte("UNIQUE"))
+func (l *LikeTableElement) End() sqltoken.Pos {
+	if len(l.Options) != 0 {
+		return l.Options[len(l.Options)-1].End()
 	}
-	sw.LParen().Idents(u.Columns, []byte(", ")).RParen()
-	return sw.End()
+	return l.Source.End()
 }
 
-type ReferentialTableConstraint struct {
-	tableConstraintSpec
-	Foreign sqltoken.Pos
-	Columns []*Ident
-	KeyExpr *ReferenceKeyExpr
+func (l *LikeTableElement) ToSQLString() string {
+	return toSQLString(l)
 }
 
-func (r *ReferentialTableConstraint) Pos() sqltoken.Pos {
-	return r.Foreign
+func (l *LikeTableElement) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("LIKE ")).Node(l.Source)
+	for _, o := range l.Options {
+		sw.Space().Node(o)
+	}
+	return sw.End()
 }
 
-func (r *ReferentialTableConstraint) End() sqltoken.Pos {
-	return r.KeyExpr.End()
+// { INCLUDING | EXCLUDING } { COMMENTS | CONSTRAINTS | DEFAULTS | IDENTITY | INDEXES | STATISTICS | STORAGE | ALL }
+type LikeOption struct {
+	From      sqltoken.Pos // first position of INCLUDING or EXCLUDING
+	Including bool
+	Name      *Ident
 }
 
-func (r *ReferentialTableConstraint) ToSQLString() string {
-	return toSQLString(r)
+func (l *LikeOption) Pos() sqltoken.Pos {
+	return l.From
 }
 
-func (r *ReferentialTableConstraint) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
-		Bytes([]byte("FOREIGN KEY")).
-		LParen().Idents(r.Columns, []byte(", ")).RParen().
-		Bytes([]byte(" REFERENCES ")).Node(r.KeyExpr).
-		End()
+func (l *LikeOption) End() sqltoken.Pos {
+	return l.Name.End()
 }
 
-type ReferenceKeyExpr struct {
-	TableName *Ident
-	Columns   []*Ident
-	RParen    sqltoken.Pos
+func (l *LikeOption) ToSQLString() string {
+	return toSQLString(l)
 }
 
-func (r *ReferenceKeyExpr) Pos() sqltoken.Pos {
-	return r.TableName.Pos()
+func (l *LikeOption) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if l.Including {
+		sw.Bytes([]byte("INCLUDING "))
+	} else {
+		sw.Bytes([]byte("EXCLUDING "))
+	}
+	return sw.Node(l.Name).End()
 }
 
-func (r *ReferenceKeyExpr) End() sqltoken.Pos {
-	return r.RParen
-}
+type PartitionStrategy int
 
-func (r *ReferenceKeyExpr) ToSQLString() string {
-	return toSQLString(r)
-}
+const (
+	RangePartition PartitionStrategy = iota
+	ListPartition
+	HashPartition
+)
 
-func (r *ReferenceKeyExpr) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
-		Node(r.TableName).LParen().Idents(r.Columns, []byte(", ")).RParen().
-		End()
+func (p PartitionStrategy) String() string {
+	switch p {
+	case RangePartition:
+		return "RANGE"
+	case ListPartition:
+		return "LIST"
+	case HashPartition:
+		return "HASH"
+	}
+	return ""
 }
 
-type CheckTableConstraint struct {
-	tableConstraintSpec
-	Check  sqltoken.Pos
-	RParen sqltoken.Pos
-	Expr   Node
+// PARTITION BY { RANGE | LIST | HASH } ( { column_name | ( expression ) } [, ...] )
+type PartitionSpec struct {
+	Partition sqltoken.Pos
+	Strategy  PartitionStrategy
+	Columns   []Node
+	RParen    sqltoken.Pos
 }
 
-func (c *CheckTableConstraint) Pos() sqltoken.Pos {
-	return c.Check
+func (p *PartitionSpec) Pos() sqltoken.Pos {
+	return p.Partition
 }
 
-func (c *CheckTableConstraint) End() sqltoken.Pos {
-	return c.RParen
+func (p *PartitionSpec) End() sqltoken.Pos {
+	return p.RParen
 }
 
-func (c *CheckTableConstraint) ToSQLString() string {
-	return toSQLString(c)
+func (p *PartitionSpec) ToSQLString() string {
+	return toSQLString(p)
 }
 
-func (c *CheckTableConstraint) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).
-		Bytes([]byte("CHECK")).LParen().Node(c.Expr).RParen().
-		End()
+func (p *PartitionSpec) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("PARTITION BY ")).Bytes([]byte(p.Strategy.String()))
+	return sw.Bytes([]byte(" (")).Nodes(p.Columns).RParen().End()
 }
 
-type ColumnDef struct {
-	tableElement
-	Name                 *Ident
-	DataType             Type
-	Default              Node
-	MyDataTypeDecoration []MyDataTypeDecoration // DataType Decoration for MySQL eg. AUTO_INCREMENT currently, only supports AUTO_INCREMENT
-	Constraints          []*ColumnConstraint
+// INHERITS ( parent_table [, ...] )
+type InheritsClause struct {
+	Inherits sqltoken.Pos
+	Parents  []*ObjectName
+	RParen   sqltoken.Pos
 }
 
-func (c *ColumnDef) Pos() sqltoken.Pos {
-	return c.Name.Pos()
+func (i *InheritsClause) Pos() sqltoken.Pos {
+	return i.Inherits
 }
 
-func (c *ColumnDef) End() sqltoken.Pos {
-	return c.Constraints[len(c.Constraints)-1].End()
+func (i *InheritsClause) End() sqltoken.Pos {
+	return i.RParen
 }
 
-func (c *ColumnDef) ToSQLString() string {
-	return toSQLString(c)
+func (i *InheritsClause) ToSQLString() string {
+	return toSQLString(i)
 }
 
-func (c *ColumnDef) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Node(c.Name).Space().Node(c.DataType)
-	if c.Default != nil {
-		sw.Bytes([]byte(" DEFAULT ")).Node(c.Default)
-	}
-	for _, m := range c.MyDataTypeDecoration {
-		sw.Space().Node(m)
+func (i *InheritsClause) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("INHERITS ("))
+	for idx, parent := range i.Parents {
+		sw.JoinComma(idx, parent)
 	}
-	for _, cons := range c.Constraints {
-		sw.Node(cons)
-	}
-	return sw.End()
+	return sw.RParen().End()
 }
 
-//go:generate genmark -t MyDataTypeDecoration -e Node
+// FOR VALUES IN ( expr [, ...] )
+// FOR VALUES FROM ( expr [, ...] ) TO ( expr [, ...] )
+// FOR VALUES WITH ( MODULUS m, REMAINDER r )
+// DEFAULT
+// MINVALUE and MAXVALUE of range bounds are parsed as identifiers.
+type PartitionBound struct {
+	From      sqltoken.Pos // first position of FOR or DEFAULT
+	Default   bool
+	In        []Node
+	RangeFrom []Node
+	RangeTo   []Node
+	Modulus   Node
+	Remainder Node
+	To        sqltoken.Pos
+}
 
-type AutoIncrement struct {
-	myDataTypeDecoration
-	Auto      sqltoken.Pos
-	Increment sqltoken.Pos
+func (p *PartitionBound) Pos() sqltoken.Pos {
+	return p.From
 }
 
-func (a *AutoIncrement) ToSQLString() string {
-	return "AUTO_INCREMENT"
+func (p *PartitionBound) End() sqltoken.Pos {
+	return p.To
 }
 
-func (a *AutoIncrement) WriteTo(w io.Writer) (int64, error) {
-	return writeSingleBytes(w, []byte("AUTO_INCREMENT"))
+func (p *PartitionBound) ToSQLString() string {
+	return toSQLString(p)
 }
 
-func (a *AutoIncrement) Pos() sqltoken.Pos {
-	return a.Auto
+func (p *PartitionBound) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	switch {
+	case p.Default:
+		sw.Bytes([]byte("DEFAULT"))
+	case p.Modulus != nil:
+		sw.Bytes([]byte("FOR VALUES WITH (MODULUS ")).Node(p.Modulus)
+		sw.Bytes([]byte(", REMAINDER ")).Node(p.Remainder).RParen()
+	case len(p.RangeFrom) != 0:
+		sw.Bytes([]byte("FOR VALUES FROM (")).Nodes(p.RangeFrom)
+		sw.Bytes([]byte(") TO (")).Nodes(p.RangeTo).RParen()
+	default:
+		sw.Bytes([]byte("FOR VALUES IN (")).Nodes(p.In).RParen()
+	}
+	return sw.End()
 }
 
-func (a *AutoIncrement) End() sqltoken.Pos {
-	return a.Increment
+type IndexTableElement struct {
+	tableElement
+	From       sqltoken.Pos // first position of UNIQUE, FULLTEXT, SPATIAL, KEY or INDEX
+	Type       IndexKind
+	IsIndex    bool   // written as INDEX instead of KEY
+	Name       *Ident // nil if omitted
+	Using      *Ident // index type i.e: BTREE, HASH. nil if omitted
+	Columns    []*IndexColumn
+	RParen     sqltoken.Pos
+	CommentPos sqltoken.Pos
+	Comment    *SingleQuotedString
 }
 
-type ColumnConstraint struct {
-	Name       *Ident
-	Constraint sqltoken.Pos
-	Spec       ColumnConstraintSpec
+func (i *IndexTableElement) Pos() sqltoken.Pos {
+	return i.From
 }
 
-func (c *ColumnConstraint) Pos() sqltoken.Pos {
-	if c.Name == nil {
-		return c.Constraint
+func (i *IndexTableElement) End() sqltoken.Pos {
+	if i.Comment != nil {
+		return i.Comment.End()
 	}
-	return c.Name.Pos()
+	if i.Using != nil {
+		return i.Using.End()
+	}
+	return i.RParen
+}
+
+func (i *IndexTableElement) ToSQLString() string {
+	return toSQLString(i)
+}
+
+func (i *IndexTableElement) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if i.Type != PlainIndex {
+		sw.Bytes([]byte(i.Type.String())).Space()
+	}
+	if i.IsIndex {
+		sw.Bytes([]byte("INDEX"))
+	} else {
+		sw.Bytes([]byte("KEY"))
+	}
+	if i.Name != nil {
+		sw.Space().Node(i.Name)
+	}
+	sw.Space().LParen()
+	for j, c := range i.Columns {
+		sw.JoinComma(j, c)
+	}
+	sw.RParen()
+	if i.Using != nil {
+		sw.Bytes([]byte(" USING ")).Node(i.Using)
+	}
+	if i.Comment != nil {
+		sw.Bytes([]byte(" COMMENT ")).Node(i.Comment)
+	}
+	return sw.End()
+}
+
+// column of index with optional prefix length i.e: name(10) DESC (MySQL)
+type IndexColumn struct {
+	Name        *Ident
+	Length      *uint        // prefix length. nil if omitted
+	RParen      sqltoken.Pos // position of ) of prefix length if Length != nil
+	OrderingPos sqltoken.Pos // ASC / DESC keyword position if ASC != nil
+	ASC         *bool
+}
+
+func (i *IndexColumn) Pos() sqltoken.Pos {
+	return i.Name.Pos()
+}
+
+func (i *IndexColumn) End() sqltoken.Pos {
+	if i.ASC != nil {
+		return i.OrderingPos
+	}
+	if i.Length != nil {
+		return i.RParen
+	}
+	return i.Name.End()
+}
+
+func (i *IndexColumn) ToSQLString() string {
+	return toSQLString(i)
+}
+
+func (i *IndexColumn) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(i.Name)
+	if i.Length != nil {
+		sw.LParen().Int(int(*i.Length)).RParen()
+	}
+	if i.ASC != nil {
+		if *i.ASC {
+			sw.Bytes([]byte(" ASC"))
+		} else {
+			sw.Bytes([]byte(" DESC"))
+		}
+	}
+	return sw.End()
+}
+
+//go:generate genmark -t TableConstraintSpec -e Node
+
+// NULLS [ NOT ] DISTINCT of UNIQUE constraints (PostgreSQL 15+)
+type NullsDistinctOption int
+
+const (
+	NullsDistinctOmitted NullsDistinctOption = iota
+	NullsDistinct
+	NullsNotDistinct
+)
+
+func (n NullsDistinctOption) String() string {
+	switch n {
+	case NullsDistinct:
+		return "NULLS DISTINCT"
+	case NullsNotDistinct:
+		return "NULLS NOT DISTINCT"
+	}
+	return ""
+}
+
+type UniqueTableConstraint struct {
+	tableConstraintSpec
+	IsPrimary       bool
+	Primary, Unique sqltoken.Pos
+	Nulls           NullsDistinctOption
+	RParen          sqltoken.Pos
+	Columns         []*Ident
+	CommentPos      sqltoken.Pos
+	Comment         *SingleQuotedString // MySQL only. COMMENT 'string'
+}
+
+func (u *UniqueTableConstraint) Pos() sqltoken.Pos {
+	if u.IsPrimary {
+		return u.Primary
+	}
+	return u.Unique
+}
+
+func (u *UniqueTableConstraint) End() sqltoken.Pos {
+	if u.Comment != nil {
+		return u.Comment.End()
+	}
+	return u.RParen
+}
+
+func (u *UniqueTableConstraint) ToSQLString() string {
+	return toSQLString(u)
+}
+
+func (u *UniqueTableConstraint) WriteTo(w io.Writer) (n int64, err error) {
+	sw := NewSQLWriter(w)
+	if u.IsPrimary {
+		sw.Bytes([]byte("PRIMARY KEY"))
+	} else {
+		sw.Bytes([]byte("UNIQUE"))
+	}
+	if u.Nulls != NullsDistinctOmitted {
+		sw.Space().Bytes([]byte(u.Nulls.String()))
+	}
+	sw.LParen().Idents(u.Columns, []byte(", ")).RParen()
+	if u.Comment != nil {
+		sw.Bytes([]byte(" COMMENT ")).Node(u.Comment)
+	}
+	return sw.End()
+}
+
+type ReferentialTableConstraint struct {
+	tableConstraintSpec
+	Foreign sqltoken.Pos
+	Columns []*Ident
+	KeyExpr *ReferenceKeyExpr
+}
+
+func (r *ReferentialTableConstraint) Pos() sqltoken.Pos {
+	return r.Foreign
+}
+
+func (r *ReferentialTableConstraint) End() sqltoken.Pos {
+	return r.KeyExpr.End()
+}
+
+func (r *ReferentialTableConstraint) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *ReferentialTableConstraint) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).
+		Bytes([]byte("FOREIGN KEY")).
+		LParen().Idents(r.Columns, []byte(", ")).RParen().
+		Bytes([]byte(" REFERENCES ")).Node(r.KeyExpr).
+		End()
+}
+
+type ReferenceKeyExpr struct {
+	TableName *Ident
+	Columns   []*Ident
+	RParen    sqltoken.Pos
+}
+
+func (r *ReferenceKeyExpr) Pos() sqltoken.Pos {
+	return r.TableName.Pos()
+}
+
+func (r *ReferenceKeyExpr) End() sqltoken.Pos {
+	return r.RParen
+}
+
+func (r *ReferenceKeyExpr) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *ReferenceKeyExpr) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).
+		Node(r.TableName).LParen().Idents(r.Columns, []byte(", ")).RParen().
+		End()
+}
+
+// [ NOT ] ENFORCED of CHECK constraints (MySQL 8.0.16+, PostgreSQL 18+)
+type EnforcedOption int
+
+const (
+	EnforcedOmitted EnforcedOption = iota
+	Enforced
+	NotEnforced
+)
+
+func (e EnforcedOption) String() string {
+	switch e {
+	case Enforced:
+		return "ENFORCED"
+	case NotEnforced:
+		return "NOT ENFORCED"
+	}
+	return ""
+}
+
+type CheckTableConstraint struct {
+	tableConstraintSpec
+	Check      sqltoken.Pos
+	RParen     sqltoken.Pos
+	Expr       Node
+	Enforced   EnforcedOption
+	EnforcedTo sqltoken.Pos // end position of [ NOT ] ENFORCED
+}
+
+func (c *CheckTableConstraint) Pos() sqltoken.Pos {
+	return c.Check
+}
+
+func (c *CheckTableConstraint) End() sqltoken.Pos {
+	if c.Enforced != EnforcedOmitted {
+		return c.EnforcedTo
+	}
+	return c.RParen
+}
+
+func (c *CheckTableConstraint) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CheckTableConstraint) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CHECK")).LParen().Node(c.Expr).RParen()
+	if c.Enforced != EnforcedOmitted {
+		sw.Space().Bytes([]byte(c.Enforced.String()))
+	}
+	return sw.End()
+}
+
+type ColumnDef struct {
+	tableElement
+	Name                 *Ident
+	DataType             Type         // nil for columns of PARTITION OF
+	WithOptions          bool         // WITH OPTIONS of columns of PARTITION OF (PostgreSQL)
+	WithOptionsPos       sqltoken.Pos // end position of WITH OPTIONS
+	Default              Node
+	MyDataTypeDecoration []MyDataTypeDecoration // DataType Decoration for MySQL eg. AUTO_INCREMENT currently, only supports AUTO_INCREMENT
+	Constraints          []*ColumnConstraint
+}
+
+func (c *ColumnDef) Pos() sqltoken.Pos {
+	return c.Name.Pos()
+}
+
+func (c *ColumnDef) End() sqltoken.Pos {
+	if len(c.Constraints) != 0 {
+		return c.Constraints[len(c.Constraints)-1].End()
+	}
+	if c.Default != nil {
+		return c.Default.End()
+	}
+	if len(c.MyDataTypeDecoration) != 0 {
+		return c.MyDataTypeDecoration[len(c.MyDataTypeDecoration)-1].End()
+	}
+	if c.WithOptions {
+		return c.WithOptionsPos
+	}
+	if c.DataType == nil {
+		return c.Name.End()
+	}
+	return c.DataType.End()
+}
+
+func (c *ColumnDef) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *ColumnDef) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(c.Name)
+	if c.DataType != nil {
+		sw.Space().Node(c.DataType)
+	}
+	sw.If(c.WithOptions, []byte(" WITH OPTIONS"))
+	if c.Default != nil {
+		sw.Bytes([]byte(" DEFAULT ")).Node(c.Default)
+	}
+	for _, m := range c.MyDataTypeDecoration {
+		sw.Space().Node(m)
+	}
+	for _, cons := range c.Constraints {
+		sw.Node(cons)
+	}
+	return sw.End()
+}
+
+//go:generate genmark -t MyDataTypeDecoration -e Node
+
+type AutoIncrement struct {
+	myDataTypeDecoration
+	Auto      sqltoken.Pos
+	Increment sqltoken.Pos
+}
+
+func (a *AutoIncrement) ToSQLString() string {
+	return "AUTO_INCREMENT"
+}
+
+func (a *AutoIncrement) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("AUTO_INCREMENT"))
+}
+
+func (a *AutoIncrement) Pos() sqltoken.Pos {
+	return a.Auto
+}
+
+func (a *AutoIncrement) End() sqltoken.Pos {
+	return a.Increment
+}
+
+// IsRowIDAlias reports whether the column is an alias for the rowid of SQLite,
+// i.e. declared exactly as INTEGER PRIMARY KEY.
+func (c *ColumnDef) IsRowIDAlias() bool {
+	i, ok := c.DataType.(*Int)
+	if !ok || !i.IsInteger || i.IsUnsigned {
+		return false
+	}
+	for _, cons := range c.Constraints {
+		if u, ok := cons.Spec.(*UniqueColumnSpec); ok && u.IsPrimaryKey {
+			return true
+		}
+	}
+	return false
+}
+
+type ColumnConstraint struct {
+	Name       *Ident
+	Constraint sqltoken.Pos
+	Spec       ColumnConstraintSpec
+}
+
+func (c *ColumnConstraint) Pos() sqltoken.Pos {
+	if c.Name == nil {
+		return c.Constraint
+	}
+	return c.Name.Pos()
 }
 
 func (c *ColumnConstraint) End() sqltoken.Pos {
@@ -560,7 +1305,7 @@ func (c *ColumnConstraint) ToSQLString() string {
 }
 
 func (c *ColumnConstraint) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Space()
 	if c.Name != nil {
 		sw.Bytes([]byte("CONSTRAINT ")).Node(c.Name).Space()
@@ -595,9 +1340,13 @@ func (*NotNullColumnSpec) WriteTo(w io.Writer) (int64, error) {
 }
 
 type UniqueColumnSpec struct {
-	IsPrimaryKey bool
-	Primary, Key sqltoken.Pos
-	Unique       sqltoken.Pos
+	IsPrimaryKey    bool
+	Primary, Key    sqltoken.Pos
+	Unique          sqltoken.Pos
+	Nulls           NullsDistinctOption
+	NullsTo         sqltoken.Pos // end position of NULLS [ NOT ] DISTINCT
+	IsAutoIncrement bool         // PRIMARY KEY AUTOINCREMENT (SQLite)
+	AutoIncrement   sqltoken.Pos // end position of AUTOINCREMENT
 }
 
 func (u *UniqueColumnSpec) Pos() sqltoken.Pos {
@@ -608,26 +1357,31 @@ func (u *UniqueColumnSpec) Pos() sqltoken.Pos {
 }
 
 func (u *UniqueColumnSpec) End() sqltoken.Pos {
+	if u.IsAutoIncrement {
+		return u.AutoIncrement
+	}
 	if u.IsPrimaryKey {
 		return u.Key
 	}
+	if u.Nulls != NullsDistinctOmitted {
+		return u.NullsTo
+	}
 	return sqltoken.Pos{
-		Line: u.Unique.Line,
-		Col:  u.Unique.Col + 6,
+		Line:   u.Unique.Line,
+		Col:    u.Unique.Col + 6,
+		Offset: u.Unique.Offset + 6,
 	}
 }
 
 func (u *UniqueColumnSpec) ToSQLString() string {
-	if u.IsPrimaryKey {
-		return "PRIMARY KEY"
-	} else {
-		return "UNIQUE"
-	}
+	return toSQLString(u)
 }
 
 func (u *UniqueColumnSpec) WriteTo(w io.Writer) (int64, error) {
 	if u.IsPrimaryKey {
-		return writeSingleBytes(w, []byte("PRIMARY KEY"))
+		return NewSQLWriter(w).Bytes([]byte("PRIMARY KEY")).If(u.IsAutoIncrement, []byte(" AUTOINCREMENT")).End()
+	} else if u.Nulls != NullsDistinctOmitted {
+		return NewSQLWriter(w).Bytes([]byte("UNIQUE ")).Bytes([]byte(u.Nulls.String())).End()
 	} else {
 		return writeSingleBytes(w, []byte("UNIQUE"))
 	}
@@ -653,16 +1407,18 @@ func (r *ReferencesColumnSpec) ToSQLString() string {
 }
 
 func (r *ReferencesColumnSpec) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("REFERENCES ")).Node(r.TableName)
 	sw.LParen().Idents(r.Columns, []byte(", ")).RParen()
 	return sw.End()
 }
 
 type CheckColumnSpec struct {
-	Expr   Node
-	Check  sqltoken.Pos
-	RParen sqltoken.Pos
+	Expr       Node
+	Check      sqltoken.Pos
+	RParen     sqltoken.Pos
+	Enforced   EnforcedOption
+	EnforcedTo sqltoken.Pos // end position of [ NOT ] ENFORCED
 }
 
 func (c *CheckColumnSpec) Pos() sqltoken.Pos {
@@ -670,6 +1426,9 @@ func (c *CheckColumnSpec) Pos() sqltoken.Pos {
 }
 
 func (c *CheckColumnSpec) End() sqltoken.Pos {
+	if c.Enforced != EnforcedOmitted {
+		return c.EnforcedTo
+	}
 	return c.RParen
 }
 
@@ -678,8 +1437,11 @@ func (c *CheckColumnSpec) ToSQLString() string {
 }
 
 func (c *CheckColumnSpec) WriteTo(w io.Writer) (n int64, err error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("CHECK")).LParen().Node(c.Expr).RParen()
+	if c.Enforced != EnforcedOmitted {
+		sw.Space().Bytes([]byte(c.Enforced.String()))
+	}
 	return sw.End()
 }
 
@@ -741,7 +1503,16 @@ type AlterTableStmt struct {
 	stmt
 	Alter     sqltoken.Pos
 	TableName *ObjectName
-	Action    AlterTableAction
+	Actions   []AlterTableAction // comma separated actions
+}
+
+// Action returns the first action.
+// It is kept for compatibility with the single action AlterTableStmt.
+func (a *AlterTableStmt) Action() AlterTableAction {
+	if len(a.Actions) == 0 {
+		return nil
+	}
+	return a.Actions[0]
 }
 
 func (a *AlterTableStmt) Pos() sqltoken.Pos {
@@ -749,7 +1520,7 @@ func (a *AlterTableStmt) Pos() sqltoken.Pos {
 }
 
 func (a *AlterTableStmt) End() sqltoken.Pos {
-	return a.Action.End()
+	return a.Actions[len(a.Actions)-1].End()
 }
 
 func (a *AlterTableStmt) ToSQLString() string {
@@ -757,8 +1528,11 @@ func (a *AlterTableStmt) ToSQLString() string {
 }
 
 func (a *AlterTableStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("ALTER TABLE ")).Node(a.TableName).Space().Node(a.Action)
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ALTER TABLE ")).Node(a.TableName).Space()
+	for i, action := range a.Actions {
+		sw.JoinComma(i, action)
+	}
 	return sw.End()
 }
 
@@ -783,7 +1557,7 @@ func (a *AddColumnTableAction) ToSQLString() string {
 }
 
 func (a *AddColumnTableAction) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("ADD COLUMN ")).Node(a.Column)
 	return sw.End()
 }
@@ -808,7 +1582,7 @@ func (a *AlterColumnTableAction) ToSQLString() string {
 }
 
 func (a *AlterColumnTableAction) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("ALTER COLUMN ")).Node(a.ColumnName).Space().Node(a.Action)
 	return sw.End()
 }
@@ -837,7 +1611,7 @@ func (s *SetDefaultColumnAction) ToSQLString() string {
 }
 
 func (s *SetDefaultColumnAction) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Bytes([]byte("SET DEFAULT ")).Node(s.Default).End()
+	return NewSQLWriter(w).Bytes([]byte("SET DEFAULT ")).Node(s.Default).End()
 }
 
 type DropDefaultColumnAction struct {
@@ -866,6 +1640,7 @@ type PGAlterDataTypeColumnAction struct {
 	alterColumnAction
 	Type     sqltoken.Pos
 	DataType Type
+	Using    Node // optional conversion expression
 }
 
 func (p *PGAlterDataTypeColumnAction) Pos() sqltoken.Pos {
@@ -873,6 +1648,9 @@ func (p *PGAlterDataTypeColumnAction) Pos() sqltoken.Pos {
 }
 
 func (p *PGAlterDataTypeColumnAction) End() sqltoken.Pos {
+	if p.Using != nil {
+		return p.Using.End()
+	}
 	return p.DataType.End()
 }
 
@@ -881,7 +1659,12 @@ func (p *PGAlterDataTypeColumnAction) ToSQLString() string {
 }
 
 func (p *PGAlterDataTypeColumnAction) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Bytes([]byte("TYPE ")).Node(p.DataType).End()
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("TYPE ")).Node(p.DataType)
+	if p.Using != nil {
+		sw.Bytes([]byte(" USING ")).Node(p.Using)
+	}
+	return sw.End()
 }
 
 type PGSetNotNullColumnAction struct {
@@ -950,7 +1733,7 @@ func (r *RemoveColumnTableAction) ToSQLString() string {
 }
 
 func (r *RemoveColumnTableAction) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("DROP COLUMN ")).Node(r.Name).If(r.Cascade, []byte(" CASCADE"))
 	return sw.End()
 }
@@ -974,7 +1757,7 @@ func (a *AddConstraintTableAction) ToSQLString() string {
 }
 
 func (a *AddConstraintTableAction) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Bytes([]byte("ADD ")).Node(a.Constraint).End()
+	return NewSQLWriter(w).Bytes([]byte("ADD ")).Node(a.Constraint).End()
 }
 
 type DropConstraintTableAction struct {
@@ -1002,18 +1785,265 @@ func (d *DropConstraintTableAction) ToSQLString() string {
 }
 
 func (d *DropConstraintTableAction) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("DROP CONSTRAINT ")).Node(d.Name).If(d.Cascade, []byte(" CASCADE"))
 	return sw.End()
 }
 
+// RENAME TO NewName
+type RenameTableAction struct {
+	alterTableAction
+	Rename  sqltoken.Pos
+	NewName *ObjectName
+}
+
+func (r *RenameTableAction) Pos() sqltoken.Pos {
+	return r.Rename
+}
+
+func (r *RenameTableAction) End() sqltoken.Pos {
+	return r.NewName.End()
+}
+
+func (r *RenameTableAction) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *RenameTableAction) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("RENAME TO ")).Node(r.NewName).End()
+}
+
+// ATTACH PARTITION Name { FOR VALUES ... | DEFAULT } (PostgreSQL)
+type AttachPartitionTableAction struct {
+	alterTableAction
+	Attach sqltoken.Pos
+	Name   *ObjectName
+	Bound  *PartitionBound
+}
+
+func (a *AttachPartitionTableAction) Pos() sqltoken.Pos {
+	return a.Attach
+}
+
+func (a *AttachPartitionTableAction) End() sqltoken.Pos {
+	return a.Bound.End()
+}
+
+func (a *AttachPartitionTableAction) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *AttachPartitionTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ATTACH PARTITION ")).Node(a.Name).Space().Node(a.Bound)
+	return sw.End()
+}
+
+// DETACH PARTITION Name [ CONCURRENTLY | FINALIZE ] (PostgreSQL)
+type DetachPartitionTableAction struct {
+	alterTableAction
+	Detach       sqltoken.Pos
+	Name         *ObjectName
+	Concurrently bool
+	Finalize     bool
+	To           sqltoken.Pos
+}
+
+func (d *DetachPartitionTableAction) Pos() sqltoken.Pos {
+	return d.Detach
+}
+
+func (d *DetachPartitionTableAction) End() sqltoken.Pos {
+	return d.To
+}
+
+func (d *DetachPartitionTableAction) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DetachPartitionTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DETACH PARTITION ")).Node(d.Name)
+	sw.If(d.Concurrently, []byte(" CONCURRENTLY")).If(d.Finalize, []byte(" FINALIZE"))
+	return sw.End()
+}
+
+// RENAME COLUMN OldName TO NewName
+type RenameColumnTableAction struct {
+	alterTableAction
+	Rename  sqltoken.Pos
+	OldName *Ident
+	NewName *Ident
+}
+
+func (r *RenameColumnTableAction) Pos() sqltoken.Pos {
+	return r.Rename
+}
+
+func (r *RenameColumnTableAction) End() sqltoken.Pos {
+	return r.NewName.End()
+}
+
+func (r *RenameColumnTableAction) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *RenameColumnTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("RENAME COLUMN ")).Node(r.OldName).Bytes([]byte(" TO ")).Node(r.NewName)
+	return sw.End()
+}
+
+// RENAME CONSTRAINT OldName TO NewName
+type RenameConstraintTableAction struct {
+	alterTableAction
+	Rename  sqltoken.Pos
+	OldName *Ident
+	NewName *Ident
+}
+
+func (r *RenameConstraintTableAction) Pos() sqltoken.Pos {
+	return r.Rename
+}
+
+func (r *RenameConstraintTableAction) End() sqltoken.Pos {
+	return r.NewName.End()
+}
+
+func (r *RenameConstraintTableAction) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *RenameConstraintTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("RENAME CONSTRAINT ")).Node(r.OldName).Bytes([]byte(" TO ")).Node(r.NewName)
+	return sw.End()
+}
+
+// MODIFY [COLUMN] column_definition [FIRST | AFTER col_name] (MySQL)
+type MyModifyColumnTableAction struct {
+	alterTableAction
+	Modify   sqltoken.Pos
+	Column   *ColumnDef
+	Position *MyColumnPosition // nil if omitted
+}
+
+func (m *MyModifyColumnTableAction) Pos() sqltoken.Pos {
+	return m.Modify
+}
+
+func (m *MyModifyColumnTableAction) End() sqltoken.Pos {
+	if m.Position != nil {
+		return m.Position.End()
+	}
+	return m.Column.End()
+}
+
+func (m *MyModifyColumnTableAction) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MyModifyColumnTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("MODIFY COLUMN ")).Node(m.Column)
+	if m.Position != nil {
+		sw.Space().Node(m.Position)
+	}
+	return sw.End()
+}
+
+// CHANGE [COLUMN] old_col_name column_definition [FIRST | AFTER col_name] (MySQL)
+type MyChangeColumnTableAction struct {
+	alterTableAction
+	Change   sqltoken.Pos
+	OldName  *Ident
+	Column   *ColumnDef
+	Position *MyColumnPosition // nil if omitted
+}
+
+func (m *MyChangeColumnTableAction) Pos() sqltoken.Pos {
+	return m.Change
+}
+
+func (m *MyChangeColumnTableAction) End() sqltoken.Pos {
+	if m.Position != nil {
+		return m.Position.End()
+	}
+	return m.Column.End()
+}
+
+func (m *MyChangeColumnTableAction) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MyChangeColumnTableAction) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CHANGE COLUMN ")).Node(m.OldName).Space().Node(m.Column)
+	if m.Position != nil {
+		sw.Space().Node(m.Position)
+	}
+	return sw.End()
+}
+
+// DROP PRIMARY KEY (MySQL)
+type MyDropPrimaryKeyTableAction struct {
+	alterTableAction
+	Drop, Key sqltoken.Pos // Key is the end position of KEY keyword
+}
+
+func (m *MyDropPrimaryKeyTableAction) Pos() sqltoken.Pos {
+	return m.Drop
+}
+
+func (m *MyDropPrimaryKeyTableAction) End() sqltoken.Pos {
+	return m.Key
+}
+
+func (m *MyDropPrimaryKeyTableAction) ToSQLString() string {
+	return "DROP PRIMARY KEY"
+}
+
+func (m *MyDropPrimaryKeyTableAction) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("DROP PRIMARY KEY"))
+}
+
+// FIRST | AFTER col_name (MySQL)
+type MyColumnPosition struct {
+	From, To sqltoken.Pos // position of FIRST or AFTER keyword
+	After    *Ident       // nil if FIRST
+}
+
+func (m *MyColumnPosition) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MyColumnPosition) End() sqltoken.Pos {
+	if m.After != nil {
+		return m.After.End()
+	}
+	return m.To
+}
+
+func (m *MyColumnPosition) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MyColumnPosition) WriteTo(w io.Writer) (int64, error) {
+	if m.After == nil {
+		return writeSingleBytes(w, []byte("FIRST"))
+	}
+	return NewSQLWriter(w).Bytes([]byte("AFTER ")).Node(m.After).End()
+}
+
 type DropTableStmt struct {
 	stmt
-	TableNames []*ObjectName
-	Cascade    bool
-	CascadePos sqltoken.Pos
-	IfExists   bool
-	Drop       sqltoken.Pos
+	TableNames  []*ObjectName
+	Cascade     bool
+	CascadePos  sqltoken.Pos
+	Restrict    bool
+	RestrictPos sqltoken.Pos
+	IfExists    bool
+	Drop        sqltoken.Pos
 }
 
 func (d *DropTableStmt) Pos() sqltoken.Pos {
@@ -1024,6 +2054,9 @@ func (d *DropTableStmt) End() sqltoken.Pos {
 	if d.Cascade {
 		return d.CascadePos
 	}
+	if d.Restrict {
+		return d.RestrictPos
+	}
 
 	return d.TableNames[len(d.TableNames)-1].End()
 }
@@ -1033,103 +2066,1524 @@ func (d *DropTableStmt) ToSQLString() string {
 }
 
 func (d *DropTableStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("DROP TABLE "))
 	sw.If(d.IfExists, []byte("IF EXISTS "))
 	for i, table := range d.TableNames {
 		sw.JoinComma(i, table)
 	}
-	sw.If(d.Cascade, []byte(" CASCADE"))
+	sw.If(d.Cascade, []byte(" CASCADE")).If(d.Restrict, []byte(" RESTRICT"))
 	return sw.End()
 }
 
-type CreateIndexStmt struct {
-	Create sqltoken.Pos
+type CreateSchemaStmt struct {
 	stmt
-	TableName   *ObjectName
-	IsUnique    bool
-	IndexName   *Ident
-	MethodName  *Ident
-	ColumnNames []*Ident
-	RParen      sqltoken.Pos
-	Selection   Node
+	Create        sqltoken.Pos
+	Schema        sqltoken.Pos
+	NotExists     bool
+	NotExistsFrom sqltoken.Pos // start position of IF NOT EXISTS
+	NotExistsTo   sqltoken.Pos // end position of IF NOT EXISTS
+	Name          *ObjectName  // nil if omitted with AUTHORIZATION
+	Authorization *Ident
+	Elements      []Stmt // CREATE and GRANT statements to be executed in the schema
 }
 
-func (c *CreateIndexStmt) Pos() sqltoken.Pos {
+func (c *CreateSchemaStmt) Pos() sqltoken.Pos {
 	return c.Create
 }
 
-func (c *CreateIndexStmt) End() sqltoken.Pos {
-	if c.Selection != nil {
-		return c.Selection.End()
+func (c *CreateSchemaStmt) End() sqltoken.Pos {
+	if len(c.Elements) != 0 {
+		return c.Elements[len(c.Elements)-1].End()
+	}
+	if c.Authorization != nil {
+		return c.Authorization.End()
 	}
+	return c.Name.End()
+}
 
-	return c.RParen
+func (c *CreateSchemaStmt) ToSQLString() string {
+	return toSQLString(c)
 }
 
-func (c *CreateIndexStmt) ToSQLString() string {
+func (c *CreateSchemaStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE SCHEMA"))
+	sw.If(c.NotExists, []byte(" IF NOT EXISTS"))
+	if c.Name != nil {
+		sw.Space().Node(c.Name)
+	}
+	if c.Authorization != nil {
+		sw.Bytes([]byte(" AUTHORIZATION ")).Node(c.Authorization)
+	}
+	for _, e := range c.Elements {
+		sw.Space().Node(e)
+	}
+	return sw.End()
+}
+
+type DropSchemaStmt struct {
+	stmt
+	SchemaNames []*ObjectName
+	Cascade     bool
+	CascadePos  sqltoken.Pos
+	Restrict    bool
+	RestrictPos sqltoken.Pos
+	IfExists    bool
+	Drop        sqltoken.Pos
+}
+
+func (d *DropSchemaStmt) Pos() sqltoken.Pos {
+	return d.Drop
+}
+
+func (d *DropSchemaStmt) End() sqltoken.Pos {
+	if d.Cascade {
+		return d.CascadePos
+	}
+	if d.Restrict {
+		return d.RestrictPos
+	}
+
+	return d.SchemaNames[len(d.SchemaNames)-1].End()
+}
+
+func (d *DropSchemaStmt) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DropSchemaStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DROP SCHEMA "))
+	sw.If(d.IfExists, []byte("IF EXISTS "))
+	for i, schema := range d.SchemaNames {
+		sw.JoinComma(i, schema)
+	}
+	sw.If(d.Cascade, []byte(" CASCADE")).If(d.Restrict, []byte(" RESTRICT"))
+	return sw.End()
+}
+
+type CreateSequenceStmt struct {
+	stmt
+	Create        sqltoken.Pos
+	Sequence      sqltoken.Pos
+	NotExists     bool
+	NotExistsFrom sqltoken.Pos // start position of IF NOT EXISTS
+	NotExistsTo   sqltoken.Pos // end position of IF NOT EXISTS
+	Name          *ObjectName
+	Options       []SequenceOption
+}
+
+func (c *CreateSequenceStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateSequenceStmt) End() sqltoken.Pos {
+	if len(c.Options) != 0 {
+		return c.Options[len(c.Options)-1].End()
+	}
+	return c.Name.End()
+}
+
+func (c *CreateSequenceStmt) ToSQLString() string {
 	return toSQLString(c)
 }
 
-func (c *CreateIndexStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("CREATE ")).If(c.IsUnique, []byte("UNIQUE ")).Bytes([]byte("INDEX"))
-	if c.IndexName != nil {
-		sw.Space().Node(c.IndexName)
+func (c *CreateSequenceStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE SEQUENCE "))
+	sw.If(c.NotExists, []byte("IF NOT EXISTS ")).Node(c.Name)
+	for _, o := range c.Options {
+		sw.Space().Node(o)
 	}
-	sw.Bytes([]byte(" ON ")).Node(c.TableName)
-	if c.MethodName != nil {
-		sw.Bytes([]byte(" USING ")).Node(c.MethodName)
+	return sw.End()
+}
+
+type AlterSequenceStmt struct {
+	stmt
+	Alter    sqltoken.Pos
+	IfExists bool
+	Name     *ObjectName
+	Options  []SequenceOption
+}
+
+func (a *AlterSequenceStmt) Pos() sqltoken.Pos {
+	return a.Alter
+}
+
+func (a *AlterSequenceStmt) End() sqltoken.Pos {
+	if len(a.Options) != 0 {
+		return a.Options[len(a.Options)-1].End()
 	}
-	sw.Space().LParen().Idents(c.ColumnNames, []byte(", ")).RParen()
-	if c.Selection != nil {
-		sw.Bytes([]byte(" WHERE ")).Node(c.Selection)
+	return a.Name.End()
+}
+
+func (a *AlterSequenceStmt) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *AlterSequenceStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ALTER SEQUENCE "))
+	sw.If(a.IfExists, []byte("IF EXISTS ")).Node(a.Name)
+	for _, o := range a.Options {
+		sw.Space().Node(o)
 	}
 	return sw.End()
 }
 
-type DropIndexStmt struct {
+type DropSequenceStmt struct {
 	stmt
-	Drop       sqltoken.Pos
-	IndexNames []*Ident
+	SequenceNames []*ObjectName
+	Cascade       bool
+	CascadePos    sqltoken.Pos
+	Restrict      bool
+	RestrictPos   sqltoken.Pos
+	IfExists      bool
+	Drop          sqltoken.Pos
 }
 
-func (d *DropIndexStmt) Pos() sqltoken.Pos {
+func (d *DropSequenceStmt) Pos() sqltoken.Pos {
 	return d.Drop
 }
 
-func (d *DropIndexStmt) End() sqltoken.Pos {
-	return d.IndexNames[len(d.IndexNames)-1].End()
+func (d *DropSequenceStmt) End() sqltoken.Pos {
+	if d.Cascade {
+		return d.CascadePos
+	}
+	if d.Restrict {
+		return d.RestrictPos
+	}
+
+	return d.SequenceNames[len(d.SequenceNames)-1].End()
 }
 
-func (d *DropIndexStmt) ToSQLString() string {
+func (d *DropSequenceStmt) ToSQLString() string {
 	return toSQLString(d)
 }
 
-func (d *DropIndexStmt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("DROP INDEX ")).Idents(d.IndexNames, []byte(", "))
+func (d *DropSequenceStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DROP SEQUENCE "))
+	sw.If(d.IfExists, []byte("IF EXISTS "))
+	for i, s := range d.SequenceNames {
+		sw.JoinComma(i, s)
+	}
+	sw.If(d.Cascade, []byte(" CASCADE")).If(d.Restrict, []byte(" RESTRICT"))
 	return sw.End()
 }
 
-type ExplainStmt struct {
+// CREATE [OR REPLACE] FUNCTION / PROCEDURE
+// the body is kept as an opaque string literal
+type CreateFunctionStmt struct {
 	stmt
-	Stmt    Stmt
-	Explain sqltoken.Pos
+	Create      sqltoken.Pos
+	OrReplace   bool
+	IsProcedure bool
+	Name        *ObjectName
+	Args        []*FunctionArg
+	RParen      sqltoken.Pos
+	Returns     *FunctionReturns // nil if omitted
+	Language    *Ident           // nil if omitted
+	Behaviors   []*Ident         // IMMUTABLE, STABLE, VOLATILE, STRICT, LEAKPROOF
+	Body        Node             // *SingleQuotedString or *DollarQuotedString, nil if omitted
 }
 
-func (e *ExplainStmt) Pos() sqltoken.Pos {
-	return e.Explain
+func (c *CreateFunctionStmt) Pos() sqltoken.Pos {
+	return c.Create
 }
 
-func (e *ExplainStmt) End() sqltoken.Pos {
-	return e.Stmt.End()
+func (c *CreateFunctionStmt) End() sqltoken.Pos {
+	if c.Body != nil {
+		return c.Body.End()
+	}
+	if len(c.Behaviors) != 0 {
+		return c.Behaviors[len(c.Behaviors)-1].End()
+	}
+	if c.Language != nil {
+		return c.Language.End()
+	}
+	if c.Returns != nil {
+		return c.Returns.End()
+	}
+	return c.RParen
 }
 
-func (e *ExplainStmt) ToSQLString() string {
-	return toSQLString(e)
+func (c *CreateFunctionStmt) ToSQLString() string {
+	return toSQLString(c)
 }
 
-func (e *ExplainStmt) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Bytes([]byte("EXPLAIN ")).Node(e.Stmt).End()
+func (c *CreateFunctionStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE ")).If(c.OrReplace, []byte("OR REPLACE "))
+	if c.IsProcedure {
+		sw.Bytes([]byte("PROCEDURE "))
+	} else {
+		sw.Bytes([]byte("FUNCTION "))
+	}
+	sw.Node(c.Name).LParen()
+	for i, a := range c.Args {
+		sw.JoinComma(i, a)
+	}
+	sw.RParen()
+	if c.Returns != nil {
+		sw.Space().Node(c.Returns)
+	}
+	if c.Language != nil {
+		sw.Bytes([]byte(" LANGUAGE ")).Node(c.Language)
+	}
+	for _, b := range c.Behaviors {
+		sw.Space().Node(b)
+	}
+	if c.Body != nil {
+		sw.Bytes([]byte(" AS ")).Node(c.Body)
+	}
+	return sw.End()
+}
+
+type ArgMode int
+
+const (
+	NoneArgMode ArgMode = iota
+	InArgMode
+	OutArgMode
+	InOutArgMode
+	VariadicArgMode
+)
+
+func (a ArgMode) String() string {
+	switch a {
+	case InArgMode:
+		return "IN"
+	case OutArgMode:
+		return "OUT"
+	case InOutArgMode:
+		return "INOUT"
+	case VariadicArgMode:
+		return "VARIADIC"
+	}
+	return ""
+}
+
+// [mode] [name] type [DEFAULT expr]
+type FunctionArg struct {
+	Mode     ArgMode
+	ModePos  sqltoken.Pos
+	Name     *Ident // nil if omitted
+	DataType Type
+	Default  Node
+}
+
+func (f *FunctionArg) Pos() sqltoken.Pos {
+	if f.Mode != NoneArgMode {
+		return f.ModePos
+	}
+	if f.Name != nil {
+		return f.Name.Pos()
+	}
+	return f.DataType.Pos()
+}
+
+func (f *FunctionArg) End() sqltoken.Pos {
+	if f.Default != nil {
+		return f.Default.End()
+	}
+	return f.DataType.End()
+}
+
+func (f *FunctionArg) ToSQLString() string {
+	return toSQLString(f)
+}
+
+func (f *FunctionArg) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if f.Mode != NoneArgMode {
+		sw.Bytes([]byte(f.Mode.String())).Space()
+	}
+	if f.Name != nil {
+		sw.Node(f.Name).Space()
+	}
+	sw.Node(f.DataType)
+	if f.Default != nil {
+		sw.Bytes([]byte(" DEFAULT ")).Node(f.Default)
+	}
+	return sw.End()
+}
+
+// RETURNS [SETOF] type | RETURNS TABLE (column_name type, ...)
+type FunctionReturns struct {
+	Returns  sqltoken.Pos
+	SetOf    bool
+	DataType Type           // nil if RETURNS TABLE
+	Columns  []*FunctionArg // columns of RETURNS TABLE
+	RParen   sqltoken.Pos
+}
+
+func (f *FunctionReturns) Pos() sqltoken.Pos {
+	return f.Returns
+}
+
+func (f *FunctionReturns) End() sqltoken.Pos {
+	if f.DataType == nil {
+		return f.RParen
+	}
+	return f.DataType.End()
+}
+
+func (f *FunctionReturns) ToSQLString() string {
+	return toSQLString(f)
+}
+
+func (f *FunctionReturns) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("RETURNS "))
+	if f.DataType == nil {
+		sw.Bytes([]byte("TABLE ")).LParen()
+		for i, c := range f.Columns {
+			sw.JoinComma(i, c)
+		}
+		return sw.RParen().End()
+	}
+	sw.If(f.SetOf, []byte("SETOF ")).Node(f.DataType)
+	return sw.End()
+}
+
+type TriggerTiming int
+
+const (
+	BeforeTrigger TriggerTiming = iota
+	AfterTrigger
+	InsteadOfTrigger
+)
+
+func (t TriggerTiming) String() string {
+	switch t {
+	case AfterTrigger:
+		return "AFTER"
+	case InsteadOfTrigger:
+		return "INSTEAD OF"
+	}
+	return "BEFORE"
+}
+
+type TriggerLevel int
+
+const (
+	NoTriggerLevel TriggerLevel = iota
+	RowTriggerLevel
+	StatementTriggerLevel
+)
+
+type CreateTriggerStmt struct {
+	stmt
+	Create      sqltoken.Pos
+	Trigger     sqltoken.Pos
+	Name        *ObjectName
+	Timing      TriggerTiming
+	TimingPos   sqltoken.Pos
+	Events      []*TriggerEvent
+	TableName   *ObjectName
+	Level       TriggerLevel // FOR EACH ROW or FOR EACH STATEMENT
+	LevelFrom   sqltoken.Pos
+	LevelTo     sqltoken.Pos
+	When        Node // nil if WHEN clause is omitted
+	Execute     sqltoken.Pos
+	IsProcedure bool // EXECUTE PROCEDURE instead of EXECUTE FUNCTION
+	Function    *ObjectName
+	Args        []Node
+	RParen      sqltoken.Pos
+}
+
+func (c *CreateTriggerStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateTriggerStmt) End() sqltoken.Pos {
+	return c.RParen
+}
+
+func (c *CreateTriggerStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CreateTriggerStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE TRIGGER ")).Node(c.Name).Space()
+	sw.Bytes([]byte(c.Timing.String())).Space()
+	for i, e := range c.Events {
+		sw.Join(i, e, []byte(" OR "))
+	}
+	sw.Bytes([]byte(" ON ")).Node(c.TableName)
+	switch c.Level {
+	case RowTriggerLevel:
+		sw.Bytes([]byte(" FOR EACH ROW"))
+	case StatementTriggerLevel:
+		sw.Bytes([]byte(" FOR EACH STATEMENT"))
+	}
+	if c.When != nil {
+		sw.Bytes([]byte(" WHEN ")).LParen().Node(c.When).RParen()
+	}
+	if c.IsProcedure {
+		sw.Bytes([]byte(" EXECUTE PROCEDURE "))
+	} else {
+		sw.Bytes([]byte(" EXECUTE FUNCTION "))
+	}
+	sw.Node(c.Function).LParen().Nodes(c.Args).RParen()
+	return sw.End()
+}
+
+type TriggerEventType int
+
+const (
+	InsertTriggerEvent TriggerEventType = iota
+	UpdateTriggerEvent
+	DeleteTriggerEvent
+	TruncateTriggerEvent
+)
+
+func (t TriggerEventType) String() string {
+	switch t {
+	case UpdateTriggerEvent:
+		return "UPDATE"
+	case DeleteTriggerEvent:
+		return "DELETE"
+	case TruncateTriggerEvent:
+		return "TRUNCATE"
+	}
+	return "INSERT"
+}
+
+// INSERT | UPDATE [OF column_name, ...] | DELETE | TRUNCATE
+type TriggerEvent struct {
+	Type     TriggerEventType
+	From, To sqltoken.Pos
+	Columns  []*Ident // UPDATE OF columns
+}
+
+func (t *TriggerEvent) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TriggerEvent) End() sqltoken.Pos {
+	return t.To
+}
+
+func (t *TriggerEvent) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TriggerEvent) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte(t.Type.String()))
+	if len(t.Columns) != 0 {
+		sw.Bytes([]byte(" OF ")).Idents(t.Columns, []byte(", "))
+	}
+	return sw.End()
+}
+
+type DropTriggerStmt struct {
+	stmt
+	Drop       sqltoken.Pos
+	IfExists   bool
+	Name       *ObjectName
+	TableName  *ObjectName // nil if ON table is omitted (MySQL)
+	Cascade    bool
+	CascadePos sqltoken.Pos
+}
+
+func (d *DropTriggerStmt) Pos() sqltoken.Pos {
+	return d.Drop
+}
+
+func (d *DropTriggerStmt) End() sqltoken.Pos {
+	if d.Cascade {
+		return d.CascadePos
+	}
+	if d.TableName != nil {
+		return d.TableName.End()
+	}
+	return d.Name.End()
+}
+
+func (d *DropTriggerStmt) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DropTriggerStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DROP TRIGGER ")).If(d.IfExists, []byte("IF EXISTS ")).Node(d.Name)
+	if d.TableName != nil {
+		sw.Bytes([]byte(" ON ")).Node(d.TableName)
+	}
+	sw.If(d.Cascade, []byte(" CASCADE"))
+	return sw.End()
+}
+
+type CreateIndexStmt struct {
+	Create sqltoken.Pos
+	stmt
+	TableName       *ObjectName
+	IsUnique        bool
+	Unique          sqltoken.Pos
+	Index           sqltoken.Pos
+	Concurrently    bool // PostgreSQL only
+	ConcurrentlyPos sqltoken.Pos
+	NotExists       bool
+	NotExistsFrom   sqltoken.Pos // start position of IF NOT EXISTS
+	NotExistsTo     sqltoken.Pos // end position of IF NOT EXISTS
+	IndexName       *Ident
+	MethodName      *Ident
+	Columns         []*IndexElement
+	RParen          sqltoken.Pos
+	Include         []*Ident // PostgreSQL only. INCLUDE (columns). nil if omitted
+	IncludeRParen   sqltoken.Pos
+	CommentPos      sqltoken.Pos
+	Comment         *SingleQuotedString // MySQL only. COMMENT 'string'
+	Selection       Node
+}
+
+// ColumnNames returns the names of the indexed columns, skipping expressions.
+// It is kept for compatibility with CreateIndexStmt which had only column names.
+func (c *CreateIndexStmt) ColumnNames() []*Ident {
+	var names []*Ident
+	for _, e := range c.Columns {
+		if i, ok := e.Expr.(*Ident); ok {
+			names = append(names, i)
+		}
+	}
+	return names
+}
+
+func (c *CreateIndexStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateIndexStmt) End() sqltoken.Pos {
+	if c.Selection != nil {
+		return c.Selection.End()
+	}
+	if c.Comment != nil {
+		return c.Comment.End()
+	}
+	if c.Include != nil {
+		return c.IncludeRParen
+	}
+
+	return c.RParen
+}
+
+func (c *CreateIndexStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CreateIndexStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE ")).If(c.IsUnique, []byte("UNIQUE ")).Bytes([]byte("INDEX"))
+	sw.If(c.Concurrently, []byte(" CONCURRENTLY")).If(c.NotExists, []byte(" IF NOT EXISTS"))
+	if c.IndexName != nil {
+		sw.Space().Node(c.IndexName)
+	}
+	sw.Bytes([]byte(" ON ")).Node(c.TableName)
+	if c.MethodName != nil {
+		sw.Bytes([]byte(" USING ")).Node(c.MethodName)
+	}
+	sw.Space().LParen()
+	for i, e := range c.Columns {
+		sw.JoinComma(i, e)
+	}
+	sw.RParen()
+	if c.Include != nil {
+		sw.Bytes([]byte(" INCLUDE (")).Idents(c.Include, []byte(", ")).RParen()
+	}
+	if c.Comment != nil {
+		sw.Bytes([]byte(" COMMENT ")).Node(c.Comment)
+	}
+	if c.Selection != nil {
+		sw.Bytes([]byte(" WHERE ")).Node(c.Selection)
+	}
+	return sw.End()
+}
+
+// column or expression of CREATE INDEX
+// Expr [COLLATE Collation] [OpClass] [ASC | DESC]
+type IndexElement struct {
+	Expr        Node // *Ident for a column, otherwise an expression such as lower(email)
+	CollatePos  sqltoken.Pos
+	Collation   *ObjectName  // nil if omitted
+	OpClass     *ObjectName  // operator class (PostgreSQL). nil if omitted
+	OrderingPos sqltoken.Pos // ASC / DESC keyword position if ASC != nil
+	ASC         *bool
+	NullsPos    sqltoken.Pos // end position of NULLS FIRST / LAST if NullsFirst != nil
+	NullsFirst  *bool
+}
+
+func (i *IndexElement) Pos() sqltoken.Pos {
+	return i.Expr.Pos()
+}
+
+func (i *IndexElement) End() sqltoken.Pos {
+	if i.NullsFirst != nil {
+		return i.NullsPos
+	}
+	if i.ASC != nil {
+		return i.OrderingPos
+	}
+	if i.OpClass != nil {
+		return i.OpClass.End()
+	}
+	if i.Collation != nil {
+		return i.Collation.End()
+	}
+	return i.Expr.End()
+}
+
+func (i *IndexElement) ToSQLString() string {
+	return toSQLString(i)
+}
+
+func (i *IndexElement) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(i.Expr)
+	if i.Collation != nil {
+		sw.Bytes([]byte(" COLLATE ")).Node(i.Collation)
+	}
+	if i.OpClass != nil {
+		sw.Space().Node(i.OpClass)
+	}
+	if i.ASC != nil {
+		if *i.ASC {
+			sw.Bytes([]byte(" ASC"))
+		} else {
+			sw.Bytes([]byte(" DESC"))
+		}
+	}
+	if i.NullsFirst != nil {
+		if *i.NullsFirst {
+			sw.Bytes([]byte(" NULLS FIRST"))
+		} else {
+			sw.Bytes([]byte(" NULLS LAST"))
+		}
+	}
+	return sw.End()
+}
+
+// DROP INDEX [CONCURRENTLY] [IF EXISTS] IndexNames... [CASCADE]
+// or DROP INDEX IndexName ON TableName (MySQL)
+type DropIndexStmt struct {
+	stmt
+	Drop            sqltoken.Pos
+	IndexNames      []*ObjectName
+	Concurrently    bool // PostgreSQL only
+	ConcurrentlyPos sqltoken.Pos
+	IfExists        bool
+	Cascade         bool
+	CascadePos      sqltoken.Pos
+	Restrict        bool
+	RestrictPos     sqltoken.Pos
+	TableName       *ObjectName // MySQL only. nil if omitted
+}
+
+func (d *DropIndexStmt) Pos() sqltoken.Pos {
+	return d.Drop
+}
+
+func (d *DropIndexStmt) End() sqltoken.Pos {
+	if d.Cascade {
+		return d.CascadePos
+	}
+	if d.Restrict {
+		return d.RestrictPos
+	}
+	if d.TableName != nil {
+		return d.TableName.End()
+	}
+
+	return d.IndexNames[len(d.IndexNames)-1].End()
+}
+
+func (d *DropIndexStmt) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DropIndexStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DROP INDEX "))
+	sw.If(d.Concurrently, []byte("CONCURRENTLY ")).If(d.IfExists, []byte("IF EXISTS "))
+	for i, name := range d.IndexNames {
+		sw.JoinComma(i, name)
+	}
+	if d.TableName != nil {
+		sw.Bytes([]byte(" ON ")).Node(d.TableName)
+	}
+	sw.If(d.Cascade, []byte(" CASCADE")).If(d.Restrict, []byte(" RESTRICT"))
+	return sw.End()
+}
+
+type TruncateStmt struct {
+	stmt
+	Truncate        sqltoken.Pos
+	TableNames      []*ObjectName
+	RestartIdentity bool
+	IdentityPos     sqltoken.Pos // end position of RESTART IDENTITY
+	Cascade         bool
+	CascadePos      sqltoken.Pos
+}
+
+func (t *TruncateStmt) Pos() sqltoken.Pos {
+	return t.Truncate
+}
+
+func (t *TruncateStmt) End() sqltoken.Pos {
+	if t.Cascade {
+		return t.CascadePos
+	}
+	if t.RestartIdentity {
+		return t.IdentityPos
+	}
+	return t.TableNames[len(t.TableNames)-1].End()
+}
+
+func (t *TruncateStmt) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TruncateStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("TRUNCATE TABLE "))
+	for i, n := range t.TableNames {
+		sw.JoinComma(i, n)
+	}
+	sw.If(t.RestartIdentity, []byte(" RESTART IDENTITY"))
+	sw.If(t.Cascade, []byte(" CASCADE"))
+	return sw.End()
+}
+
+// SET [ SESSION | LOCAL | GLOBAL ] name { TO | = } value [, ...]
+// SET NAMES charset
+type SetVariableStmt struct {
+	stmt
+	Set    sqltoken.Pos
+	Scope  *Ident      // SESSION, LOCAL or GLOBAL; nil if omitted
+	Names  bool        // SET NAMES charset
+	Name   *ObjectName // nil if Names
+	UseTo  bool        // TO is used instead of =
+	Values []Node
+}
+
+func (s *SetVariableStmt) Pos() sqltoken.Pos {
+	return s.Set
+}
+
+func (s *SetVariableStmt) End() sqltoken.Pos {
+	return s.Values[len(s.Values)-1].End()
+}
+
+func (s *SetVariableStmt) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *SetVariableStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("SET "))
+	if s.Scope != nil {
+		sw.Node(s.Scope).Space()
+	}
+	if s.Names {
+		sw.Bytes([]byte("NAMES "))
+	} else {
+		sw.Node(s.Name)
+		if s.UseTo {
+			sw.Bytes([]byte(" TO "))
+		} else {
+			sw.Bytes([]byte(" = "))
+		}
+	}
+	return sw.Nodes(s.Values).End()
+}
+
+// SHOW name
+type ShowStmt struct {
+	stmt
+	Show sqltoken.Pos
+	Name *ObjectName
+}
+
+func (s *ShowStmt) Pos() sqltoken.Pos {
+	return s.Show
+}
+
+func (s *ShowStmt) End() sqltoken.Pos {
+	return s.Name.End()
+}
+
+func (s *ShowStmt) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *ShowStmt) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("SHOW ")).Node(s.Name).End()
+}
+
+// SHOW { WARNINGS | ERRORS } [ LIMIT [ offset, ] row_count ] (MySQL)
+type ShowWarningsStmt struct {
+	stmt
+	Show   sqltoken.Pos
+	Errors bool         // SHOW ERRORS
+	To     sqltoken.Pos // end position of WARNINGS or ERRORS
+	Offset *LongValue   // nil if offset is omitted
+	Limit  *LongValue   // nil if LIMIT clause is omitted
+}
+
+func (s *ShowWarningsStmt) Pos() sqltoken.Pos {
+	return s.Show
+}
+
+func (s *ShowWarningsStmt) End() sqltoken.Pos {
+	if s.Limit != nil {
+		return s.Limit.End()
+	}
+	return s.To
+}
+
+func (s *ShowWarningsStmt) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *ShowWarningsStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if s.Errors {
+		sw.Bytes([]byte("SHOW ERRORS"))
+	} else {
+		sw.Bytes([]byte("SHOW WARNINGS"))
+	}
+	if s.Limit != nil {
+		sw.Bytes([]byte(" LIMIT "))
+		if s.Offset != nil {
+			sw.Node(s.Offset).Bytes([]byte(", "))
+		}
+		sw.Node(s.Limit)
+	}
+	return sw.End()
+}
+
+type KillModifier int
+
+const (
+	NoKillModifier KillModifier = iota
+	QueryKillModifier
+	ConnectionKillModifier
+)
+
+func (k KillModifier) String() string {
+	switch k {
+	case QueryKillModifier:
+		return "QUERY"
+	case ConnectionKillModifier:
+		return "CONNECTION"
+	}
+	return ""
+}
+
+// KILL [ QUERY | CONNECTION ] processlist_id (MySQL)
+type KillStmt struct {
+	stmt
+	Kill     sqltoken.Pos
+	Modifier KillModifier
+	ID       *LongValue
+}
+
+func (k *KillStmt) Pos() sqltoken.Pos {
+	return k.Kill
+}
+
+func (k *KillStmt) End() sqltoken.Pos {
+	return k.ID.End()
+}
+
+func (k *KillStmt) ToSQLString() string {
+	return toSQLString(k)
+}
+
+func (k *KillStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("KILL "))
+	if k.Modifier != NoKillModifier {
+		sw.Bytes([]byte(k.Modifier.String())).Space()
+	}
+	return sw.Node(k.ID).End()
+}
+
+// USE database
+type UseStmt struct {
+	stmt
+	Use      sqltoken.Pos
+	Database *Ident
+}
+
+func (u *UseStmt) Pos() sqltoken.Pos {
+	return u.Use
+}
+
+func (u *UseStmt) End() sqltoken.Pos {
+	return u.Database.End()
+}
+
+func (u *UseStmt) ToSQLString() string {
+	return toSQLString(u)
+}
+
+func (u *UseStmt) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("USE ")).Node(u.Database).End()
+}
+
+type CommentObjectType int
+
+const (
+	TableCommentObject CommentObjectType = iota
+	ColumnCommentObject
+	ViewCommentObject
+	IndexCommentObject
+	SchemaCommentObject
+	SequenceCommentObject
+	TypeCommentObject
+	DomainCommentObject
+	ExtensionCommentObject
+)
+
+func (c CommentObjectType) String() string {
+	switch c {
+	case TableCommentObject:
+		return "TABLE"
+	case ColumnCommentObject:
+		return "COLUMN"
+	case ViewCommentObject:
+		return "VIEW"
+	case IndexCommentObject:
+		return "INDEX"
+	case SchemaCommentObject:
+		return "SCHEMA"
+	case SequenceCommentObject:
+		return "SEQUENCE"
+	case TypeCommentObject:
+		return "TYPE"
+	case DomainCommentObject:
+		return "DOMAIN"
+	case ExtensionCommentObject:
+		return "EXTENSION"
+	}
+	return ""
+}
+
+// COMMENT ON { TABLE | COLUMN | VIEW | INDEX | SCHEMA | SEQUENCE | TYPE | DOMAIN | EXTENSION } object_name IS { 'text' | NULL }
+type CommentOnStmt struct {
+	stmt
+	Comment    sqltoken.Pos
+	ObjectType CommentObjectType
+	Name       *ObjectName // table_name.column_name if ObjectType is ColumnCommentObject
+	Text       Node        // *SingleQuotedString or *NullValue
+}
+
+func (c *CommentOnStmt) Pos() sqltoken.Pos {
+	return c.Comment
+}
+
+func (c *CommentOnStmt) End() sqltoken.Pos {
+	return c.Text.End()
+}
+
+func (c *CommentOnStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CommentOnStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("COMMENT ON ")).Bytes([]byte(c.ObjectType.String())).Space()
+	sw.Node(c.Name).Bytes([]byte(" IS ")).Node(c.Text)
+	return sw.End()
+}
+
+// EXPLAIN [ ANALYZE ] [ VERBOSE ] [ ( option [, ...] ) ] [ FORMAT = format_name ] { statement | table_name }
+type ExplainStmt struct {
+	stmt
+	Explain  sqltoken.Pos
+	Describe bool // DESCRIBE or DESC instead of EXPLAIN (MySQL)
+	Analyze  bool
+	Verbose  bool          // PostgreSQL only
+	Options  []*CopyOption // i.e: (ANALYZE, FORMAT JSON), the same syntax as COPY options (PostgreSQL)
+	Format   *Ident        // FORMAT = TREE (MySQL)
+	Stmt     Stmt
+	Table    *ObjectName // DESCRIBE table_name (MySQL), nil if Stmt is given
+}
+
+func (e *ExplainStmt) Pos() sqltoken.Pos {
+	return e.Explain
+}
+
+func (e *ExplainStmt) End() sqltoken.Pos {
+	if e.Table != nil {
+		return e.Table.End()
+	}
+	return e.Stmt.End()
+}
+
+func (e *ExplainStmt) ToSQLString() string {
+	return toSQLString(e)
+}
+
+func (e *ExplainStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	if e.Describe {
+		sw.Bytes([]byte("DESCRIBE "))
+	} else {
+		sw.Bytes([]byte("EXPLAIN "))
+	}
+	sw.If(e.Analyze, []byte("ANALYZE ")).If(e.Verbose, []byte("VERBOSE "))
+	if len(e.Options) != 0 {
+		sw.LParen()
+		for i, o := range e.Options {
+			sw.JoinComma(i, o)
+		}
+		sw.Bytes([]byte(") "))
+	}
+	if e.Format != nil {
+		sw.Bytes([]byte("FORMAT=")).Node(e.Format).Space()
+	}
+	if e.Table != nil {
+		sw.Node(e.Table)
+	} else {
+		sw.Node(e.Stmt)
+	}
+	return sw.End()
+}
+
+// PREPARE name [ ( data_type [, ...] ) ] AS statement (PostgreSQL)
+// PREPARE name FROM { 'statement' | @var_name } (MySQL)
+type PrepareStmt struct {
+	stmt
+	Prepare sqltoken.Pos
+	Name    *Ident
+	Types   []Type // PostgreSQL only
+	Stmt    Stmt   // nil if From is given
+	From    Node   // *SingleQuotedString or *Ident (MySQL)
+}
+
+func (p *PrepareStmt) Pos() sqltoken.Pos {
+	return p.Prepare
+}
+
+func (p *PrepareStmt) End() sqltoken.Pos {
+	if p.From != nil {
+		return p.From.End()
+	}
+	return p.Stmt.End()
+}
+
+func (p *PrepareStmt) ToSQLString() string {
+	return toSQLString(p)
+}
+
+func (p *PrepareStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("PREPARE ")).Node(p.Name)
+	if len(p.Types) != 0 {
+		sw.Bytes([]byte(" ("))
+		for i, t := range p.Types {
+			sw.JoinComma(i, t)
+		}
+		sw.RParen()
+	}
+	if p.From != nil {
+		sw.Bytes([]byte(" FROM ")).Node(p.From)
+	} else {
+		sw.Bytes([]byte(" AS ")).Node(p.Stmt)
+	}
+	return sw.End()
+}
+
+// EXECUTE name [ ( argument [, ...] ) ] (PostgreSQL)
+// EXECUTE name [ USING @var_name [, ...] ] (MySQL)
+type ExecuteStmt struct {
+	stmt
+	Execute sqltoken.Pos
+	Name    *Ident
+	Args    []Node
+	Using   bool         // USING arguments instead of parenthesized ones (MySQL)
+	RParen  sqltoken.Pos // zero if arguments aren't parenthesized
+}
+
+func (e *ExecuteStmt) Pos() sqltoken.Pos {
+	return e.Execute
+}
+
+func (e *ExecuteStmt) End() sqltoken.Pos {
+	if e.Using {
+		return e.Args[len(e.Args)-1].End()
+	}
+	if len(e.Args) != 0 {
+		return e.RParen
+	}
+	return e.Name.End()
+}
+
+func (e *ExecuteStmt) ToSQLString() string {
+	return toSQLString(e)
+}
+
+func (e *ExecuteStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("EXECUTE ")).Node(e.Name)
+	if e.Using {
+		sw.Bytes([]byte(" USING ")).Nodes(e.Args)
+	} else if len(e.Args) != 0 {
+		sw.Bytes([]byte(" (")).Nodes(e.Args).RParen()
+	}
+	return sw.End()
+}
+
+// DEALLOCATE [ PREPARE ] { name | ALL }
+type DeallocateStmt struct {
+	stmt
+	Deallocate sqltoken.Pos
+	Prepare    bool
+	Name       *Ident       // nil for ALL
+	AllPos     sqltoken.Pos // end position of ALL
+}
+
+func (d *DeallocateStmt) Pos() sqltoken.Pos {
+	return d.Deallocate
+}
+
+func (d *DeallocateStmt) End() sqltoken.Pos {
+	if d.Name == nil {
+		return d.AllPos
+	}
+	return d.Name.End()
+}
+
+func (d *DeallocateStmt) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DeallocateStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("DEALLOCATE ")).If(d.Prepare, []byte("PREPARE "))
+	if d.Name == nil {
+		sw.Bytes([]byte("ALL"))
+	} else {
+		sw.Node(d.Name)
+	}
+	return sw.End()
+}
+
+// table_name [ ( column_name [, ...] ) ] of VACUUM and ANALYZE
+type VacuumRelation struct {
+	Name    *ObjectName
+	Columns []*Ident
+	RParen  sqltoken.Pos // zero if Columns is empty
+}
+
+func (v *VacuumRelation) Pos() sqltoken.Pos {
+	return v.Name.Pos()
+}
+
+func (v *VacuumRelation) End() sqltoken.Pos {
+	if len(v.Columns) != 0 {
+		return v.RParen
+	}
+	return v.Name.End()
+}
+
+func (v *VacuumRelation) ToSQLString() string {
+	return toSQLString(v)
+}
+
+func (v *VacuumRelation) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Node(v.Name)
+	if len(v.Columns) != 0 {
+		sw.Bytes([]byte(" (")).Idents(v.Columns, []byte(", ")).RParen()
+	}
+	return sw.End()
+}
+
+// VACUUM [ ( option [, ...] ) ] [ table_and_columns [, ...] ]
+// VACUUM [ FULL ] [ FREEZE ] [ VERBOSE ] [ ANALYZE ] [ table_and_columns [, ...] ] (PostgreSQL)
+type VacuumStmt struct {
+	stmt
+	Vacuum  sqltoken.Pos
+	Full    bool
+	Freeze  bool
+	Verbose bool
+	Analyze bool
+	Options []*CopyOption // the same syntax as COPY options
+	Tables  []*VacuumRelation
+	To      sqltoken.Pos // end position of the last keyword or the options, used if Tables is empty
+}
+
+func (v *VacuumStmt) Pos() sqltoken.Pos {
+	return v.Vacuum
+}
+
+func (v *VacuumStmt) End() sqltoken.Pos {
+	if len(v.Tables) != 0 {
+		return v.Tables[len(v.Tables)-1].End()
+	}
+	return v.To
+}
+
+func (v *VacuumStmt) ToSQLString() string {
+	return toSQLString(v)
+}
+
+func (v *VacuumStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("VACUUM"))
+	sw.If(v.Full, []byte(" FULL")).If(v.Freeze, []byte(" FREEZE"))
+	sw.If(v.Verbose, []byte(" VERBOSE")).If(v.Analyze, []byte(" ANALYZE"))
+	writeMaintenanceOptions(sw, v.Options)
+	writeVacuumRelations(sw, v.Tables)
+	return sw.End()
+}
+
+// ANALYZE [ ( option [, ...] ) ] [ table_and_columns [, ...] ]
+// ANALYZE [ VERBOSE ] [ table_and_columns [, ...] ] (PostgreSQL)
+type AnalyzeStmt struct {
+	stmt
+	Analyze sqltoken.Pos
+	Verbose bool
+	Options []*CopyOption // the same syntax as COPY options
+	Tables  []*VacuumRelation
+	To      sqltoken.Pos // end position of the last keyword or the options, used if Tables is empty
+}
+
+func (a *AnalyzeStmt) Pos() sqltoken.Pos {
+	return a.Analyze
+}
+
+func (a *AnalyzeStmt) End() sqltoken.Pos {
+	if len(a.Tables) != 0 {
+		return a.Tables[len(a.Tables)-1].End()
+	}
+	return a.To
+}
+
+func (a *AnalyzeStmt) ToSQLString() string {
+	return toSQLString(a)
+}
+
+func (a *AnalyzeStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("ANALYZE")).If(a.Verbose, []byte(" VERBOSE"))
+	writeMaintenanceOptions(sw, a.Options)
+	writeVacuumRelations(sw, a.Tables)
+	return sw.End()
+}
+
+type ReindexObjectType int
+
+const (
+	IndexReindexObject ReindexObjectType = iota
+	TableReindexObject
+	SchemaReindexObject
+	DatabaseReindexObject
+	SystemReindexObject
+)
+
+func (r ReindexObjectType) String() string {
+	switch r {
+	case IndexReindexObject:
+		return "INDEX"
+	case TableReindexObject:
+		return "TABLE"
+	case SchemaReindexObject:
+		return "SCHEMA"
+	case DatabaseReindexObject:
+		return "DATABASE"
+	case SystemReindexObject:
+		return "SYSTEM"
+	}
+	return ""
+}
+
+// REINDEX [ ( option [, ...] ) ] { INDEX | TABLE | SCHEMA | DATABASE | SYSTEM } [ CONCURRENTLY ] name (PostgreSQL)
+type ReindexStmt struct {
+	stmt
+	Reindex      sqltoken.Pos
+	Options      []*CopyOption // the same syntax as COPY options
+	ObjectType   ReindexObjectType
+	Concurrently bool
+	Name         *ObjectName
+}
+
+func (r *ReindexStmt) Pos() sqltoken.Pos {
+	return r.Reindex
+}
+
+func (r *ReindexStmt) End() sqltoken.Pos {
+	return r.Name.End()
+}
+
+func (r *ReindexStmt) ToSQLString() string {
+	return toSQLString(r)
+}
+
+func (r *ReindexStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("REINDEX"))
+	writeMaintenanceOptions(sw, r.Options)
+	sw.Space().Bytes([]byte(r.ObjectType.String()))
+	sw.If(r.Concurrently, []byte(" CONCURRENTLY"))
+	return sw.Space().Node(r.Name).End()
+}
+
+func writeMaintenanceOptions(sw *SQLWriter, options []*CopyOption) {
+	if len(options) == 0 {
+		return
+	}
+	sw.Bytes([]byte(" ("))
+	for i, o := range options {
+		sw.JoinComma(i, o)
+	}
+	sw.RParen()
+}
+
+func writeVacuumRelations(sw *SQLWriter, tables []*VacuumRelation) {
+	if len(tables) == 0 {
+		return
+	}
+	sw.Space()
+	for i, t := range tables {
+		sw.JoinComma(i, t)
+	}
+}
+
+// CREATE TYPE name AS ENUM ( [ 'label' [, ...] ] )
+// CREATE TYPE name AS ( attribute_name data_type [, ...] ) (PostgreSQL)
+type CreateTypeStmt struct {
+	stmt
+	Create     sqltoken.Pos
+	Name       *ObjectName
+	Enum       bool
+	Labels     []*SingleQuotedString // labels of ENUM
+	Attributes []*ColumnDef          // attributes of a composite type
+	RParen     sqltoken.Pos
+}
+
+func (c *CreateTypeStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateTypeStmt) End() sqltoken.Pos {
+	return c.RParen
+}
+
+func (c *CreateTypeStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CreateTypeStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE TYPE ")).Node(c.Name).Bytes([]byte(" AS "))
+	if c.Enum {
+		sw.Bytes([]byte("ENUM ")).LParen()
+		for i, l := range c.Labels {
+			sw.JoinComma(i, l)
+		}
+	} else {
+		sw.LParen()
+		for i, a := range c.Attributes {
+			sw.JoinComma(i, a)
+		}
+	}
+	return sw.RParen().End()
+}
+
+// CREATE DOMAIN name [ AS ] data_type [ DEFAULT expr ] [ constraint [ ... ] ] (PostgreSQL)
+type CreateDomainStmt struct {
+	stmt
+	Create      sqltoken.Pos
+	Name        *ObjectName
+	DataType    Type
+	Default     Node
+	Constraints []*ColumnConstraint // NOT NULL or CHECK constraints
+}
+
+func (c *CreateDomainStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateDomainStmt) End() sqltoken.Pos {
+	if len(c.Constraints) != 0 {
+		return c.Constraints[len(c.Constraints)-1].End()
+	}
+	if c.Default != nil {
+		return c.Default.End()
+	}
+	return c.DataType.End()
+}
+
+func (c *CreateDomainStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CreateDomainStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE DOMAIN ")).Node(c.Name).Bytes([]byte(" AS ")).Node(c.DataType)
+	if c.Default != nil {
+		sw.Bytes([]byte(" DEFAULT ")).Node(c.Default)
+	}
+	for _, cons := range c.Constraints {
+		sw.Node(cons)
+	}
+	return sw.End()
+}
+
+// CREATE EXTENSION [ IF NOT EXISTS ] extension_name [ WITH ] [ SCHEMA schema_name ] [ VERSION version ] [ CASCADE ] (PostgreSQL)
+type CreateExtensionStmt struct {
+	stmt
+	Create        sqltoken.Pos
+	NotExists     bool
+	NotExistsFrom sqltoken.Pos // start position of IF NOT EXISTS
+	NotExistsTo   sqltoken.Pos // end position of IF NOT EXISTS
+	Name          *Ident
+	Schema        *Ident
+	Version       Node // *Ident or *SingleQuotedString
+	Cascade       bool
+	CascadePos    sqltoken.Pos
+}
+
+func (c *CreateExtensionStmt) Pos() sqltoken.Pos {
+	return c.Create
+}
+
+func (c *CreateExtensionStmt) End() sqltoken.Pos {
+	if c.Cascade {
+		return c.CascadePos
+	}
+	if c.Version != nil {
+		return c.Version.End()
+	}
+	if c.Schema != nil {
+		return c.Schema.End()
+	}
+	return c.Name.End()
+}
+
+func (c *CreateExtensionStmt) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *CreateExtensionStmt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("CREATE EXTENSION "))
+	sw.If(c.NotExists, []byte("IF NOT EXISTS ")).Node(c.Name)
+	sw.If(c.Schema != nil || c.Version != nil, []byte(" WITH"))
+	if c.Schema != nil {
+		sw.Bytes([]byte(" SCHEMA ")).Node(c.Schema)
+	}
+	if c.Version != nil {
+		sw.Bytes([]byte(" VERSION ")).Node(c.Version)
+	}
+	sw.If(c.Cascade, []byte(" CASCADE"))
+	return sw.End()
 }
@@ -463,11 +463,13 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "add column",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("customers"),
-				Action: &AddColumnTableAction{
-					Column: &ColumnDef{
-						Name: NewIdent("email"),
-						DataType: &VarcharType{
-							Size: NewSize(255),
+				Actions: []AlterTableAction{
+					&AddColumnTableAction{
+						Column: &ColumnDef{
+							Name: NewIdent("email"),
+							DataType: &VarcharType{
+								Size: NewSize(255),
+							},
 						},
 					},
 				},
@@ -479,11 +481,13 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "add column over uint8",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("customers"),
-				Action: &AddColumnTableAction{
-					Column: &ColumnDef{
-						Name: NewIdent("email"),
-						DataType: &VarcharType{
-							Size: NewSize(256),
+				Actions: []AlterTableAction{
+					&AddColumnTableAction{
+						Column: &ColumnDef{
+							Name: NewIdent("email"),
+							DataType: &VarcharType{
+								Size: NewSize(256),
+							},
 						},
 					},
 				},
@@ -495,9 +499,11 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "remove column",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("products"),
-				Action: &RemoveColumnTableAction{
-					Name:    NewIdent("description"),
-					Cascade: true,
+				Actions: []AlterTableAction{
+					&RemoveColumnTableAction{
+						Name:    NewIdent("description"),
+						Cascade: true,
+					},
 				},
 			},
 			out: "ALTER TABLE products " +
@@ -507,13 +513,15 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "add constraint",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("products"),
-				Action: &AddConstraintTableAction{
-					Constraint: &TableConstraint{
-						Spec: &ReferentialTableConstraint{
-							Columns: []*Ident{NewIdent("test_id")},
-							KeyExpr: &ReferenceKeyExpr{
-								TableName: NewIdent("other_table"),
-								Columns:   []*Ident{NewIdent("col1"), NewIdent("col2")},
+				Actions: []AlterTableAction{
+					&AddConstraintTableAction{
+						Constraint: &TableConstraint{
+							Spec: &ReferentialTableConstraint{
+								Columns: []*Ident{NewIdent("test_id")},
+								KeyExpr: &ReferenceKeyExpr{
+									TableName: NewIdent("other_table"),
+									Columns:   []*Ident{NewIdent("col1"), NewIdent("col2")},
+								},
 							},
 						},
 					},
@@ -526,10 +534,12 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "alter column",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("products"),
-				Action: &AlterColumnTableAction{
-					ColumnName: NewIdent("created_at"),
-					Action: &SetDefaultColumnAction{
-						Default: NewIdent("current_timestamp"),
+				Actions: []AlterTableAction{
+					&AlterColumnTableAction{
+						ColumnName: NewIdent("created_at"),
+						Action: &SetDefaultColumnAction{
+							Default: NewIdent("current_timestamp"),
+						},
 					},
 				},
 			},
@@ -540,12 +550,14 @@ func TestSQLAlterTable_ToSQLString(t *testing.T) {
 			name: "pg change type",
 			in: &AlterTableStmt{
 				TableName: NewObjectName("products"),
-				Action: &AlterColumnTableAction{
-					ColumnName: NewIdent("number"),
-					Action: &PGAlterDataTypeColumnAction{
-						DataType: &Decimal{
-							Scale:     NewSize(10),
-							Precision: NewSize(255),
+				Actions: []AlterTableAction{
+					&AlterColumnTableAction{
+						ColumnName: NewIdent("number"),
+						Action: &PGAlterDataTypeColumnAction{
+							DataType: &Decimal{
+								Scale:     NewSize(10),
+								Precision: NewSize(255),
+							},
 						},
 					},
 				},
@@ -574,49 +586,49 @@ func TestSQLCreateIndex_ToSQLString(t *testing.T) {
 		{
 			name: "create index",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName: NewObjectName("customers"),
+				Columns:   []*IndexElement{{Expr: NewIdent("name")}},
 			},
 			out: "CREATE INDEX ON customers (name)",
 		},
 		{
 			name: "create unique index",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IsUnique:    true,
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName: NewObjectName("customers"),
+				IsUnique:  true,
+				Columns:   []*IndexElement{{Expr: NewIdent("name")}},
 			},
 			out: "CREATE UNIQUE INDEX ON customers (name)",
 		},
 		{
 			name: "create index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				ColumnNames: []*Ident{NewIdent("name"), NewIdent("email")},
+				TableName: NewObjectName("customers"),
+				IndexName: NewIdent("customers_idx"),
+				IsUnique:  true,
+				Columns:   []*IndexElement{{Expr: NewIdent("name")}, {Expr: NewIdent("email")}},
 			},
 			out: "CREATE UNIQUE INDEX customers_idx ON customers (name, email)",
 		},
 		{
 			name: "create index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				MethodName:  NewIdent("gist"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName:  NewObjectName("customers"),
+				IndexName:  NewIdent("customers_idx"),
+				IsUnique:   true,
+				MethodName: NewIdent("gist"),
+				Columns:    []*IndexElement{{Expr: NewIdent("name")}},
 			},
 			out: "CREATE UNIQUE INDEX customers_idx ON customers USING gist (name)",
 		},
 		{
 			name: "create partial index with name",
 			in: &CreateIndexStmt{
-				TableName:   NewObjectName("customers"),
-				IndexName:   NewIdent("customers_idx"),
-				IsUnique:    true,
-				MethodName:  NewIdent("gist"),
-				ColumnNames: []*Ident{NewIdent("name")},
+				TableName:  NewObjectName("customers"),
+				IndexName:  NewIdent("customers_idx"),
+				IsUnique:   true,
+				MethodName: NewIdent("gist"),
+				Columns:    []*IndexElement{{Expr: NewIdent("name")}},
 				Selection: &BinaryExpr{
 					Left:  NewIdent("name"),
 					Op:    &Operator{Type: Eq},
@@ -8,7 +8,7 @@ import (
 
 //go:generate genmark -t TableOption -e Node
 
-//ENGINE option ( = InnoDB, MyISAM ...)
+// ENGINE option ( = InnoDB, MyISAM ...)
 type MyEngine struct {
 	tableOption
 	Engine sqltoken.Pos
@@ -21,7 +21,7 @@ func (m *MyEngine) ToSQLString() string {
 }
 
 func (m *MyEngine) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("ENGINE ")).If(m.Equal, []byte("= ")).Node(m.Name)
 	return sw.End()
 }
@@ -48,7 +48,7 @@ func (m *MyCharset) ToSQLString() string {
 }
 
 func (m *MyCharset) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.If(m.IsDefault, []byte("DEFAULT ")).Bytes([]byte("CHARSET "))
 	sw.If(m.Equal, []byte("= ")).Node(m.Name)
 	return sw.End()
@@ -64,3 +64,58 @@ func (m *MyCharset) Pos() sqltoken.Pos {
 func (m *MyCharset) End() sqltoken.Pos {
 	return m.Name.To
 }
+
+// COLLATE option ( = utf8mb4_bin ...)
+type MyCollate struct {
+	tableOption
+	IsDefault bool
+	Default   sqltoken.Pos
+	Collate   sqltoken.Pos
+	Equal     bool
+	Name      *Ident
+}
+
+func (m *MyCollate) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MyCollate) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.If(m.IsDefault, []byte("DEFAULT ")).Bytes([]byte("COLLATE "))
+	sw.If(m.Equal, []byte("= ")).Node(m.Name)
+	return sw.End()
+}
+
+func (m *MyCollate) Pos() sqltoken.Pos {
+	if m.IsDefault {
+		return m.Default
+	}
+	return m.Collate
+}
+
+func (m *MyCollate) End() sqltoken.Pos {
+	return m.Name.To
+}
+
+// WITHOUT ROWID option (SQLite)
+type SQLiteWithoutRowID struct {
+	tableOption
+	Without sqltoken.Pos
+	RowID   sqltoken.Pos
+}
+
+func (s *SQLiteWithoutRowID) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *SQLiteWithoutRowID) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("WITHOUT ROWID"))
+}
+
+func (s *SQLiteWithoutRowID) Pos() sqltoken.Pos {
+	return s.Without
+}
+
+func (s *SQLiteWithoutRowID) End() sqltoken.Pos {
+	return s.RowID
+}
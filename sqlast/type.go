@@ -10,9 +10,38 @@ type Type interface {
 	Node
 }
 
+// CHARACTER SET charset_name and COLLATE collation_name modifiers of character string types
+type CharsetCollation struct {
+	Charset    *Ident // nil if CHARACTER SET is omitted
+	CharsetPos sqltoken.Pos
+	Collation  *Ident // nil if COLLATE is omitted
+	CollatePos sqltoken.Pos
+}
+
+func (c *CharsetCollation) end(typeEnd sqltoken.Pos) sqltoken.Pos {
+	if c.Collation != nil {
+		return c.Collation.End()
+	}
+	if c.Charset != nil {
+		return c.Charset.End()
+	}
+	return typeEnd
+}
+
+func (c *CharsetCollation) write(sw *SQLWriter) *SQLWriter {
+	if c.Charset != nil {
+		sw.Bytes([]byte(" CHARACTER SET ")).Node(c.Charset)
+	}
+	if c.Collation != nil {
+		sw.Bytes([]byte(" COLLATE ")).Node(c.Collation)
+	}
+	return sw
+}
+
 type CharType struct {
 	Size             *uint
 	From, To, RParen sqltoken.Pos
+	CharsetCollation
 }
 
 func (c *CharType) Pos() sqltoken.Pos {
@@ -21,9 +50,9 @@ func (c *CharType) Pos() sqltoken.Pos {
 
 func (c *CharType) End() sqltoken.Pos {
 	if c.Size != nil {
-		return c.RParen
+		return c.CharsetCollation.end(c.RParen)
 	}
-	return c.To
+	return c.CharsetCollation.end(c.To)
 }
 
 func (c *CharType) ToSQLString() string {
@@ -31,12 +60,15 @@ func (c *CharType) ToSQLString() string {
 }
 
 func (c *CharType) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("char"), c.Size).End()
+	sw := NewSQLWriter(w).TypeWithOptionalLength([]byte("char"), c.Size)
+	return c.CharsetCollation.write(sw).End()
 }
 
 type VarcharType struct {
 	Size                       *uint
+	IsMax                      bool // VARCHAR(MAX) (MSSQL)
 	Character, Varying, RParen sqltoken.Pos
+	CharsetCollation
 }
 
 func (v *VarcharType) Pos() sqltoken.Pos {
@@ -44,10 +76,10 @@ func (v *VarcharType) Pos() sqltoken.Pos {
 }
 
 func (v *VarcharType) End() sqltoken.Pos {
-	if v.Size != nil {
-		return v.RParen
+	if v.Size != nil || v.IsMax {
+		return v.CharsetCollation.end(v.RParen)
 	}
-	return v.Varying
+	return v.CharsetCollation.end(v.Varying)
 }
 
 func (v *VarcharType) ToSQLString() string {
@@ -55,7 +87,43 @@ func (v *VarcharType) ToSQLString() string {
 }
 
 func (v *VarcharType) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("character varying"), v.Size).End()
+	sw := NewSQLWriter(w)
+	if v.IsMax {
+		sw.Bytes([]byte("varchar(max)"))
+	} else {
+		sw.TypeWithOptionalLength([]byte("character varying"), v.Size)
+	}
+	return v.CharsetCollation.write(sw).End()
+}
+
+// NVARCHAR[(size | MAX)] (MSSQL)
+type NVarcharType struct {
+	Size     *uint
+	IsMax    bool
+	From, To sqltoken.Pos
+	RParen   sqltoken.Pos
+}
+
+func (n *NVarcharType) Pos() sqltoken.Pos {
+	return n.From
+}
+
+func (n *NVarcharType) End() sqltoken.Pos {
+	if n.Size != nil || n.IsMax {
+		return n.RParen
+	}
+	return n.To
+}
+
+func (n *NVarcharType) ToSQLString() string {
+	return toSQLString(n)
+}
+
+func (n *NVarcharType) WriteTo(w io.Writer) (int64, error) {
+	if n.IsMax {
+		return writeSingleBytes(w, []byte("nvarchar(max)"))
+	}
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("nvarchar"), n.Size).End()
 }
 
 type UUID struct {
@@ -96,7 +164,7 @@ func (c *Clob) ToSQLString() string {
 }
 
 func (c *Clob) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("clob"), &c.Size).End()
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("clob"), &c.Size).End()
 }
 
 type Binary struct {
@@ -117,7 +185,7 @@ func (b *Binary) ToSQLString() string {
 }
 
 func (b *Binary) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("binary"), &b.Size).End()
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("binary"), &b.Size).End()
 }
 
 type Varbinary struct {
@@ -138,12 +206,12 @@ func (v *Varbinary) ToSQLString() string {
 }
 
 func (v *Varbinary) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("varbinary"), &v.Size).End()
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("varbinary"), &v.Size).End()
 }
 
 type Blob struct {
-	Size         uint
-	Blob, RParen sqltoken.Pos
+	Size         uint         // length is omitted if 0
+	Blob, RParen sqltoken.Pos // RParen is the end of BLOB keyword if the length is omitted
 }
 
 func (b *Blob) Pos() sqltoken.Pos {
@@ -159,10 +227,13 @@ func (b *Blob) ToSQLString() string {
 }
 
 func (b *Blob) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).TypeWithOptionalLength([]byte("blob"), &b.Size).End()
+	if b.Size == 0 {
+		return writeSingleBytes(w, []byte("blob"))
+	}
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("blob"), &b.Size).End()
 }
 
-// All unsigned props are only available on MySQL
+// All unsigned, zerofill and display width props are only available on MySQL
 
 type Decimal struct {
 	Precision       *uint
@@ -170,6 +241,8 @@ type Decimal struct {
 	Numeric, RParen sqltoken.Pos
 	IsUnsigned      bool
 	Unsigned        sqltoken.Pos
+	IsZerofill      bool
+	Zerofill        sqltoken.Pos
 }
 
 func (d *Decimal) Pos() sqltoken.Pos {
@@ -177,6 +250,9 @@ func (d *Decimal) Pos() sqltoken.Pos {
 }
 
 func (d *Decimal) End() sqltoken.Pos {
+	if d.IsZerofill {
+		return d.Zerofill
+	}
 	if d.IsUnsigned {
 		return d.Unsigned
 	}
@@ -188,7 +264,7 @@ func (d *Decimal) ToSQLString() string {
 }
 
 func (d *Decimal) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
+	sw := NewSQLWriter(w)
 	sw.Bytes([]byte("numeric"))
 	if d.Precision != nil {
 		sw.LParen()
@@ -199,7 +275,7 @@ func (d *Decimal) WriteTo(w io.Writer) (int64, error) {
 		}
 		sw.RParen()
 	}
-	sw.If(d.IsUnsigned, []byte(" unsigned"))
+	sw.If(d.IsUnsigned, []byte(" unsigned")).If(d.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
@@ -208,6 +284,8 @@ type Float struct {
 	From, To, RParen sqltoken.Pos
 	IsUnsigned       bool
 	Unsigned         sqltoken.Pos
+	IsZerofill       bool
+	Zerofill         sqltoken.Pos
 }
 
 func (f *Float) Pos() sqltoken.Pos {
@@ -215,6 +293,9 @@ func (f *Float) Pos() sqltoken.Pos {
 }
 
 func (f *Float) End() sqltoken.Pos {
+	if f.IsZerofill {
+		return f.Zerofill
+	}
 	if f.IsUnsigned {
 		return f.Unsigned
 	}
@@ -229,15 +310,19 @@ func (f *Float) ToSQLString() string {
 }
 
 func (f *Float) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.TypeWithOptionalLength([]byte("float"), f.Size).If(f.IsUnsigned, []byte(" unsigned"))
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("float"), f.Size).If(f.IsUnsigned, []byte(" unsigned")).If(f.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
 type SmallInt struct {
 	From, To   sqltoken.Pos
+	Width      *uint // display width. nil if omitted
+	RParen     sqltoken.Pos
 	IsUnsigned bool
 	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
 }
 
 func (s *SmallInt) Pos() sqltoken.Pos {
@@ -245,9 +330,15 @@ func (s *SmallInt) Pos() sqltoken.Pos {
 }
 
 func (s *SmallInt) End() sqltoken.Pos {
+	if s.IsZerofill {
+		return s.Zerofill
+	}
 	if s.IsUnsigned {
 		return s.Unsigned
 	}
+	if s.Width != nil {
+		return s.RParen
+	}
 	return s.To
 }
 
@@ -256,15 +347,20 @@ func (s *SmallInt) ToSQLString() string {
 }
 
 func (s *SmallInt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("smallint")).If(s.IsUnsigned, []byte(" unsigned"))
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("smallint"), s.Width).If(s.IsUnsigned, []byte(" unsigned")).If(s.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
 type Int struct {
 	From, To   sqltoken.Pos
+	Width      *uint // display width. nil if omitted
+	RParen     sqltoken.Pos
+	IsInteger  bool // spelled as INTEGER, which matters for SQLite's INTEGER PRIMARY KEY
 	IsUnsigned bool
 	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
 }
 
 func (i *Int) Pos() sqltoken.Pos {
@@ -272,9 +368,15 @@ func (i *Int) Pos() sqltoken.Pos {
 }
 
 func (i *Int) End() sqltoken.Pos {
+	if i.IsZerofill {
+		return i.Zerofill
+	}
 	if i.IsUnsigned {
 		return i.Unsigned
 	}
+	if i.Width != nil {
+		return i.RParen
+	}
 	return i.To
 }
 
@@ -283,15 +385,24 @@ func (i *Int) ToSQLString() string {
 }
 
 func (i *Int) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("int")).If(i.IsUnsigned, []byte(" unsigned"))
+	sw := NewSQLWriter(w)
+	if i.IsInteger {
+		sw.TypeWithOptionalLength([]byte("integer"), i.Width)
+	} else {
+		sw.TypeWithOptionalLength([]byte("int"), i.Width)
+	}
+	sw.If(i.IsUnsigned, []byte(" unsigned")).If(i.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
 type BigInt struct {
 	From, To   sqltoken.Pos
+	Width      *uint // display width. nil if omitted
+	RParen     sqltoken.Pos
 	IsUnsigned bool
 	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
 }
 
 func (b *BigInt) Pos() sqltoken.Pos {
@@ -299,9 +410,15 @@ func (b *BigInt) Pos() sqltoken.Pos {
 }
 
 func (b *BigInt) End() sqltoken.Pos {
+	if b.IsZerofill {
+		return b.Zerofill
+	}
 	if b.IsUnsigned {
 		return b.Unsigned
 	}
+	if b.Width != nil {
+		return b.RParen
+	}
 	return b.To
 }
 
@@ -310,15 +427,79 @@ func (b *BigInt) ToSQLString() string {
 }
 
 func (b *BigInt) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("bigint")).If(b.IsUnsigned, []byte(" unsigned"))
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("bigint"), b.Width).If(b.IsUnsigned, []byte(" unsigned")).If(b.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
+// auto-incrementing integer types of PostgreSQL. these are not true types but
+// notational convenience for integer columns with a sequence default.
+type SmallSerial struct {
+	From, To sqltoken.Pos
+}
+
+func (s *SmallSerial) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *SmallSerial) End() sqltoken.Pos {
+	return s.To
+}
+
+func (*SmallSerial) ToSQLString() string {
+	return "smallserial"
+}
+
+func (*SmallSerial) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("smallserial"))
+}
+
+type Serial struct {
+	From, To sqltoken.Pos
+}
+
+func (s *Serial) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *Serial) End() sqltoken.Pos {
+	return s.To
+}
+
+func (*Serial) ToSQLString() string {
+	return "serial"
+}
+
+func (*Serial) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("serial"))
+}
+
+type BigSerial struct {
+	From, To sqltoken.Pos
+}
+
+func (b *BigSerial) Pos() sqltoken.Pos {
+	return b.From
+}
+
+func (b *BigSerial) End() sqltoken.Pos {
+	return b.To
+}
+
+func (*BigSerial) ToSQLString() string {
+	return "bigserial"
+}
+
+func (*BigSerial) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("bigserial"))
+}
+
 type Real struct {
 	From, To   sqltoken.Pos
 	IsUnsigned bool
 	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
 }
 
 func (r *Real) Pos() sqltoken.Pos {
@@ -326,6 +507,9 @@ func (r *Real) Pos() sqltoken.Pos {
 }
 
 func (r *Real) End() sqltoken.Pos {
+	if r.IsZerofill {
+		return r.Zerofill
+	}
 	if r.IsUnsigned {
 		return r.Unsigned
 	}
@@ -337,8 +521,8 @@ func (r *Real) ToSQLString() string {
 }
 
 func (r *Real) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("real")).If(r.IsUnsigned, []byte(" unsigned"))
+	sw := NewSQLWriter(w)
+	sw.Bytes([]byte("real")).If(r.IsUnsigned, []byte(" unsigned")).If(r.IsZerofill, []byte(" zerofill"))
 	return sw.End()
 }
 
@@ -403,7 +587,11 @@ func (*Date) WriteTo(w io.Writer) (int64, error) {
 }
 
 type Time struct {
-	From, To sqltoken.Pos
+	From, To     sqltoken.Pos
+	Precision    *uint // fractional seconds precision. nil if omitted
+	RParen       sqltoken.Pos
+	WithTimeZone bool
+	Zone         sqltoken.Pos
 }
 
 func (t *Time) Pos() sqltoken.Pos {
@@ -411,21 +599,25 @@ func (t *Time) Pos() sqltoken.Pos {
 }
 
 func (t *Time) End() sqltoken.Pos {
-	return t.To
+	return timeTypeEnd(t.To, t.Precision, t.RParen, t.WithTimeZone, t.Zone)
 }
 
-func (*Time) ToSQLString() string {
-	return "time"
+func (t *Time) ToSQLString() string {
+	return toSQLString(t)
 }
 
-func (*Time) WriteTo(w io.Writer) (int64, error) {
-	return writeSingleBytes(w, []byte("time"))
+func (t *Time) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("time"), t.Precision).If(t.WithTimeZone, []byte(" with time zone"))
+	return sw.End()
 }
 
 type Timestamp struct {
 	WithTimeZone bool
 	Timestamp    sqltoken.Pos
 	Zone         sqltoken.Pos
+	Precision    *uint // fractional seconds precision. nil if omitted
+	RParen       sqltoken.Pos
 }
 
 func (t *Timestamp) Pos() sqltoken.Pos {
@@ -433,14 +625,12 @@ func (t *Timestamp) Pos() sqltoken.Pos {
 }
 
 func (t *Timestamp) End() sqltoken.Pos {
-	if t.WithTimeZone {
-		return t.Zone
-	}
-
-	return sqltoken.Pos{
-		Line: t.Timestamp.Line,
-		Col:  t.Timestamp.Col + 9,
+	to := sqltoken.Pos{
+		Line:   t.Timestamp.Line,
+		Col:    t.Timestamp.Col + 9,
+		Offset: t.Timestamp.Offset + 9,
 	}
+	return timeTypeEnd(to, t.Precision, t.RParen, t.WithTimeZone, t.Zone)
 }
 
 func (t *Timestamp) ToSQLString() string {
@@ -448,11 +638,24 @@ func (t *Timestamp) ToSQLString() string {
 }
 
 func (t *Timestamp) WriteTo(w io.Writer) (int64, error) {
-	sw := newSQLWriter(w)
-	sw.Bytes([]byte("timestamp")).If(t.WithTimeZone, []byte(" with time zone"))
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("timestamp"), t.Precision).If(t.WithTimeZone, []byte(" with time zone"))
 	return sw.End()
 }
 
+// timeTypeEnd returns the last position of TIME and TIMESTAMP types
+// whose precision can be placed either before or after WITH TIME ZONE.
+func timeTypeEnd(to sqltoken.Pos, precision *uint, rparen sqltoken.Pos, withTimeZone bool, zone sqltoken.Pos) sqltoken.Pos {
+	end := to
+	if precision != nil && sqltoken.ComparePos(rparen, end) > 0 {
+		end = rparen
+	}
+	if withTimeZone && sqltoken.ComparePos(zone, end) > 0 {
+		end = zone
+	}
+	return end
+}
+
 type Regclass struct {
 	From, To sqltoken.Pos
 }
@@ -475,6 +678,7 @@ func (*Regclass) WriteTo(w io.Writer) (int64, error) {
 
 type Text struct {
 	From, To sqltoken.Pos
+	CharsetCollation
 }
 
 func (t *Text) Pos() sqltoken.Pos {
@@ -482,15 +686,16 @@ func (t *Text) Pos() sqltoken.Pos {
 }
 
 func (t *Text) End() sqltoken.Pos {
-	return t.To
+	return t.CharsetCollation.end(t.To)
 }
 
-func (*Text) ToSQLString() string {
-	return "text"
+func (t *Text) ToSQLString() string {
+	return toSQLString(t)
 }
 
-func (*Text) WriteTo(w io.Writer) (int64, error) {
-	return writeSingleBytes(w, []byte("text"))
+func (t *Text) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("text"))
+	return t.CharsetCollation.write(sw).End()
 }
 
 type Bytea struct {
@@ -513,9 +718,337 @@ func (*Bytea) WriteTo(w io.Writer) (int64, error) {
 	return writeSingleBytes(w, []byte("bytea"))
 }
 
+// Ty[] or Ty[Size] (PostgreSQL). Multi-dimensional arrays are nested Arrays.
+// MySQL specific types
+
+type TinyInt struct {
+	From, To   sqltoken.Pos
+	Width      *uint // display width. nil if omitted
+	RParen     sqltoken.Pos
+	IsUnsigned bool
+	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
+}
+
+func (t *TinyInt) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TinyInt) End() sqltoken.Pos {
+	if t.IsZerofill {
+		return t.Zerofill
+	}
+	if t.IsUnsigned {
+		return t.Unsigned
+	}
+	if t.Width != nil {
+		return t.RParen
+	}
+	return t.To
+}
+
+func (t *TinyInt) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TinyInt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("tinyint"), t.Width).If(t.IsUnsigned, []byte(" unsigned")).If(t.IsZerofill, []byte(" zerofill"))
+	return sw.End()
+}
+
+type MediumInt struct {
+	From, To   sqltoken.Pos
+	Width      *uint // display width. nil if omitted
+	RParen     sqltoken.Pos
+	IsUnsigned bool
+	Unsigned   sqltoken.Pos
+	IsZerofill bool
+	Zerofill   sqltoken.Pos
+}
+
+func (m *MediumInt) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MediumInt) End() sqltoken.Pos {
+	if m.IsZerofill {
+		return m.Zerofill
+	}
+	if m.IsUnsigned {
+		return m.Unsigned
+	}
+	if m.Width != nil {
+		return m.RParen
+	}
+	return m.To
+}
+
+func (m *MediumInt) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MediumInt) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w)
+	sw.TypeWithOptionalLength([]byte("mediumint"), m.Width).If(m.IsUnsigned, []byte(" unsigned")).If(m.IsZerofill, []byte(" zerofill"))
+	return sw.End()
+}
+
+// ENUM('value1', 'value2', ...) (MySQL)
+type Enum struct {
+	From   sqltoken.Pos
+	Values []*SingleQuotedString
+	RParen sqltoken.Pos
+	CharsetCollation
+}
+
+func (e *Enum) Pos() sqltoken.Pos {
+	return e.From
+}
+
+func (e *Enum) End() sqltoken.Pos {
+	return e.CharsetCollation.end(e.RParen)
+}
+
+func (e *Enum) ToSQLString() string {
+	return toSQLString(e)
+}
+
+func (e *Enum) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("enum")).LParen()
+	for i, v := range e.Values {
+		sw.JoinComma(i, v)
+	}
+	sw.RParen()
+	return e.CharsetCollation.write(sw).End()
+}
+
+// SET('value1', 'value2', ...) (MySQL)
+type Set struct {
+	From   sqltoken.Pos
+	Values []*SingleQuotedString
+	RParen sqltoken.Pos
+	CharsetCollation
+}
+
+func (s *Set) Pos() sqltoken.Pos {
+	return s.From
+}
+
+func (s *Set) End() sqltoken.Pos {
+	return s.CharsetCollation.end(s.RParen)
+}
+
+func (s *Set) ToSQLString() string {
+	return toSQLString(s)
+}
+
+func (s *Set) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("set")).LParen()
+	for i, v := range s.Values {
+		sw.JoinComma(i, v)
+	}
+	sw.RParen()
+	return s.CharsetCollation.write(sw).End()
+}
+
+type JSON struct {
+	From, To sqltoken.Pos
+}
+
+func (j *JSON) Pos() sqltoken.Pos {
+	return j.From
+}
+
+func (j *JSON) End() sqltoken.Pos {
+	return j.To
+}
+
+func (*JSON) ToSQLString() string {
+	return "json"
+}
+
+func (*JSON) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("json"))
+}
+
+// DATETIME[(fsp)] (MySQL)
+type DateTime struct {
+	From, To  sqltoken.Pos
+	Precision *uint // fractional seconds precision. nil if omitted
+	RParen    sqltoken.Pos
+}
+
+func (d *DateTime) Pos() sqltoken.Pos {
+	return d.From
+}
+
+func (d *DateTime) End() sqltoken.Pos {
+	if d.Precision != nil {
+		return d.RParen
+	}
+	return d.To
+}
+
+func (d *DateTime) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DateTime) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).TypeWithOptionalLength([]byte("datetime"), d.Precision).End()
+}
+
+type Year struct {
+	From, To sqltoken.Pos
+}
+
+func (y *Year) Pos() sqltoken.Pos {
+	return y.From
+}
+
+func (y *Year) End() sqltoken.Pos {
+	return y.To
+}
+
+func (*Year) ToSQLString() string {
+	return "year"
+}
+
+func (*Year) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("year"))
+}
+
+type TinyText struct {
+	From, To sqltoken.Pos
+	CharsetCollation
+}
+
+func (t *TinyText) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TinyText) End() sqltoken.Pos {
+	return t.CharsetCollation.end(t.To)
+}
+
+func (t *TinyText) ToSQLString() string {
+	return toSQLString(t)
+}
+
+func (t *TinyText) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("tinytext"))
+	return t.CharsetCollation.write(sw).End()
+}
+
+type MediumText struct {
+	From, To sqltoken.Pos
+	CharsetCollation
+}
+
+func (m *MediumText) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MediumText) End() sqltoken.Pos {
+	return m.CharsetCollation.end(m.To)
+}
+
+func (m *MediumText) ToSQLString() string {
+	return toSQLString(m)
+}
+
+func (m *MediumText) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("mediumtext"))
+	return m.CharsetCollation.write(sw).End()
+}
+
+type LongText struct {
+	From, To sqltoken.Pos
+	CharsetCollation
+}
+
+func (l *LongText) Pos() sqltoken.Pos {
+	return l.From
+}
+
+func (l *LongText) End() sqltoken.Pos {
+	return l.CharsetCollation.end(l.To)
+}
+
+func (l *LongText) ToSQLString() string {
+	return toSQLString(l)
+}
+
+func (l *LongText) WriteTo(w io.Writer) (int64, error) {
+	sw := NewSQLWriter(w).Bytes([]byte("longtext"))
+	return l.CharsetCollation.write(sw).End()
+}
+
+type TinyBlob struct {
+	From, To sqltoken.Pos
+}
+
+func (t *TinyBlob) Pos() sqltoken.Pos {
+	return t.From
+}
+
+func (t *TinyBlob) End() sqltoken.Pos {
+	return t.To
+}
+
+func (*TinyBlob) ToSQLString() string {
+	return "tinyblob"
+}
+
+func (*TinyBlob) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("tinyblob"))
+}
+
+type MediumBlob struct {
+	From, To sqltoken.Pos
+}
+
+func (m *MediumBlob) Pos() sqltoken.Pos {
+	return m.From
+}
+
+func (m *MediumBlob) End() sqltoken.Pos {
+	return m.To
+}
+
+func (*MediumBlob) ToSQLString() string {
+	return "mediumblob"
+}
+
+func (*MediumBlob) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("mediumblob"))
+}
+
+type LongBlob struct {
+	From, To sqltoken.Pos
+}
+
+func (l *LongBlob) Pos() sqltoken.Pos {
+	return l.From
+}
+
+func (l *LongBlob) End() sqltoken.Pos {
+	return l.To
+}
+
+func (*LongBlob) ToSQLString() string {
+	return "longblob"
+}
+
+func (*LongBlob) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleBytes(w, []byte("longblob"))
+}
+
 type Array struct {
 	Ty     Type
-	RParen sqltoken.Pos
+	Size   *uint
+	RParen sqltoken.Pos // position of ]
 }
 
 func (a *Array) Pos() sqltoken.Pos {
@@ -531,7 +1064,12 @@ func (a *Array) ToSQLString() string {
 }
 
 func (a *Array) WriteTo(w io.Writer) (int64, error) {
-	return newSQLWriter(w).Node(a.Ty).Bytes([]byte("[]")).End()
+	sw := NewSQLWriter(w)
+	sw.Node(a.Ty).Bytes([]byte("["))
+	if a.Size != nil {
+		sw.Int(int(*a.Size))
+	}
+	return sw.Bytes([]byte("]")).End()
 }
 
 type Custom struct {
@@ -551,7 +1089,7 @@ func (c *Custom) ToSQLString() string {
 }
 
 func (c *Custom) WriteTo(w io.Writer) (int64, error) {
-	return c.Ty.WriteTo(w)
+	return NewSQLWriter(w).Node(c.Ty).End()
 }
 
 func NewSize(s uint) *uint {
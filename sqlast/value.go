@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/akito0107/xsqlparser/sqltoken"
@@ -17,6 +18,7 @@ type Value interface {
 type LongValue struct {
 	From, To sqltoken.Pos
 	Long     int64
+	Text     string // literal as written in SQL i.e: 1_000, 1e10. empty if unknown
 }
 
 func NewLongValue(i int64) *LongValue {
@@ -42,6 +44,9 @@ func (l *LongValue) ToSQLString() string {
 }
 
 func (l *LongValue) WriteTo(w io.Writer) (int64, error) {
+	if l.Text != "" {
+		return writeSingleString(w, l.Text)
+	}
 	n, err := io.WriteString(w, strconv.FormatInt(l.Long, 10))
 	return int64(n), err
 }
@@ -49,6 +54,7 @@ func (l *LongValue) WriteTo(w io.Writer) (int64, error) {
 type DoubleValue struct {
 	From, To sqltoken.Pos
 	Double   float64
+	Text     string // literal as written in SQL i.e: 1_000, 1e10. empty if unknown
 }
 
 func NewDoubleValue(f float64) *DoubleValue {
@@ -74,7 +80,10 @@ func (d *DoubleValue) ToSQLString() string {
 }
 
 func (d *DoubleValue) WriteTo(w io.Writer) (int64, error) {
-	var b [32] byte
+	if d.Text != "" {
+		return writeSingleString(w, d.Text)
+	}
+	var b [32]byte
 	buf := strconv.AppendFloat(b[:0], d.Double, 'f', -1, 64)
 	n, err := w.Write(buf)
 	return int64(n), err
@@ -83,6 +92,9 @@ func (d *DoubleValue) WriteTo(w io.Writer) (int64, error) {
 type SingleQuotedString struct {
 	From, To sqltoken.Pos
 	String   string
+	// BackslashEscape is set when backslash is an escape character of the dialect (MySQL),
+	// so that backslashes in String are written as \\.
+	BackslashEscape bool
 }
 
 func NewSingleQuotedString(str string) *SingleQuotedString {
@@ -112,7 +124,7 @@ func (s *SingleQuotedString) WriteTo(w io.Writer) (int64, error) {
 	if err != nil {
 		return int64(n), err
 	}
-	n1, err := io.WriteString(w, s.String)
+	n1, err := io.WriteString(w, escapeString(s.String, s.BackslashEscape))
 	if err != nil {
 		return int64(n + n1), err
 	}
@@ -121,8 +133,9 @@ func (s *SingleQuotedString) WriteTo(w io.Writer) (int64, error) {
 }
 
 type NationalStringLiteral struct {
-	From, To sqltoken.Pos
-	String   string
+	From, To        sqltoken.Pos
+	String          string
+	BackslashEscape bool // same as SingleQuotedString.BackslashEscape
 }
 
 func NewNationalStringLiteral(str string) *NationalStringLiteral {
@@ -144,7 +157,7 @@ func (n *NationalStringLiteral) Value() interface{} {
 }
 
 func (n *NationalStringLiteral) ToSQLString() string {
-	return fmt.Sprintf("N'%s'", n.String)
+	return fmt.Sprintf("N'%s'", escapeString(n.String, n.BackslashEscape))
 }
 
 func (n *NationalStringLiteral) WriteTo(w io.Writer) (int64, error) {
@@ -152,7 +165,7 @@ func (n *NationalStringLiteral) WriteTo(w io.Writer) (int64, error) {
 	if err != nil {
 		return int64(n0), err
 	}
-	n1, err := io.WriteString(w, n.String)
+	n1, err := io.WriteString(w, escapeString(n.String, n.BackslashEscape))
 	if err != nil {
 		return int64(n0 + n1), err
 	}
@@ -160,6 +173,93 @@ func (n *NationalStringLiteral) WriteTo(w io.Writer) (int64, error) {
 	return int64(n0 + n1 + n2), err
 }
 
+// X'String'
+type HexStringLiteral struct {
+	From, To sqltoken.Pos
+	String   string // hexadecimal digits
+}
+
+func (h *HexStringLiteral) Pos() sqltoken.Pos {
+	return h.From
+}
+
+func (h *HexStringLiteral) End() sqltoken.Pos {
+	return h.To
+}
+
+func (h *HexStringLiteral) Value() interface{} {
+	return h.String
+}
+
+func (h *HexStringLiteral) ToSQLString() string {
+	return toSQLString(h)
+}
+
+func (h *HexStringLiteral) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleString(w, "X'"+h.String+"'")
+}
+
+// B'String'
+type BitStringLiteral struct {
+	From, To sqltoken.Pos
+	String   string // binary digits
+}
+
+func (b *BitStringLiteral) Pos() sqltoken.Pos {
+	return b.From
+}
+
+func (b *BitStringLiteral) End() sqltoken.Pos {
+	return b.To
+}
+
+func (b *BitStringLiteral) Value() interface{} {
+	return b.String
+}
+
+func (b *BitStringLiteral) ToSQLString() string {
+	return toSQLString(b)
+}
+
+func (b *BitStringLiteral) WriteTo(w io.Writer) (int64, error) {
+	return writeSingleString(w, "B'"+b.String+"'")
+}
+
+func escapeString(s string, backslash bool) string {
+	if backslash {
+		s = strings.ReplaceAll(s, `\`, `\\`)
+	}
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// $tag$String$tag$ (PostgreSQL)
+type DollarQuotedString struct {
+	From, To sqltoken.Pos
+	Tag      string
+	String   string
+}
+
+func (d *DollarQuotedString) Pos() sqltoken.Pos {
+	return d.From
+}
+
+func (d *DollarQuotedString) End() sqltoken.Pos {
+	return d.To
+}
+
+func (d *DollarQuotedString) Value() interface{} {
+	return d.String
+}
+
+func (d *DollarQuotedString) ToSQLString() string {
+	return toSQLString(d)
+}
+
+func (d *DollarQuotedString) WriteTo(w io.Writer) (int64, error) {
+	delim := "$" + d.Tag + "$"
+	return writeSingleString(w, delim+d.String+delim)
+}
+
 type BooleanValue struct {
 	From, To sqltoken.Pos
 	Boolean  bool
@@ -198,6 +298,7 @@ func (b *BooleanValue) WriteTo(w io.Writer) (int64, error) {
 type DateValue struct {
 	From, To sqltoken.Pos
 	Date     time.Time
+	Text     string // special value such as 'today' and 'infinity' which Date can't represent. empty if Date is used
 }
 
 func (d *DateValue) Pos() sqltoken.Pos {
@@ -209,6 +310,9 @@ func (d *DateValue) End() sqltoken.Pos {
 }
 
 func (d *DateValue) Value() interface{} {
+	if d.Text != "" {
+		return d.Text
+	}
 	return d.Date
 }
 
@@ -217,8 +321,12 @@ func (d *DateValue) ToSQLString() string {
 }
 
 func (d *DateValue) WriteTo(w io.Writer) (int64, error) {
-	var b [16]byte
-	buf := d.Date.AppendFormat(b[:0], "2006-01-02")
+	if d.Text != "" {
+		return writeSingleString(w, "DATE '"+escapeString(d.Text, false)+"'")
+	}
+	var b [32]byte
+	buf := d.Date.AppendFormat(append(b[:0], "DATE '"...), "2006-01-02")
+	buf = append(buf, '\'')
 	n, err := w.Write(buf)
 	return int64(n), err
 }
@@ -226,6 +334,7 @@ func (d *DateValue) WriteTo(w io.Writer) (int64, error) {
 type TimeValue struct {
 	From, To sqltoken.Pos
 	Time     time.Time
+	Text     string // special value such as 'allballs' which Time can't represent. empty if Time is used
 }
 
 func NewTimeValue(t time.Time) *TimeValue {
@@ -243,6 +352,9 @@ func (t *TimeValue) End() sqltoken.Pos {
 }
 
 func (t *TimeValue) Value() interface{} {
+	if t.Text != "" {
+		return t.Text
+	}
 	return t.Time
 }
 
@@ -251,8 +363,12 @@ func (t *TimeValue) ToSQLString() string {
 }
 
 func (t *TimeValue) WriteTo(w io.Writer) (int64, error) {
-	var b [16]byte
-	buf := t.Time.AppendFormat(b[:0], "15:04:05")
+	if t.Text != "" {
+		return writeSingleString(w, "TIME '"+escapeString(t.Text, false)+"'")
+	}
+	var b [32]byte
+	buf := t.Time.AppendFormat(append(b[:0], "TIME '"...), "15:04:05.999999999")
+	buf = append(buf, '\'')
 	n, err := w.Write(buf)
 	return int64(n), err
 }
@@ -294,6 +410,7 @@ func (d *DateTimeValue) WriteTo(w io.Writer) (int64, error) {
 type TimestampValue struct {
 	From, To  sqltoken.Pos
 	Timestamp time.Time
+	Text      string // special value such as 'epoch' and 'infinity' which Timestamp can't represent. empty if Timestamp is used
 }
 
 func NewTimestampValue(t time.Time) *TimestampValue {
@@ -309,6 +426,9 @@ func (t *TimestampValue) End() sqltoken.Pos {
 }
 
 func (t *TimestampValue) Value() interface{} {
+	if t.Text != "" {
+		return t.Text
+	}
 	return t.Timestamp
 }
 
@@ -317,8 +437,15 @@ func (t *TimestampValue) ToSQLString() string {
 }
 
 func (t *TimestampValue) WriteTo(w io.Writer) (int64, error) {
-	var b [32]byte
-	buf := t.Timestamp.AppendFormat(b[:0], "2006-01-02 15:04:05")
+	if t.Text != "" {
+		return writeSingleString(w, "TIMESTAMP '"+escapeString(t.Text, false)+"'")
+	}
+	var b [64]byte
+	buf := t.Timestamp.AppendFormat(append(b[:0], "TIMESTAMP '"...), "2006-01-02 15:04:05.999999999")
+	if t.Timestamp.Location() != time.UTC {
+		buf = t.Timestamp.AppendFormat(buf, "-07:00")
+	}
+	buf = append(buf, '\'')
 	n, err := w.Write(buf)
 	return int64(n), err
 }
@@ -0,0 +1,46 @@
+package sqlast
+
+import "testing"
+
+type identCollector struct {
+	BaseVisitor
+	idents []string
+}
+
+func (c *identCollector) VisitIdent(node *Ident) bool {
+	c.idents = append(c.idents, node.Value)
+	return true
+}
+
+func (c *identCollector) VisitSQLSelect(node *SQLSelect) bool {
+	// children are skipped if WHERE clause exists
+	return node.WhereClause == nil
+}
+
+func TestWalkTyped(t *testing.T) {
+	stmt := &QueryStmt{
+		Body: &SelectExpr{
+			Select: &SQLSelect{
+				Projection: []SQLSelectItem{
+					&UnnamedSelectItem{Node: NewIdent("a")},
+				},
+				FromClause: []TableReference{
+					&Table{Name: NewObjectName("t")},
+				},
+			},
+		},
+	}
+
+	c := &identCollector{}
+	WalkTyped(c, stmt)
+	if len(c.idents) != 2 || c.idents[0] != "a" || c.idents[1] != "t" {
+		t.Errorf("unexpected idents %v", c.idents)
+	}
+
+	stmt.Body.(*SelectExpr).Select.WhereClause = NewIdent("b")
+	c = &identCollector{}
+	WalkTyped(c, stmt)
+	if len(c.idents) != 0 {
+		t.Errorf("children of SQLSelect must be skipped but %v", c.idents)
+	}
+}
@@ -20,8 +20,17 @@ func walkASTNodeLists(v Visitor, list []Node) {
 	}
 }
 
+func walkCharsetCollation(v Visitor, c *CharsetCollation) {
+	if c.Charset != nil {
+		Walk(v, c.Charset)
+	}
+	if c.Collation != nil {
+		Walk(v, c.Collation)
+	}
+}
+
 func Walk(v Visitor, node Node) {
-	if v := v.Visit(node); v == nil {
+	if v = v.Visit(node); v == nil {
 		return
 	}
 
@@ -38,10 +47,17 @@ func Walk(v Visitor, node Node) {
 		walkIdentLists(v, n.Idents)
 	case *CompoundIdent:
 		walkIdentLists(v, n.Idents)
+	case *Placeholder:
+		// nothing to do
 	case *IsNull:
 		Walk(v, n.X)
 	case *IsNotNull:
 		Walk(v, n.X)
+	case *IsOf:
+		Walk(v, n.X)
+		for _, t := range n.Types {
+			Walk(v, t)
+		}
 	case *InList:
 		Walk(v, n.Expr)
 		walkASTNodeLists(v, n.List)
@@ -56,9 +72,54 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Left)
 		Walk(v, n.Op)
 		Walk(v, n.Right)
+		if n.Escape != nil {
+			Walk(v, n.Escape)
+		}
 	case *Cast:
 		Walk(v, n.Expr)
 		Walk(v, n.DataType)
+	case *QuantifiedComparison:
+		Walk(v, n.Left)
+		Walk(v, n.Op)
+		Walk(v, n.Operand)
+	case *ExtractExpr:
+		Walk(v, n.Field)
+		Walk(v, n.Source)
+	case *PositionExpr:
+		Walk(v, n.Substring)
+		Walk(v, n.String)
+	case *SubstringExpr:
+		Walk(v, n.Expr)
+		if n.From != nil {
+			Walk(v, n.From)
+		}
+		if n.For != nil {
+			Walk(v, n.For)
+		}
+	case *TrimExpr:
+		if n.Chars != nil {
+			Walk(v, n.Chars)
+		}
+		Walk(v, n.Expr)
+	case *OverlayExpr:
+		Walk(v, n.Expr)
+		Walk(v, n.Placing)
+		Walk(v, n.From)
+		if n.For != nil {
+			Walk(v, n.For)
+		}
+	case *Subscript:
+		Walk(v, n.Expr)
+		if n.Index != nil {
+			Walk(v, n.Index)
+		}
+		if n.Upper != nil {
+			Walk(v, n.Upper)
+		}
+	case *ArrayConstructor:
+		for _, e := range n.Elements {
+			Walk(v, e)
+		}
 	case *Nested:
 		Walk(v, n.AST)
 	case *UnaryExpr:
@@ -71,7 +132,14 @@ func Walk(v Visitor, node Node) {
 			Walk(v, n.Over)
 		}
 	case *CaseExpr:
-		Walk(v, n.Operand)
+		if n.Operand != nil {
+			Walk(v, n.Operand)
+		}
+		walkASTNodeLists(v, n.Conditions)
+		walkASTNodeLists(v, n.Results)
+		if n.ElseResult != nil {
+			Walk(v, n.ElseResult)
+		}
 	case *Exists:
 		Walk(v, n.Query)
 	case *SubQuery:
@@ -115,13 +183,31 @@ func Walk(v Visitor, node Node) {
 		if n.Limit != nil {
 			Walk(v, n.Limit)
 		}
+		if n.Offset != nil {
+			Walk(v, n.Offset)
+		}
+		if n.Fetch != nil {
+			Walk(v, n.Fetch)
+		}
+		for _, l := range n.Locking {
+			Walk(v, l)
+		}
 	case *CTE:
 		Walk(v, n.Query)
 		Walk(v, n.Alias)
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
 	case *SelectExpr:
 		Walk(v, n.Select)
 	case *QueryExpr:
 		Walk(v, n.Query)
+	case *ValuesExpr:
+		for _, r := range n.Rows {
+			Walk(v, r)
+		}
+	case *TableExpr:
+		Walk(v, n.Name)
 	case *SetOperationExpr:
 		Walk(v, n.Op)
 		Walk(v, n.Left)
@@ -133,6 +219,12 @@ func Walk(v Visitor, node Node) {
 	case *IntersectOperator:
 		// nothing to do
 	case *SQLSelect:
+		if n.Hint != nil {
+			Walk(v, n.Hint)
+		}
+		if n.Top != nil {
+			Walk(v, n.Top)
+		}
 		for _, p := range n.Projection {
 			Walk(v, p)
 		}
@@ -148,6 +240,23 @@ func Walk(v Visitor, node Node) {
 		if n.HavingClause != nil {
 			Walk(v, n.HavingClause)
 		}
+		if n.QualifyClause != nil {
+			Walk(v, n.QualifyClause)
+		}
+	case *TopExpr:
+		Walk(v, n.Expr)
+	case *HintComment:
+		// nothing to do
+	case *OffsetExpr:
+		Walk(v, n.Value)
+	case *FetchExpr:
+		if n.Quantity != nil {
+			Walk(v, n.Quantity)
+		}
+	case *LockingClause:
+		for _, o := range n.Of {
+			Walk(v, o)
+		}
 	case *QualifiedJoin:
 		Walk(v, n.LeftElement)
 		Walk(v, n.Type)
@@ -173,13 +282,36 @@ func Walk(v Visitor, node Node) {
 		if n.Alias != nil {
 			Walk(v, n.Alias)
 		}
+		for _, c := range n.ColumnAliases {
+			Walk(v, c)
+		}
 		walkASTNodeLists(v, n.Args)
+		for _, h := range n.IndexHints {
+			Walk(v, h)
+		}
 		walkASTNodeLists(v, n.WithHints)
+	case *IndexHint:
+		for _, i := range n.Indexes {
+			Walk(v, i)
+		}
 	case *Derived:
 		Walk(v, n.SubQuery)
 		if n.Alias != nil {
 			Walk(v, n.Alias)
 		}
+		for _, c := range n.ColumnAliases {
+			Walk(v, c)
+		}
+	case *TableFunction:
+		for _, f := range n.Functions {
+			Walk(v, f)
+		}
+		if n.Alias != nil {
+			Walk(v, n.Alias)
+		}
+		for _, c := range n.ColumnAliases {
+			Walk(v, c)
+		}
 	case *UnnamedSelectItem:
 		Walk(v, n.Node)
 	case *AliasSelectItem:
@@ -199,8 +331,10 @@ func Walk(v Visitor, node Node) {
 			Walk(v, n.OffsetValue)
 		}
 	case *CharType:
-		// nothing to do
+		walkCharsetCollation(v, &n.CharsetCollation)
 	case *VarcharType:
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *NVarcharType:
 		// nothing to do
 	case *UUID:
 		// nothing to do
@@ -222,6 +356,12 @@ func Walk(v Visitor, node Node) {
 		// nothing to do
 	case *BigInt:
 		// nothing to do
+	case *SmallSerial:
+		// nothing to do
+	case *Serial:
+		// nothing to do
+	case *BigSerial:
+		// nothing to do
 	case *Real:
 		// nothing to do
 	case *Double:
@@ -237,9 +377,41 @@ func Walk(v Visitor, node Node) {
 	case *Regclass:
 		// nothing to do
 	case *Text:
-		// nothing to do
+		walkCharsetCollation(v, &n.CharsetCollation)
 	case *Bytea:
 		// nothing to do
+	case *TinyInt:
+		// nothing to do
+	case *MediumInt:
+		// nothing to do
+	case *JSON:
+		// nothing to do
+	case *DateTime:
+		// nothing to do
+	case *Year:
+		// nothing to do
+	case *Enum:
+		for _, val := range n.Values {
+			Walk(v, val)
+		}
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *Set:
+		for _, val := range n.Values {
+			Walk(v, val)
+		}
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *TinyText:
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *MediumText:
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *LongText:
+		walkCharsetCollation(v, &n.CharsetCollation)
+	case *TinyBlob:
+		// nothing to do
+	case *MediumBlob:
+		// nothing to do
+	case *LongBlob:
+		// nothing to do
 	case *Array:
 		// nothing to do
 	case *Custom:
@@ -252,7 +424,26 @@ func Walk(v Visitor, node Node) {
 		for _, a := range n.UpdateAssignments {
 			Walk(v, a)
 		}
-
+		if n.OnConflict != nil {
+			Walk(v, n.OnConflict)
+		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
+	case *OnConflict:
+		walkIdentLists(v, n.Columns)
+		if n.TargetWhere != nil {
+			Walk(v, n.TargetWhere)
+		}
+		if n.Constraint != nil {
+			Walk(v, n.Constraint)
+		}
+		for _, a := range n.Assignments {
+			Walk(v, a)
+		}
+		if n.Selection != nil {
+			Walk(v, n.Selection)
+		}
 	case *ConstructorSource:
 		for _, r := range n.Rows {
 			Walk(v, r)
@@ -266,25 +457,115 @@ func Walk(v Visitor, node Node) {
 	case *CopyStmt:
 		Walk(v, n.TableName)
 		walkIdentLists(v, n.Columns)
+		if n.File != nil {
+			Walk(v, n.File)
+		}
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *CopyOption:
+		Walk(v, n.Name)
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
 	case *UpdateStmt:
+		if n.Hint != nil {
+			Walk(v, n.Hint)
+		}
 		Walk(v, n.TableName)
 		for _, a := range n.Assignments {
 			Walk(v, a)
 		}
+		for _, f := range n.FromClause {
+			Walk(v, f)
+		}
 		Walk(v, n.Selection)
+		for _, o := range n.OrderBy {
+			Walk(v, o)
+		}
+		if n.Limit != nil {
+			Walk(v, n.Limit)
+		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
 	case *DeleteStmt:
+		if n.Hint != nil {
+			Walk(v, n.Hint)
+		}
 		Walk(v, n.TableName)
+		for _, u := range n.Using {
+			Walk(v, u)
+		}
 		if n.Selection != nil {
 			Walk(v, n.Selection)
 		}
+		for _, o := range n.OrderBy {
+			Walk(v, o)
+		}
+		if n.Limit != nil {
+			Walk(v, n.Limit)
+		}
+		for _, r := range n.Returning {
+			Walk(v, r)
+		}
 	case *CreateViewStmt:
 		Walk(v, n.Name)
+		walkIdentLists(v, n.Columns)
 		Walk(v, n.Query)
+	case *AlterViewStmt:
+		Walk(v, n.Name)
+		walkIdentLists(v, n.Columns)
+		if n.Query != nil {
+			Walk(v, n.Query)
+		}
+		if n.NewName != nil {
+			Walk(v, n.NewName)
+		}
+	case *DropViewStmt:
+		for _, name := range n.ViewNames {
+			Walk(v, name)
+		}
 	case *CreateTableStmt:
 		Walk(v, n.Name)
+		if n.PartitionOf != nil {
+			Walk(v, n.PartitionOf)
+		}
 		for _, e := range n.Elements {
 			Walk(v, e)
 		}
+		if n.Inherits != nil {
+			Walk(v, n.Inherits)
+		}
+		if n.Bound != nil {
+			Walk(v, n.Bound)
+		}
+		if n.PartitionBy != nil {
+			Walk(v, n.PartitionBy)
+		}
+	case *LikeTableElement:
+		Walk(v, n.Source)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *LikeOption:
+		Walk(v, n.Name)
+	case *PartitionSpec:
+		walkASTNodeLists(v, n.Columns)
+	case *InheritsClause:
+		for _, parent := range n.Parents {
+			Walk(v, parent)
+		}
+	case *PartitionBound:
+		walkASTNodeLists(v, n.In)
+		walkASTNodeLists(v, n.RangeFrom)
+		walkASTNodeLists(v, n.RangeTo)
+		if n.Modulus != nil {
+			Walk(v, n.Modulus)
+		}
+		if n.Remainder != nil {
+			Walk(v, n.Remainder)
+		}
 	case *Assignment:
 		Walk(v, n.ID)
 		Walk(v, n.Value)
@@ -293,8 +574,26 @@ func Walk(v Visitor, node Node) {
 			Walk(v, n.Name)
 		}
 		Walk(v, n.Spec)
+	case *IndexTableElement:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+		if n.Using != nil {
+			Walk(v, n.Using)
+		}
+		if n.Comment != nil {
+			Walk(v, n.Comment)
+		}
+	case *IndexColumn:
+		Walk(v, n.Name)
 	case *UniqueTableConstraint:
 		walkIdentLists(v, n.Columns)
+		if n.Comment != nil {
+			Walk(v, n.Comment)
+		}
 	case *ReferentialTableConstraint:
 		walkIdentLists(v, n.Columns)
 		Walk(v, n.KeyExpr)
@@ -305,7 +604,9 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Expr)
 	case *ColumnDef:
 		Walk(v, n.Name)
-		Walk(v, n.DataType)
+		if n.DataType != nil {
+			Walk(v, n.DataType)
+		}
 		if n.Default != nil {
 			Walk(v, n.Default)
 		}
@@ -328,7 +629,9 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Expr)
 	case *AlterTableStmt:
 		Walk(v, n.TableName)
-		Walk(v, n.Action)
+		for _, action := range n.Actions {
+			Walk(v, action)
+		}
 	case *AddColumnTableAction:
 		Walk(v, n.Column)
 	case *AlterColumnTableAction:
@@ -340,6 +643,9 @@ func Walk(v Visitor, node Node) {
 		// nothing to do
 	case *PGAlterDataTypeColumnAction:
 		Walk(v, n.DataType)
+		if n.Using != nil {
+			Walk(v, n.Using)
+		}
 	case *PGSetNotNullColumnAction:
 		// nothing to do
 	case *PGDropNotNullColumnAction:
@@ -350,10 +656,142 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Constraint)
 	case *DropConstraintTableAction:
 		Walk(v, n.Name)
+	case *AttachPartitionTableAction:
+		Walk(v, n.Name)
+		Walk(v, n.Bound)
+	case *DetachPartitionTableAction:
+		Walk(v, n.Name)
+	case *RenameTableAction:
+		Walk(v, n.NewName)
+	case *RenameColumnTableAction:
+		Walk(v, n.OldName)
+		Walk(v, n.NewName)
+	case *RenameConstraintTableAction:
+		Walk(v, n.OldName)
+		Walk(v, n.NewName)
+	case *MyModifyColumnTableAction:
+		Walk(v, n.Column)
+		if n.Position != nil {
+			Walk(v, n.Position)
+		}
+	case *MyDropPrimaryKeyTableAction:
+		// nothing to do
+	case *MyChangeColumnTableAction:
+		Walk(v, n.OldName)
+		Walk(v, n.Column)
+		if n.Position != nil {
+			Walk(v, n.Position)
+		}
+	case *MyColumnPosition:
+		if n.After != nil {
+			Walk(v, n.After)
+		}
 	case *DropTableStmt:
 		for _, t := range n.TableNames {
 			Walk(v, t)
 		}
+	case *CreateSchemaStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		if n.Authorization != nil {
+			Walk(v, n.Authorization)
+		}
+		for _, e := range n.Elements {
+			Walk(v, e)
+		}
+	case *DropSchemaStmt:
+		for _, s := range n.SchemaNames {
+			Walk(v, s)
+		}
+	case *CreateSequenceStmt:
+		Walk(v, n.Name)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *AlterSequenceStmt:
+		Walk(v, n.Name)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+	case *DropSequenceStmt:
+		for _, s := range n.SequenceNames {
+			Walk(v, s)
+		}
+	case *AsSequenceOption:
+		Walk(v, n.DataType)
+	case *IncrementBySequenceOption:
+		Walk(v, n.Value)
+	case *MinValueSequenceOption:
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
+	case *MaxValueSequenceOption:
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
+	case *StartWithSequenceOption:
+		Walk(v, n.Value)
+	case *RestartSequenceOption:
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
+	case *CacheSequenceOption:
+		Walk(v, n.Value)
+	case *CycleSequenceOption:
+		// nothing to do
+	case *OwnedBySequenceOption:
+		if n.Column != nil {
+			Walk(v, n.Column)
+		}
+	case *CreateFunctionStmt:
+		Walk(v, n.Name)
+		for _, a := range n.Args {
+			Walk(v, a)
+		}
+		if n.Returns != nil {
+			Walk(v, n.Returns)
+		}
+		if n.Language != nil {
+			Walk(v, n.Language)
+		}
+		walkIdentLists(v, n.Behaviors)
+		if n.Body != nil {
+			Walk(v, n.Body)
+		}
+	case *FunctionArg:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		Walk(v, n.DataType)
+		if n.Default != nil {
+			Walk(v, n.Default)
+		}
+	case *FunctionReturns:
+		if n.DataType != nil {
+			Walk(v, n.DataType)
+		}
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+	case *CreateTriggerStmt:
+		Walk(v, n.Name)
+		for _, e := range n.Events {
+			Walk(v, e)
+		}
+		Walk(v, n.TableName)
+		if n.When != nil {
+			Walk(v, n.When)
+		}
+		Walk(v, n.Function)
+		walkASTNodeLists(v, n.Args)
+	case *TriggerEvent:
+		walkIdentLists(v, n.Columns)
+	case *DropTriggerStmt:
+		Walk(v, n.Name)
+		if n.TableName != nil {
+			Walk(v, n.TableName)
+		}
 	case *CreateIndexStmt:
 		Walk(v, n.TableName)
 		if n.IndexName != nil {
@@ -362,14 +800,135 @@ func Walk(v Visitor, node Node) {
 		if n.MethodName != nil {
 			Walk(v, n.MethodName)
 		}
-		walkIdentLists(v, n.ColumnNames)
+		for _, c := range n.Columns {
+			Walk(v, c)
+		}
+		walkIdentLists(v, n.Include)
+		if n.Comment != nil {
+			Walk(v, n.Comment)
+		}
 		if n.Selection != nil {
 			Walk(v, n.Selection)
 		}
+	case *IndexElement:
+		Walk(v, n.Expr)
+		if n.Collation != nil {
+			Walk(v, n.Collation)
+		}
+		if n.OpClass != nil {
+			Walk(v, n.OpClass)
+		}
 	case *DropIndexStmt:
-		walkIdentLists(v, n.IndexNames)
+		for _, name := range n.IndexNames {
+			Walk(v, name)
+		}
+		if n.TableName != nil {
+			Walk(v, n.TableName)
+		}
+	case *TruncateStmt:
+		for _, t := range n.TableNames {
+			Walk(v, t)
+		}
+	case *SetVariableStmt:
+		if n.Scope != nil {
+			Walk(v, n.Scope)
+		}
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+		walkASTNodeLists(v, n.Values)
+	case *ShowStmt:
+		Walk(v, n.Name)
+	case *ShowWarningsStmt:
+		if n.Offset != nil {
+			Walk(v, n.Offset)
+		}
+		if n.Limit != nil {
+			Walk(v, n.Limit)
+		}
+	case *KillStmt:
+		Walk(v, n.ID)
+	case *UseStmt:
+		Walk(v, n.Database)
+	case *CommentOnStmt:
+		Walk(v, n.Name)
+		Walk(v, n.Text)
 	case *ExplainStmt:
-		Walk(v, n.Stmt)
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+		if n.Format != nil {
+			Walk(v, n.Format)
+		}
+		if n.Table != nil {
+			Walk(v, n.Table)
+		} else {
+			Walk(v, n.Stmt)
+		}
+	case *PrepareStmt:
+		Walk(v, n.Name)
+		for _, t := range n.Types {
+			Walk(v, t)
+		}
+		if n.From != nil {
+			Walk(v, n.From)
+		} else {
+			Walk(v, n.Stmt)
+		}
+	case *ExecuteStmt:
+		Walk(v, n.Name)
+		walkASTNodeLists(v, n.Args)
+	case *DeallocateStmt:
+		if n.Name != nil {
+			Walk(v, n.Name)
+		}
+	case *VacuumRelation:
+		Walk(v, n.Name)
+		walkIdentLists(v, n.Columns)
+	case *VacuumStmt:
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+		for _, t := range n.Tables {
+			Walk(v, t)
+		}
+	case *AnalyzeStmt:
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+		for _, t := range n.Tables {
+			Walk(v, t)
+		}
+	case *ReindexStmt:
+		for _, o := range n.Options {
+			Walk(v, o)
+		}
+		Walk(v, n.Name)
+	case *CreateTypeStmt:
+		Walk(v, n.Name)
+		for _, l := range n.Labels {
+			Walk(v, l)
+		}
+		for _, a := range n.Attributes {
+			Walk(v, a)
+		}
+	case *CreateDomainStmt:
+		Walk(v, n.Name)
+		Walk(v, n.DataType)
+		if n.Default != nil {
+			Walk(v, n.Default)
+		}
+		for _, c := range n.Constraints {
+			Walk(v, c)
+		}
+	case *CreateExtensionStmt:
+		Walk(v, n.Name)
+		if n.Schema != nil {
+			Walk(v, n.Schema)
+		}
+		if n.Version != nil {
+			Walk(v, n.Version)
+		}
 	case *Operator:
 		// nothing to do
 	case *NullValue,
@@ -377,6 +936,9 @@ func Walk(v Visitor, node Node) {
 		*DoubleValue,
 		*SingleQuotedString,
 		*NationalStringLiteral,
+		*HexStringLiteral,
+		*BitStringLiteral,
+		*DollarQuotedString,
 		*BooleanValue,
 		*DateValue,
 		*TimeValue,
@@ -402,3 +964,16 @@ func (f inspector) Visit(node Node) Visitor {
 func Inspect(node Node, f func(node Node) bool) {
 	Walk(inspector(f), node)
 }
+
+//go:generate genvisitor
+
+// WalkTyped traverses an AST in depth-first order like Walk, calling the method of v
+// which corresponds to the concrete type of each node.
+func WalkTyped(v TypedVisitor, node Node) {
+	Inspect(node, func(n Node) bool {
+		if n == nil {
+			return false
+		}
+		return dispatchTypedVisitor(v, n)
+	})
+}
@@ -6,14 +6,44 @@ import (
 	"strings"
 )
 
-type sqlWriter struct {
-	w   io.Writer
-	n   int64
-	err error
+// SQLWriter writes SQL fragments and nodes to the underlying io.Writer.
+// Once a write fails, the following writes are skipped and End returns the first error.
+// Custom nodes can implement io.WriterTo with SQLWriter to be written like built-in nodes.
+type SQLWriter struct {
+	w    io.Writer
+	n    int64
+	err  error
+	hook WriteHook
 }
 
-func newSQLWriter(w io.Writer) *sqlWriter {
-	return &sqlWriter{w: w}
+// WriteHook overrides how the node n is written.
+// It returns false to write n with its own WriteTo method.
+// Writing n itself with sw.Node inside the hook calls the hook again,
+// use sw.Direct(n.WriteTo(sw.Writer())) to fall back to the default form.
+type WriteHook func(sw *SQLWriter, n Node) bool
+
+type hookWriter struct {
+	io.Writer
+	hook WriteHook
+}
+
+// NewSQLWriter returns a SQLWriter writing to w.
+// If w is a writer passed down from WriteWithHook, the hook is inherited.
+func NewSQLWriter(w io.Writer) *SQLWriter {
+	if hw, ok := w.(*hookWriter); ok {
+		return &SQLWriter{w: w, hook: hw.hook}
+	}
+	return &SQLWriter{w: w}
+}
+
+// WriteWithHook writes n to w, calling hook for every node written by SQLWriter.Node under n.
+func WriteWithHook(w io.Writer, n Node, hook WriteHook) (int64, error) {
+	return NewSQLWriter(&hookWriter{Writer: w, hook: hook}).Node(n).End()
+}
+
+// Writer returns the underlying io.Writer.
+func (w *SQLWriter) Writer() io.Writer {
+	return w.w
 }
 
 var selectBytes = []byte("SELECT ")
@@ -23,7 +53,8 @@ var wildcardBytes = []byte("*")
 var dotBytes = []byte(".")
 var spaceBytes = []byte(" ")
 
-func (w *sqlWriter) Bytes(b []byte) *sqlWriter {
+// Bytes writes b as is.
+func (w *SQLWriter) Bytes(b []byte) *SQLWriter {
 	if w.err != nil {
 		return w
 	}
@@ -35,19 +66,23 @@ func (w *sqlWriter) Bytes(b []byte) *sqlWriter {
 	return w
 }
 
-func (w *sqlWriter) Space() *sqlWriter {
+// Space writes a single space.
+func (w *SQLWriter) Space() *SQLWriter {
 	return w.Bytes(spaceBytes)
 }
 
-func (w *sqlWriter) LParen() *sqlWriter {
+// LParen writes "(".
+func (w *SQLWriter) LParen() *SQLWriter {
 	return w.Bytes([]byte("("))
 }
 
-func (w *sqlWriter) RParen() *sqlWriter {
+// RParen writes ")".
+func (w *SQLWriter) RParen() *SQLWriter {
 	return w.Bytes([]byte(")"))
 }
 
-func (w *sqlWriter) Int(i int) *sqlWriter {
+// Int writes i in decimal.
+func (w *SQLWriter) Int(i int) *SQLWriter {
 	if w.err != nil {
 		return w
 	}
@@ -62,10 +97,16 @@ func (w *sqlWriter) Int(i int) *sqlWriter {
 	return w
 }
 
-func (w *sqlWriter) Node(wt io.WriterTo) *sqlWriter {
+// Node writes wt, or calls the hook instead if wt is a Node and a hook is set.
+func (w *SQLWriter) Node(wt io.WriterTo) *SQLWriter {
 	if w.err != nil {
 		return w
 	}
+	if w.hook != nil {
+		if n, ok := wt.(Node); ok && w.hook(w, n) {
+			return w
+		}
+	}
 	n, err := wt.WriteTo(w.w)
 	w.n += n
 	if err != nil {
@@ -74,28 +115,32 @@ func (w *sqlWriter) Node(wt io.WriterTo) *sqlWriter {
 	return w
 }
 
-func (w *sqlWriter) Join(i int, wt io.WriterTo, sep []byte) *sqlWriter {
+// Join writes wt preceded by sep unless wt is the first (i == 0) element of a list.
+func (w *SQLWriter) Join(i int, wt io.WriterTo, sep []byte) *SQLWriter {
 	if i > 0 {
 		w.Bytes(sep)
 	}
 	return w.Node(wt)
 }
 
-func (w *sqlWriter) JoinComma(i int, wt io.WriterTo) *sqlWriter {
+// JoinComma is Join with ", " separator.
+func (w *SQLWriter) JoinComma(i int, wt io.WriterTo) *SQLWriter {
 	if i > 0 {
 		w.Bytes([]byte(", "))
 	}
 	return w.Node(wt)
 }
 
-func (w *sqlWriter) JoinNewLine(i int, wt io.WriterTo) *sqlWriter {
+// JoinNewLine is Join with newline separator.
+func (w *SQLWriter) JoinNewLine(i int, wt io.WriterTo) *SQLWriter {
 	if i > 0 {
 		w.Bytes([]byte("\n"))
 	}
 	return w.Node(wt)
 }
 
-func (w *sqlWriter) Idents(idents []*Ident, sep []byte) *sqlWriter {
+// Idents writes idents separated by sep.
+func (w *SQLWriter) Idents(idents []*Ident, sep []byte) *SQLWriter {
 	if w.err != nil {
 		return w
 	}
@@ -117,7 +162,8 @@ func (w *sqlWriter) Idents(idents []*Ident, sep []byte) *sqlWriter {
 	return w
 }
 
-func (w *sqlWriter) Nodes(nodes []Node) *sqlWriter {
+// Nodes writes nodes separated by ", ".
+func (w *SQLWriter) Nodes(nodes []Node) *SQLWriter {
 	if w.err != nil {
 		return w
 	}
@@ -127,7 +173,8 @@ func (w *sqlWriter) Nodes(nodes []Node) *sqlWriter {
 	return w
 }
 
-func (w *sqlWriter) TypeWithOptionalLength(sqltype []byte, size *uint) *sqlWriter {
+// TypeWithOptionalLength writes sqltype followed by (size) if size is not nil.
+func (w *SQLWriter) TypeWithOptionalLength(sqltype []byte, size *uint) *SQLWriter {
 	w.Bytes(sqltype)
 	if size != nil {
 		w.Bytes([]byte("(")).Int(int(*size)).Bytes([]byte(")"))
@@ -135,30 +182,49 @@ func (w *sqlWriter) TypeWithOptionalLength(sqltype []byte, size *uint) *sqlWrite
 	return w
 }
 
-func (w *sqlWriter) Negated(negated bool) *sqlWriter {
+// Negated writes "NOT " if negated.
+func (w *SQLWriter) Negated(negated bool) *SQLWriter {
 	return w.If(negated, []byte("NOT "))
 }
 
-func (w *sqlWriter) If(ok bool, b []byte) *sqlWriter {
+// If writes b only if ok.
+func (w *SQLWriter) If(ok bool, b []byte) *SQLWriter {
 	if ok {
 		w.Bytes(b)
 	}
 	return w
 }
 
-func (w *sqlWriter) As() *sqlWriter {
+// As writes " AS ".
+func (w *SQLWriter) As() *SQLWriter {
 	return w.Bytes([]byte(" AS "))
 }
 
-func (w *sqlWriter) End() (int64, error) {
+// TableAlias writes " AS alias" followed by (columns) if columns is not empty.
+// Nothing is written if alias is nil.
+func (w *SQLWriter) TableAlias(alias *Ident, columns []*Ident) *SQLWriter {
+	if alias == nil {
+		return w
+	}
+	w.As().Node(alias)
+	if len(columns) != 0 {
+		w.LParen().Idents(columns, []byte(", ")).RParen()
+	}
+	return w
+}
+
+// End returns the number of bytes written and the first error.
+func (w *SQLWriter) End() (int64, error) {
 	return w.n, w.err
 }
 
-func (w *sqlWriter) Err() error {
+// Err returns the first error.
+func (w *SQLWriter) Err() error {
 	return w.err
 }
 
-func (w *sqlWriter) Direct(n int64, err error) *sqlWriter {
+// Direct records the result of a write done directly to the underlying writer.
+func (w *SQLWriter) Direct(n int64, err error) *SQLWriter {
 	w.n += n
 	if err != nil {
 		w.err = err
@@ -0,0 +1,96 @@
+package sqlast
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+type customNode struct {
+	Name *Ident
+}
+
+func (c *customNode) Pos() sqltoken.Pos {
+	return c.Name.Pos()
+}
+
+func (c *customNode) End() sqltoken.Pos {
+	return c.Name.End()
+}
+
+func (c *customNode) ToSQLString() string {
+	return toSQLString(c)
+}
+
+func (c *customNode) WriteTo(w io.Writer) (int64, error) {
+	return NewSQLWriter(w).Bytes([]byte("CUSTOM(")).Node(c.Name).RParen().End()
+}
+
+func TestWriteWithHook(t *testing.T) {
+	stmt := &QueryStmt{
+		Body: &SQLSelect{
+			Projection: []SQLSelectItem{
+				&UnnamedSelectItem{Node: NewIdent("a")},
+				&UnnamedSelectItem{Node: &customNode{Name: NewIdent("b")}},
+			},
+			FromClause: []TableReference{
+				&Table{Name: NewObjectName("t")},
+			},
+		},
+	}
+
+	cases := []struct {
+		name string
+		hook WriteHook
+		out  string
+	}{
+		{
+			name: "no override",
+			hook: func(sw *SQLWriter, n Node) bool {
+				return false
+			},
+			out: "SELECT a, CUSTOM(b) FROM t",
+		},
+		{
+			name: "override ident",
+			hook: func(sw *SQLWriter, n Node) bool {
+				i, ok := n.(*Ident)
+				if !ok {
+					return false
+				}
+				sw.Bytes([]byte(strings.ToUpper(i.Value)))
+				return true
+			},
+			out: "SELECT A, CUSTOM(B) FROM T",
+		},
+		{
+			name: "fall back to default",
+			hook: func(sw *SQLWriter, n Node) bool {
+				if _, ok := n.(*customNode); !ok {
+					return false
+				}
+				sw.Bytes([]byte("/* custom */ ")).Direct(n.WriteTo(sw.Writer()))
+				return true
+			},
+			out: "SELECT a, /* custom */ CUSTOM(b) FROM t",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var b strings.Builder
+			n, err := WriteWithHook(&b, stmt, c.hook)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if c.out != b.String() {
+				t.Errorf("should be \n %s but \n %s", c.out, b.String())
+			}
+			if int(n) != b.Len() {
+				t.Errorf("written bytes should be %d but %d", b.Len(), n)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Package sqlastjson encodes sqlast trees to JSON and decodes them back.
+//
+// Each node is encoded as a JSON object which has its type name in "type"
+// field and its exported fields, e.g.
+//
+//	{"type":"Ident","Value":"a","QuoteStyle":0,"From":{"Line":1,"Col":8,"Offset":7},"To":{"Line":1,"Col":9,"Offset":8}}
+package sqlastjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+const typeKey = "type"
+
+var nodeType = reflect.TypeOf((*sqlast.Node)(nil)).Elem()
+
+var kinds = func() map[string]sqlast.NodeKind {
+	m := make(map[string]sqlast.NodeKind)
+	// kinds of removed node types are left in the sequence and have no New
+	for k := sqlast.KindInvalid + 1; !strings.HasPrefix(k.String(), "NodeKind("); k++ {
+		if k.New() != nil {
+			m[k.String()] = k
+		}
+	}
+	return m
+}()
+
+// Marshal returns the JSON encoding of node.
+func Marshal(node sqlast.Node) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := encodeValue(buf, reflect.ValueOf(&node).Elem()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses the JSON-encoded data made by Marshal and returns the node.
+func Unmarshal(data []byte) (sqlast.Node, error) {
+	return decodeNode(data)
+}
+
+func isNodeType(t reflect.Type) bool {
+	return (t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface) && t.Implements(nodeType)
+}
+
+// isFlattened reports whether the embedded field f is encoded as fields of the parent, like CharsetCollation.
+// Unexported marker structs are ignored.
+func isFlattened(f reflect.StructField) bool {
+	return f.Anonymous && f.PkgPath == "" && f.Type.Kind() == reflect.Struct
+}
+
+func encodeValue(buf *bytes.Buffer, v reflect.Value) error {
+	switch {
+	case isNodeType(v.Type()):
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+			if v.Kind() == reflect.Ptr && v.IsNil() {
+				buf.WriteString("null")
+				return nil
+			}
+		}
+		return encodeNode(buf, v)
+	case v.Kind() == reflect.Slice && isNodeType(v.Type().Elem()):
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			if err := encodeValue(buf, v.Index(i)); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	default:
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return errors.Errorf("json.Marshal failed: %w", err)
+		}
+		buf.Write(b)
+		return nil
+	}
+}
+
+func encodeNode(buf *bytes.Buffer, v reflect.Value) error {
+	kind := sqlast.KindOf(v.Interface().(sqlast.Node))
+	if kind == sqlast.KindInvalid {
+		return errors.Errorf("unknown node type %s", v.Type())
+	}
+	buf.WriteString(`{"` + typeKey + `":`)
+	b, _ := json.Marshal(kind.String())
+	buf.Write(b)
+	if err := encodeFields(buf, v.Elem()); err != nil {
+		return errors.Errorf("encode %s failed: %w", kind, err)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+func encodeFields(buf *bytes.Buffer, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if isFlattened(f) {
+			if err := encodeFields(buf, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.Anonymous || f.PkgPath != "" {
+			continue
+		}
+		b, _ := json.Marshal(f.Name)
+		buf.WriteByte(',')
+		buf.Write(b)
+		buf.WriteByte(':')
+		if err := encodeValue(buf, v.Field(i)); err != nil {
+			return errors.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func decodeNode(data []byte) (sqlast.Node, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Errorf("json.Unmarshal failed: %w", err)
+	}
+	if fields == nil {
+		return nil, nil
+	}
+
+	var name string
+	if err := json.Unmarshal(fields[typeKey], &name); err != nil {
+		return nil, errors.Errorf("invalid %q field: %w", typeKey, err)
+	}
+	kind, ok := kinds[name]
+	if !ok {
+		return nil, errors.Errorf("unknown node type %q", name)
+	}
+
+	node := kind.New()
+	if err := decodeFields(fields, reflect.ValueOf(node).Elem()); err != nil {
+		return nil, errors.Errorf("decode %s failed: %w", name, err)
+	}
+	return node, nil
+}
+
+func decodeFields(fields map[string]json.RawMessage, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if isFlattened(f) {
+			if err := decodeFields(fields, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.Anonymous || f.PkgPath != "" {
+			continue
+		}
+		data, ok := fields[f.Name]
+		if !ok {
+			continue
+		}
+		if err := decodeValue(data, v.Field(i)); err != nil {
+			return errors.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func decodeValue(data []byte, v reflect.Value) error {
+	switch {
+	case isNodeType(v.Type()):
+		node, err := decodeNode(data)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return nil
+		}
+		nv := reflect.ValueOf(node)
+		if !nv.Type().AssignableTo(v.Type()) {
+			return errors.Errorf("%s is not assignable to %s", nv.Type(), v.Type())
+		}
+		v.Set(nv)
+		return nil
+	case v.Kind() == reflect.Slice && isNodeType(v.Type().Elem()):
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return errors.Errorf("json.Unmarshal failed: %w", err)
+		}
+		if list == nil {
+			return nil
+		}
+		s := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i, d := range list {
+			if err := decodeValue(d, s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	default:
+		if err := json.Unmarshal(data, v.Addr().Interface()); err != nil {
+			return errors.Errorf("json.Unmarshal failed: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,97 @@
+package sqlastjson
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestMarshal(t *testing.T) {
+	b, err := Marshal(sqlast.NewIdentWithPos("a", sqltoken.NewPos(1, 8), sqltoken.NewPos(1, 9)))
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	expect := `{"type":"Ident","Value":"a","QuoteStyle":0,"From":{"Line":1,"Col":8,"Offset":0},"To":{"Line":1,"Col":9,"Offset":0}}`
+	if string(b) != expect {
+		t.Errorf("should be \n %s but \n %s", expect, string(b))
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+	}{
+		{
+			name: "select",
+			src:  "SELECT a, COUNT(*) AS c FROM t AS x INNER JOIN u ON x.id = u.id WHERE a IN (SELECT b FROM v) GROUP BY a ORDER BY c DESC LIMIT 10",
+		},
+		{
+			name: "create table",
+			src:  "CREATE TABLE t (id int PRIMARY KEY, name varchar(255) CHARACTER SET utf8 COLLATE utf8_bin NOT NULL, CONSTRAINT c CHECK(id > 0))",
+		},
+		{
+			name: "insert",
+			src:  "INSERT INTO t (a, b) VALUES (1, 'x'), (2, NULL) ON CONFLICT (a) DO UPDATE SET b = 'y'",
+		},
+		{
+			name: "alter table",
+			src:  "ALTER TABLE t ADD COLUMN c int, ALTER COLUMN d SET DEFAULT 0",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.src), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			b, err := Marshal(stmt)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			node, err := Unmarshal(b)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if !reflect.DeepEqual(stmt, node) {
+				t.Errorf("diff %s", xsqlparser.CompareWithoutMarker(stmt, node))
+			}
+			if node.ToSQLString() != stmt.ToSQLString() {
+				t.Errorf("should be \n %s but \n %s", stmt.ToSQLString(), node.ToSQLString())
+			}
+		})
+	}
+}
+
+func TestUnmarshal_Error(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+	}{
+		{name: "unknown type", in: `{"type":"Unknown"}`},
+		{name: "missing type", in: `{"Value":"a"}`},
+		{name: "not assignable", in: `{"type":"Table","Name":{"type":"Ident","Value":"a"}}`},
+		{name: "invalid json", in: `{"type":`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := Unmarshal([]byte(c.in)); err == nil {
+				t.Errorf("must be error")
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Package sqlasttest provides helpers for testing code which builds or
+// transforms sqlast trees.
+package sqlasttest
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/andreyvit/diff"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+// Update makes AssertGolden rewrite golden files instead of comparing them.
+// Run tests with `go test -args -update-golden` to regenerate them.
+var Update = flag.Bool("update-golden", false, "update golden files")
+
+// IgnoreMarker ignores unexported marker structs embedded in AST nodes.
+var IgnoreMarker = sqlastutil.IgnoreMarker
+
+// IgnorePos ignores all sqltoken.Pos values.
+var IgnorePos = sqlastutil.IgnorePos
+
+// Diff returns a human readable report of the differences between a and b.
+// Positions and marker structs are ignored. Diff returns empty string when
+// a and b are equal.
+func Diff(a, b interface{}) string {
+	return cmp.Diff(a, b, IgnoreMarker, IgnorePos)
+}
+
+// Equal reports whether a and b are the same tree, ignoring positions.
+func Equal(a, b sqlast.Node) bool {
+	return sqlastutil.EqualIgnoringPos(a, b)
+}
+
+// SQLDiff renders a and b as SQL and returns the line diff between them.
+// SQLDiff returns empty string when both render to the same SQL.
+func SQLDiff(a, b sqlast.Node) string {
+	as, bs := toSQL(a), toSQL(b)
+	if as == bs {
+		return ""
+	}
+	return diff.LineDiff(as, bs)
+}
+
+// TB is the subset of testing.TB used by the assertion helpers.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// AssertEqual reports an error to t when want and got are different trees.
+// The report contains both the SQL level diff and the AST level diff.
+func AssertEqual(t TB, want, got sqlast.Node) {
+	t.Helper()
+	if Equal(want, got) {
+		return
+	}
+	var b strings.Builder
+	if d := SQLDiff(want, got); d != "" {
+		fmt.Fprintf(&b, "sql diff (-want +got):\n%s\n", d)
+	}
+	fmt.Fprintf(&b, "ast diff (-want +got):\n%s", Diff(want, got))
+	t.Errorf("AST mismatch\n%s", b.String())
+}
+
+// AssertGolden compares the SQL rendering of got with the content of the
+// golden file at path. When Update is set, the golden file is overwritten
+// with the rendering of got instead.
+func AssertGolden(t TB, path string, got sqlast.Node) {
+	t.Helper()
+	act := toSQL(got)
+	if *Update {
+		if err := ioutil.WriteFile(path, []byte(act+"\n"), 0644); err != nil {
+			t.Fatalf("update golden file %s: %v", path, err)
+		}
+		return
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file %s: %v", path, err)
+	}
+	want := strings.TrimRight(string(b), "\n")
+	if want != act {
+		t.Errorf("golden file %s mismatch (-want +got):\n%s", path, diff.LineDiff(want, act))
+	}
+}
+
+func toSQL(n sqlast.Node) string {
+	if n == nil {
+		return ""
+	}
+	return n.ToSQLString()
+}
@@ -0,0 +1,81 @@
+package sqlasttest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlasttest"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func selectFrom(col, table string, pos sqltoken.Pos) *sqlast.QueryStmt {
+	return &sqlast.QueryStmt{
+		Body: &sqlast.SQLSelect{
+			Select: pos,
+			Projection: []sqlast.SQLSelectItem{
+				&sqlast.UnnamedSelectItem{Node: &sqlast.Ident{Value: col, From: pos, To: pos}},
+			},
+			FromClause: []sqlast.TableReference{
+				&sqlast.Table{Name: sqlast.NewObjectName(table)},
+			},
+		},
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	t.Run("ignore positions", func(t *testing.T) {
+		r := &recorder{}
+		sqlasttest.AssertEqual(r, selectFrom("a", "t", sqltoken.NewPos(1, 1)), selectFrom("a", "t", sqltoken.NewPos(3, 5)))
+		if len(r.errors) != 0 {
+			t.Errorf("must be equal but %v", r.errors)
+		}
+	})
+
+	t.Run("report sql diff", func(t *testing.T) {
+		r := &recorder{}
+		sqlasttest.AssertEqual(r, selectFrom("a", "t", sqltoken.NewPos(1, 1)), selectFrom("b", "t", sqltoken.NewPos(1, 1)))
+		if len(r.errors) != 1 {
+			t.Fatalf("must be reported once but %v", r.errors)
+		}
+		if !strings.Contains(r.errors[0], "-SELECT a FROM t") || !strings.Contains(r.errors[0], "+SELECT b FROM t") {
+			t.Errorf("must contain sql diff but %s", r.errors[0])
+		}
+	})
+}
+
+func TestAssertGolden(t *testing.T) {
+	t.Run("match", func(t *testing.T) {
+		r := &recorder{}
+		sqlasttest.AssertGolden(r, "testdata/select.golden", selectFrom("a", "t", sqltoken.NewPos(1, 1)))
+		if len(r.errors) != 0 {
+			t.Errorf("must match but %v", r.errors)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		if *sqlasttest.Update {
+			t.Skip("golden files are being updated")
+		}
+		r := &recorder{}
+		sqlasttest.AssertGolden(r, "testdata/select.golden", selectFrom("b", "t", sqltoken.NewPos(1, 1)))
+		if len(r.errors) != 1 {
+			t.Errorf("must be reported once but %v", r.errors)
+		}
+	})
+}
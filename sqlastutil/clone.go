@@ -0,0 +1,32 @@
+package sqlastutil
+
+import (
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+//go:generate genclone
+
+// Clone returns a deep copy of node. Positions are preserved.
+func Clone(node sqlast.Node) sqlast.Node {
+	c := &cloner{}
+	return c.clone(node)
+}
+
+// CloneWithoutPos returns a deep copy of node whose positions are all zero.
+// It is useful to insert the copied node into another tree.
+func CloneWithoutPos(node sqlast.Node) sqlast.Node {
+	c := &cloner{zeroPos: true}
+	return c.clone(node)
+}
+
+type cloner struct {
+	zeroPos bool
+}
+
+func (c *cloner) pos(p sqltoken.Pos) sqltoken.Pos {
+	if c.zeroPos {
+		return sqltoken.Pos{}
+	}
+	return p
+}
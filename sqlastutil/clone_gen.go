@@ -0,0 +1,3751 @@
+package sqlastutil
+
+// Code generated by genclone. DO NOT EDIT.
+
+import (
+	"log"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+func (c *cloner) clone(node sqlast.Node) sqlast.Node {
+	switch n := node.(type) {
+	case nil:
+		return nil
+	case *sqlast.AddColumnTableAction:
+		return c.cloneAddColumnTableAction(n)
+	case *sqlast.AddConstraintTableAction:
+		return c.cloneAddConstraintTableAction(n)
+	case *sqlast.AliasSelectItem:
+		return c.cloneAliasSelectItem(n)
+	case *sqlast.AlterColumnTableAction:
+		return c.cloneAlterColumnTableAction(n)
+	case *sqlast.AlterSequenceStmt:
+		return c.cloneAlterSequenceStmt(n)
+	case *sqlast.AlterTableStmt:
+		return c.cloneAlterTableStmt(n)
+	case *sqlast.AlterViewStmt:
+		return c.cloneAlterViewStmt(n)
+	case *sqlast.AnalyzeStmt:
+		return c.cloneAnalyzeStmt(n)
+	case *sqlast.Array:
+		return c.cloneArray(n)
+	case *sqlast.ArrayConstructor:
+		return c.cloneArrayConstructor(n)
+	case *sqlast.AsSequenceOption:
+		return c.cloneAsSequenceOption(n)
+	case *sqlast.Assignment:
+		return c.cloneAssignment(n)
+	case *sqlast.AttachPartitionTableAction:
+		return c.cloneAttachPartitionTableAction(n)
+	case *sqlast.AutoIncrement:
+		return c.cloneAutoIncrement(n)
+	case *sqlast.Between:
+		return c.cloneBetween(n)
+	case *sqlast.BigInt:
+		return c.cloneBigInt(n)
+	case *sqlast.BigSerial:
+		return c.cloneBigSerial(n)
+	case *sqlast.Binary:
+		return c.cloneBinary(n)
+	case *sqlast.BinaryExpr:
+		return c.cloneBinaryExpr(n)
+	case *sqlast.BitStringLiteral:
+		return c.cloneBitStringLiteral(n)
+	case *sqlast.Blob:
+		return c.cloneBlob(n)
+	case *sqlast.Boolean:
+		return c.cloneBoolean(n)
+	case *sqlast.BooleanValue:
+		return c.cloneBooleanValue(n)
+	case *sqlast.Bytea:
+		return c.cloneBytea(n)
+	case *sqlast.CTE:
+		return c.cloneCTE(n)
+	case *sqlast.CacheSequenceOption:
+		return c.cloneCacheSequenceOption(n)
+	case *sqlast.CaseExpr:
+		return c.cloneCaseExpr(n)
+	case *sqlast.Cast:
+		return c.cloneCast(n)
+	case *sqlast.CharType:
+		return c.cloneCharType(n)
+	case *sqlast.CheckColumnSpec:
+		return c.cloneCheckColumnSpec(n)
+	case *sqlast.CheckTableConstraint:
+		return c.cloneCheckTableConstraint(n)
+	case *sqlast.Clob:
+		return c.cloneClob(n)
+	case *sqlast.ColumnConstraint:
+		return c.cloneColumnConstraint(n)
+	case *sqlast.ColumnDef:
+		return c.cloneColumnDef(n)
+	case *sqlast.Comment:
+		return c.cloneComment(n)
+	case *sqlast.CommentGroup:
+		return c.cloneCommentGroup(n)
+	case *sqlast.CommentOnStmt:
+		return c.cloneCommentOnStmt(n)
+	case *sqlast.CompoundIdent:
+		return c.cloneCompoundIdent(n)
+	case *sqlast.ConstructorSource:
+		return c.cloneConstructorSource(n)
+	case *sqlast.CopyOption:
+		return c.cloneCopyOption(n)
+	case *sqlast.CopyStmt:
+		return c.cloneCopyStmt(n)
+	case *sqlast.CreateDomainStmt:
+		return c.cloneCreateDomainStmt(n)
+	case *sqlast.CreateExtensionStmt:
+		return c.cloneCreateExtensionStmt(n)
+	case *sqlast.CreateFunctionStmt:
+		return c.cloneCreateFunctionStmt(n)
+	case *sqlast.CreateIndexStmt:
+		return c.cloneCreateIndexStmt(n)
+	case *sqlast.CreateSchemaStmt:
+		return c.cloneCreateSchemaStmt(n)
+	case *sqlast.CreateSequenceStmt:
+		return c.cloneCreateSequenceStmt(n)
+	case *sqlast.CreateTableStmt:
+		return c.cloneCreateTableStmt(n)
+	case *sqlast.CreateTriggerStmt:
+		return c.cloneCreateTriggerStmt(n)
+	case *sqlast.CreateTypeStmt:
+		return c.cloneCreateTypeStmt(n)
+	case *sqlast.CreateViewStmt:
+		return c.cloneCreateViewStmt(n)
+	case *sqlast.CrossJoin:
+		return c.cloneCrossJoin(n)
+	case *sqlast.CurrentRow:
+		return c.cloneCurrentRow(n)
+	case *sqlast.Custom:
+		return c.cloneCustom(n)
+	case *sqlast.CycleSequenceOption:
+		return c.cloneCycleSequenceOption(n)
+	case *sqlast.Date:
+		return c.cloneDate(n)
+	case *sqlast.DateTime:
+		return c.cloneDateTime(n)
+	case *sqlast.DateTimeValue:
+		return c.cloneDateTimeValue(n)
+	case *sqlast.DateValue:
+		return c.cloneDateValue(n)
+	case *sqlast.DeallocateStmt:
+		return c.cloneDeallocateStmt(n)
+	case *sqlast.Decimal:
+		return c.cloneDecimal(n)
+	case *sqlast.DeleteStmt:
+		return c.cloneDeleteStmt(n)
+	case *sqlast.Derived:
+		return c.cloneDerived(n)
+	case *sqlast.DetachPartitionTableAction:
+		return c.cloneDetachPartitionTableAction(n)
+	case *sqlast.DollarQuotedString:
+		return c.cloneDollarQuotedString(n)
+	case *sqlast.Double:
+		return c.cloneDouble(n)
+	case *sqlast.DoubleValue:
+		return c.cloneDoubleValue(n)
+	case *sqlast.DropConstraintTableAction:
+		return c.cloneDropConstraintTableAction(n)
+	case *sqlast.DropDefaultColumnAction:
+		return c.cloneDropDefaultColumnAction(n)
+	case *sqlast.DropIndexStmt:
+		return c.cloneDropIndexStmt(n)
+	case *sqlast.DropSchemaStmt:
+		return c.cloneDropSchemaStmt(n)
+	case *sqlast.DropSequenceStmt:
+		return c.cloneDropSequenceStmt(n)
+	case *sqlast.DropTableStmt:
+		return c.cloneDropTableStmt(n)
+	case *sqlast.DropTriggerStmt:
+		return c.cloneDropTriggerStmt(n)
+	case *sqlast.DropViewStmt:
+		return c.cloneDropViewStmt(n)
+	case *sqlast.Enum:
+		return c.cloneEnum(n)
+	case *sqlast.ExceptOperator:
+		return c.cloneExceptOperator(n)
+	case *sqlast.ExecuteStmt:
+		return c.cloneExecuteStmt(n)
+	case *sqlast.Exists:
+		return c.cloneExists(n)
+	case *sqlast.ExplainStmt:
+		return c.cloneExplainStmt(n)
+	case *sqlast.ExtractExpr:
+		return c.cloneExtractExpr(n)
+	case *sqlast.FetchExpr:
+		return c.cloneFetchExpr(n)
+	case *sqlast.File:
+		return c.cloneFile(n)
+	case *sqlast.Float:
+		return c.cloneFloat(n)
+	case *sqlast.Following:
+		return c.cloneFollowing(n)
+	case *sqlast.Function:
+		return c.cloneFunction(n)
+	case *sqlast.FunctionArg:
+		return c.cloneFunctionArg(n)
+	case *sqlast.FunctionReturns:
+		return c.cloneFunctionReturns(n)
+	case *sqlast.HexStringLiteral:
+		return c.cloneHexStringLiteral(n)
+	case *sqlast.HintComment:
+		return c.cloneHintComment(n)
+	case *sqlast.Ident:
+		return c.cloneIdent(n)
+	case *sqlast.InList:
+		return c.cloneInList(n)
+	case *sqlast.InSubQuery:
+		return c.cloneInSubQuery(n)
+	case *sqlast.IncrementBySequenceOption:
+		return c.cloneIncrementBySequenceOption(n)
+	case *sqlast.IndexColumn:
+		return c.cloneIndexColumn(n)
+	case *sqlast.IndexElement:
+		return c.cloneIndexElement(n)
+	case *sqlast.IndexHint:
+		return c.cloneIndexHint(n)
+	case *sqlast.IndexTableElement:
+		return c.cloneIndexTableElement(n)
+	case *sqlast.InheritsClause:
+		return c.cloneInheritsClause(n)
+	case *sqlast.InsertStmt:
+		return c.cloneInsertStmt(n)
+	case *sqlast.Int:
+		return c.cloneInt(n)
+	case *sqlast.IntersectOperator:
+		return c.cloneIntersectOperator(n)
+	case *sqlast.IsNotNull:
+		return c.cloneIsNotNull(n)
+	case *sqlast.IsNull:
+		return c.cloneIsNull(n)
+	case *sqlast.IsOf:
+		return c.cloneIsOf(n)
+	case *sqlast.JSON:
+		return c.cloneJSON(n)
+	case *sqlast.JoinCondition:
+		return c.cloneJoinCondition(n)
+	case *sqlast.JoinType:
+		return c.cloneJoinType(n)
+	case *sqlast.KillStmt:
+		return c.cloneKillStmt(n)
+	case *sqlast.LikeOption:
+		return c.cloneLikeOption(n)
+	case *sqlast.LikeTableElement:
+		return c.cloneLikeTableElement(n)
+	case *sqlast.LimitExpr:
+		return c.cloneLimitExpr(n)
+	case *sqlast.LockingClause:
+		return c.cloneLockingClause(n)
+	case *sqlast.LongBlob:
+		return c.cloneLongBlob(n)
+	case *sqlast.LongText:
+		return c.cloneLongText(n)
+	case *sqlast.LongValue:
+		return c.cloneLongValue(n)
+	case *sqlast.MaxValueSequenceOption:
+		return c.cloneMaxValueSequenceOption(n)
+	case *sqlast.MediumBlob:
+		return c.cloneMediumBlob(n)
+	case *sqlast.MediumInt:
+		return c.cloneMediumInt(n)
+	case *sqlast.MediumText:
+		return c.cloneMediumText(n)
+	case *sqlast.MinValueSequenceOption:
+		return c.cloneMinValueSequenceOption(n)
+	case *sqlast.MyChangeColumnTableAction:
+		return c.cloneMyChangeColumnTableAction(n)
+	case *sqlast.MyCharset:
+		return c.cloneMyCharset(n)
+	case *sqlast.MyCollate:
+		return c.cloneMyCollate(n)
+	case *sqlast.MyColumnPosition:
+		return c.cloneMyColumnPosition(n)
+	case *sqlast.MyDropPrimaryKeyTableAction:
+		return c.cloneMyDropPrimaryKeyTableAction(n)
+	case *sqlast.MyEngine:
+		return c.cloneMyEngine(n)
+	case *sqlast.MyModifyColumnTableAction:
+		return c.cloneMyModifyColumnTableAction(n)
+	case *sqlast.NVarcharType:
+		return c.cloneNVarcharType(n)
+	case *sqlast.NamedColumnsJoin:
+		return c.cloneNamedColumnsJoin(n)
+	case *sqlast.NationalStringLiteral:
+		return c.cloneNationalStringLiteral(n)
+	case *sqlast.NaturalJoin:
+		return c.cloneNaturalJoin(n)
+	case *sqlast.Nested:
+		return c.cloneNested(n)
+	case *sqlast.NotNullColumnSpec:
+		return c.cloneNotNullColumnSpec(n)
+	case *sqlast.NullValue:
+		return c.cloneNullValue(n)
+	case *sqlast.ObjectName:
+		return c.cloneObjectName(n)
+	case *sqlast.OffsetExpr:
+		return c.cloneOffsetExpr(n)
+	case *sqlast.OnConflict:
+		return c.cloneOnConflict(n)
+	case *sqlast.Operator:
+		return c.cloneOperator(n)
+	case *sqlast.OrderByExpr:
+		return c.cloneOrderByExpr(n)
+	case *sqlast.OverlayExpr:
+		return c.cloneOverlayExpr(n)
+	case *sqlast.OwnedBySequenceOption:
+		return c.cloneOwnedBySequenceOption(n)
+	case *sqlast.PGAlterDataTypeColumnAction:
+		return c.clonePGAlterDataTypeColumnAction(n)
+	case *sqlast.PGDropNotNullColumnAction:
+		return c.clonePGDropNotNullColumnAction(n)
+	case *sqlast.PGSetNotNullColumnAction:
+		return c.clonePGSetNotNullColumnAction(n)
+	case *sqlast.PartitionBound:
+		return c.clonePartitionBound(n)
+	case *sqlast.PartitionSpec:
+		return c.clonePartitionSpec(n)
+	case *sqlast.PartitionedJoinTable:
+		return c.clonePartitionedJoinTable(n)
+	case *sqlast.Placeholder:
+		return c.clonePlaceholder(n)
+	case *sqlast.PositionExpr:
+		return c.clonePositionExpr(n)
+	case *sqlast.Preceding:
+		return c.clonePreceding(n)
+	case *sqlast.PrepareStmt:
+		return c.clonePrepareStmt(n)
+	case *sqlast.QualifiedJoin:
+		return c.cloneQualifiedJoin(n)
+	case *sqlast.QualifiedWildcard:
+		return c.cloneQualifiedWildcard(n)
+	case *sqlast.QualifiedWildcardSelectItem:
+		return c.cloneQualifiedWildcardSelectItem(n)
+	case *sqlast.QuantifiedComparison:
+		return c.cloneQuantifiedComparison(n)
+	case *sqlast.QueryExpr:
+		return c.cloneQueryExpr(n)
+	case *sqlast.QueryStmt:
+		return c.cloneQueryStmt(n)
+	case *sqlast.Real:
+		return c.cloneReal(n)
+	case *sqlast.ReferenceKeyExpr:
+		return c.cloneReferenceKeyExpr(n)
+	case *sqlast.ReferencesColumnSpec:
+		return c.cloneReferencesColumnSpec(n)
+	case *sqlast.ReferentialTableConstraint:
+		return c.cloneReferentialTableConstraint(n)
+	case *sqlast.Regclass:
+		return c.cloneRegclass(n)
+	case *sqlast.ReindexStmt:
+		return c.cloneReindexStmt(n)
+	case *sqlast.RemoveColumnTableAction:
+		return c.cloneRemoveColumnTableAction(n)
+	case *sqlast.RenameColumnTableAction:
+		return c.cloneRenameColumnTableAction(n)
+	case *sqlast.RenameConstraintTableAction:
+		return c.cloneRenameConstraintTableAction(n)
+	case *sqlast.RenameTableAction:
+		return c.cloneRenameTableAction(n)
+	case *sqlast.RestartSequenceOption:
+		return c.cloneRestartSequenceOption(n)
+	case *sqlast.RowValueExpr:
+		return c.cloneRowValueExpr(n)
+	case *sqlast.SQLSelect:
+		return c.cloneSQLSelect(n)
+	case *sqlast.SQLiteWithoutRowID:
+		return c.cloneSQLiteWithoutRowID(n)
+	case *sqlast.SelectExpr:
+		return c.cloneSelectExpr(n)
+	case *sqlast.Serial:
+		return c.cloneSerial(n)
+	case *sqlast.Set:
+		return c.cloneSet(n)
+	case *sqlast.SetDefaultColumnAction:
+		return c.cloneSetDefaultColumnAction(n)
+	case *sqlast.SetOperationExpr:
+		return c.cloneSetOperationExpr(n)
+	case *sqlast.SetVariableStmt:
+		return c.cloneSetVariableStmt(n)
+	case *sqlast.ShowStmt:
+		return c.cloneShowStmt(n)
+	case *sqlast.ShowWarningsStmt:
+		return c.cloneShowWarningsStmt(n)
+	case *sqlast.SingleQuotedString:
+		return c.cloneSingleQuotedString(n)
+	case *sqlast.SmallInt:
+		return c.cloneSmallInt(n)
+	case *sqlast.SmallSerial:
+		return c.cloneSmallSerial(n)
+	case *sqlast.StartWithSequenceOption:
+		return c.cloneStartWithSequenceOption(n)
+	case *sqlast.SubQuery:
+		return c.cloneSubQuery(n)
+	case *sqlast.SubQuerySource:
+		return c.cloneSubQuerySource(n)
+	case *sqlast.Subscript:
+		return c.cloneSubscript(n)
+	case *sqlast.SubstringExpr:
+		return c.cloneSubstringExpr(n)
+	case *sqlast.Table:
+		return c.cloneTable(n)
+	case *sqlast.TableConstraint:
+		return c.cloneTableConstraint(n)
+	case *sqlast.TableExpr:
+		return c.cloneTableExpr(n)
+	case *sqlast.TableFunction:
+		return c.cloneTableFunction(n)
+	case *sqlast.TableJoinElement:
+		return c.cloneTableJoinElement(n)
+	case *sqlast.Text:
+		return c.cloneText(n)
+	case *sqlast.Time:
+		return c.cloneTime(n)
+	case *sqlast.TimeValue:
+		return c.cloneTimeValue(n)
+	case *sqlast.Timestamp:
+		return c.cloneTimestamp(n)
+	case *sqlast.TimestampValue:
+		return c.cloneTimestampValue(n)
+	case *sqlast.TinyBlob:
+		return c.cloneTinyBlob(n)
+	case *sqlast.TinyInt:
+		return c.cloneTinyInt(n)
+	case *sqlast.TinyText:
+		return c.cloneTinyText(n)
+	case *sqlast.TopExpr:
+		return c.cloneTopExpr(n)
+	case *sqlast.TriggerEvent:
+		return c.cloneTriggerEvent(n)
+	case *sqlast.TrimExpr:
+		return c.cloneTrimExpr(n)
+	case *sqlast.TruncateStmt:
+		return c.cloneTruncateStmt(n)
+	case *sqlast.UUID:
+		return c.cloneUUID(n)
+	case *sqlast.UnaryExpr:
+		return c.cloneUnaryExpr(n)
+	case *sqlast.UnboundedFollowing:
+		return c.cloneUnboundedFollowing(n)
+	case *sqlast.UnboundedPreceding:
+		return c.cloneUnboundedPreceding(n)
+	case *sqlast.UnionOperator:
+		return c.cloneUnionOperator(n)
+	case *sqlast.UniqueColumnSpec:
+		return c.cloneUniqueColumnSpec(n)
+	case *sqlast.UniqueTableConstraint:
+		return c.cloneUniqueTableConstraint(n)
+	case *sqlast.UnnamedSelectItem:
+		return c.cloneUnnamedSelectItem(n)
+	case *sqlast.UpdateStmt:
+		return c.cloneUpdateStmt(n)
+	case *sqlast.UseStmt:
+		return c.cloneUseStmt(n)
+	case *sqlast.VacuumRelation:
+		return c.cloneVacuumRelation(n)
+	case *sqlast.VacuumStmt:
+		return c.cloneVacuumStmt(n)
+	case *sqlast.ValuesExpr:
+		return c.cloneValuesExpr(n)
+	case *sqlast.Varbinary:
+		return c.cloneVarbinary(n)
+	case *sqlast.VarcharType:
+		return c.cloneVarcharType(n)
+	case *sqlast.Wildcard:
+		return c.cloneWildcard(n)
+	case *sqlast.WildcardSelectItem:
+		return c.cloneWildcardSelectItem(n)
+	case *sqlast.WindowFrame:
+		return c.cloneWindowFrame(n)
+	case *sqlast.WindowFrameUnit:
+		return c.cloneWindowFrameUnit(n)
+	case *sqlast.WindowSpec:
+		return c.cloneWindowSpec(n)
+	case *sqlast.Year:
+		return c.cloneYear(n)
+	}
+	log.Panicf("not implemented type %T: %+v", node, node)
+	return nil
+}
+
+func (c *cloner) cloneAddColumnTableAction(n *sqlast.AddColumnTableAction) *sqlast.AddColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Add = c.pos(n.Add)
+	x.Column = c.cloneColumnDef(n.Column)
+	return &x
+}
+
+func (c *cloner) cloneAddConstraintTableAction(n *sqlast.AddConstraintTableAction) *sqlast.AddConstraintTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Add = c.pos(n.Add)
+	x.Constraint = c.cloneTableConstraint(n.Constraint)
+	return &x
+}
+
+func (c *cloner) cloneAliasSelectItem(n *sqlast.AliasSelectItem) *sqlast.AliasSelectItem {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.Alias = c.cloneIdent(n.Alias)
+	return &x
+}
+
+func (c *cloner) cloneAlterColumnTableAction(n *sqlast.AlterColumnTableAction) *sqlast.AlterColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.ColumnName = c.cloneIdent(n.ColumnName)
+	x.Alter = c.pos(n.Alter)
+	if n.Action != nil {
+		x.Action = c.clone(n.Action).(sqlast.AlterColumnAction)
+	}
+	return &x
+}
+
+func (c *cloner) cloneAlterSequenceStmt(n *sqlast.AlterSequenceStmt) *sqlast.AlterSequenceStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Alter = c.pos(n.Alter)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Options != nil {
+		x.Options = make([]sqlast.SequenceOption, len(n.Options))
+		for i, e := range n.Options {
+			if e != nil {
+				x.Options[i] = c.clone(e).(sqlast.SequenceOption)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneAlterTableStmt(n *sqlast.AlterTableStmt) *sqlast.AlterTableStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Alter = c.pos(n.Alter)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Actions != nil {
+		x.Actions = make([]sqlast.AlterTableAction, len(n.Actions))
+		for i, e := range n.Actions {
+			if e != nil {
+				x.Actions[i] = c.clone(e).(sqlast.AlterTableAction)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneAlterViewStmt(n *sqlast.AlterViewStmt) *sqlast.AlterViewStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Alter = c.pos(n.Alter)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Query = c.cloneQueryStmt(n.Query)
+	x.NewName = c.cloneObjectName(n.NewName)
+	return &x
+}
+
+func (c *cloner) cloneAnalyzeStmt(n *sqlast.AnalyzeStmt) *sqlast.AnalyzeStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Analyze = c.pos(n.Analyze)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.CopyOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneCopyOption(e)
+		}
+	}
+	if n.Tables != nil {
+		x.Tables = make([]*sqlast.VacuumRelation, len(n.Tables))
+		for i, e := range n.Tables {
+			x.Tables[i] = c.cloneVacuumRelation(e)
+		}
+	}
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneArray(n *sqlast.Array) *sqlast.Array {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Ty != nil {
+		x.Ty = c.clone(n.Ty).(sqlast.Type)
+	}
+	if n.Size != nil {
+		v := *n.Size
+		x.Size = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneArrayConstructor(n *sqlast.ArrayConstructor) *sqlast.ArrayConstructor {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Array = c.pos(n.Array)
+	x.LBracket = c.pos(n.LBracket)
+	if n.Elements != nil {
+		x.Elements = make([]sqlast.Node, len(n.Elements))
+		for i, e := range n.Elements {
+			x.Elements[i] = c.clone(e)
+		}
+	}
+	x.RBracket = c.pos(n.RBracket)
+	return &x
+}
+
+func (c *cloner) cloneAsSequenceOption(n *sqlast.AsSequenceOption) *sqlast.AsSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.As = c.pos(n.As)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	return &x
+}
+
+func (c *cloner) cloneAssignment(n *sqlast.Assignment) *sqlast.Assignment {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.ID = c.cloneIdent(n.ID)
+	x.Value = c.clone(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneAttachPartitionTableAction(n *sqlast.AttachPartitionTableAction) *sqlast.AttachPartitionTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Attach = c.pos(n.Attach)
+	x.Name = c.cloneObjectName(n.Name)
+	x.Bound = c.clonePartitionBound(n.Bound)
+	return &x
+}
+
+func (c *cloner) cloneAutoIncrement(n *sqlast.AutoIncrement) *sqlast.AutoIncrement {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Auto = c.pos(n.Auto)
+	x.Increment = c.pos(n.Increment)
+	return &x
+}
+
+func (c *cloner) cloneBetween(n *sqlast.Between) *sqlast.Between {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.Low = c.clone(n.Low)
+	x.High = c.clone(n.High)
+	return &x
+}
+
+func (c *cloner) cloneBigInt(n *sqlast.BigInt) *sqlast.BigInt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Width != nil {
+		v := *n.Width
+		x.Width = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneBigSerial(n *sqlast.BigSerial) *sqlast.BigSerial {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneBinary(n *sqlast.Binary) *sqlast.Binary {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Binary = c.pos(n.Binary)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneBinaryExpr(n *sqlast.BinaryExpr) *sqlast.BinaryExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Left = c.clone(n.Left)
+	x.Op = c.cloneOperator(n.Op)
+	x.Right = c.clone(n.Right)
+	x.Escape = c.clone(n.Escape)
+	return &x
+}
+
+func (c *cloner) cloneBitStringLiteral(n *sqlast.BitStringLiteral) *sqlast.BitStringLiteral {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneBlob(n *sqlast.Blob) *sqlast.Blob {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Blob = c.pos(n.Blob)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneBoolean(n *sqlast.Boolean) *sqlast.Boolean {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneBooleanValue(n *sqlast.BooleanValue) *sqlast.BooleanValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneBytea(n *sqlast.Bytea) *sqlast.Bytea {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneCTE(n *sqlast.CTE) *sqlast.CTE {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Alias = c.cloneIdent(n.Alias)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.Query = c.cloneQueryStmt(n.Query)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneCacheSequenceOption(n *sqlast.CacheSequenceOption) *sqlast.CacheSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Cache = c.pos(n.Cache)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneCaseExpr(n *sqlast.CaseExpr) *sqlast.CaseExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Case = c.pos(n.Case)
+	x.CaseEnd = c.pos(n.CaseEnd)
+	x.Operand = c.clone(n.Operand)
+	if n.Conditions != nil {
+		x.Conditions = make([]sqlast.Node, len(n.Conditions))
+		for i, e := range n.Conditions {
+			x.Conditions[i] = c.clone(e)
+		}
+	}
+	if n.Results != nil {
+		x.Results = make([]sqlast.Node, len(n.Results))
+		for i, e := range n.Results {
+			x.Results[i] = c.clone(e)
+		}
+	}
+	x.ElseResult = c.clone(n.ElseResult)
+	return &x
+}
+
+func (c *cloner) cloneCast(n *sqlast.Cast) *sqlast.Cast {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	x.Cast = c.pos(n.Cast)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneCharType(n *sqlast.CharType) *sqlast.CharType {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Size != nil {
+		v := *n.Size
+		x.Size = &v
+	}
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.RParen = c.pos(n.RParen)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneCheckColumnSpec(n *sqlast.CheckColumnSpec) *sqlast.CheckColumnSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.Check = c.pos(n.Check)
+	x.RParen = c.pos(n.RParen)
+	x.EnforcedTo = c.pos(n.EnforcedTo)
+	return &x
+}
+
+func (c *cloner) cloneCheckTableConstraint(n *sqlast.CheckTableConstraint) *sqlast.CheckTableConstraint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Check = c.pos(n.Check)
+	x.RParen = c.pos(n.RParen)
+	x.Expr = c.clone(n.Expr)
+	x.EnforcedTo = c.pos(n.EnforcedTo)
+	return &x
+}
+
+func (c *cloner) cloneClob(n *sqlast.Clob) *sqlast.Clob {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Clob = c.pos(n.Clob)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneColumnConstraint(n *sqlast.ColumnConstraint) *sqlast.ColumnConstraint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	x.Constraint = c.pos(n.Constraint)
+	if n.Spec != nil {
+		x.Spec = c.clone(n.Spec).(sqlast.ColumnConstraintSpec)
+	}
+	return &x
+}
+
+func (c *cloner) cloneColumnDef(n *sqlast.ColumnDef) *sqlast.ColumnDef {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	x.WithOptionsPos = c.pos(n.WithOptionsPos)
+	x.Default = c.clone(n.Default)
+	if n.MyDataTypeDecoration != nil {
+		x.MyDataTypeDecoration = make([]sqlast.MyDataTypeDecoration, len(n.MyDataTypeDecoration))
+		for i, e := range n.MyDataTypeDecoration {
+			if e != nil {
+				x.MyDataTypeDecoration[i] = c.clone(e).(sqlast.MyDataTypeDecoration)
+			}
+		}
+	}
+	if n.Constraints != nil {
+		x.Constraints = make([]*sqlast.ColumnConstraint, len(n.Constraints))
+		for i, e := range n.Constraints {
+			x.Constraints[i] = c.cloneColumnConstraint(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneComment(n *sqlast.Comment) *sqlast.Comment {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneCommentGroup(n *sqlast.CommentGroup) *sqlast.CommentGroup {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.List != nil {
+		x.List = make([]*sqlast.Comment, len(n.List))
+		for i, e := range n.List {
+			x.List[i] = c.cloneComment(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCommentOnStmt(n *sqlast.CommentOnStmt) *sqlast.CommentOnStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Comment = c.pos(n.Comment)
+	x.Name = c.cloneObjectName(n.Name)
+	x.Text = c.clone(n.Text)
+	return &x
+}
+
+func (c *cloner) cloneCompoundIdent(n *sqlast.CompoundIdent) *sqlast.CompoundIdent {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Idents != nil {
+		x.Idents = make([]*sqlast.Ident, len(n.Idents))
+		for i, e := range n.Idents {
+			x.Idents[i] = c.cloneIdent(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneConstructorSource(n *sqlast.ConstructorSource) *sqlast.ConstructorSource {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Values = c.pos(n.Values)
+	if n.Rows != nil {
+		x.Rows = make([]*sqlast.RowValueExpr, len(n.Rows))
+		for i, e := range n.Rows {
+			x.Rows[i] = c.cloneRowValueExpr(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCopyOption(n *sqlast.CopyOption) *sqlast.CopyOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	x.Value = c.clone(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneCopyStmt(n *sqlast.CopyStmt) *sqlast.CopyStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Copy = c.pos(n.Copy)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.File = c.cloneSingleQuotedString(n.File)
+	x.StdioPos = c.pos(n.StdioPos)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.CopyOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneCopyOption(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	if n.Data != nil {
+		v := *n.Data
+		x.Data = &v
+	}
+	x.DataEnd = c.pos(n.DataEnd)
+	return &x
+}
+
+func (c *cloner) cloneCreateDomainStmt(n *sqlast.CreateDomainStmt) *sqlast.CreateDomainStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	x.Default = c.clone(n.Default)
+	if n.Constraints != nil {
+		x.Constraints = make([]*sqlast.ColumnConstraint, len(n.Constraints))
+		for i, e := range n.Constraints {
+			x.Constraints[i] = c.cloneColumnConstraint(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCreateExtensionStmt(n *sqlast.CreateExtensionStmt) *sqlast.CreateExtensionStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	x.Name = c.cloneIdent(n.Name)
+	x.Schema = c.cloneIdent(n.Schema)
+	x.Version = c.clone(n.Version)
+	x.CascadePos = c.pos(n.CascadePos)
+	return &x
+}
+
+func (c *cloner) cloneCreateFunctionStmt(n *sqlast.CreateFunctionStmt) *sqlast.CreateFunctionStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Args != nil {
+		x.Args = make([]*sqlast.FunctionArg, len(n.Args))
+		for i, e := range n.Args {
+			x.Args[i] = c.cloneFunctionArg(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Returns = c.cloneFunctionReturns(n.Returns)
+	x.Language = c.cloneIdent(n.Language)
+	if n.Behaviors != nil {
+		x.Behaviors = make([]*sqlast.Ident, len(n.Behaviors))
+		for i, e := range n.Behaviors {
+			x.Behaviors[i] = c.cloneIdent(e)
+		}
+	}
+	x.Body = c.clone(n.Body)
+	return &x
+}
+
+func (c *cloner) cloneCreateIndexStmt(n *sqlast.CreateIndexStmt) *sqlast.CreateIndexStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.TableName = c.cloneObjectName(n.TableName)
+	x.Unique = c.pos(n.Unique)
+	x.Index = c.pos(n.Index)
+	x.ConcurrentlyPos = c.pos(n.ConcurrentlyPos)
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	x.IndexName = c.cloneIdent(n.IndexName)
+	x.MethodName = c.cloneIdent(n.MethodName)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.IndexElement, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIndexElement(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	if n.Include != nil {
+		x.Include = make([]*sqlast.Ident, len(n.Include))
+		for i, e := range n.Include {
+			x.Include[i] = c.cloneIdent(e)
+		}
+	}
+	x.IncludeRParen = c.pos(n.IncludeRParen)
+	x.CommentPos = c.pos(n.CommentPos)
+	x.Comment = c.cloneSingleQuotedString(n.Comment)
+	x.Selection = c.clone(n.Selection)
+	return &x
+}
+
+func (c *cloner) cloneCreateSchemaStmt(n *sqlast.CreateSchemaStmt) *sqlast.CreateSchemaStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Schema = c.pos(n.Schema)
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	x.Name = c.cloneObjectName(n.Name)
+	x.Authorization = c.cloneIdent(n.Authorization)
+	if n.Elements != nil {
+		x.Elements = make([]sqlast.Stmt, len(n.Elements))
+		for i, e := range n.Elements {
+			if e != nil {
+				x.Elements[i] = c.clone(e).(sqlast.Stmt)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCreateSequenceStmt(n *sqlast.CreateSequenceStmt) *sqlast.CreateSequenceStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Sequence = c.pos(n.Sequence)
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Options != nil {
+		x.Options = make([]sqlast.SequenceOption, len(n.Options))
+		for i, e := range n.Options {
+			if e != nil {
+				x.Options[i] = c.clone(e).(sqlast.SequenceOption)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCreateTableStmt(n *sqlast.CreateTableStmt) *sqlast.CreateTableStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Table = c.pos(n.Table)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Elements != nil {
+		x.Elements = make([]sqlast.TableElement, len(n.Elements))
+		for i, e := range n.Elements {
+			if e != nil {
+				x.Elements[i] = c.clone(e).(sqlast.TableElement)
+			}
+		}
+	}
+	if n.Location != nil {
+		v := *n.Location
+		x.Location = &v
+	}
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	x.PartitionOf = c.cloneObjectName(n.PartitionOf)
+	x.Inherits = c.cloneInheritsClause(n.Inherits)
+	x.Bound = c.clonePartitionBound(n.Bound)
+	x.PartitionBy = c.clonePartitionSpec(n.PartitionBy)
+	if n.Options != nil {
+		x.Options = make([]sqlast.TableOption, len(n.Options))
+		for i, e := range n.Options {
+			if e != nil {
+				x.Options[i] = c.clone(e).(sqlast.TableOption)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneCreateTriggerStmt(n *sqlast.CreateTriggerStmt) *sqlast.CreateTriggerStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Trigger = c.pos(n.Trigger)
+	x.Name = c.cloneObjectName(n.Name)
+	x.TimingPos = c.pos(n.TimingPos)
+	if n.Events != nil {
+		x.Events = make([]*sqlast.TriggerEvent, len(n.Events))
+		for i, e := range n.Events {
+			x.Events[i] = c.cloneTriggerEvent(e)
+		}
+	}
+	x.TableName = c.cloneObjectName(n.TableName)
+	x.LevelFrom = c.pos(n.LevelFrom)
+	x.LevelTo = c.pos(n.LevelTo)
+	x.When = c.clone(n.When)
+	x.Execute = c.pos(n.Execute)
+	x.Function = c.cloneObjectName(n.Function)
+	if n.Args != nil {
+		x.Args = make([]sqlast.Node, len(n.Args))
+		for i, e := range n.Args {
+			x.Args[i] = c.clone(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneCreateTypeStmt(n *sqlast.CreateTypeStmt) *sqlast.CreateTypeStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Labels != nil {
+		x.Labels = make([]*sqlast.SingleQuotedString, len(n.Labels))
+		for i, e := range n.Labels {
+			x.Labels[i] = c.cloneSingleQuotedString(e)
+		}
+	}
+	if n.Attributes != nil {
+		x.Attributes = make([]*sqlast.ColumnDef, len(n.Attributes))
+		for i, e := range n.Attributes {
+			x.Attributes[i] = c.cloneColumnDef(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneCreateViewStmt(n *sqlast.CreateViewStmt) *sqlast.CreateViewStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Create = c.pos(n.Create)
+	x.View = c.pos(n.View)
+	x.Name = c.cloneObjectName(n.Name)
+	x.Query = c.cloneQueryStmt(n.Query)
+	x.MaterializedPos = c.pos(n.MaterializedPos)
+	x.NotExistsFrom = c.pos(n.NotExistsFrom)
+	x.NotExistsTo = c.pos(n.NotExistsTo)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneCrossJoin(n *sqlast.CrossJoin) *sqlast.CrossJoin {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Reference != nil {
+		x.Reference = c.clone(n.Reference).(sqlast.TableReference)
+	}
+	if n.Factor != nil {
+		x.Factor = c.clone(n.Factor).(sqlast.TableFactor)
+	}
+	return &x
+}
+
+func (c *cloner) cloneCurrentRow(n *sqlast.CurrentRow) *sqlast.CurrentRow {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Current = c.pos(n.Current)
+	x.Row = c.pos(n.Row)
+	return &x
+}
+
+func (c *cloner) cloneCustom(n *sqlast.Custom) *sqlast.Custom {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Ty = c.cloneObjectName(n.Ty)
+	return &x
+}
+
+func (c *cloner) cloneCycleSequenceOption(n *sqlast.CycleSequenceOption) *sqlast.CycleSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDate(n *sqlast.Date) *sqlast.Date {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDateTime(n *sqlast.DateTime) *sqlast.DateTime {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Precision != nil {
+		v := *n.Precision
+		x.Precision = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneDateTimeValue(n *sqlast.DateTimeValue) *sqlast.DateTimeValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDateValue(n *sqlast.DateValue) *sqlast.DateValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDeallocateStmt(n *sqlast.DeallocateStmt) *sqlast.DeallocateStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Deallocate = c.pos(n.Deallocate)
+	x.Name = c.cloneIdent(n.Name)
+	x.AllPos = c.pos(n.AllPos)
+	return &x
+}
+
+func (c *cloner) cloneDecimal(n *sqlast.Decimal) *sqlast.Decimal {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Precision != nil {
+		v := *n.Precision
+		x.Precision = &v
+	}
+	if n.Scale != nil {
+		v := *n.Scale
+		x.Scale = &v
+	}
+	x.Numeric = c.pos(n.Numeric)
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneDeleteStmt(n *sqlast.DeleteStmt) *sqlast.DeleteStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Delete = c.pos(n.Delete)
+	x.Hint = c.cloneHintComment(n.Hint)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Using != nil {
+		x.Using = make([]sqlast.TableReference, len(n.Using))
+		for i, e := range n.Using {
+			if e != nil {
+				x.Using[i] = c.clone(e).(sqlast.TableReference)
+			}
+		}
+	}
+	x.Selection = c.clone(n.Selection)
+	if n.OrderBy != nil {
+		x.OrderBy = make([]*sqlast.OrderByExpr, len(n.OrderBy))
+		for i, e := range n.OrderBy {
+			x.OrderBy[i] = c.cloneOrderByExpr(e)
+		}
+	}
+	x.Limit = c.cloneLimitExpr(n.Limit)
+	if n.Returning != nil {
+		x.Returning = make([]sqlast.SQLSelectItem, len(n.Returning))
+		for i, e := range n.Returning {
+			if e != nil {
+				x.Returning[i] = c.clone(e).(sqlast.SQLSelectItem)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneDerived(n *sqlast.Derived) *sqlast.Derived {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.LateralPos = c.pos(n.LateralPos)
+	x.LParen = c.pos(n.LParen)
+	x.RParen = c.pos(n.RParen)
+	x.SubQuery = c.cloneQueryStmt(n.SubQuery)
+	x.Alias = c.cloneIdent(n.Alias)
+	if n.ColumnAliases != nil {
+		x.ColumnAliases = make([]*sqlast.Ident, len(n.ColumnAliases))
+		for i, e := range n.ColumnAliases {
+			x.ColumnAliases[i] = c.cloneIdent(e)
+		}
+	}
+	x.AliasRParen = c.pos(n.AliasRParen)
+	return &x
+}
+
+func (c *cloner) cloneDetachPartitionTableAction(n *sqlast.DetachPartitionTableAction) *sqlast.DetachPartitionTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Detach = c.pos(n.Detach)
+	x.Name = c.cloneObjectName(n.Name)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDollarQuotedString(n *sqlast.DollarQuotedString) *sqlast.DollarQuotedString {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDouble(n *sqlast.Double) *sqlast.Double {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDoubleValue(n *sqlast.DoubleValue) *sqlast.DoubleValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneDropConstraintTableAction(n *sqlast.DropConstraintTableAction) *sqlast.DropConstraintTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	x.Drop = c.pos(n.Drop)
+	x.CascadePos = c.pos(n.CascadePos)
+	return &x
+}
+
+func (c *cloner) cloneDropDefaultColumnAction(n *sqlast.DropDefaultColumnAction) *sqlast.DropDefaultColumnAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	x.Default = c.pos(n.Default)
+	return &x
+}
+
+func (c *cloner) cloneDropIndexStmt(n *sqlast.DropIndexStmt) *sqlast.DropIndexStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	if n.IndexNames != nil {
+		x.IndexNames = make([]*sqlast.ObjectName, len(n.IndexNames))
+		for i, e := range n.IndexNames {
+			x.IndexNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.ConcurrentlyPos = c.pos(n.ConcurrentlyPos)
+	x.CascadePos = c.pos(n.CascadePos)
+	x.RestrictPos = c.pos(n.RestrictPos)
+	x.TableName = c.cloneObjectName(n.TableName)
+	return &x
+}
+
+func (c *cloner) cloneDropSchemaStmt(n *sqlast.DropSchemaStmt) *sqlast.DropSchemaStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.SchemaNames != nil {
+		x.SchemaNames = make([]*sqlast.ObjectName, len(n.SchemaNames))
+		for i, e := range n.SchemaNames {
+			x.SchemaNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.CascadePos = c.pos(n.CascadePos)
+	x.RestrictPos = c.pos(n.RestrictPos)
+	x.Drop = c.pos(n.Drop)
+	return &x
+}
+
+func (c *cloner) cloneDropSequenceStmt(n *sqlast.DropSequenceStmt) *sqlast.DropSequenceStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.SequenceNames != nil {
+		x.SequenceNames = make([]*sqlast.ObjectName, len(n.SequenceNames))
+		for i, e := range n.SequenceNames {
+			x.SequenceNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.CascadePos = c.pos(n.CascadePos)
+	x.RestrictPos = c.pos(n.RestrictPos)
+	x.Drop = c.pos(n.Drop)
+	return &x
+}
+
+func (c *cloner) cloneDropTableStmt(n *sqlast.DropTableStmt) *sqlast.DropTableStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.TableNames != nil {
+		x.TableNames = make([]*sqlast.ObjectName, len(n.TableNames))
+		for i, e := range n.TableNames {
+			x.TableNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.CascadePos = c.pos(n.CascadePos)
+	x.RestrictPos = c.pos(n.RestrictPos)
+	x.Drop = c.pos(n.Drop)
+	return &x
+}
+
+func (c *cloner) cloneDropTriggerStmt(n *sqlast.DropTriggerStmt) *sqlast.DropTriggerStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	x.Name = c.cloneObjectName(n.Name)
+	x.TableName = c.cloneObjectName(n.TableName)
+	x.CascadePos = c.pos(n.CascadePos)
+	return &x
+}
+
+func (c *cloner) cloneDropViewStmt(n *sqlast.DropViewStmt) *sqlast.DropViewStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	if n.ViewNames != nil {
+		x.ViewNames = make([]*sqlast.ObjectName, len(n.ViewNames))
+		for i, e := range n.ViewNames {
+			x.ViewNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.CascadePos = c.pos(n.CascadePos)
+	x.RestrictPos = c.pos(n.RestrictPos)
+	return &x
+}
+
+func (c *cloner) cloneEnum(n *sqlast.Enum) *sqlast.Enum {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	if n.Values != nil {
+		x.Values = make([]*sqlast.SingleQuotedString, len(n.Values))
+		for i, e := range n.Values {
+			x.Values[i] = c.cloneSingleQuotedString(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneExceptOperator(n *sqlast.ExceptOperator) *sqlast.ExceptOperator {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneExecuteStmt(n *sqlast.ExecuteStmt) *sqlast.ExecuteStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Execute = c.pos(n.Execute)
+	x.Name = c.cloneIdent(n.Name)
+	if n.Args != nil {
+		x.Args = make([]sqlast.Node, len(n.Args))
+		for i, e := range n.Args {
+			x.Args[i] = c.clone(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneExists(n *sqlast.Exists) *sqlast.Exists {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Query = c.cloneQueryStmt(n.Query)
+	x.Not = c.pos(n.Not)
+	x.Exists = c.pos(n.Exists)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneExplainStmt(n *sqlast.ExplainStmt) *sqlast.ExplainStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Explain = c.pos(n.Explain)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.CopyOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneCopyOption(e)
+		}
+	}
+	x.Format = c.cloneIdent(n.Format)
+	if n.Stmt != nil {
+		x.Stmt = c.clone(n.Stmt).(sqlast.Stmt)
+	}
+	x.Table = c.cloneObjectName(n.Table)
+	return &x
+}
+
+func (c *cloner) cloneExtractExpr(n *sqlast.ExtractExpr) *sqlast.ExtractExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Extract = c.pos(n.Extract)
+	x.Field = c.cloneIdent(n.Field)
+	x.Source = c.clone(n.Source)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneFetchExpr(n *sqlast.FetchExpr) *sqlast.FetchExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Fetch = c.pos(n.Fetch)
+	x.Quantity = c.cloneLongValue(n.Quantity)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneFile(n *sqlast.File) *sqlast.File {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Stmts != nil {
+		x.Stmts = make([]sqlast.Stmt, len(n.Stmts))
+		for i, e := range n.Stmts {
+			if e != nil {
+				x.Stmts[i] = c.clone(e).(sqlast.Stmt)
+			}
+		}
+	}
+	if n.Comments != nil {
+		x.Comments = make([]*sqlast.CommentGroup, len(n.Comments))
+		for i, e := range n.Comments {
+			x.Comments[i] = c.cloneCommentGroup(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneFloat(n *sqlast.Float) *sqlast.Float {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Size != nil {
+		v := *n.Size
+		x.Size = &v
+	}
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneFollowing(n *sqlast.Following) *sqlast.Following {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.Following = c.pos(n.Following)
+	if n.Bound != nil {
+		v := *n.Bound
+		x.Bound = &v
+	}
+	return &x
+}
+
+func (c *cloner) cloneFunction(n *sqlast.Function) *sqlast.Function {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Args != nil {
+		x.Args = make([]sqlast.Node, len(n.Args))
+		for i, e := range n.Args {
+			x.Args[i] = c.clone(e)
+		}
+	}
+	x.ArgsRParen = c.pos(n.ArgsRParen)
+	x.Over = c.cloneWindowSpec(n.Over)
+	x.OverRparen = c.pos(n.OverRparen)
+	return &x
+}
+
+func (c *cloner) cloneFunctionArg(n *sqlast.FunctionArg) *sqlast.FunctionArg {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.ModePos = c.pos(n.ModePos)
+	x.Name = c.cloneIdent(n.Name)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	x.Default = c.clone(n.Default)
+	return &x
+}
+
+func (c *cloner) cloneFunctionReturns(n *sqlast.FunctionReturns) *sqlast.FunctionReturns {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Returns = c.pos(n.Returns)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.FunctionArg, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneFunctionArg(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneHexStringLiteral(n *sqlast.HexStringLiteral) *sqlast.HexStringLiteral {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneHintComment(n *sqlast.HintComment) *sqlast.HintComment {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneIdent(n *sqlast.Ident) *sqlast.Ident {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneInList(n *sqlast.InList) *sqlast.InList {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	if n.List != nil {
+		x.List = make([]sqlast.Node, len(n.List))
+		for i, e := range n.List {
+			x.List[i] = c.clone(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.ElidedFrom = c.pos(n.ElidedFrom)
+	x.ElidedTo = c.pos(n.ElidedTo)
+	return &x
+}
+
+func (c *cloner) cloneInSubQuery(n *sqlast.InSubQuery) *sqlast.InSubQuery {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.SubQuery = c.cloneQueryStmt(n.SubQuery)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneIncrementBySequenceOption(n *sqlast.IncrementBySequenceOption) *sqlast.IncrementBySequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Increment = c.pos(n.Increment)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneIndexColumn(n *sqlast.IndexColumn) *sqlast.IndexColumn {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	if n.Length != nil {
+		v := *n.Length
+		x.Length = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.OrderingPos = c.pos(n.OrderingPos)
+	if n.ASC != nil {
+		v := *n.ASC
+		x.ASC = &v
+	}
+	return &x
+}
+
+func (c *cloner) cloneIndexElement(n *sqlast.IndexElement) *sqlast.IndexElement {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.CollatePos = c.pos(n.CollatePos)
+	x.Collation = c.cloneObjectName(n.Collation)
+	x.OpClass = c.cloneObjectName(n.OpClass)
+	x.OrderingPos = c.pos(n.OrderingPos)
+	if n.ASC != nil {
+		v := *n.ASC
+		x.ASC = &v
+	}
+	x.NullsPos = c.pos(n.NullsPos)
+	if n.NullsFirst != nil {
+		v := *n.NullsFirst
+		x.NullsFirst = &v
+	}
+	return &x
+}
+
+func (c *cloner) cloneIndexHint(n *sqlast.IndexHint) *sqlast.IndexHint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	if n.Indexes != nil {
+		x.Indexes = make([]*sqlast.Ident, len(n.Indexes))
+		for i, e := range n.Indexes {
+			x.Indexes[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneIndexTableElement(n *sqlast.IndexTableElement) *sqlast.IndexTableElement {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.Name = c.cloneIdent(n.Name)
+	x.Using = c.cloneIdent(n.Using)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.IndexColumn, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIndexColumn(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.CommentPos = c.pos(n.CommentPos)
+	x.Comment = c.cloneSingleQuotedString(n.Comment)
+	return &x
+}
+
+func (c *cloner) cloneInheritsClause(n *sqlast.InheritsClause) *sqlast.InheritsClause {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Inherits = c.pos(n.Inherits)
+	if n.Parents != nil {
+		x.Parents = make([]*sqlast.ObjectName, len(n.Parents))
+		for i, e := range n.Parents {
+			x.Parents[i] = c.cloneObjectName(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneInsertStmt(n *sqlast.InsertStmt) *sqlast.InsertStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Insert = c.pos(n.Insert)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	if n.Source != nil {
+		x.Source = c.clone(n.Source).(sqlast.InsertSource)
+	}
+	if n.UpdateAssignments != nil {
+		x.UpdateAssignments = make([]*sqlast.Assignment, len(n.UpdateAssignments))
+		for i, e := range n.UpdateAssignments {
+			x.UpdateAssignments[i] = c.cloneAssignment(e)
+		}
+	}
+	x.OnConflict = c.cloneOnConflict(n.OnConflict)
+	if n.Returning != nil {
+		x.Returning = make([]sqlast.SQLSelectItem, len(n.Returning))
+		for i, e := range n.Returning {
+			if e != nil {
+				x.Returning[i] = c.clone(e).(sqlast.SQLSelectItem)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneInt(n *sqlast.Int) *sqlast.Int {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Width != nil {
+		v := *n.Width
+		x.Width = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneIntersectOperator(n *sqlast.IntersectOperator) *sqlast.IntersectOperator {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneIsNotNull(n *sqlast.IsNotNull) *sqlast.IsNotNull {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.X = c.clone(n.X)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneIsNull(n *sqlast.IsNull) *sqlast.IsNull {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.X = c.clone(n.X)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneIsOf(n *sqlast.IsOf) *sqlast.IsOf {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.X = c.clone(n.X)
+	if n.Types != nil {
+		x.Types = make([]sqlast.Type, len(n.Types))
+		for i, e := range n.Types {
+			if e != nil {
+				x.Types[i] = c.clone(e).(sqlast.Type)
+			}
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneJSON(n *sqlast.JSON) *sqlast.JSON {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneJoinCondition(n *sqlast.JoinCondition) *sqlast.JoinCondition {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.SearchCondition = c.clone(n.SearchCondition)
+	x.On = c.pos(n.On)
+	return &x
+}
+
+func (c *cloner) cloneJoinType(n *sqlast.JoinType) *sqlast.JoinType {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneKillStmt(n *sqlast.KillStmt) *sqlast.KillStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Kill = c.pos(n.Kill)
+	x.ID = c.cloneLongValue(n.ID)
+	return &x
+}
+
+func (c *cloner) cloneLikeOption(n *sqlast.LikeOption) *sqlast.LikeOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.Name = c.cloneIdent(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneLikeTableElement(n *sqlast.LikeTableElement) *sqlast.LikeTableElement {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Like = c.pos(n.Like)
+	x.Source = c.cloneObjectName(n.Source)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.LikeOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneLikeOption(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneLimitExpr(n *sqlast.LimitExpr) *sqlast.LimitExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.AllPos = c.pos(n.AllPos)
+	x.Limit = c.pos(n.Limit)
+	x.LimitValue = c.clone(n.LimitValue)
+	x.OffsetValue = c.clone(n.OffsetValue)
+	return &x
+}
+
+func (c *cloner) cloneLockingClause(n *sqlast.LockingClause) *sqlast.LockingClause {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.For = c.pos(n.For)
+	if n.Of != nil {
+		x.Of = make([]*sqlast.ObjectName, len(n.Of))
+		for i, e := range n.Of {
+			x.Of[i] = c.cloneObjectName(e)
+		}
+	}
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneLongBlob(n *sqlast.LongBlob) *sqlast.LongBlob {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneLongText(n *sqlast.LongText) *sqlast.LongText {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneLongValue(n *sqlast.LongValue) *sqlast.LongValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneMaxValueSequenceOption(n *sqlast.MaxValueSequenceOption) *sqlast.MaxValueSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneMediumBlob(n *sqlast.MediumBlob) *sqlast.MediumBlob {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneMediumInt(n *sqlast.MediumInt) *sqlast.MediumInt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Width != nil {
+		v := *n.Width
+		x.Width = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneMediumText(n *sqlast.MediumText) *sqlast.MediumText {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneMinValueSequenceOption(n *sqlast.MinValueSequenceOption) *sqlast.MinValueSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneMyChangeColumnTableAction(n *sqlast.MyChangeColumnTableAction) *sqlast.MyChangeColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Change = c.pos(n.Change)
+	x.OldName = c.cloneIdent(n.OldName)
+	x.Column = c.cloneColumnDef(n.Column)
+	x.Position = c.cloneMyColumnPosition(n.Position)
+	return &x
+}
+
+func (c *cloner) cloneMyCharset(n *sqlast.MyCharset) *sqlast.MyCharset {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Default = c.pos(n.Default)
+	x.Charset = c.pos(n.Charset)
+	x.Name = c.cloneIdent(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneMyCollate(n *sqlast.MyCollate) *sqlast.MyCollate {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Default = c.pos(n.Default)
+	x.Collate = c.pos(n.Collate)
+	x.Name = c.cloneIdent(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneMyColumnPosition(n *sqlast.MyColumnPosition) *sqlast.MyColumnPosition {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.After = c.cloneIdent(n.After)
+	return &x
+}
+
+func (c *cloner) cloneMyDropPrimaryKeyTableAction(n *sqlast.MyDropPrimaryKeyTableAction) *sqlast.MyDropPrimaryKeyTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	x.Key = c.pos(n.Key)
+	return &x
+}
+
+func (c *cloner) cloneMyEngine(n *sqlast.MyEngine) *sqlast.MyEngine {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Engine = c.pos(n.Engine)
+	x.Name = c.cloneIdent(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneMyModifyColumnTableAction(n *sqlast.MyModifyColumnTableAction) *sqlast.MyModifyColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Modify = c.pos(n.Modify)
+	x.Column = c.cloneColumnDef(n.Column)
+	x.Position = c.cloneMyColumnPosition(n.Position)
+	return &x
+}
+
+func (c *cloner) cloneNVarcharType(n *sqlast.NVarcharType) *sqlast.NVarcharType {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Size != nil {
+		v := *n.Size
+		x.Size = &v
+	}
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneNamedColumnsJoin(n *sqlast.NamedColumnsJoin) *sqlast.NamedColumnsJoin {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.ColumnList != nil {
+		x.ColumnList = make([]*sqlast.Ident, len(n.ColumnList))
+		for i, e := range n.ColumnList {
+			x.ColumnList[i] = c.cloneIdent(e)
+		}
+	}
+	x.Using = c.pos(n.Using)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneNationalStringLiteral(n *sqlast.NationalStringLiteral) *sqlast.NationalStringLiteral {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneNaturalJoin(n *sqlast.NaturalJoin) *sqlast.NaturalJoin {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.LeftElement = c.cloneTableJoinElement(n.LeftElement)
+	x.Type = c.cloneJoinType(n.Type)
+	x.RightElement = c.cloneTableJoinElement(n.RightElement)
+	return &x
+}
+
+func (c *cloner) cloneNested(n *sqlast.Nested) *sqlast.Nested {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.AST = c.clone(n.AST)
+	x.LParen = c.pos(n.LParen)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneNotNullColumnSpec(n *sqlast.NotNullColumnSpec) *sqlast.NotNullColumnSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Not = c.pos(n.Not)
+	x.Null = c.pos(n.Null)
+	return &x
+}
+
+func (c *cloner) cloneNullValue(n *sqlast.NullValue) *sqlast.NullValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneObjectName(n *sqlast.ObjectName) *sqlast.ObjectName {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Idents != nil {
+		x.Idents = make([]*sqlast.Ident, len(n.Idents))
+		for i, e := range n.Idents {
+			x.Idents[i] = c.cloneIdent(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneOffsetExpr(n *sqlast.OffsetExpr) *sqlast.OffsetExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Offset = c.pos(n.Offset)
+	x.Value = c.clone(n.Value)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneOnConflict(n *sqlast.OnConflict) *sqlast.OnConflict {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.On = c.pos(n.On)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.TargetWhere = c.clone(n.TargetWhere)
+	x.Constraint = c.cloneIdent(n.Constraint)
+	x.Nothing = c.pos(n.Nothing)
+	if n.Assignments != nil {
+		x.Assignments = make([]*sqlast.Assignment, len(n.Assignments))
+		for i, e := range n.Assignments {
+			x.Assignments[i] = c.cloneAssignment(e)
+		}
+	}
+	x.Selection = c.clone(n.Selection)
+	return &x
+}
+
+func (c *cloner) cloneOperator(n *sqlast.Operator) *sqlast.Operator {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneOrderByExpr(n *sqlast.OrderByExpr) *sqlast.OrderByExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.OrderingPos = c.pos(n.OrderingPos)
+	if n.ASC != nil {
+		v := *n.ASC
+		x.ASC = &v
+	}
+	return &x
+}
+
+func (c *cloner) cloneOverlayExpr(n *sqlast.OverlayExpr) *sqlast.OverlayExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Overlay = c.pos(n.Overlay)
+	x.Expr = c.clone(n.Expr)
+	x.Placing = c.clone(n.Placing)
+	x.From = c.clone(n.From)
+	x.For = c.clone(n.For)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneOwnedBySequenceOption(n *sqlast.OwnedBySequenceOption) *sqlast.OwnedBySequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Column = c.cloneObjectName(n.Column)
+	return &x
+}
+
+func (c *cloner) clonePGAlterDataTypeColumnAction(n *sqlast.PGAlterDataTypeColumnAction) *sqlast.PGAlterDataTypeColumnAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Type = c.pos(n.Type)
+	if n.DataType != nil {
+		x.DataType = c.clone(n.DataType).(sqlast.Type)
+	}
+	x.Using = c.clone(n.Using)
+	return &x
+}
+
+func (c *cloner) clonePGDropNotNullColumnAction(n *sqlast.PGDropNotNullColumnAction) *sqlast.PGDropNotNullColumnAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Drop = c.pos(n.Drop)
+	x.Null = c.pos(n.Null)
+	return &x
+}
+
+func (c *cloner) clonePGSetNotNullColumnAction(n *sqlast.PGSetNotNullColumnAction) *sqlast.PGSetNotNullColumnAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Set = c.pos(n.Set)
+	x.Null = c.pos(n.Null)
+	return &x
+}
+
+func (c *cloner) clonePartitionBound(n *sqlast.PartitionBound) *sqlast.PartitionBound {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	if n.In != nil {
+		x.In = make([]sqlast.Node, len(n.In))
+		for i, e := range n.In {
+			x.In[i] = c.clone(e)
+		}
+	}
+	if n.RangeFrom != nil {
+		x.RangeFrom = make([]sqlast.Node, len(n.RangeFrom))
+		for i, e := range n.RangeFrom {
+			x.RangeFrom[i] = c.clone(e)
+		}
+	}
+	if n.RangeTo != nil {
+		x.RangeTo = make([]sqlast.Node, len(n.RangeTo))
+		for i, e := range n.RangeTo {
+			x.RangeTo[i] = c.clone(e)
+		}
+	}
+	x.Modulus = c.clone(n.Modulus)
+	x.Remainder = c.clone(n.Remainder)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) clonePartitionSpec(n *sqlast.PartitionSpec) *sqlast.PartitionSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Partition = c.pos(n.Partition)
+	if n.Columns != nil {
+		x.Columns = make([]sqlast.Node, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.clone(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) clonePartitionedJoinTable(n *sqlast.PartitionedJoinTable) *sqlast.PartitionedJoinTable {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Factor != nil {
+		x.Factor = c.clone(n.Factor).(sqlast.TableFactor)
+	}
+	if n.ColumnList != nil {
+		x.ColumnList = make([]*sqlast.Ident, len(n.ColumnList))
+		for i, e := range n.ColumnList {
+			x.ColumnList[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) clonePlaceholder(n *sqlast.Placeholder) *sqlast.Placeholder {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) clonePositionExpr(n *sqlast.PositionExpr) *sqlast.PositionExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Position = c.pos(n.Position)
+	x.Substring = c.clone(n.Substring)
+	x.String = c.clone(n.String)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) clonePreceding(n *sqlast.Preceding) *sqlast.Preceding {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Bound != nil {
+		v := *n.Bound
+		x.Bound = &v
+	}
+	x.From = c.pos(n.From)
+	x.Preceding = c.pos(n.Preceding)
+	return &x
+}
+
+func (c *cloner) clonePrepareStmt(n *sqlast.PrepareStmt) *sqlast.PrepareStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Prepare = c.pos(n.Prepare)
+	x.Name = c.cloneIdent(n.Name)
+	if n.Types != nil {
+		x.Types = make([]sqlast.Type, len(n.Types))
+		for i, e := range n.Types {
+			if e != nil {
+				x.Types[i] = c.clone(e).(sqlast.Type)
+			}
+		}
+	}
+	if n.Stmt != nil {
+		x.Stmt = c.clone(n.Stmt).(sqlast.Stmt)
+	}
+	x.From = c.clone(n.From)
+	return &x
+}
+
+func (c *cloner) cloneQualifiedJoin(n *sqlast.QualifiedJoin) *sqlast.QualifiedJoin {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.LeftElement = c.cloneTableJoinElement(n.LeftElement)
+	x.Type = c.cloneJoinType(n.Type)
+	x.RightElement = c.cloneTableJoinElement(n.RightElement)
+	if n.Spec != nil {
+		x.Spec = c.clone(n.Spec).(sqlast.JoinSpec)
+	}
+	return &x
+}
+
+func (c *cloner) cloneQualifiedWildcard(n *sqlast.QualifiedWildcard) *sqlast.QualifiedWildcard {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Idents != nil {
+		x.Idents = make([]*sqlast.Ident, len(n.Idents))
+		for i, e := range n.Idents {
+			x.Idents[i] = c.cloneIdent(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneQualifiedWildcardSelectItem(n *sqlast.QualifiedWildcardSelectItem) *sqlast.QualifiedWildcardSelectItem {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Prefix = c.cloneObjectName(n.Prefix)
+	return &x
+}
+
+func (c *cloner) cloneQuantifiedComparison(n *sqlast.QuantifiedComparison) *sqlast.QuantifiedComparison {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Left = c.clone(n.Left)
+	x.Op = c.cloneOperator(n.Op)
+	x.Operand = c.clone(n.Operand)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneQueryExpr(n *sqlast.QueryExpr) *sqlast.QueryExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.LParen = c.pos(n.LParen)
+	x.RParen = c.pos(n.RParen)
+	x.Query = c.cloneQueryStmt(n.Query)
+	return &x
+}
+
+func (c *cloner) cloneQueryStmt(n *sqlast.QueryStmt) *sqlast.QueryStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.With = c.pos(n.With)
+	if n.CTEs != nil {
+		x.CTEs = make([]*sqlast.CTE, len(n.CTEs))
+		for i, e := range n.CTEs {
+			x.CTEs[i] = c.cloneCTE(e)
+		}
+	}
+	if n.Body != nil {
+		x.Body = c.clone(n.Body).(sqlast.SQLSetExpr)
+	}
+	if n.OrderBy != nil {
+		x.OrderBy = make([]*sqlast.OrderByExpr, len(n.OrderBy))
+		for i, e := range n.OrderBy {
+			x.OrderBy[i] = c.cloneOrderByExpr(e)
+		}
+	}
+	x.Limit = c.cloneLimitExpr(n.Limit)
+	x.Offset = c.cloneOffsetExpr(n.Offset)
+	x.Fetch = c.cloneFetchExpr(n.Fetch)
+	if n.Locking != nil {
+		x.Locking = make([]*sqlast.LockingClause, len(n.Locking))
+		for i, e := range n.Locking {
+			x.Locking[i] = c.cloneLockingClause(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneReal(n *sqlast.Real) *sqlast.Real {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneReferenceKeyExpr(n *sqlast.ReferenceKeyExpr) *sqlast.ReferenceKeyExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.TableName = c.cloneIdent(n.TableName)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneReferencesColumnSpec(n *sqlast.ReferencesColumnSpec) *sqlast.ReferencesColumnSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.References = c.pos(n.References)
+	x.RParen = c.pos(n.RParen)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneReferentialTableConstraint(n *sqlast.ReferentialTableConstraint) *sqlast.ReferentialTableConstraint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Foreign = c.pos(n.Foreign)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.KeyExpr = c.cloneReferenceKeyExpr(n.KeyExpr)
+	return &x
+}
+
+func (c *cloner) cloneRegclass(n *sqlast.Regclass) *sqlast.Regclass {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneReindexStmt(n *sqlast.ReindexStmt) *sqlast.ReindexStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Reindex = c.pos(n.Reindex)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.CopyOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneCopyOption(e)
+		}
+	}
+	x.Name = c.cloneObjectName(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneRemoveColumnTableAction(n *sqlast.RemoveColumnTableAction) *sqlast.RemoveColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneIdent(n.Name)
+	x.CascadePos = c.pos(n.CascadePos)
+	x.Drop = c.pos(n.Drop)
+	return &x
+}
+
+func (c *cloner) cloneRenameColumnTableAction(n *sqlast.RenameColumnTableAction) *sqlast.RenameColumnTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Rename = c.pos(n.Rename)
+	x.OldName = c.cloneIdent(n.OldName)
+	x.NewName = c.cloneIdent(n.NewName)
+	return &x
+}
+
+func (c *cloner) cloneRenameConstraintTableAction(n *sqlast.RenameConstraintTableAction) *sqlast.RenameConstraintTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Rename = c.pos(n.Rename)
+	x.OldName = c.cloneIdent(n.OldName)
+	x.NewName = c.cloneIdent(n.NewName)
+	return &x
+}
+
+func (c *cloner) cloneRenameTableAction(n *sqlast.RenameTableAction) *sqlast.RenameTableAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Rename = c.pos(n.Rename)
+	x.NewName = c.cloneObjectName(n.NewName)
+	return &x
+}
+
+func (c *cloner) cloneRestartSequenceOption(n *sqlast.RestartSequenceOption) *sqlast.RestartSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneRowValueExpr(n *sqlast.RowValueExpr) *sqlast.RowValueExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Values != nil {
+		x.Values = make([]sqlast.Node, len(n.Values))
+		for i, e := range n.Values {
+			x.Values[i] = c.clone(e)
+		}
+	}
+	x.LParen = c.pos(n.LParen)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneSQLSelect(n *sqlast.SQLSelect) *sqlast.SQLSelect {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Hint = c.cloneHintComment(n.Hint)
+	x.Top = c.cloneTopExpr(n.Top)
+	if n.Projection != nil {
+		x.Projection = make([]sqlast.SQLSelectItem, len(n.Projection))
+		for i, e := range n.Projection {
+			if e != nil {
+				x.Projection[i] = c.clone(e).(sqlast.SQLSelectItem)
+			}
+		}
+	}
+	if n.FromClause != nil {
+		x.FromClause = make([]sqlast.TableReference, len(n.FromClause))
+		for i, e := range n.FromClause {
+			if e != nil {
+				x.FromClause[i] = c.clone(e).(sqlast.TableReference)
+			}
+		}
+	}
+	x.WhereClause = c.clone(n.WhereClause)
+	if n.GroupByClause != nil {
+		x.GroupByClause = make([]sqlast.Node, len(n.GroupByClause))
+		for i, e := range n.GroupByClause {
+			x.GroupByClause[i] = c.clone(e)
+		}
+	}
+	x.HavingClause = c.clone(n.HavingClause)
+	x.QualifyClause = c.clone(n.QualifyClause)
+	x.Select = c.pos(n.Select)
+	return &x
+}
+
+func (c *cloner) cloneSQLiteWithoutRowID(n *sqlast.SQLiteWithoutRowID) *sqlast.SQLiteWithoutRowID {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Without = c.pos(n.Without)
+	x.RowID = c.pos(n.RowID)
+	return &x
+}
+
+func (c *cloner) cloneSelectExpr(n *sqlast.SelectExpr) *sqlast.SelectExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Select = c.cloneSQLSelect(n.Select)
+	return &x
+}
+
+func (c *cloner) cloneSerial(n *sqlast.Serial) *sqlast.Serial {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneSet(n *sqlast.Set) *sqlast.Set {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	if n.Values != nil {
+		x.Values = make([]*sqlast.SingleQuotedString, len(n.Values))
+		for i, e := range n.Values {
+			x.Values[i] = c.cloneSingleQuotedString(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneSetDefaultColumnAction(n *sqlast.SetDefaultColumnAction) *sqlast.SetDefaultColumnAction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Set = c.pos(n.Set)
+	x.Default = c.clone(n.Default)
+	return &x
+}
+
+func (c *cloner) cloneSetOperationExpr(n *sqlast.SetOperationExpr) *sqlast.SetOperationExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Op != nil {
+		x.Op = c.clone(n.Op).(sqlast.SQLSetOperator)
+	}
+	if n.Left != nil {
+		x.Left = c.clone(n.Left).(sqlast.SQLSetExpr)
+	}
+	if n.Right != nil {
+		x.Right = c.clone(n.Right).(sqlast.SQLSetExpr)
+	}
+	return &x
+}
+
+func (c *cloner) cloneSetVariableStmt(n *sqlast.SetVariableStmt) *sqlast.SetVariableStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Set = c.pos(n.Set)
+	x.Scope = c.cloneIdent(n.Scope)
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Values != nil {
+		x.Values = make([]sqlast.Node, len(n.Values))
+		for i, e := range n.Values {
+			x.Values[i] = c.clone(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneShowStmt(n *sqlast.ShowStmt) *sqlast.ShowStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Show = c.pos(n.Show)
+	x.Name = c.cloneObjectName(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneShowWarningsStmt(n *sqlast.ShowWarningsStmt) *sqlast.ShowWarningsStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Show = c.pos(n.Show)
+	x.To = c.pos(n.To)
+	x.Offset = c.cloneLongValue(n.Offset)
+	x.Limit = c.cloneLongValue(n.Limit)
+	return &x
+}
+
+func (c *cloner) cloneSingleQuotedString(n *sqlast.SingleQuotedString) *sqlast.SingleQuotedString {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneSmallInt(n *sqlast.SmallInt) *sqlast.SmallInt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Width != nil {
+		v := *n.Width
+		x.Width = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneSmallSerial(n *sqlast.SmallSerial) *sqlast.SmallSerial {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneStartWithSequenceOption(n *sqlast.StartWithSequenceOption) *sqlast.StartWithSequenceOption {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Start = c.pos(n.Start)
+	x.Value = c.cloneLongValue(n.Value)
+	return &x
+}
+
+func (c *cloner) cloneSubQuery(n *sqlast.SubQuery) *sqlast.SubQuery {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.RParen = c.pos(n.RParen)
+	x.LParen = c.pos(n.LParen)
+	x.Query = c.cloneQueryStmt(n.Query)
+	return &x
+}
+
+func (c *cloner) cloneSubQuerySource(n *sqlast.SubQuerySource) *sqlast.SubQuerySource {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.SubQuery = c.cloneQueryStmt(n.SubQuery)
+	return &x
+}
+
+func (c *cloner) cloneSubscript(n *sqlast.Subscript) *sqlast.Subscript {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Expr = c.clone(n.Expr)
+	x.Index = c.clone(n.Index)
+	x.Upper = c.clone(n.Upper)
+	x.RBracket = c.pos(n.RBracket)
+	return &x
+}
+
+func (c *cloner) cloneSubstringExpr(n *sqlast.SubstringExpr) *sqlast.SubstringExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Substring = c.pos(n.Substring)
+	x.Expr = c.clone(n.Expr)
+	x.From = c.clone(n.From)
+	x.For = c.clone(n.For)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneTable(n *sqlast.Table) *sqlast.Table {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneObjectName(n.Name)
+	x.Alias = c.cloneIdent(n.Alias)
+	if n.ColumnAliases != nil {
+		x.ColumnAliases = make([]*sqlast.Ident, len(n.ColumnAliases))
+		for i, e := range n.ColumnAliases {
+			x.ColumnAliases[i] = c.cloneIdent(e)
+		}
+	}
+	x.AliasRParen = c.pos(n.AliasRParen)
+	if n.Args != nil {
+		x.Args = make([]sqlast.Node, len(n.Args))
+		for i, e := range n.Args {
+			x.Args[i] = c.clone(e)
+		}
+	}
+	x.ArgsRParen = c.pos(n.ArgsRParen)
+	if n.IndexHints != nil {
+		x.IndexHints = make([]*sqlast.IndexHint, len(n.IndexHints))
+		for i, e := range n.IndexHints {
+			x.IndexHints[i] = c.cloneIndexHint(e)
+		}
+	}
+	if n.WithHints != nil {
+		x.WithHints = make([]sqlast.Node, len(n.WithHints))
+		for i, e := range n.WithHints {
+			x.WithHints[i] = c.clone(e)
+		}
+	}
+	x.WithHintsRParen = c.pos(n.WithHintsRParen)
+	return &x
+}
+
+func (c *cloner) cloneTableConstraint(n *sqlast.TableConstraint) *sqlast.TableConstraint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Constraint = c.pos(n.Constraint)
+	x.Name = c.cloneIdent(n.Name)
+	if n.Spec != nil {
+		x.Spec = c.clone(n.Spec).(sqlast.TableConstraintSpec)
+	}
+	return &x
+}
+
+func (c *cloner) cloneTableExpr(n *sqlast.TableExpr) *sqlast.TableExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Table = c.pos(n.Table)
+	x.Name = c.cloneObjectName(n.Name)
+	return &x
+}
+
+func (c *cloner) cloneTableFunction(n *sqlast.TableFunction) *sqlast.TableFunction {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.LateralPos = c.pos(n.LateralPos)
+	x.RowsFromPos = c.pos(n.RowsFromPos)
+	x.RParen = c.pos(n.RParen)
+	if n.Functions != nil {
+		x.Functions = make([]*sqlast.Function, len(n.Functions))
+		for i, e := range n.Functions {
+			x.Functions[i] = c.cloneFunction(e)
+		}
+	}
+	x.OrdinalityPos = c.pos(n.OrdinalityPos)
+	x.Alias = c.cloneIdent(n.Alias)
+	if n.ColumnAliases != nil {
+		x.ColumnAliases = make([]*sqlast.Ident, len(n.ColumnAliases))
+		for i, e := range n.ColumnAliases {
+			x.ColumnAliases[i] = c.cloneIdent(e)
+		}
+	}
+	x.AliasRParen = c.pos(n.AliasRParen)
+	return &x
+}
+
+func (c *cloner) cloneTableJoinElement(n *sqlast.TableJoinElement) *sqlast.TableJoinElement {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Ref != nil {
+		x.Ref = c.clone(n.Ref).(sqlast.TableReference)
+	}
+	return &x
+}
+
+func (c *cloner) cloneText(n *sqlast.Text) *sqlast.Text {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneTime(n *sqlast.Time) *sqlast.Time {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Precision != nil {
+		v := *n.Precision
+		x.Precision = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Zone = c.pos(n.Zone)
+	return &x
+}
+
+func (c *cloner) cloneTimeValue(n *sqlast.TimeValue) *sqlast.TimeValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneTimestamp(n *sqlast.Timestamp) *sqlast.Timestamp {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Timestamp = c.pos(n.Timestamp)
+	x.Zone = c.pos(n.Zone)
+	if n.Precision != nil {
+		v := *n.Precision
+		x.Precision = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneTimestampValue(n *sqlast.TimestampValue) *sqlast.TimestampValue {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneTinyBlob(n *sqlast.TinyBlob) *sqlast.TinyBlob {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneTinyInt(n *sqlast.TinyInt) *sqlast.TinyInt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Width != nil {
+		v := *n.Width
+		x.Width = &v
+	}
+	x.RParen = c.pos(n.RParen)
+	x.Unsigned = c.pos(n.Unsigned)
+	x.Zerofill = c.pos(n.Zerofill)
+	return &x
+}
+
+func (c *cloner) cloneTinyText(n *sqlast.TinyText) *sqlast.TinyText {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneTopExpr(n *sqlast.TopExpr) *sqlast.TopExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Top = c.pos(n.Top)
+	x.Expr = c.clone(n.Expr)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneTriggerEvent(n *sqlast.TriggerEvent) *sqlast.TriggerEvent {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneTrimExpr(n *sqlast.TrimExpr) *sqlast.TrimExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Trim = c.pos(n.Trim)
+	x.Chars = c.clone(n.Chars)
+	x.Expr = c.clone(n.Expr)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneTruncateStmt(n *sqlast.TruncateStmt) *sqlast.TruncateStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Truncate = c.pos(n.Truncate)
+	if n.TableNames != nil {
+		x.TableNames = make([]*sqlast.ObjectName, len(n.TableNames))
+		for i, e := range n.TableNames {
+			x.TableNames[i] = c.cloneObjectName(e)
+		}
+	}
+	x.IdentityPos = c.pos(n.IdentityPos)
+	x.CascadePos = c.pos(n.CascadePos)
+	return &x
+}
+
+func (c *cloner) cloneUUID(n *sqlast.UUID) *sqlast.UUID {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneUnaryExpr(n *sqlast.UnaryExpr) *sqlast.UnaryExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.Op = c.cloneOperator(n.Op)
+	x.Expr = c.clone(n.Expr)
+	return &x
+}
+
+func (c *cloner) cloneUnboundedFollowing(n *sqlast.UnboundedFollowing) *sqlast.UnboundedFollowing {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Unbounded = c.pos(n.Unbounded)
+	x.Following = c.pos(n.Following)
+	return &x
+}
+
+func (c *cloner) cloneUnboundedPreceding(n *sqlast.UnboundedPreceding) *sqlast.UnboundedPreceding {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Unbounded = c.pos(n.Unbounded)
+	x.Preceding = c.pos(n.Preceding)
+	return &x
+}
+
+func (c *cloner) cloneUnionOperator(n *sqlast.UnionOperator) *sqlast.UnionOperator {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneUniqueColumnSpec(n *sqlast.UniqueColumnSpec) *sqlast.UniqueColumnSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Primary = c.pos(n.Primary)
+	x.Key = c.pos(n.Key)
+	x.Unique = c.pos(n.Unique)
+	x.NullsTo = c.pos(n.NullsTo)
+	x.AutoIncrement = c.pos(n.AutoIncrement)
+	return &x
+}
+
+func (c *cloner) cloneUniqueTableConstraint(n *sqlast.UniqueTableConstraint) *sqlast.UniqueTableConstraint {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Primary = c.pos(n.Primary)
+	x.Unique = c.pos(n.Unique)
+	x.RParen = c.pos(n.RParen)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.CommentPos = c.pos(n.CommentPos)
+	x.Comment = c.cloneSingleQuotedString(n.Comment)
+	return &x
+}
+
+func (c *cloner) cloneUnnamedSelectItem(n *sqlast.UnnamedSelectItem) *sqlast.UnnamedSelectItem {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Node = c.clone(n.Node)
+	return &x
+}
+
+func (c *cloner) cloneUpdateStmt(n *sqlast.UpdateStmt) *sqlast.UpdateStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Update = c.pos(n.Update)
+	x.Hint = c.cloneHintComment(n.Hint)
+	x.TableName = c.cloneObjectName(n.TableName)
+	if n.Assignments != nil {
+		x.Assignments = make([]*sqlast.Assignment, len(n.Assignments))
+		for i, e := range n.Assignments {
+			x.Assignments[i] = c.cloneAssignment(e)
+		}
+	}
+	if n.FromClause != nil {
+		x.FromClause = make([]sqlast.TableReference, len(n.FromClause))
+		for i, e := range n.FromClause {
+			if e != nil {
+				x.FromClause[i] = c.clone(e).(sqlast.TableReference)
+			}
+		}
+	}
+	x.Selection = c.clone(n.Selection)
+	if n.OrderBy != nil {
+		x.OrderBy = make([]*sqlast.OrderByExpr, len(n.OrderBy))
+		for i, e := range n.OrderBy {
+			x.OrderBy[i] = c.cloneOrderByExpr(e)
+		}
+	}
+	x.Limit = c.cloneLimitExpr(n.Limit)
+	if n.Returning != nil {
+		x.Returning = make([]sqlast.SQLSelectItem, len(n.Returning))
+		for i, e := range n.Returning {
+			if e != nil {
+				x.Returning[i] = c.clone(e).(sqlast.SQLSelectItem)
+			}
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneUseStmt(n *sqlast.UseStmt) *sqlast.UseStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Use = c.pos(n.Use)
+	x.Database = c.cloneIdent(n.Database)
+	return &x
+}
+
+func (c *cloner) cloneVacuumRelation(n *sqlast.VacuumRelation) *sqlast.VacuumRelation {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Name = c.cloneObjectName(n.Name)
+	if n.Columns != nil {
+		x.Columns = make([]*sqlast.Ident, len(n.Columns))
+		for i, e := range n.Columns {
+			x.Columns[i] = c.cloneIdent(e)
+		}
+	}
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneVacuumStmt(n *sqlast.VacuumStmt) *sqlast.VacuumStmt {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Vacuum = c.pos(n.Vacuum)
+	if n.Options != nil {
+		x.Options = make([]*sqlast.CopyOption, len(n.Options))
+		for i, e := range n.Options {
+			x.Options[i] = c.cloneCopyOption(e)
+		}
+	}
+	if n.Tables != nil {
+		x.Tables = make([]*sqlast.VacuumRelation, len(n.Tables))
+		for i, e := range n.Tables {
+			x.Tables[i] = c.cloneVacuumRelation(e)
+		}
+	}
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneValuesExpr(n *sqlast.ValuesExpr) *sqlast.ValuesExpr {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Values = c.pos(n.Values)
+	if n.Rows != nil {
+		x.Rows = make([]*sqlast.RowValueExpr, len(n.Rows))
+		for i, e := range n.Rows {
+			x.Rows[i] = c.cloneRowValueExpr(e)
+		}
+	}
+	return &x
+}
+
+func (c *cloner) cloneVarbinary(n *sqlast.Varbinary) *sqlast.Varbinary {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Varbinary = c.pos(n.Varbinary)
+	x.RParen = c.pos(n.RParen)
+	return &x
+}
+
+func (c *cloner) cloneVarcharType(n *sqlast.VarcharType) *sqlast.VarcharType {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.Size != nil {
+		v := *n.Size
+		x.Size = &v
+	}
+	x.Character = c.pos(n.Character)
+	x.Varying = c.pos(n.Varying)
+	x.RParen = c.pos(n.RParen)
+	x.Charset = c.cloneIdent(n.Charset)
+	x.CharsetPos = c.pos(n.CharsetPos)
+	x.Collation = c.cloneIdent(n.Collation)
+	x.CollatePos = c.pos(n.CollatePos)
+	return &x
+}
+
+func (c *cloner) cloneWildcard(n *sqlast.Wildcard) *sqlast.Wildcard {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Wildcard = c.pos(n.Wildcard)
+	return &x
+}
+
+func (c *cloner) cloneWildcardSelectItem(n *sqlast.WildcardSelectItem) *sqlast.WildcardSelectItem {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneWindowFrame(n *sqlast.WindowFrame) *sqlast.WindowFrame {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.Units = c.cloneWindowFrameUnit(n.Units)
+	if n.StartBound != nil {
+		x.StartBound = c.clone(n.StartBound).(sqlast.SQLWindowFrameBound)
+	}
+	if n.EndBound != nil {
+		x.EndBound = c.clone(n.EndBound).(sqlast.SQLWindowFrameBound)
+	}
+	return &x
+}
+
+func (c *cloner) cloneWindowFrameUnit(n *sqlast.WindowFrameUnit) *sqlast.WindowFrameUnit {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
+
+func (c *cloner) cloneWindowSpec(n *sqlast.WindowSpec) *sqlast.WindowSpec {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	if n.PartitionBy != nil {
+		x.PartitionBy = make([]sqlast.Node, len(n.PartitionBy))
+		for i, e := range n.PartitionBy {
+			x.PartitionBy[i] = c.clone(e)
+		}
+	}
+	if n.OrderBy != nil {
+		x.OrderBy = make([]*sqlast.OrderByExpr, len(n.OrderBy))
+		for i, e := range n.OrderBy {
+			x.OrderBy[i] = c.cloneOrderByExpr(e)
+		}
+	}
+	x.WindowsFrame = c.cloneWindowFrame(n.WindowsFrame)
+	x.Partition = c.pos(n.Partition)
+	x.Order = c.pos(n.Order)
+	return &x
+}
+
+func (c *cloner) cloneYear(n *sqlast.Year) *sqlast.Year {
+	if n == nil {
+		return nil
+	}
+	x := *n
+	x.From = c.pos(n.From)
+	x.To = c.pos(n.To)
+	return &x
+}
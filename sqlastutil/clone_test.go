@@ -0,0 +1,75 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestClone(t *testing.T) {
+	src := `SELECT a, b AS c FROM t INNER JOIN u ON t.id = u.id WHERE a > 1 ORDER BY b DESC LIMIT 10;
+CREATE TABLE persons (id int PRIMARY KEY, name varchar(255) CHARACTER SET utf8 NOT NULL, age int CHECK(age > 0));
+INSERT INTO persons (id, name) VALUES (1, 'a'), (2, 'b');`
+
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	f, err := parser.ParseFile()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	t.Run("deep copy", func(t *testing.T) {
+		expect := f.ToSQLString()
+		cloned := sqlastutil.Clone(f)
+		if !reflect.DeepEqual(f, cloned) {
+			t.Fatalf("cloned node must be equal to the original")
+		}
+
+		sqlastutil.Apply(cloned, func(cursor *sqlastutil.Cursor) bool {
+			switch n := cursor.Node().(type) {
+			case *sqlast.Ident:
+				n.Value = "x"
+			case *sqlast.LongValue:
+				cursor.Replace(sqlast.NewLongValue(0))
+			}
+			return true
+		}, nil)
+
+		if act := f.ToSQLString(); act != expect {
+			t.Errorf("original must not be changed but \n %s", act)
+		}
+		if cloned.ToSQLString() == expect {
+			t.Errorf("cloned must be changed")
+		}
+	})
+
+	t.Run("without pos", func(t *testing.T) {
+		cloned := sqlastutil.CloneWithoutPos(f)
+		if cloned.ToSQLString() != f.ToSQLString() {
+			t.Errorf("should be \n %s but \n %s", f.ToSQLString(), cloned.ToSQLString())
+		}
+		sqlast.Inspect(cloned, func(node sqlast.Node) bool {
+			if node == nil {
+				return false
+			}
+			if p := node.Pos(); p != (sqltoken.Pos{}) {
+				t.Errorf("position of %T must be zero but %v", node, p)
+			}
+			return true
+		})
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		if n := sqlastutil.Clone(nil); n != nil {
+			t.Errorf("must be nil but %v", n)
+		}
+	})
+}
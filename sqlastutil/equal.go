@@ -0,0 +1,38 @@
+package sqlastutil
+
+import (
+	"reflect"
+	"unicode"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+var posType = reflect.TypeOf(sqltoken.Pos{})
+
+// IgnoreMarker is a cmp.Option which ignores unexported marker structs
+// embedded in AST nodes.
+var IgnoreMarker = cmp.FilterPath(func(paths cmp.Path) bool {
+	s := paths.Last().Type()
+	name := s.Name()
+	r := []rune(name)
+	return s.Kind() == reflect.Struct && len(r) > 0 && unicode.IsLower(r[0])
+}, cmp.Ignore())
+
+// IgnorePos is a cmp.Option which ignores all sqltoken.Pos values.
+var IgnorePos = cmp.FilterPath(func(paths cmp.Path) bool {
+	return paths.Last().Type() == posType
+}, cmp.Ignore())
+
+// EqualIgnoringPos reports whether a and b are the same tree, ignoring positions.
+func EqualIgnoringPos(a, b sqlast.Node) bool {
+	return cmp.Equal(a, b, IgnoreMarker, IgnorePos)
+}
+
+// Diff returns a human readable report of the differences between a and b.
+// Positions are ignored. Diff returns empty string when a and b are equal.
+func Diff(a, b sqlast.Node) string {
+	return cmp.Diff(a, b, IgnoreMarker, IgnorePos)
+}
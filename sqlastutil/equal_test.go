@@ -0,0 +1,64 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestEqualIgnoringPos(t *testing.T) {
+	parse := func(src string) sqlast.Stmt {
+		t.Helper()
+		parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		stmt, err := parser.ParseStatement()
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		return stmt
+	}
+
+	cases := []struct {
+		name  string
+		a     string
+		b     string
+		equal bool
+	}{
+		{
+			name:  "different positions",
+			a:     "SELECT a, b FROM t WHERE a = 1",
+			b:     "SELECT a,\n    b\nFROM t\nWHERE a = 1",
+			equal: true,
+		},
+		{
+			name:  "different literal",
+			a:     "SELECT a FROM t WHERE a = 1",
+			b:     "SELECT a FROM t WHERE a = 2",
+			equal: false,
+		},
+		{
+			name:  "different column",
+			a:     "SELECT a FROM t",
+			b:     "SELECT b FROM t",
+			equal: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			a, b := parse(c.a), parse(c.b)
+			if act := sqlastutil.EqualIgnoringPos(a, b); act != c.equal {
+				t.Errorf("must be %v but %v", c.equal, act)
+			}
+			if d := sqlastutil.Diff(a, b); (d == "") != c.equal {
+				t.Errorf("unexpected diff %s", d)
+			}
+		})
+	}
+}
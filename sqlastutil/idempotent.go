@@ -0,0 +1,127 @@
+package sqlastutil
+
+import (
+	"fmt"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// Warning reports a statement which MakeIdempotent left unchanged.
+type Warning struct {
+	Stmt    sqlast.Stmt
+	Message string
+}
+
+func (w *Warning) Pos() sqltoken.Pos {
+	return w.Stmt.Pos()
+}
+
+func (w *Warning) String() string {
+	pos := w.Pos()
+	return fmt.Sprintf("%d:%d: %s", pos.Line, pos.Col, w.Message)
+}
+
+// MakeIdempotent rewrites the CREATE / DROP statements of f so that f can be applied repeatedly,
+// adding IF NOT EXISTS, IF EXISTS or OR REPLACE where the dialect d accepts them.
+// Statements which can't be made idempotent are left as is and reported as warnings.
+func MakeIdempotent(f *sqlast.File, d dialect.Dialect) []*Warning {
+	var warnings []*Warning
+	warn := func(stmt sqlast.Stmt, format string, args ...interface{}) {
+		warnings = append(warnings, &Warning{Stmt: stmt, Message: fmt.Sprintf(format, args...)})
+	}
+
+	createIfNotExists := dialect.Supports(d, dialect.CreateIfNotExists)
+	dropIfExists := dialect.Supports(d, dialect.DropIfExists)
+
+	for _, stmt := range f.Stmts {
+		switch s := stmt.(type) {
+		case *sqlast.CreateTableStmt:
+			if !createIfNotExists {
+				warn(s, "CREATE TABLE %s: IF NOT EXISTS is not supported by the dialect", s.Name.ToSQLString())
+				continue
+			}
+			s.NotExists = true
+		case *sqlast.CreateSchemaStmt:
+			if !createIfNotExists {
+				warn(s, "CREATE SCHEMA: IF NOT EXISTS is not supported by the dialect")
+				continue
+			}
+			s.NotExists = true
+		case *sqlast.CreateSequenceStmt:
+			if !createIfNotExists {
+				warn(s, "CREATE SEQUENCE %s: IF NOT EXISTS is not supported by the dialect", s.Name.ToSQLString())
+				continue
+			}
+			s.NotExists = true
+		case *sqlast.CreateFunctionStmt:
+			if !dialect.Supports(d, dialect.CreateOrReplace) {
+				kind := "FUNCTION"
+				if s.IsProcedure {
+					kind = "PROCEDURE"
+				}
+				warn(s, "CREATE %s %s: OR REPLACE is not supported by the dialect", kind, s.Name.ToSQLString())
+				continue
+			}
+			s.OrReplace = true
+		case *sqlast.DropTableStmt:
+			if !dropIfExists {
+				warn(s, "DROP TABLE: IF EXISTS is not supported by the dialect")
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.DropSchemaStmt:
+			if !dropIfExists {
+				warn(s, "DROP SCHEMA: IF EXISTS is not supported by the dialect")
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.DropSequenceStmt:
+			if !dropIfExists {
+				warn(s, "DROP SEQUENCE: IF EXISTS is not supported by the dialect")
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.DropTriggerStmt:
+			if !dropIfExists {
+				warn(s, "DROP TRIGGER %s: IF EXISTS is not supported by the dialect", s.Name.ToSQLString())
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.CreateViewStmt:
+			if s.Materialized {
+				if !createIfNotExists {
+					warn(s, "CREATE MATERIALIZED VIEW %s: IF NOT EXISTS is not supported by the dialect", s.Name.ToSQLString())
+					continue
+				}
+				s.NotExists = true
+				continue
+			}
+			if !dialect.Supports(d, dialect.CreateOrReplace) {
+				warn(s, "CREATE VIEW %s: OR REPLACE is not supported by the dialect", s.Name.ToSQLString())
+				continue
+			}
+			s.OrReplace = true
+		case *sqlast.DropViewStmt:
+			if !dropIfExists {
+				warn(s, "DROP VIEW: IF EXISTS is not supported by the dialect")
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.CreateIndexStmt:
+			warn(s, "CREATE INDEX %s can't be made idempotent", s.IndexName.ToSQLString())
+		case *sqlast.DropIndexStmt:
+			// DROP INDEX ... ON table of MySQL doesn't accept IF EXISTS
+			if !dropIfExists || s.TableName != nil {
+				warn(s, "DROP INDEX can't be made idempotent")
+				continue
+			}
+			s.IfExists = true
+		case *sqlast.CreateTriggerStmt:
+			warn(s, "CREATE TRIGGER %s can't be made idempotent", s.Name.ToSQLString())
+		}
+	}
+
+	return warnings
+}
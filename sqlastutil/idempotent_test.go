@@ -0,0 +1,111 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestMakeIdempotent(t *testing.T) {
+	cases := []struct {
+		name     string
+		dialect  dialect.Dialect
+		src      string
+		expect   []string
+		warnings []string
+	}{
+		{
+			name:    "postgres",
+			dialect: &dialect.PostgresqlDialect{},
+			src: `CREATE TABLE t (id int);
+CREATE SCHEMA s;
+CREATE SEQUENCE seq;
+CREATE FUNCTION f() RETURNS int AS 'SELECT 1' LANGUAGE sql;
+DROP TABLE t;
+DROP SCHEMA s;
+DROP SEQUENCE seq;
+CREATE VIEW v AS SELECT 1 FROM t;
+CREATE MATERIALIZED VIEW mv AS SELECT 1 FROM t;
+DROP VIEW v;
+DROP INDEX idx;
+SELECT 1 FROM t;`,
+			expect: []string{
+				"CREATE TABLE IF NOT EXISTS t (id int)",
+				"CREATE SCHEMA IF NOT EXISTS s",
+				"CREATE SEQUENCE IF NOT EXISTS seq",
+				"CREATE OR REPLACE FUNCTION f() RETURNS int LANGUAGE sql AS 'SELECT 1'",
+				"DROP TABLE IF EXISTS t",
+				"DROP SCHEMA IF EXISTS s",
+				"DROP SEQUENCE IF EXISTS seq",
+				"CREATE OR REPLACE VIEW v AS SELECT 1 FROM t",
+				"CREATE MATERIALIZED VIEW IF NOT EXISTS mv AS SELECT 1 FROM t",
+				"DROP VIEW IF EXISTS v",
+				"DROP INDEX IF EXISTS idx",
+				"SELECT 1 FROM t",
+			},
+		},
+		{
+			name:    "not supported statements",
+			dialect: &dialect.GenericSQLDialect{},
+			src:     `CREATE INDEX idx ON t (a);`,
+			expect: []string{
+				"CREATE INDEX idx ON t (a)",
+			},
+			warnings: []string{
+				"1:1: CREATE INDEX idx can't be made idempotent",
+			},
+		},
+		{
+			name:    "mssql",
+			dialect: &dialect.MSSQLDialect{},
+			src: `CREATE TABLE t (id int);
+DROP TABLE t;
+CREATE VIEW v AS SELECT 1 FROM t;`,
+			expect: []string{
+				"CREATE TABLE t (id int)",
+				"DROP TABLE IF EXISTS t",
+				"CREATE VIEW v AS SELECT 1 FROM t",
+			},
+			warnings: []string{
+				"1:1: CREATE TABLE t: IF NOT EXISTS is not supported by the dialect",
+				"3:1: CREATE VIEW v: OR REPLACE is not supported by the dialect",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.src), c.dialect)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			f, err := parser.ParseFile()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			warnings := sqlastutil.MakeIdempotent(f, c.dialect)
+
+			if len(f.Stmts) != len(c.expect) {
+				t.Fatalf("should have %d statements but %d", len(c.expect), len(f.Stmts))
+			}
+			for i, stmt := range f.Stmts {
+				if c.expect[i] != stmt.ToSQLString() {
+					t.Errorf("should be \n %s but \n %s", c.expect[i], stmt.ToSQLString())
+				}
+			}
+
+			if len(warnings) != len(c.warnings) {
+				t.Fatalf("should have %d warnings but %d", len(c.warnings), len(warnings))
+			}
+			for i, w := range warnings {
+				if c.warnings[i] != w.String() {
+					t.Errorf("should be \n %s but \n %s", c.warnings[i], w.String())
+				}
+			}
+		})
+	}
+}
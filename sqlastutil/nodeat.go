@@ -0,0 +1,53 @@
+package sqlastutil
+
+import (
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// NodeAt returns the path of nodes which enclose pos, from root to the innermost node.
+// A node encloses pos if pos is at or after its Pos and before its End.
+// Nodes without positions, e.g. nodes made by hand, are included if their descendants enclose pos.
+// It is an error if root doesn't enclose pos.
+func NodeAt(root sqlast.Node, pos sqltoken.Pos) ([]sqlast.Node, error) {
+	path := nodeAt(root, pos)
+	if len(path) == 0 {
+		return nil, errors.Errorf("no node encloses %s", pos.String())
+	}
+	return path, nil
+}
+
+func nodeAt(node sqlast.Node, pos sqltoken.Pos) []sqlast.Node {
+	hasPos := node.Pos().Line != 0
+	if hasPos && (sqltoken.ComparePos(pos, node.Pos()) < 0 || sqltoken.ComparePos(pos, node.End()) >= 0) {
+		return nil
+	}
+
+	for _, c := range children(node) {
+		if path := nodeAt(c, pos); path != nil {
+			return append([]sqlast.Node{node}, path...)
+		}
+	}
+	if hasPos {
+		return []sqlast.Node{node}
+	}
+	return nil
+}
+
+// children returns the direct children of node.
+func children(node sqlast.Node) []sqlast.Node {
+	var nodes []sqlast.Node
+	sqlast.Inspect(node, func(n sqlast.Node) bool {
+		if n == nil {
+			return false
+		}
+		if n == node {
+			return true
+		}
+		nodes = append(nodes, n)
+		return false
+	})
+	return nodes
+}
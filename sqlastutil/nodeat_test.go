@@ -0,0 +1,106 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+func TestNodeAt(t *testing.T) {
+	src := "SELECT a, b + c\nFROM t\nWHERE d IN (SELECT e FROM u)"
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	stmt, err := parser.ParseStatement()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	cases := []struct {
+		name string
+		pos  sqltoken.Pos
+		path []string // type and SQL of each node
+	}{
+		{
+			name: "identifier in expression",
+			pos:  sqltoken.NewPos(1, 15),
+			path: []string{
+				"*sqlast.QueryStmt", "*sqlast.SQLSelect", "*sqlast.UnnamedSelectItem b + c",
+				"*sqlast.BinaryExpr b + c", "*sqlast.Ident c",
+			},
+		},
+		{
+			name: "operator",
+			pos:  sqltoken.NewPos(1, 13),
+			path: []string{
+				"*sqlast.QueryStmt", "*sqlast.SQLSelect", "*sqlast.UnnamedSelectItem b + c",
+				"*sqlast.BinaryExpr b + c", "*sqlast.Operator +",
+			},
+		},
+		{
+			name: "table",
+			pos:  sqltoken.NewPos(2, 6),
+			path: []string{
+				"*sqlast.QueryStmt", "*sqlast.SQLSelect", "*sqlast.Table t", "*sqlast.ObjectName t", "*sqlast.Ident t",
+			},
+		},
+		{
+			name: "subquery",
+			pos:  sqltoken.NewPos(3, 27),
+			path: []string{
+				"*sqlast.QueryStmt", "*sqlast.SQLSelect", "*sqlast.InSubQuery d IN (SELECT e FROM u)",
+				"*sqlast.QueryStmt SELECT e FROM u", "*sqlast.SQLSelect SELECT e FROM u",
+				"*sqlast.Table u", "*sqlast.ObjectName u", "*sqlast.Ident u",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path, err := sqlastutil.NodeAt(stmt, c.pos)
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			var act []string
+			for i, n := range path {
+				s := fmt.Sprintf("%T", n)
+				// skip SQL of the outer statement
+				if i > 1 {
+					s += " " + n.ToSQLString()
+				}
+				act = append(act, s)
+			}
+			if diff := cmp.Diff(c.path, act); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+
+	t.Run("outside", func(t *testing.T) {
+		for _, pos := range []sqltoken.Pos{sqltoken.NewPos(3, 30), sqltoken.NewPos(4, 1)} {
+			if _, err := sqlastutil.NodeAt(stmt, pos); err == nil {
+				t.Errorf("%+v must be an error", pos)
+			}
+		}
+	})
+
+	t.Run("node without position", func(t *testing.T) {
+		explain := &sqlast.ExplainStmt{Stmt: stmt}
+		path, err := sqlastutil.NodeAt(explain, sqltoken.NewPos(1, 8))
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if len(path) < 2 || path[0] != explain || path[1] != stmt {
+			t.Errorf("must be the path through the statement but %+v", path)
+		}
+	})
+}
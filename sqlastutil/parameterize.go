@@ -0,0 +1,58 @@
+package sqlastutil
+
+import (
+	"reflect"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Parameterized is the result of Parameterize.
+type Parameterized struct {
+	Stmt sqlast.Node // copy of the statement whose literals are replaced by `?`
+	// Values has an argument for each placeholder of Stmt in appearance order:
+	// the replaced sqlast.Value, or the *sqlast.Placeholder which was already in the statement.
+	Values []sqlast.Node
+	SQL    string // normalized SQL of Stmt
+}
+
+var placeholderType = reflect.TypeOf(&sqlast.Placeholder{})
+
+// Parameterize replaces literal values under stmt with `?` and extracts them.
+// stmt itself isn't modified.
+// NULL and literals which can't be a placeholder, such as function bodies,
+// are left as is. Statements which differ only in the other literals have the same SQL.
+func Parameterize(stmt sqlast.Node) *Parameterized {
+	p := &Parameterized{}
+	p.Stmt = Apply(Clone(stmt), func(c *Cursor) bool {
+		switch n := c.Node().(type) {
+		case *sqlast.Placeholder:
+			p.Values = append(p.Values, n)
+		case *sqlast.NullValue:
+		case sqlast.Value:
+			if !acceptsPlaceholder(c) {
+				return true
+			}
+			p.Values = append(p.Values, n)
+			c.Replace(&sqlast.Placeholder{From: n.Pos(), To: n.End(), Style: sqlast.QuestionPlaceholder})
+		}
+		return true
+	}, nil)
+	p.SQL = p.Stmt.ToSQLString()
+	return p
+}
+
+// acceptsPlaceholder reports whether the node at c can be replaced by a placeholder.
+func acceptsPlaceholder(c *Cursor) bool {
+	switch c.Parent().(type) {
+	case *rootNode:
+		return true
+	case *sqlast.TopExpr, *sqlast.HintComment, *sqlast.CopyOption, *sqlast.CommentOnStmt, *sqlast.CreateFunctionStmt:
+		// typed as Node but the grammar accepts only literals
+		return false
+	}
+	t := reflect.ValueOf(c.Parent()).Elem().FieldByName(c.Name()).Type()
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return placeholderType.AssignableTo(t)
+}
@@ -0,0 +1,80 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestParameterize(t *testing.T) {
+	cases := []struct {
+		name   string
+		in     string
+		out    string
+		values []string
+	}{
+		{
+			name:   "select",
+			in:     "SELECT a, 'x' FROM t WHERE b = 1 AND c IN (2.5, N'y', $1) AND d IS NULL LIMIT 10",
+			out:    "SELECT a, ? FROM t WHERE b = ? AND c IN (?, ?, $1) AND d IS NULL LIMIT ?",
+			values: []string{"'x'", "1", "2.5", "N'y'", "$1", "10"},
+		},
+		{
+			name:   "insert",
+			in:     "INSERT INTO t (a, b, c) VALUES (1, true, NULL), (2, false, 'z')",
+			out:    "INSERT INTO t (a, b, c) VALUES (?, ?, NULL), (?, ?, ?)",
+			values: []string{"1", "true", "2", "false", "'z'"},
+		},
+		{
+			name:   "update",
+			in:     "UPDATE t SET a = a + 1 WHERE b = DATE '2020-01-01'",
+			out:    "UPDATE t SET a = a + ? WHERE b = ?",
+			values: []string{"1", "DATE '2020-01-01'"},
+		},
+		{
+			name:   "searched case",
+			in:     "SELECT CASE WHEN a = 1 THEN 'x' ELSE 'y' END FROM t WHERE b = 2",
+			out:    "SELECT CASE WHEN a = ? THEN ? ELSE ? END FROM t WHERE b = ?",
+			values: []string{"1", "'x'", "'y'", "2"},
+		},
+		{
+			name:   "simple case",
+			in:     "SELECT CASE a WHEN 1 THEN 'x' END FROM t",
+			out:    "SELECT CASE a WHEN ? THEN ? END FROM t",
+			values: []string{"1", "'x'"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			p := sqlastutil.Parameterize(stmt)
+
+			if p.SQL != c.out {
+				t.Errorf("should be\n%s\nbut\n%s", c.out, p.SQL)
+			}
+			if act := stmt.ToSQLString(); act != c.in {
+				t.Errorf("stmt must not be modified but\n%s", act)
+			}
+			if len(p.Values) != len(c.values) {
+				t.Fatalf("should have %d values but %d", len(c.values), len(p.Values))
+			}
+			for i, v := range p.Values {
+				if act := v.ToSQLString(); act != c.values[i] {
+					t.Errorf("value %d should be %s but %s", i, c.values[i], act)
+				}
+			}
+		})
+	}
+}
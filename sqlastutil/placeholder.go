@@ -0,0 +1,71 @@
+package sqlastutil
+
+import (
+	"strconv"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Binding maps a placeholder rewritten by RebindPlaceholders to the original one.
+type Binding struct {
+	Placeholder *sqlast.Placeholder // placeholder in the rewritten tree
+	Original    sqlast.Placeholder  // placeholder before rewriting
+}
+
+// RebindPlaceholders rewrites every placeholder under node into style in place
+// and returns the bindings in the order the new placeholders take arguments.
+//
+//   - QuestionPlaceholder: each `?` takes its own argument, so a parameter used twice,
+//     such as $1 or :name, results in two bindings.
+//   - DollarPlaceholder: parameters are numbered $1..$n in appearance order.
+//     Each `?` is a new parameter, $n and named ones share the number by their index and name.
+//   - ColonPlaceholder and AtPlaceholder: named parameters keep their names,
+//     the others are named p1..pn by their number.
+func RebindPlaceholders(node sqlast.Node, style sqlast.PlaceholderStyle) []*Binding {
+	var placeholders []*sqlast.Placeholder
+	sqlast.Inspect(node, func(node sqlast.Node) bool {
+		if p, ok := node.(*sqlast.Placeholder); ok {
+			placeholders = append(placeholders, p)
+		}
+		return true
+	})
+
+	var bindings []*Binding
+	index := make(map[string]int) // parameter key -> 1-origin number
+	for i, p := range placeholders {
+		original := *p
+
+		key := "?" + strconv.Itoa(i)
+		switch p.Style {
+		case sqlast.DollarPlaceholder:
+			key = "$" + strconv.Itoa(p.Index)
+		case sqlast.ColonPlaceholder, sqlast.AtPlaceholder:
+			key = ":" + p.Name
+		}
+		n, seen := index[key]
+		if !seen {
+			n = len(index) + 1
+			index[key] = n
+		}
+
+		p.Style = style
+		p.Index = 0
+		p.Name = ""
+		switch style {
+		case sqlast.DollarPlaceholder:
+			p.Index = n
+		case sqlast.ColonPlaceholder, sqlast.AtPlaceholder:
+			if original.Style == sqlast.ColonPlaceholder || original.Style == sqlast.AtPlaceholder {
+				p.Name = original.Name
+			} else {
+				p.Name = "p" + strconv.Itoa(n)
+			}
+		}
+
+		if seen && style != sqlast.QuestionPlaceholder {
+			continue
+		}
+		bindings = append(bindings, &Binding{Placeholder: p, Original: original})
+	}
+	return bindings
+}
@@ -0,0 +1,108 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestRebindPlaceholders(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       string
+		style    sqlast.PlaceholderStyle
+		out      string
+		original []string // original placeholder of each binding
+	}{
+		{
+			name:     "question to dollar",
+			in:       "SELECT * FROM t WHERE a = ? AND b IN (?, ?)",
+			style:    sqlast.DollarPlaceholder,
+			out:      "SELECT * FROM t WHERE a = $1 AND b IN ($2, $3)",
+			original: []string{"?", "?", "?"},
+		},
+		{
+			name:     "dollar to question",
+			in:       "SELECT * FROM t WHERE a = $2 OR b = $1 OR c = $2",
+			style:    sqlast.QuestionPlaceholder,
+			out:      "SELECT * FROM t WHERE a = ? OR b = ? OR c = ?",
+			original: []string{"$2", "$1", "$2"},
+		},
+		{
+			name:     "named to dollar",
+			in:       "UPDATE t SET a = :a, b = :b WHERE a = :a",
+			style:    sqlast.DollarPlaceholder,
+			out:      "UPDATE t SET a = $1, b = $2 WHERE a = $1",
+			original: []string{":a", ":b"},
+		},
+		{
+			name:     "question to named",
+			in:       "INSERT INTO t (a, b) VALUES (?, ?)",
+			style:    sqlast.ColonPlaceholder,
+			out:      "INSERT INTO t (a, b) VALUES (:p1, :p2)",
+			original: []string{"?", "?"},
+		},
+		{
+			name:     "colon to at",
+			in:       "SELECT * FROM t WHERE a = :a AND b = $1 AND c = :a",
+			style:    sqlast.AtPlaceholder,
+			out:      "SELECT * FROM t WHERE a = @a AND b = @p2 AND c = @a",
+			original: []string{":a", "$1"},
+		},
+		{
+			name:     "case",
+			in:       "SELECT CASE a WHEN ? THEN ? ELSE ? END FROM t WHERE b = ?",
+			style:    sqlast.DollarPlaceholder,
+			out:      "SELECT CASE a WHEN $1 THEN $2 ELSE $3 END FROM t WHERE b = $4",
+			original: []string{"?", "?", "?", "?"},
+		},
+		{
+			name:     "subquery",
+			in:       "SELECT * FROM t WHERE a = ? AND b IN (SELECT b FROM u WHERE c = ?) AND EXISTS (SELECT 1 FROM v WHERE d = ?)",
+			style:    sqlast.DollarPlaceholder,
+			out:      "SELECT * FROM t WHERE a = $1 AND b IN (SELECT b FROM u WHERE c = $2) AND EXISTS (SELECT 1 FROM v WHERE d = $3)",
+			original: []string{"?", "?", "?"},
+		},
+		{
+			name:     "values",
+			in:       "INSERT INTO t (a, b) VALUES ($1, $2), ($3, $1)",
+			style:    sqlast.QuestionPlaceholder,
+			out:      "INSERT INTO t (a, b) VALUES (?, ?), (?, ?)",
+			original: []string{"$1", "$2", "$3", "$1"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			bindings := sqlastutil.RebindPlaceholders(stmt, c.style)
+
+			if act := stmt.ToSQLString(); act != c.out {
+				t.Errorf("should be\n%s\nbut\n%s", c.out, act)
+			}
+			if len(bindings) != len(c.original) {
+				t.Fatalf("should have %d bindings but %d", len(c.original), len(bindings))
+			}
+			for i, b := range bindings {
+				if act := b.Original.ToSQLString(); act != c.original[i] {
+					t.Errorf("binding %d should be %s but %s", i, c.original[i], act)
+				}
+				if b.Placeholder.Style != c.style {
+					t.Errorf("binding %d has style %d", i, b.Placeholder.Style)
+				}
+			}
+		})
+	}
+}
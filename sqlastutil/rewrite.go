@@ -2,19 +2,23 @@ package sqlastutil
 
 import (
 	"log"
-	"reflect"
 
 	"github.com/akito0107/xsqlparser/sqlast"
 )
 
+//go:generate genapply
+
 type ApplyFunc func(*Cursor) bool
 
 var abort = new(int)
 
+// rootNode holds the root node given to Apply so that it can be replaced.
+type rootNode struct {
+	sqlast.Node
+}
+
 func Apply(root sqlast.Node, pre, post ApplyFunc) (result sqlast.Node) {
-	parent := &struct {
-		sqlast.Node
-	}{root}
+	parent := &rootNode{root}
 
 	defer func() {
 		if r := recover(); r != nil && r != abort {
@@ -47,16 +51,20 @@ func (c *Cursor) Index() int {
 	return -1
 }
 
-func (c *Cursor) field() reflect.Value {
-	return reflect.Indirect(reflect.ValueOf(c.parent)).FieldByName(c.name)
+func (c *Cursor) list() nodeList {
+	return listField(c.parent, c.name)
 }
 
 func (c *Cursor) Replace(n sqlast.Node) {
-	v := c.field()
 	if i := c.Index(); i >= 0 {
-		v = v.Index(i)
+		c.list().Set(i, n)
+		return
 	}
-	v.Set(reflect.ValueOf(n))
+	if r, ok := c.parent.(*rootNode); ok {
+		r.Node = n
+		return
+	}
+	setField(c.parent, c.name, n)
 }
 
 func (c *Cursor) Delete() {
@@ -64,12 +72,7 @@ func (c *Cursor) Delete() {
 	if i < 0 {
 		log.Panicln("delete node not contained in slice")
 	}
-	v := c.field()
-	l := v.Len()
-
-	reflect.Copy(v.Slice(i, l), v.Slice(i+1, l))
-	v.Index(l - 1).Set(reflect.Zero(v.Type().Elem()))
-	v.SetLen(l - 1)
+	c.list().Delete(i)
 	c.iter.step--
 }
 
@@ -78,11 +81,7 @@ func (c *Cursor) InsertAfter(n sqlast.Node) {
 	if i < 0 {
 		log.Panicln("InsertAfter node not contained in slice")
 	}
-	v := c.field()
-	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
-	l := v.Len()
-	reflect.Copy(v.Slice(i+2, l), v.Slice(i+1, l))
-	v.Index(i + 1).Set(reflect.ValueOf(n))
+	c.list().Insert(i+1, n)
 	c.iter.step++
 }
 
@@ -91,14 +90,19 @@ func (c *Cursor) InsertBefore(n sqlast.Node) {
 	if i < 0 {
 		log.Panicln("InsertBefore node not contained in slice")
 	}
-	v := c.field()
-	v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
-	l := v.Len()
-	reflect.Copy(v.Slice(i+1, l), v.Slice(i, l))
-	v.Index(i).Set(reflect.ValueOf(n))
+	c.list().Insert(i, n)
 	c.iter.index++
 }
 
+// nodeList is a slice field of a node.
+type nodeList interface {
+	Len() int
+	At(i int) sqlast.Node
+	Set(i int, n sqlast.Node)
+	Delete(i int)
+	Insert(i int, n sqlast.Node)
+}
+
 type iterator struct {
 	index, step int
 }
@@ -110,7 +114,7 @@ type application struct {
 }
 
 func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n sqlast.Node) {
-	if v := reflect.ValueOf(n); v.Kind() == reflect.Ptr && v.IsNil() {
+	if isNil(n) {
 		n = nil
 	}
 
@@ -132,6 +136,8 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		// nothing to do
 	case *sqlast.Wildcard:
 		// nothing to do
+	case *sqlast.Placeholder:
+		// nothing to do
 	case *sqlast.QualifiedWildcard:
 		a.applyList(n, "Idents")
 	case *sqlast.CompoundIdent:
@@ -140,6 +146,9 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "X", nil, n.X)
 	case *sqlast.IsNotNull:
 		a.apply(n, "X", nil, n.X)
+	case *sqlast.IsOf:
+		a.apply(n, "X", nil, n.X)
+		a.applyList(n, "Types")
 	case *sqlast.InList:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.applyList(n, "List")
@@ -154,9 +163,52 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Left", nil, n.Left)
 		a.apply(n, "Op", nil, n.Op)
 		a.apply(n, "Right", nil, n.Right)
+		if n.Escape != nil {
+			a.apply(n, "Escape", nil, n.Escape)
+		}
 	case *sqlast.Cast:
 		a.apply(n, "Expr", nil, n.Expr)
 		a.apply(n, "DataType", nil, n.DataType)
+	case *sqlast.QuantifiedComparison:
+		a.apply(n, "Left", nil, n.Left)
+		a.apply(n, "Op", nil, n.Op)
+		a.apply(n, "Operand", nil, n.Operand)
+	case *sqlast.ExtractExpr:
+		a.apply(n, "Field", nil, n.Field)
+		a.apply(n, "Source", nil, n.Source)
+	case *sqlast.PositionExpr:
+		a.apply(n, "Substring", nil, n.Substring)
+		a.apply(n, "String", nil, n.String)
+	case *sqlast.SubstringExpr:
+		a.apply(n, "Expr", nil, n.Expr)
+		if n.From != nil {
+			a.apply(n, "From", nil, n.From)
+		}
+		if n.For != nil {
+			a.apply(n, "For", nil, n.For)
+		}
+	case *sqlast.TrimExpr:
+		if n.Chars != nil {
+			a.apply(n, "Chars", nil, n.Chars)
+		}
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.OverlayExpr:
+		a.apply(n, "Expr", nil, n.Expr)
+		a.apply(n, "Placing", nil, n.Placing)
+		a.apply(n, "From", nil, n.From)
+		if n.For != nil {
+			a.apply(n, "For", nil, n.For)
+		}
+	case *sqlast.Subscript:
+		a.apply(n, "Expr", nil, n.Expr)
+		if n.Index != nil {
+			a.apply(n, "Index", nil, n.Index)
+		}
+		if n.Upper != nil {
+			a.apply(n, "Upper", nil, n.Upper)
+		}
+	case *sqlast.ArrayConstructor:
+		a.applyList(n, "Elements")
 	case *sqlast.Nested:
 		a.apply(n, "AST", nil, n.AST)
 	case *sqlast.UnaryExpr:
@@ -169,11 +221,18 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 			a.apply(n, "Over", nil, n.Over)
 		}
 	case *sqlast.CaseExpr:
-		a.apply(n, "Operand", nil, n.Operand)
+		if n.Operand != nil {
+			a.apply(n, "Operand", nil, n.Operand)
+		}
+		a.applyList(n, "Conditions")
+		a.applyList(n, "Results")
+		if n.ElseResult != nil {
+			a.apply(n, "ElseResult", nil, n.ElseResult)
+		}
 	case *sqlast.Exists:
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.apply(n, "Query", nil, n.Query)
 	case *sqlast.SubQuery:
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.apply(n, "Query", nil, n.Query)
 	case *sqlast.ObjectName:
 		a.applyList(n, "Idents")
 	case *sqlast.WindowSpec:
@@ -202,13 +261,25 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.Limit != nil {
 			a.apply(n, "Limit", nil, n.Limit)
 		}
+		if n.Offset != nil {
+			a.apply(n, "Offset", nil, n.Offset)
+		}
+		if n.Fetch != nil {
+			a.apply(n, "Fetch", nil, n.Fetch)
+		}
+		a.applyList(n, "Locking")
 	case *sqlast.CTE:
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.apply(n, "Query", nil, n.Query)
 		a.apply(n, "Alias", nil, n.Alias)
+		a.applyList(n, "Columns")
 	case *sqlast.SelectExpr:
 		a.apply(n, "Select", nil, n.Select)
 	case *sqlast.QueryExpr:
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.apply(n, "Query", nil, n.Query)
+	case *sqlast.ValuesExpr:
+		a.applyList(n, "Rows")
+	case *sqlast.TableExpr:
+		a.apply(n, "Name", nil, n.Name)
 	case *sqlast.SetOperationExpr:
 		a.apply(n, "Op", nil, n.Op)
 		a.apply(n, "Left", nil, n.Left)
@@ -220,6 +291,12 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.IntersectOperator:
 		// nothing to do
 	case *sqlast.SQLSelect:
+		if n.Hint != nil {
+			a.apply(n, "Hint", nil, n.Hint)
+		}
+		if n.Top != nil {
+			a.apply(n, "Top", nil, n.Top)
+		}
 		a.applyList(n, "Projection")
 		a.applyList(n, "FromClause")
 		if n.WhereClause != nil {
@@ -229,6 +306,21 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.HavingClause != nil {
 			a.apply(n, "HavingClause", nil, n.HavingClause)
 		}
+		if n.QualifyClause != nil {
+			a.apply(n, "QualifyClause", nil, n.QualifyClause)
+		}
+	case *sqlast.TopExpr:
+		a.apply(n, "Expr", nil, n.Expr)
+	case *sqlast.HintComment:
+		// nothing to do
+	case *sqlast.OffsetExpr:
+		a.apply(n, "Value", nil, n.Value)
+	case *sqlast.FetchExpr:
+		if n.Quantity != nil {
+			a.apply(n, "Quantity", nil, n.Quantity)
+		}
+	case *sqlast.LockingClause:
+		a.applyList(n, "Of")
 	case *sqlast.QualifiedJoin:
 		a.apply(n, "LeftElement", nil, n.LeftElement)
 		a.apply(n, "Type", nil, n.Type)
@@ -252,13 +344,24 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.Alias != nil {
 			a.apply(n, "Alias", nil, n.Alias)
 		}
+		a.applyList(n, "ColumnAliases")
 		a.applyList(n, "Args")
+		a.applyList(n, "IndexHints")
 		a.applyList(n, "WithHints")
+	case *sqlast.IndexHint:
+		a.applyList(n, "Indexes")
 	case *sqlast.Derived:
 		a.apply(n, "SubQuery", nil, n.SubQuery)
 		if n.Alias != nil {
 			a.apply(n, "Alias", nil, n.Alias)
 		}
+		a.applyList(n, "ColumnAliases")
+	case *sqlast.TableFunction:
+		a.applyList(n, "Functions")
+		if n.Alias != nil {
+			a.apply(n, "Alias", nil, n.Alias)
+		}
+		a.applyList(n, "ColumnAliases")
 	case *sqlast.UnnamedSelectItem:
 		a.apply(n, "Node", nil, n.Node)
 	case *sqlast.AliasSelectItem:
@@ -278,8 +381,10 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 			a.apply(n, "OffsetValue", nil, n.OffsetValue)
 		}
 	case *sqlast.CharType:
-		// nothing to do
+		a.applyCharsetCollation(n, &n.CharsetCollation)
 	case *sqlast.VarcharType:
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.NVarcharType:
 		// nothing to do
 	case *sqlast.UUID:
 		// nothing to do
@@ -301,6 +406,12 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		// nothing to do
 	case *sqlast.BigInt:
 		// nothing to do
+	case *sqlast.SmallSerial:
+		// nothing to do
+	case *sqlast.Serial:
+		// nothing to do
+	case *sqlast.BigSerial:
+		// nothing to do
 	case *sqlast.Real:
 		// nothing to do
 	case *sqlast.Double:
@@ -316,9 +427,37 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.Regclass:
 		// nothing to do
 	case *sqlast.Text:
-		// nothing to do
+		a.applyCharsetCollation(n, &n.CharsetCollation)
 	case *sqlast.Bytea:
 		// nothing to do
+	case *sqlast.TinyInt:
+		// nothing to do
+	case *sqlast.MediumInt:
+		// nothing to do
+	case *sqlast.JSON:
+		// nothing to do
+	case *sqlast.DateTime:
+		// nothing to do
+	case *sqlast.Year:
+		// nothing to do
+	case *sqlast.Enum:
+		a.applyList(n, "Values")
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.Set:
+		a.applyList(n, "Values")
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.TinyText:
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.MediumText:
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.LongText:
+		a.applyCharsetCollation(n, &n.CharsetCollation)
+	case *sqlast.TinyBlob:
+		// nothing to do
+	case *sqlast.MediumBlob:
+		// nothing to do
+	case *sqlast.LongBlob:
+		// nothing to do
 	case *sqlast.Array:
 		// nothing to do
 	case *sqlast.Custom:
@@ -328,6 +467,22 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.applyList(n, "Columns")
 		a.apply(n, "Source", nil, n.Source)
 		a.applyList(n, "UpdateAssignments")
+		if n.OnConflict != nil {
+			a.apply(n, "OnConflict", nil, n.OnConflict)
+		}
+		a.applyList(n, "Returning")
+	case *sqlast.OnConflict:
+		a.applyList(n, "Columns")
+		if n.TargetWhere != nil {
+			a.apply(n, "TargetWhere", nil, n.TargetWhere)
+		}
+		if n.Constraint != nil {
+			a.apply(n, "Constraint", nil, n.Constraint)
+		}
+		a.applyList(n, "Assignments")
+		if n.Selection != nil {
+			a.apply(n, "Selection", nil, n.Selection)
+		}
 	case *sqlast.ConstructorSource:
 		a.applyList(n, "Rows")
 	case *sqlast.RowValueExpr:
@@ -337,21 +492,91 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	case *sqlast.CopyStmt:
 		a.apply(n, "TableName", nil, n.TableName)
 		a.applyList(n, "Columns")
+		if n.File != nil {
+			a.apply(n, "File", nil, n.File)
+		}
+		a.applyList(n, "Options")
+	case *sqlast.CopyOption:
+		a.apply(n, "Name", nil, n.Name)
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
 	case *sqlast.UpdateStmt:
+		if n.Hint != nil {
+			a.apply(n, "Hint", nil, n.Hint)
+		}
 		a.apply(n, "TableName", nil, n.TableName)
 		a.applyList(n, "Assignments")
+		a.applyList(n, "FromClause")
 		a.apply(n, "Selection", nil, n.Selection)
+		a.applyList(n, "OrderBy")
+		if n.Limit != nil {
+			a.apply(n, "Limit", nil, n.Limit)
+		}
+		a.applyList(n, "Returning")
 	case *sqlast.DeleteStmt:
+		if n.Hint != nil {
+			a.apply(n, "Hint", nil, n.Hint)
+		}
 		a.apply(n, "TableName", nil, n.TableName)
+		a.applyList(n, "Using")
 		if n.Selection != nil {
 			a.apply(n, "Selection", nil, n.Selection)
 		}
+		a.applyList(n, "OrderBy")
+		if n.Limit != nil {
+			a.apply(n, "Limit", nil, n.Limit)
+		}
+		a.applyList(n, "Returning")
 	case *sqlast.CreateViewStmt:
 		a.apply(n, "Name", nil, n.Name)
-		a.apply(n, "QueryStmt", nil, n.Query)
+		a.applyList(n, "Columns")
+		a.apply(n, "Query", nil, n.Query)
+	case *sqlast.AlterViewStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Columns")
+		if n.Query != nil {
+			a.apply(n, "Query", nil, n.Query)
+		}
+		if n.NewName != nil {
+			a.apply(n, "NewName", nil, n.NewName)
+		}
+	case *sqlast.DropViewStmt:
+		a.applyList(n, "ViewNames")
 	case *sqlast.CreateTableStmt:
 		a.apply(n, "Name", nil, n.Name)
+		if n.PartitionOf != nil {
+			a.apply(n, "PartitionOf", nil, n.PartitionOf)
+		}
 		a.applyList(n, "Elements")
+		if n.Inherits != nil {
+			a.apply(n, "Inherits", nil, n.Inherits)
+		}
+		if n.Bound != nil {
+			a.apply(n, "Bound", nil, n.Bound)
+		}
+		if n.PartitionBy != nil {
+			a.apply(n, "PartitionBy", nil, n.PartitionBy)
+		}
+	case *sqlast.LikeTableElement:
+		a.apply(n, "Source", nil, n.Source)
+		a.applyList(n, "Options")
+	case *sqlast.LikeOption:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.PartitionSpec:
+		a.applyList(n, "Columns")
+	case *sqlast.InheritsClause:
+		a.applyList(n, "Parents")
+	case *sqlast.PartitionBound:
+		a.applyList(n, "In")
+		a.applyList(n, "RangeFrom")
+		a.applyList(n, "RangeTo")
+		if n.Modulus != nil {
+			a.apply(n, "Modulus", nil, n.Modulus)
+		}
+		if n.Remainder != nil {
+			a.apply(n, "Remainder", nil, n.Remainder)
+		}
 	case *sqlast.Assignment:
 		a.apply(n, "ID", nil, n.ID)
 		a.apply(n, "Value", nil, n.Value)
@@ -360,8 +585,24 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 			a.apply(n, "Name", nil, n.Name)
 		}
 		a.apply(n, "Spec", nil, n.Spec)
+	case *sqlast.IndexTableElement:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		a.applyList(n, "Columns")
+		if n.Using != nil {
+			a.apply(n, "Using", nil, n.Using)
+		}
+		if n.Comment != nil {
+			a.apply(n, "Comment", nil, n.Comment)
+		}
+	case *sqlast.IndexColumn:
+		a.apply(n, "Name", nil, n.Name)
 	case *sqlast.UniqueTableConstraint:
 		a.applyList(n, "Columns")
+		if n.Comment != nil {
+			a.apply(n, "Comment", nil, n.Comment)
+		}
 	case *sqlast.ReferentialTableConstraint:
 		a.applyList(n, "Columns")
 		a.apply(n, "KeyExpr", nil, n.KeyExpr)
@@ -372,7 +613,9 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Expr", nil, n.Expr)
 	case *sqlast.ColumnDef:
 		a.apply(n, "Name", nil, n.Name)
-		a.apply(n, "DataType", nil, n.DataType)
+		if n.DataType != nil {
+			a.apply(n, "DataType", nil, n.DataType)
+		}
 		if n.Default != nil {
 			a.apply(n, "Default", nil, n.Default)
 		}
@@ -393,7 +636,7 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Expr", nil, n.Expr)
 	case *sqlast.AlterTableStmt:
 		a.apply(n, "TableName", nil, n.TableName)
-		a.apply(n, "Action", nil, n.Action)
+		a.applyList(n, "Actions")
 	case *sqlast.AddColumnTableAction:
 		a.apply(n, "Column", nil, n.Column)
 	case *sqlast.AlterColumnTableAction:
@@ -405,6 +648,9 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		// nothing to do
 	case *sqlast.PGAlterDataTypeColumnAction:
 		a.apply(n, "DataType", nil, n.DataType)
+		if n.Using != nil {
+			a.apply(n, "Using", nil, n.Using)
+		}
 	case *sqlast.PGSetNotNullColumnAction:
 		// nothing to do
 	case *sqlast.PGDropNotNullColumnAction:
@@ -415,8 +661,124 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		a.apply(n, "Constraint", nil, n.Constraint)
 	case *sqlast.DropConstraintTableAction:
 		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.AttachPartitionTableAction:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Bound", nil, n.Bound)
+	case *sqlast.DetachPartitionTableAction:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.RenameTableAction:
+		a.apply(n, "NewName", nil, n.NewName)
+	case *sqlast.RenameColumnTableAction:
+		a.apply(n, "OldName", nil, n.OldName)
+		a.apply(n, "NewName", nil, n.NewName)
+	case *sqlast.RenameConstraintTableAction:
+		a.apply(n, "OldName", nil, n.OldName)
+		a.apply(n, "NewName", nil, n.NewName)
+	case *sqlast.MyModifyColumnTableAction:
+		a.apply(n, "Column", nil, n.Column)
+		if n.Position != nil {
+			a.apply(n, "Position", nil, n.Position)
+		}
+	case *sqlast.MyDropPrimaryKeyTableAction:
+		// nothing to do
+	case *sqlast.MyChangeColumnTableAction:
+		a.apply(n, "OldName", nil, n.OldName)
+		a.apply(n, "Column", nil, n.Column)
+		if n.Position != nil {
+			a.apply(n, "Position", nil, n.Position)
+		}
+	case *sqlast.MyColumnPosition:
+		if n.After != nil {
+			a.apply(n, "After", nil, n.After)
+		}
 	case *sqlast.DropTableStmt:
 		a.applyList(n, "TableNames")
+	case *sqlast.CreateSchemaStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		if n.Authorization != nil {
+			a.apply(n, "Authorization", nil, n.Authorization)
+		}
+		a.applyList(n, "Elements")
+	case *sqlast.DropSchemaStmt:
+		a.applyList(n, "SchemaNames")
+	case *sqlast.CreateSequenceStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Options")
+	case *sqlast.AlterSequenceStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Options")
+	case *sqlast.DropSequenceStmt:
+		a.applyList(n, "SequenceNames")
+	case *sqlast.AsSequenceOption:
+		a.apply(n, "DataType", nil, n.DataType)
+	case *sqlast.IncrementBySequenceOption:
+		a.apply(n, "Value", nil, n.Value)
+	case *sqlast.MinValueSequenceOption:
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
+	case *sqlast.MaxValueSequenceOption:
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
+	case *sqlast.StartWithSequenceOption:
+		a.apply(n, "Value", nil, n.Value)
+	case *sqlast.RestartSequenceOption:
+		if n.Value != nil {
+			a.apply(n, "Value", nil, n.Value)
+		}
+	case *sqlast.CacheSequenceOption:
+		a.apply(n, "Value", nil, n.Value)
+	case *sqlast.CycleSequenceOption:
+		// nothing to do
+	case *sqlast.OwnedBySequenceOption:
+		if n.Column != nil {
+			a.apply(n, "Column", nil, n.Column)
+		}
+	case *sqlast.CreateFunctionStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Args")
+		if n.Returns != nil {
+			a.apply(n, "Returns", nil, n.Returns)
+		}
+		if n.Language != nil {
+			a.apply(n, "Language", nil, n.Language)
+		}
+		a.applyList(n, "Behaviors")
+		if n.Body != nil {
+			a.apply(n, "Body", nil, n.Body)
+		}
+	case *sqlast.FunctionArg:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		a.apply(n, "DataType", nil, n.DataType)
+		if n.Default != nil {
+			a.apply(n, "Default", nil, n.Default)
+		}
+	case *sqlast.FunctionReturns:
+		if n.DataType != nil {
+			a.apply(n, "DataType", nil, n.DataType)
+		}
+		a.applyList(n, "Columns")
+	case *sqlast.CreateTriggerStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Events")
+		a.apply(n, "TableName", nil, n.TableName)
+		if n.When != nil {
+			a.apply(n, "When", nil, n.When)
+		}
+		a.apply(n, "Function", nil, n.Function)
+		a.applyList(n, "Args")
+	case *sqlast.TriggerEvent:
+		a.applyList(n, "Columns")
+	case *sqlast.DropTriggerStmt:
+		a.apply(n, "Name", nil, n.Name)
+		if n.TableName != nil {
+			a.apply(n, "TableName", nil, n.TableName)
+		}
 	case *sqlast.CreateIndexStmt:
 		a.apply(n, "TableName", nil, n.TableName)
 		if n.IndexName != nil {
@@ -425,14 +787,109 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		if n.MethodName != nil {
 			a.apply(n, "MethodName", nil, n.MethodName)
 		}
-		a.applyList(n, "ColumnNames")
+		a.applyList(n, "Columns")
+		a.applyList(n, "Include")
+		if n.Comment != nil {
+			a.apply(n, "Comment", nil, n.Comment)
+		}
 		if n.Selection != nil {
 			a.apply(n, "Selection", nil, n.Selection)
 		}
+	case *sqlast.IndexElement:
+		a.apply(n, "Expr", nil, n.Expr)
+		if n.Collation != nil {
+			a.apply(n, "Collation", nil, n.Collation)
+		}
+		if n.OpClass != nil {
+			a.apply(n, "OpClass", nil, n.OpClass)
+		}
 	case *sqlast.DropIndexStmt:
 		a.applyList(n, "IndexNames")
+		if n.TableName != nil {
+			a.apply(n, "TableName", nil, n.TableName)
+		}
+	case *sqlast.TruncateStmt:
+		a.applyList(n, "TableNames")
+	case *sqlast.SetVariableStmt:
+		if n.Scope != nil {
+			a.apply(n, "Scope", nil, n.Scope)
+		}
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+		a.applyList(n, "Values")
+	case *sqlast.ShowStmt:
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.ShowWarningsStmt:
+		if n.Offset != nil {
+			a.apply(n, "Offset", nil, n.Offset)
+		}
+		if n.Limit != nil {
+			a.apply(n, "Limit", nil, n.Limit)
+		}
+	case *sqlast.KillStmt:
+		a.apply(n, "ID", nil, n.ID)
+	case *sqlast.UseStmt:
+		a.apply(n, "Database", nil, n.Database)
+	case *sqlast.CommentOnStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "Text", nil, n.Text)
 	case *sqlast.ExplainStmt:
-		a.apply(n, "Stmt", nil, n.Stmt)
+		a.applyList(n, "Options")
+		if n.Format != nil {
+			a.apply(n, "Format", nil, n.Format)
+		}
+		if n.Table != nil {
+			a.apply(n, "Table", nil, n.Table)
+		} else {
+			a.apply(n, "Stmt", nil, n.Stmt)
+		}
+	case *sqlast.PrepareStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Types")
+		if n.From != nil {
+			a.apply(n, "From", nil, n.From)
+		} else {
+			a.apply(n, "Stmt", nil, n.Stmt)
+		}
+	case *sqlast.ExecuteStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Args")
+	case *sqlast.DeallocateStmt:
+		if n.Name != nil {
+			a.apply(n, "Name", nil, n.Name)
+		}
+	case *sqlast.VacuumRelation:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Columns")
+	case *sqlast.VacuumStmt:
+		a.applyList(n, "Options")
+		a.applyList(n, "Tables")
+	case *sqlast.AnalyzeStmt:
+		a.applyList(n, "Options")
+		a.applyList(n, "Tables")
+	case *sqlast.ReindexStmt:
+		a.applyList(n, "Options")
+		a.apply(n, "Name", nil, n.Name)
+	case *sqlast.CreateTypeStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.applyList(n, "Labels")
+		a.applyList(n, "Attributes")
+	case *sqlast.CreateDomainStmt:
+		a.apply(n, "Name", nil, n.Name)
+		a.apply(n, "DataType", nil, n.DataType)
+		if n.Default != nil {
+			a.apply(n, "Default", nil, n.Default)
+		}
+		a.applyList(n, "Constraints")
+	case *sqlast.CreateExtensionStmt:
+		a.apply(n, "Name", nil, n.Name)
+		if n.Schema != nil {
+			a.apply(n, "Schema", nil, n.Schema)
+		}
+		if n.Version != nil {
+			a.apply(n, "Version", nil, n.Version)
+		}
 	case *sqlast.Operator:
 		// nothing to do
 	case *sqlast.NullValue,
@@ -440,6 +897,9 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 		*sqlast.DoubleValue,
 		*sqlast.SingleQuotedString,
 		*sqlast.NationalStringLiteral,
+		*sqlast.HexStringLiteral,
+		*sqlast.BitStringLiteral,
+		*sqlast.DollarQuotedString,
 		*sqlast.BooleanValue,
 		*sqlast.DateValue,
 		*sqlast.TimeValue,
@@ -456,22 +916,22 @@ func (a *application) apply(parent sqlast.Node, name string, iter *iterator, n s
 	a.cursor = saved
 }
 
+func (a *application) applyCharsetCollation(parent sqlast.Node, c *sqlast.CharsetCollation) {
+	if c.Charset != nil {
+		a.apply(parent, "Charset", nil, c.Charset)
+	}
+	if c.Collation != nil {
+		a.apply(parent, "Collation", nil, c.Collation)
+	}
+}
+
 func (a *application) applyList(parent sqlast.Node, name string) {
 	saved := a.iter
 	a.iter.index = 0
-	for {
-		v := reflect.Indirect(reflect.ValueOf(parent)).FieldByName(name)
-		if a.iter.index >= v.Len() {
-			break
-		}
-
-		var x sqlast.Node
-		if e := v.Index(a.iter.index); e.IsValid() {
-			x = e.Interface().(sqlast.Node)
-		}
-
+	l := listField(parent, name)
+	for a.iter.index < l.Len() {
 		a.iter.step = 1
-		a.apply(parent, name, &a.iter, x)
+		a.apply(parent, name, &a.iter, l.At(a.iter.index))
 		a.iter.index += a.iter.step
 	}
 	a.iter = saved
@@ -0,0 +1,2542 @@
+package sqlastutil
+
+// Code generated by genapply. DO NOT EDIT.
+
+import (
+	"log"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// isNil reports whether n is nil or a nil pointer of the node type.
+func isNil(n sqlast.Node) bool {
+	switch n := n.(type) {
+	case nil:
+		return true
+	case *sqlast.AddColumnTableAction:
+		return n == nil
+	case *sqlast.AddConstraintTableAction:
+		return n == nil
+	case *sqlast.AliasSelectItem:
+		return n == nil
+	case *sqlast.AlterColumnTableAction:
+		return n == nil
+	case *sqlast.AlterSequenceStmt:
+		return n == nil
+	case *sqlast.AlterTableStmt:
+		return n == nil
+	case *sqlast.AlterViewStmt:
+		return n == nil
+	case *sqlast.AnalyzeStmt:
+		return n == nil
+	case *sqlast.Array:
+		return n == nil
+	case *sqlast.ArrayConstructor:
+		return n == nil
+	case *sqlast.AsSequenceOption:
+		return n == nil
+	case *sqlast.Assignment:
+		return n == nil
+	case *sqlast.AttachPartitionTableAction:
+		return n == nil
+	case *sqlast.AutoIncrement:
+		return n == nil
+	case *sqlast.Between:
+		return n == nil
+	case *sqlast.BigInt:
+		return n == nil
+	case *sqlast.BigSerial:
+		return n == nil
+	case *sqlast.Binary:
+		return n == nil
+	case *sqlast.BinaryExpr:
+		return n == nil
+	case *sqlast.BitStringLiteral:
+		return n == nil
+	case *sqlast.Blob:
+		return n == nil
+	case *sqlast.Boolean:
+		return n == nil
+	case *sqlast.BooleanValue:
+		return n == nil
+	case *sqlast.Bytea:
+		return n == nil
+	case *sqlast.CTE:
+		return n == nil
+	case *sqlast.CacheSequenceOption:
+		return n == nil
+	case *sqlast.CaseExpr:
+		return n == nil
+	case *sqlast.Cast:
+		return n == nil
+	case *sqlast.CharType:
+		return n == nil
+	case *sqlast.CheckColumnSpec:
+		return n == nil
+	case *sqlast.CheckTableConstraint:
+		return n == nil
+	case *sqlast.Clob:
+		return n == nil
+	case *sqlast.ColumnConstraint:
+		return n == nil
+	case *sqlast.ColumnDef:
+		return n == nil
+	case *sqlast.Comment:
+		return n == nil
+	case *sqlast.CommentGroup:
+		return n == nil
+	case *sqlast.CommentOnStmt:
+		return n == nil
+	case *sqlast.CompoundIdent:
+		return n == nil
+	case *sqlast.ConstructorSource:
+		return n == nil
+	case *sqlast.CopyOption:
+		return n == nil
+	case *sqlast.CopyStmt:
+		return n == nil
+	case *sqlast.CreateDomainStmt:
+		return n == nil
+	case *sqlast.CreateExtensionStmt:
+		return n == nil
+	case *sqlast.CreateFunctionStmt:
+		return n == nil
+	case *sqlast.CreateIndexStmt:
+		return n == nil
+	case *sqlast.CreateSchemaStmt:
+		return n == nil
+	case *sqlast.CreateSequenceStmt:
+		return n == nil
+	case *sqlast.CreateTableStmt:
+		return n == nil
+	case *sqlast.CreateTriggerStmt:
+		return n == nil
+	case *sqlast.CreateTypeStmt:
+		return n == nil
+	case *sqlast.CreateViewStmt:
+		return n == nil
+	case *sqlast.CrossJoin:
+		return n == nil
+	case *sqlast.CurrentRow:
+		return n == nil
+	case *sqlast.Custom:
+		return n == nil
+	case *sqlast.CycleSequenceOption:
+		return n == nil
+	case *sqlast.Date:
+		return n == nil
+	case *sqlast.DateTime:
+		return n == nil
+	case *sqlast.DateTimeValue:
+		return n == nil
+	case *sqlast.DateValue:
+		return n == nil
+	case *sqlast.DeallocateStmt:
+		return n == nil
+	case *sqlast.Decimal:
+		return n == nil
+	case *sqlast.DeleteStmt:
+		return n == nil
+	case *sqlast.Derived:
+		return n == nil
+	case *sqlast.DetachPartitionTableAction:
+		return n == nil
+	case *sqlast.DollarQuotedString:
+		return n == nil
+	case *sqlast.Double:
+		return n == nil
+	case *sqlast.DoubleValue:
+		return n == nil
+	case *sqlast.DropConstraintTableAction:
+		return n == nil
+	case *sqlast.DropDefaultColumnAction:
+		return n == nil
+	case *sqlast.DropIndexStmt:
+		return n == nil
+	case *sqlast.DropSchemaStmt:
+		return n == nil
+	case *sqlast.DropSequenceStmt:
+		return n == nil
+	case *sqlast.DropTableStmt:
+		return n == nil
+	case *sqlast.DropTriggerStmt:
+		return n == nil
+	case *sqlast.DropViewStmt:
+		return n == nil
+	case *sqlast.Enum:
+		return n == nil
+	case *sqlast.ExceptOperator:
+		return n == nil
+	case *sqlast.ExecuteStmt:
+		return n == nil
+	case *sqlast.Exists:
+		return n == nil
+	case *sqlast.ExplainStmt:
+		return n == nil
+	case *sqlast.ExtractExpr:
+		return n == nil
+	case *sqlast.FetchExpr:
+		return n == nil
+	case *sqlast.File:
+		return n == nil
+	case *sqlast.Float:
+		return n == nil
+	case *sqlast.Following:
+		return n == nil
+	case *sqlast.Function:
+		return n == nil
+	case *sqlast.FunctionArg:
+		return n == nil
+	case *sqlast.FunctionReturns:
+		return n == nil
+	case *sqlast.HexStringLiteral:
+		return n == nil
+	case *sqlast.HintComment:
+		return n == nil
+	case *sqlast.Ident:
+		return n == nil
+	case *sqlast.InList:
+		return n == nil
+	case *sqlast.InSubQuery:
+		return n == nil
+	case *sqlast.IncrementBySequenceOption:
+		return n == nil
+	case *sqlast.IndexColumn:
+		return n == nil
+	case *sqlast.IndexElement:
+		return n == nil
+	case *sqlast.IndexHint:
+		return n == nil
+	case *sqlast.IndexTableElement:
+		return n == nil
+	case *sqlast.InheritsClause:
+		return n == nil
+	case *sqlast.InsertStmt:
+		return n == nil
+	case *sqlast.Int:
+		return n == nil
+	case *sqlast.IntersectOperator:
+		return n == nil
+	case *sqlast.IsNotNull:
+		return n == nil
+	case *sqlast.IsNull:
+		return n == nil
+	case *sqlast.IsOf:
+		return n == nil
+	case *sqlast.JSON:
+		return n == nil
+	case *sqlast.JoinCondition:
+		return n == nil
+	case *sqlast.JoinType:
+		return n == nil
+	case *sqlast.KillStmt:
+		return n == nil
+	case *sqlast.LikeOption:
+		return n == nil
+	case *sqlast.LikeTableElement:
+		return n == nil
+	case *sqlast.LimitExpr:
+		return n == nil
+	case *sqlast.LockingClause:
+		return n == nil
+	case *sqlast.LongBlob:
+		return n == nil
+	case *sqlast.LongText:
+		return n == nil
+	case *sqlast.LongValue:
+		return n == nil
+	case *sqlast.MaxValueSequenceOption:
+		return n == nil
+	case *sqlast.MediumBlob:
+		return n == nil
+	case *sqlast.MediumInt:
+		return n == nil
+	case *sqlast.MediumText:
+		return n == nil
+	case *sqlast.MinValueSequenceOption:
+		return n == nil
+	case *sqlast.MyChangeColumnTableAction:
+		return n == nil
+	case *sqlast.MyCharset:
+		return n == nil
+	case *sqlast.MyCollate:
+		return n == nil
+	case *sqlast.MyColumnPosition:
+		return n == nil
+	case *sqlast.MyDropPrimaryKeyTableAction:
+		return n == nil
+	case *sqlast.MyEngine:
+		return n == nil
+	case *sqlast.MyModifyColumnTableAction:
+		return n == nil
+	case *sqlast.NVarcharType:
+		return n == nil
+	case *sqlast.NamedColumnsJoin:
+		return n == nil
+	case *sqlast.NationalStringLiteral:
+		return n == nil
+	case *sqlast.NaturalJoin:
+		return n == nil
+	case *sqlast.Nested:
+		return n == nil
+	case *sqlast.NotNullColumnSpec:
+		return n == nil
+	case *sqlast.NullValue:
+		return n == nil
+	case *sqlast.ObjectName:
+		return n == nil
+	case *sqlast.OffsetExpr:
+		return n == nil
+	case *sqlast.OnConflict:
+		return n == nil
+	case *sqlast.Operator:
+		return n == nil
+	case *sqlast.OrderByExpr:
+		return n == nil
+	case *sqlast.OverlayExpr:
+		return n == nil
+	case *sqlast.OwnedBySequenceOption:
+		return n == nil
+	case *sqlast.PGAlterDataTypeColumnAction:
+		return n == nil
+	case *sqlast.PGDropNotNullColumnAction:
+		return n == nil
+	case *sqlast.PGSetNotNullColumnAction:
+		return n == nil
+	case *sqlast.PartitionBound:
+		return n == nil
+	case *sqlast.PartitionSpec:
+		return n == nil
+	case *sqlast.PartitionedJoinTable:
+		return n == nil
+	case *sqlast.Placeholder:
+		return n == nil
+	case *sqlast.PositionExpr:
+		return n == nil
+	case *sqlast.Preceding:
+		return n == nil
+	case *sqlast.PrepareStmt:
+		return n == nil
+	case *sqlast.QualifiedJoin:
+		return n == nil
+	case *sqlast.QualifiedWildcard:
+		return n == nil
+	case *sqlast.QualifiedWildcardSelectItem:
+		return n == nil
+	case *sqlast.QuantifiedComparison:
+		return n == nil
+	case *sqlast.QueryExpr:
+		return n == nil
+	case *sqlast.QueryStmt:
+		return n == nil
+	case *sqlast.Real:
+		return n == nil
+	case *sqlast.ReferenceKeyExpr:
+		return n == nil
+	case *sqlast.ReferencesColumnSpec:
+		return n == nil
+	case *sqlast.ReferentialTableConstraint:
+		return n == nil
+	case *sqlast.Regclass:
+		return n == nil
+	case *sqlast.ReindexStmt:
+		return n == nil
+	case *sqlast.RemoveColumnTableAction:
+		return n == nil
+	case *sqlast.RenameColumnTableAction:
+		return n == nil
+	case *sqlast.RenameConstraintTableAction:
+		return n == nil
+	case *sqlast.RenameTableAction:
+		return n == nil
+	case *sqlast.RestartSequenceOption:
+		return n == nil
+	case *sqlast.RowValueExpr:
+		return n == nil
+	case *sqlast.SQLSelect:
+		return n == nil
+	case *sqlast.SQLiteWithoutRowID:
+		return n == nil
+	case *sqlast.SelectExpr:
+		return n == nil
+	case *sqlast.Serial:
+		return n == nil
+	case *sqlast.Set:
+		return n == nil
+	case *sqlast.SetDefaultColumnAction:
+		return n == nil
+	case *sqlast.SetOperationExpr:
+		return n == nil
+	case *sqlast.SetVariableStmt:
+		return n == nil
+	case *sqlast.ShowStmt:
+		return n == nil
+	case *sqlast.ShowWarningsStmt:
+		return n == nil
+	case *sqlast.SingleQuotedString:
+		return n == nil
+	case *sqlast.SmallInt:
+		return n == nil
+	case *sqlast.SmallSerial:
+		return n == nil
+	case *sqlast.StartWithSequenceOption:
+		return n == nil
+	case *sqlast.SubQuery:
+		return n == nil
+	case *sqlast.SubQuerySource:
+		return n == nil
+	case *sqlast.Subscript:
+		return n == nil
+	case *sqlast.SubstringExpr:
+		return n == nil
+	case *sqlast.Table:
+		return n == nil
+	case *sqlast.TableConstraint:
+		return n == nil
+	case *sqlast.TableExpr:
+		return n == nil
+	case *sqlast.TableFunction:
+		return n == nil
+	case *sqlast.TableJoinElement:
+		return n == nil
+	case *sqlast.Text:
+		return n == nil
+	case *sqlast.Time:
+		return n == nil
+	case *sqlast.TimeValue:
+		return n == nil
+	case *sqlast.Timestamp:
+		return n == nil
+	case *sqlast.TimestampValue:
+		return n == nil
+	case *sqlast.TinyBlob:
+		return n == nil
+	case *sqlast.TinyInt:
+		return n == nil
+	case *sqlast.TinyText:
+		return n == nil
+	case *sqlast.TopExpr:
+		return n == nil
+	case *sqlast.TriggerEvent:
+		return n == nil
+	case *sqlast.TrimExpr:
+		return n == nil
+	case *sqlast.TruncateStmt:
+		return n == nil
+	case *sqlast.UUID:
+		return n == nil
+	case *sqlast.UnaryExpr:
+		return n == nil
+	case *sqlast.UnboundedFollowing:
+		return n == nil
+	case *sqlast.UnboundedPreceding:
+		return n == nil
+	case *sqlast.UnionOperator:
+		return n == nil
+	case *sqlast.UniqueColumnSpec:
+		return n == nil
+	case *sqlast.UniqueTableConstraint:
+		return n == nil
+	case *sqlast.UnnamedSelectItem:
+		return n == nil
+	case *sqlast.UpdateStmt:
+		return n == nil
+	case *sqlast.UseStmt:
+		return n == nil
+	case *sqlast.VacuumRelation:
+		return n == nil
+	case *sqlast.VacuumStmt:
+		return n == nil
+	case *sqlast.ValuesExpr:
+		return n == nil
+	case *sqlast.Varbinary:
+		return n == nil
+	case *sqlast.VarcharType:
+		return n == nil
+	case *sqlast.Wildcard:
+		return n == nil
+	case *sqlast.WildcardSelectItem:
+		return n == nil
+	case *sqlast.WindowFrame:
+		return n == nil
+	case *sqlast.WindowFrameUnit:
+		return n == nil
+	case *sqlast.WindowSpec:
+		return n == nil
+	case *sqlast.Year:
+		return n == nil
+	}
+	return false
+}
+
+// setField sets n to the field name of parent.
+func setField(parent sqlast.Node, name string, n sqlast.Node) {
+	switch p := parent.(type) {
+	case *sqlast.AddColumnTableAction:
+		switch name {
+		case "Column":
+			p.Column = n.(*sqlast.ColumnDef)
+			return
+		}
+	case *sqlast.AddConstraintTableAction:
+		switch name {
+		case "Constraint":
+			p.Constraint = n.(*sqlast.TableConstraint)
+			return
+		}
+	case *sqlast.AliasSelectItem:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "Alias":
+			p.Alias = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.AlterColumnTableAction:
+		switch name {
+		case "ColumnName":
+			p.ColumnName = n.(*sqlast.Ident)
+			return
+		case "Action":
+			p.Action = n.(sqlast.AlterColumnAction)
+			return
+		}
+	case *sqlast.AlterSequenceStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.AlterTableStmt:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.AlterViewStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		case "NewName":
+			p.NewName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.Array:
+		switch name {
+		case "Ty":
+			p.Ty = n.(sqlast.Type)
+			return
+		}
+	case *sqlast.AsSequenceOption:
+		switch name {
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		}
+	case *sqlast.Assignment:
+		switch name {
+		case "ID":
+			p.ID = n.(*sqlast.Ident)
+			return
+		case "Value":
+			p.Value = n
+			return
+		}
+	case *sqlast.AttachPartitionTableAction:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Bound":
+			p.Bound = n.(*sqlast.PartitionBound)
+			return
+		}
+	case *sqlast.Between:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "Low":
+			p.Low = n
+			return
+		case "High":
+			p.High = n
+			return
+		}
+	case *sqlast.BinaryExpr:
+		switch name {
+		case "Left":
+			p.Left = n
+			return
+		case "Op":
+			p.Op = n.(*sqlast.Operator)
+			return
+		case "Right":
+			p.Right = n
+			return
+		case "Escape":
+			p.Escape = n
+			return
+		}
+	case *sqlast.CTE:
+		switch name {
+		case "Alias":
+			p.Alias = n.(*sqlast.Ident)
+			return
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.CacheSequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.CaseExpr:
+		switch name {
+		case "Operand":
+			p.Operand = n
+			return
+		case "ElseResult":
+			p.ElseResult = n
+			return
+		}
+	case *sqlast.Cast:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		}
+	case *sqlast.CharType:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.CheckColumnSpec:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.CheckTableConstraint:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.ColumnConstraint:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Spec":
+			p.Spec = n.(sqlast.ColumnConstraintSpec)
+			return
+		}
+	case *sqlast.ColumnDef:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		case "Default":
+			p.Default = n
+			return
+		}
+	case *sqlast.CommentOnStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Text":
+			p.Text = n
+			return
+		}
+	case *sqlast.CopyOption:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Value":
+			p.Value = n
+			return
+		}
+	case *sqlast.CopyStmt:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "File":
+			p.File = n.(*sqlast.SingleQuotedString)
+			return
+		}
+	case *sqlast.CreateDomainStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		case "Default":
+			p.Default = n
+			return
+		}
+	case *sqlast.CreateExtensionStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Schema":
+			p.Schema = n.(*sqlast.Ident)
+			return
+		case "Version":
+			p.Version = n
+			return
+		}
+	case *sqlast.CreateFunctionStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Returns":
+			p.Returns = n.(*sqlast.FunctionReturns)
+			return
+		case "Language":
+			p.Language = n.(*sqlast.Ident)
+			return
+		case "Body":
+			p.Body = n
+			return
+		}
+	case *sqlast.CreateIndexStmt:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "IndexName":
+			p.IndexName = n.(*sqlast.Ident)
+			return
+		case "MethodName":
+			p.MethodName = n.(*sqlast.Ident)
+			return
+		case "Comment":
+			p.Comment = n.(*sqlast.SingleQuotedString)
+			return
+		case "Selection":
+			p.Selection = n
+			return
+		}
+	case *sqlast.CreateSchemaStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Authorization":
+			p.Authorization = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.CreateSequenceStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.CreateTableStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "PartitionOf":
+			p.PartitionOf = n.(*sqlast.ObjectName)
+			return
+		case "Inherits":
+			p.Inherits = n.(*sqlast.InheritsClause)
+			return
+		case "Bound":
+			p.Bound = n.(*sqlast.PartitionBound)
+			return
+		case "PartitionBy":
+			p.PartitionBy = n.(*sqlast.PartitionSpec)
+			return
+		}
+	case *sqlast.CreateTriggerStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "When":
+			p.When = n
+			return
+		case "Function":
+			p.Function = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.CreateTypeStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.CreateViewStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.CrossJoin:
+		switch name {
+		case "Reference":
+			p.Reference = n.(sqlast.TableReference)
+			return
+		case "Factor":
+			p.Factor = n.(sqlast.TableFactor)
+			return
+		}
+	case *sqlast.Custom:
+		switch name {
+		case "Ty":
+			p.Ty = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.DeallocateStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.DeleteStmt:
+		switch name {
+		case "Hint":
+			p.Hint = n.(*sqlast.HintComment)
+			return
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "Selection":
+			p.Selection = n
+			return
+		case "Limit":
+			p.Limit = n.(*sqlast.LimitExpr)
+			return
+		}
+	case *sqlast.Derived:
+		switch name {
+		case "SubQuery":
+			p.SubQuery = n.(*sqlast.QueryStmt)
+			return
+		case "Alias":
+			p.Alias = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.DetachPartitionTableAction:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.DropConstraintTableAction:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.DropIndexStmt:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.DropTriggerStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.Enum:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.ExecuteStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.Exists:
+		switch name {
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.ExplainStmt:
+		switch name {
+		case "Format":
+			p.Format = n.(*sqlast.Ident)
+			return
+		case "Stmt":
+			p.Stmt = n.(sqlast.Stmt)
+			return
+		case "Table":
+			p.Table = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.ExtractExpr:
+		switch name {
+		case "Field":
+			p.Field = n.(*sqlast.Ident)
+			return
+		case "Source":
+			p.Source = n
+			return
+		}
+	case *sqlast.FetchExpr:
+		switch name {
+		case "Quantity":
+			p.Quantity = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.Function:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Over":
+			p.Over = n.(*sqlast.WindowSpec)
+			return
+		}
+	case *sqlast.FunctionArg:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		case "Default":
+			p.Default = n
+			return
+		}
+	case *sqlast.FunctionReturns:
+		switch name {
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		}
+	case *sqlast.InList:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.InSubQuery:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "SubQuery":
+			p.SubQuery = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.IncrementBySequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.IndexColumn:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.IndexElement:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.ObjectName)
+			return
+		case "OpClass":
+			p.OpClass = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.IndexTableElement:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Using":
+			p.Using = n.(*sqlast.Ident)
+			return
+		case "Comment":
+			p.Comment = n.(*sqlast.SingleQuotedString)
+			return
+		}
+	case *sqlast.InsertStmt:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "Source":
+			p.Source = n.(sqlast.InsertSource)
+			return
+		case "OnConflict":
+			p.OnConflict = n.(*sqlast.OnConflict)
+			return
+		}
+	case *sqlast.IsNotNull:
+		switch name {
+		case "X":
+			p.X = n
+			return
+		}
+	case *sqlast.IsNull:
+		switch name {
+		case "X":
+			p.X = n
+			return
+		}
+	case *sqlast.IsOf:
+		switch name {
+		case "X":
+			p.X = n
+			return
+		}
+	case *sqlast.JoinCondition:
+		switch name {
+		case "SearchCondition":
+			p.SearchCondition = n
+			return
+		}
+	case *sqlast.KillStmt:
+		switch name {
+		case "ID":
+			p.ID = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.LikeOption:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.LikeTableElement:
+		switch name {
+		case "Source":
+			p.Source = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.LimitExpr:
+		switch name {
+		case "LimitValue":
+			p.LimitValue = n
+			return
+		case "OffsetValue":
+			p.OffsetValue = n
+			return
+		}
+	case *sqlast.LongText:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MaxValueSequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.MediumText:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MinValueSequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.MyChangeColumnTableAction:
+		switch name {
+		case "OldName":
+			p.OldName = n.(*sqlast.Ident)
+			return
+		case "Column":
+			p.Column = n.(*sqlast.ColumnDef)
+			return
+		case "Position":
+			p.Position = n.(*sqlast.MyColumnPosition)
+			return
+		}
+	case *sqlast.MyCharset:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MyCollate:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MyColumnPosition:
+		switch name {
+		case "After":
+			p.After = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MyEngine:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.MyModifyColumnTableAction:
+		switch name {
+		case "Column":
+			p.Column = n.(*sqlast.ColumnDef)
+			return
+		case "Position":
+			p.Position = n.(*sqlast.MyColumnPosition)
+			return
+		}
+	case *sqlast.NaturalJoin:
+		switch name {
+		case "LeftElement":
+			p.LeftElement = n.(*sqlast.TableJoinElement)
+			return
+		case "Type":
+			p.Type = n.(*sqlast.JoinType)
+			return
+		case "RightElement":
+			p.RightElement = n.(*sqlast.TableJoinElement)
+			return
+		}
+	case *sqlast.Nested:
+		switch name {
+		case "AST":
+			p.AST = n
+			return
+		}
+	case *sqlast.OffsetExpr:
+		switch name {
+		case "Value":
+			p.Value = n
+			return
+		}
+	case *sqlast.OnConflict:
+		switch name {
+		case "TargetWhere":
+			p.TargetWhere = n
+			return
+		case "Constraint":
+			p.Constraint = n.(*sqlast.Ident)
+			return
+		case "Selection":
+			p.Selection = n
+			return
+		}
+	case *sqlast.OrderByExpr:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.OverlayExpr:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "Placing":
+			p.Placing = n
+			return
+		case "From":
+			p.From = n
+			return
+		case "For":
+			p.For = n
+			return
+		}
+	case *sqlast.OwnedBySequenceOption:
+		switch name {
+		case "Column":
+			p.Column = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.PGAlterDataTypeColumnAction:
+		switch name {
+		case "DataType":
+			p.DataType = n.(sqlast.Type)
+			return
+		case "Using":
+			p.Using = n
+			return
+		}
+	case *sqlast.PartitionBound:
+		switch name {
+		case "Modulus":
+			p.Modulus = n
+			return
+		case "Remainder":
+			p.Remainder = n
+			return
+		}
+	case *sqlast.PartitionedJoinTable:
+		switch name {
+		case "Factor":
+			p.Factor = n.(sqlast.TableFactor)
+			return
+		}
+	case *sqlast.PositionExpr:
+		switch name {
+		case "Substring":
+			p.Substring = n
+			return
+		case "String":
+			p.String = n
+			return
+		}
+	case *sqlast.PrepareStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Stmt":
+			p.Stmt = n.(sqlast.Stmt)
+			return
+		case "From":
+			p.From = n
+			return
+		}
+	case *sqlast.QualifiedJoin:
+		switch name {
+		case "LeftElement":
+			p.LeftElement = n.(*sqlast.TableJoinElement)
+			return
+		case "Type":
+			p.Type = n.(*sqlast.JoinType)
+			return
+		case "RightElement":
+			p.RightElement = n.(*sqlast.TableJoinElement)
+			return
+		case "Spec":
+			p.Spec = n.(sqlast.JoinSpec)
+			return
+		}
+	case *sqlast.QualifiedWildcardSelectItem:
+		switch name {
+		case "Prefix":
+			p.Prefix = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.QuantifiedComparison:
+		switch name {
+		case "Left":
+			p.Left = n
+			return
+		case "Op":
+			p.Op = n.(*sqlast.Operator)
+			return
+		case "Operand":
+			p.Operand = n
+			return
+		}
+	case *sqlast.QueryExpr:
+		switch name {
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.QueryStmt:
+		switch name {
+		case "Body":
+			p.Body = n.(sqlast.SQLSetExpr)
+			return
+		case "Limit":
+			p.Limit = n.(*sqlast.LimitExpr)
+			return
+		case "Offset":
+			p.Offset = n.(*sqlast.OffsetExpr)
+			return
+		case "Fetch":
+			p.Fetch = n.(*sqlast.FetchExpr)
+			return
+		}
+	case *sqlast.ReferenceKeyExpr:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.ReferencesColumnSpec:
+		switch name {
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.ReferentialTableConstraint:
+		switch name {
+		case "KeyExpr":
+			p.KeyExpr = n.(*sqlast.ReferenceKeyExpr)
+			return
+		}
+	case *sqlast.ReindexStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.RemoveColumnTableAction:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.RenameColumnTableAction:
+		switch name {
+		case "OldName":
+			p.OldName = n.(*sqlast.Ident)
+			return
+		case "NewName":
+			p.NewName = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.RenameConstraintTableAction:
+		switch name {
+		case "OldName":
+			p.OldName = n.(*sqlast.Ident)
+			return
+		case "NewName":
+			p.NewName = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.RenameTableAction:
+		switch name {
+		case "NewName":
+			p.NewName = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.RestartSequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.SQLSelect:
+		switch name {
+		case "Hint":
+			p.Hint = n.(*sqlast.HintComment)
+			return
+		case "Top":
+			p.Top = n.(*sqlast.TopExpr)
+			return
+		case "WhereClause":
+			p.WhereClause = n
+			return
+		case "HavingClause":
+			p.HavingClause = n
+			return
+		case "QualifyClause":
+			p.QualifyClause = n
+			return
+		}
+	case *sqlast.SelectExpr:
+		switch name {
+		case "Select":
+			p.Select = n.(*sqlast.SQLSelect)
+			return
+		}
+	case *sqlast.Set:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.SetDefaultColumnAction:
+		switch name {
+		case "Default":
+			p.Default = n
+			return
+		}
+	case *sqlast.SetOperationExpr:
+		switch name {
+		case "Op":
+			p.Op = n.(sqlast.SQLSetOperator)
+			return
+		case "Left":
+			p.Left = n.(sqlast.SQLSetExpr)
+			return
+		case "Right":
+			p.Right = n.(sqlast.SQLSetExpr)
+			return
+		}
+	case *sqlast.SetVariableStmt:
+		switch name {
+		case "Scope":
+			p.Scope = n.(*sqlast.Ident)
+			return
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.ShowStmt:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.ShowWarningsStmt:
+		switch name {
+		case "Offset":
+			p.Offset = n.(*sqlast.LongValue)
+			return
+		case "Limit":
+			p.Limit = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.StartWithSequenceOption:
+		switch name {
+		case "Value":
+			p.Value = n.(*sqlast.LongValue)
+			return
+		}
+	case *sqlast.SubQuery:
+		switch name {
+		case "Query":
+			p.Query = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.SubQuerySource:
+		switch name {
+		case "SubQuery":
+			p.SubQuery = n.(*sqlast.QueryStmt)
+			return
+		}
+	case *sqlast.Subscript:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "Index":
+			p.Index = n
+			return
+		case "Upper":
+			p.Upper = n
+			return
+		}
+	case *sqlast.SubstringExpr:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		case "From":
+			p.From = n
+			return
+		case "For":
+			p.For = n
+			return
+		}
+	case *sqlast.Table:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		case "Alias":
+			p.Alias = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.TableConstraint:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.Ident)
+			return
+		case "Spec":
+			p.Spec = n.(sqlast.TableConstraintSpec)
+			return
+		}
+	case *sqlast.TableExpr:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.TableFunction:
+		switch name {
+		case "Alias":
+			p.Alias = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.TableJoinElement:
+		switch name {
+		case "Ref":
+			p.Ref = n.(sqlast.TableReference)
+			return
+		}
+	case *sqlast.Text:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.TinyText:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.TopExpr:
+		switch name {
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.TrimExpr:
+		switch name {
+		case "Chars":
+			p.Chars = n
+			return
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.UnaryExpr:
+		switch name {
+		case "Op":
+			p.Op = n.(*sqlast.Operator)
+			return
+		case "Expr":
+			p.Expr = n
+			return
+		}
+	case *sqlast.UniqueTableConstraint:
+		switch name {
+		case "Comment":
+			p.Comment = n.(*sqlast.SingleQuotedString)
+			return
+		}
+	case *sqlast.UnnamedSelectItem:
+		switch name {
+		case "Node":
+			p.Node = n
+			return
+		}
+	case *sqlast.UpdateStmt:
+		switch name {
+		case "Hint":
+			p.Hint = n.(*sqlast.HintComment)
+			return
+		case "TableName":
+			p.TableName = n.(*sqlast.ObjectName)
+			return
+		case "Selection":
+			p.Selection = n
+			return
+		case "Limit":
+			p.Limit = n.(*sqlast.LimitExpr)
+			return
+		}
+	case *sqlast.UseStmt:
+		switch name {
+		case "Database":
+			p.Database = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.VacuumRelation:
+		switch name {
+		case "Name":
+			p.Name = n.(*sqlast.ObjectName)
+			return
+		}
+	case *sqlast.VarcharType:
+		switch name {
+		case "Charset":
+			p.Charset = n.(*sqlast.Ident)
+			return
+		case "Collation":
+			p.Collation = n.(*sqlast.Ident)
+			return
+		}
+	case *sqlast.WindowFrame:
+		switch name {
+		case "Units":
+			p.Units = n.(*sqlast.WindowFrameUnit)
+			return
+		case "StartBound":
+			p.StartBound = n.(sqlast.SQLWindowFrameBound)
+			return
+		case "EndBound":
+			p.EndBound = n.(sqlast.SQLWindowFrameBound)
+			return
+		}
+	case *sqlast.WindowSpec:
+		switch name {
+		case "WindowsFrame":
+			p.WindowsFrame = n.(*sqlast.WindowFrame)
+			return
+		}
+	}
+	log.Panicf("%T has no node field %s", parent, name)
+}
+
+// listField returns the slice field name of parent.
+func listField(parent sqlast.Node, name string) nodeList {
+	switch p := parent.(type) {
+	case *sqlast.AlterSequenceStmt:
+		switch name {
+		case "Options":
+			return (*listOfSequenceOption)(&p.Options)
+		}
+	case *sqlast.AlterTableStmt:
+		switch name {
+		case "Actions":
+			return (*listOfAlterTableAction)(&p.Actions)
+		}
+	case *sqlast.AlterViewStmt:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.AnalyzeStmt:
+		switch name {
+		case "Options":
+			return (*listOfCopyOption)(&p.Options)
+		case "Tables":
+			return (*listOfVacuumRelation)(&p.Tables)
+		}
+	case *sqlast.ArrayConstructor:
+		switch name {
+		case "Elements":
+			return (*listOfNode)(&p.Elements)
+		}
+	case *sqlast.CTE:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.CaseExpr:
+		switch name {
+		case "Conditions":
+			return (*listOfNode)(&p.Conditions)
+		case "Results":
+			return (*listOfNode)(&p.Results)
+		}
+	case *sqlast.ColumnDef:
+		switch name {
+		case "MyDataTypeDecoration":
+			return (*listOfMyDataTypeDecoration)(&p.MyDataTypeDecoration)
+		case "Constraints":
+			return (*listOfColumnConstraint)(&p.Constraints)
+		}
+	case *sqlast.CommentGroup:
+		switch name {
+		case "List":
+			return (*listOfComment)(&p.List)
+		}
+	case *sqlast.CompoundIdent:
+		switch name {
+		case "Idents":
+			return (*listOfIdent)(&p.Idents)
+		}
+	case *sqlast.ConstructorSource:
+		switch name {
+		case "Rows":
+			return (*listOfRowValueExpr)(&p.Rows)
+		}
+	case *sqlast.CopyStmt:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		case "Options":
+			return (*listOfCopyOption)(&p.Options)
+		}
+	case *sqlast.CreateDomainStmt:
+		switch name {
+		case "Constraints":
+			return (*listOfColumnConstraint)(&p.Constraints)
+		}
+	case *sqlast.CreateFunctionStmt:
+		switch name {
+		case "Args":
+			return (*listOfFunctionArg)(&p.Args)
+		case "Behaviors":
+			return (*listOfIdent)(&p.Behaviors)
+		}
+	case *sqlast.CreateIndexStmt:
+		switch name {
+		case "Columns":
+			return (*listOfIndexElement)(&p.Columns)
+		case "Include":
+			return (*listOfIdent)(&p.Include)
+		}
+	case *sqlast.CreateSchemaStmt:
+		switch name {
+		case "Elements":
+			return (*listOfStmt)(&p.Elements)
+		}
+	case *sqlast.CreateSequenceStmt:
+		switch name {
+		case "Options":
+			return (*listOfSequenceOption)(&p.Options)
+		}
+	case *sqlast.CreateTableStmt:
+		switch name {
+		case "Elements":
+			return (*listOfTableElement)(&p.Elements)
+		case "Options":
+			return (*listOfTableOption)(&p.Options)
+		}
+	case *sqlast.CreateTriggerStmt:
+		switch name {
+		case "Events":
+			return (*listOfTriggerEvent)(&p.Events)
+		case "Args":
+			return (*listOfNode)(&p.Args)
+		}
+	case *sqlast.CreateTypeStmt:
+		switch name {
+		case "Labels":
+			return (*listOfSingleQuotedString)(&p.Labels)
+		case "Attributes":
+			return (*listOfColumnDef)(&p.Attributes)
+		}
+	case *sqlast.CreateViewStmt:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.DeleteStmt:
+		switch name {
+		case "Using":
+			return (*listOfTableReference)(&p.Using)
+		case "OrderBy":
+			return (*listOfOrderByExpr)(&p.OrderBy)
+		case "Returning":
+			return (*listOfSQLSelectItem)(&p.Returning)
+		}
+	case *sqlast.Derived:
+		switch name {
+		case "ColumnAliases":
+			return (*listOfIdent)(&p.ColumnAliases)
+		}
+	case *sqlast.DropIndexStmt:
+		switch name {
+		case "IndexNames":
+			return (*listOfObjectName)(&p.IndexNames)
+		}
+	case *sqlast.DropSchemaStmt:
+		switch name {
+		case "SchemaNames":
+			return (*listOfObjectName)(&p.SchemaNames)
+		}
+	case *sqlast.DropSequenceStmt:
+		switch name {
+		case "SequenceNames":
+			return (*listOfObjectName)(&p.SequenceNames)
+		}
+	case *sqlast.DropTableStmt:
+		switch name {
+		case "TableNames":
+			return (*listOfObjectName)(&p.TableNames)
+		}
+	case *sqlast.DropViewStmt:
+		switch name {
+		case "ViewNames":
+			return (*listOfObjectName)(&p.ViewNames)
+		}
+	case *sqlast.Enum:
+		switch name {
+		case "Values":
+			return (*listOfSingleQuotedString)(&p.Values)
+		}
+	case *sqlast.ExecuteStmt:
+		switch name {
+		case "Args":
+			return (*listOfNode)(&p.Args)
+		}
+	case *sqlast.ExplainStmt:
+		switch name {
+		case "Options":
+			return (*listOfCopyOption)(&p.Options)
+		}
+	case *sqlast.File:
+		switch name {
+		case "Stmts":
+			return (*listOfStmt)(&p.Stmts)
+		case "Comments":
+			return (*listOfCommentGroup)(&p.Comments)
+		}
+	case *sqlast.Function:
+		switch name {
+		case "Args":
+			return (*listOfNode)(&p.Args)
+		}
+	case *sqlast.FunctionReturns:
+		switch name {
+		case "Columns":
+			return (*listOfFunctionArg)(&p.Columns)
+		}
+	case *sqlast.InList:
+		switch name {
+		case "List":
+			return (*listOfNode)(&p.List)
+		}
+	case *sqlast.IndexHint:
+		switch name {
+		case "Indexes":
+			return (*listOfIdent)(&p.Indexes)
+		}
+	case *sqlast.IndexTableElement:
+		switch name {
+		case "Columns":
+			return (*listOfIndexColumn)(&p.Columns)
+		}
+	case *sqlast.InheritsClause:
+		switch name {
+		case "Parents":
+			return (*listOfObjectName)(&p.Parents)
+		}
+	case *sqlast.InsertStmt:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		case "UpdateAssignments":
+			return (*listOfAssignment)(&p.UpdateAssignments)
+		case "Returning":
+			return (*listOfSQLSelectItem)(&p.Returning)
+		}
+	case *sqlast.IsOf:
+		switch name {
+		case "Types":
+			return (*listOfType)(&p.Types)
+		}
+	case *sqlast.LikeTableElement:
+		switch name {
+		case "Options":
+			return (*listOfLikeOption)(&p.Options)
+		}
+	case *sqlast.LockingClause:
+		switch name {
+		case "Of":
+			return (*listOfObjectName)(&p.Of)
+		}
+	case *sqlast.NamedColumnsJoin:
+		switch name {
+		case "ColumnList":
+			return (*listOfIdent)(&p.ColumnList)
+		}
+	case *sqlast.ObjectName:
+		switch name {
+		case "Idents":
+			return (*listOfIdent)(&p.Idents)
+		}
+	case *sqlast.OnConflict:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		case "Assignments":
+			return (*listOfAssignment)(&p.Assignments)
+		}
+	case *sqlast.PartitionBound:
+		switch name {
+		case "In":
+			return (*listOfNode)(&p.In)
+		case "RangeFrom":
+			return (*listOfNode)(&p.RangeFrom)
+		case "RangeTo":
+			return (*listOfNode)(&p.RangeTo)
+		}
+	case *sqlast.PartitionSpec:
+		switch name {
+		case "Columns":
+			return (*listOfNode)(&p.Columns)
+		}
+	case *sqlast.PartitionedJoinTable:
+		switch name {
+		case "ColumnList":
+			return (*listOfIdent)(&p.ColumnList)
+		}
+	case *sqlast.PrepareStmt:
+		switch name {
+		case "Types":
+			return (*listOfType)(&p.Types)
+		}
+	case *sqlast.QualifiedWildcard:
+		switch name {
+		case "Idents":
+			return (*listOfIdent)(&p.Idents)
+		}
+	case *sqlast.QueryStmt:
+		switch name {
+		case "CTEs":
+			return (*listOfCTE)(&p.CTEs)
+		case "OrderBy":
+			return (*listOfOrderByExpr)(&p.OrderBy)
+		case "Locking":
+			return (*listOfLockingClause)(&p.Locking)
+		}
+	case *sqlast.ReferenceKeyExpr:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.ReferencesColumnSpec:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.ReferentialTableConstraint:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.ReindexStmt:
+		switch name {
+		case "Options":
+			return (*listOfCopyOption)(&p.Options)
+		}
+	case *sqlast.RowValueExpr:
+		switch name {
+		case "Values":
+			return (*listOfNode)(&p.Values)
+		}
+	case *sqlast.SQLSelect:
+		switch name {
+		case "Projection":
+			return (*listOfSQLSelectItem)(&p.Projection)
+		case "FromClause":
+			return (*listOfTableReference)(&p.FromClause)
+		case "GroupByClause":
+			return (*listOfNode)(&p.GroupByClause)
+		}
+	case *sqlast.Set:
+		switch name {
+		case "Values":
+			return (*listOfSingleQuotedString)(&p.Values)
+		}
+	case *sqlast.SetVariableStmt:
+		switch name {
+		case "Values":
+			return (*listOfNode)(&p.Values)
+		}
+	case *sqlast.Table:
+		switch name {
+		case "ColumnAliases":
+			return (*listOfIdent)(&p.ColumnAliases)
+		case "Args":
+			return (*listOfNode)(&p.Args)
+		case "IndexHints":
+			return (*listOfIndexHint)(&p.IndexHints)
+		case "WithHints":
+			return (*listOfNode)(&p.WithHints)
+		}
+	case *sqlast.TableFunction:
+		switch name {
+		case "Functions":
+			return (*listOfFunction)(&p.Functions)
+		case "ColumnAliases":
+			return (*listOfIdent)(&p.ColumnAliases)
+		}
+	case *sqlast.TriggerEvent:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.TruncateStmt:
+		switch name {
+		case "TableNames":
+			return (*listOfObjectName)(&p.TableNames)
+		}
+	case *sqlast.UniqueTableConstraint:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.UpdateStmt:
+		switch name {
+		case "Assignments":
+			return (*listOfAssignment)(&p.Assignments)
+		case "FromClause":
+			return (*listOfTableReference)(&p.FromClause)
+		case "OrderBy":
+			return (*listOfOrderByExpr)(&p.OrderBy)
+		case "Returning":
+			return (*listOfSQLSelectItem)(&p.Returning)
+		}
+	case *sqlast.VacuumRelation:
+		switch name {
+		case "Columns":
+			return (*listOfIdent)(&p.Columns)
+		}
+	case *sqlast.VacuumStmt:
+		switch name {
+		case "Options":
+			return (*listOfCopyOption)(&p.Options)
+		case "Tables":
+			return (*listOfVacuumRelation)(&p.Tables)
+		}
+	case *sqlast.ValuesExpr:
+		switch name {
+		case "Rows":
+			return (*listOfRowValueExpr)(&p.Rows)
+		}
+	case *sqlast.WindowSpec:
+		switch name {
+		case "PartitionBy":
+			return (*listOfNode)(&p.PartitionBy)
+		case "OrderBy":
+			return (*listOfOrderByExpr)(&p.OrderBy)
+		}
+	}
+	log.Panicf("%T has no node list field %s", parent, name)
+	return nil
+}
+
+type listOfAlterTableAction []sqlast.AlterTableAction
+
+func (l *listOfAlterTableAction) Len() int                 { return len(*l) }
+func (l *listOfAlterTableAction) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfAlterTableAction) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.AlterTableAction) }
+func (l *listOfAlterTableAction) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfAlterTableAction) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.AlterTableAction)
+}
+
+type listOfAssignment []*sqlast.Assignment
+
+func (l *listOfAssignment) Len() int                 { return len(*l) }
+func (l *listOfAssignment) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfAssignment) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.Assignment) }
+func (l *listOfAssignment) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfAssignment) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.Assignment)
+}
+
+type listOfCTE []*sqlast.CTE
+
+func (l *listOfCTE) Len() int                 { return len(*l) }
+func (l *listOfCTE) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfCTE) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.CTE) }
+func (l *listOfCTE) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfCTE) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.CTE)
+}
+
+type listOfColumnConstraint []*sqlast.ColumnConstraint
+
+func (l *listOfColumnConstraint) Len() int                 { return len(*l) }
+func (l *listOfColumnConstraint) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfColumnConstraint) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.ColumnConstraint) }
+func (l *listOfColumnConstraint) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfColumnConstraint) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.ColumnConstraint)
+}
+
+type listOfColumnDef []*sqlast.ColumnDef
+
+func (l *listOfColumnDef) Len() int                 { return len(*l) }
+func (l *listOfColumnDef) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfColumnDef) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.ColumnDef) }
+func (l *listOfColumnDef) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfColumnDef) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.ColumnDef)
+}
+
+type listOfComment []*sqlast.Comment
+
+func (l *listOfComment) Len() int                 { return len(*l) }
+func (l *listOfComment) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfComment) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.Comment) }
+func (l *listOfComment) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfComment) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.Comment)
+}
+
+type listOfCommentGroup []*sqlast.CommentGroup
+
+func (l *listOfCommentGroup) Len() int                 { return len(*l) }
+func (l *listOfCommentGroup) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfCommentGroup) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.CommentGroup) }
+func (l *listOfCommentGroup) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfCommentGroup) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.CommentGroup)
+}
+
+type listOfCopyOption []*sqlast.CopyOption
+
+func (l *listOfCopyOption) Len() int                 { return len(*l) }
+func (l *listOfCopyOption) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfCopyOption) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.CopyOption) }
+func (l *listOfCopyOption) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfCopyOption) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.CopyOption)
+}
+
+type listOfFunction []*sqlast.Function
+
+func (l *listOfFunction) Len() int                 { return len(*l) }
+func (l *listOfFunction) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfFunction) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.Function) }
+func (l *listOfFunction) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfFunction) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.Function)
+}
+
+type listOfFunctionArg []*sqlast.FunctionArg
+
+func (l *listOfFunctionArg) Len() int                 { return len(*l) }
+func (l *listOfFunctionArg) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfFunctionArg) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.FunctionArg) }
+func (l *listOfFunctionArg) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfFunctionArg) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.FunctionArg)
+}
+
+type listOfIdent []*sqlast.Ident
+
+func (l *listOfIdent) Len() int                 { return len(*l) }
+func (l *listOfIdent) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfIdent) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.Ident) }
+func (l *listOfIdent) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfIdent) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.Ident)
+}
+
+type listOfIndexColumn []*sqlast.IndexColumn
+
+func (l *listOfIndexColumn) Len() int                 { return len(*l) }
+func (l *listOfIndexColumn) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfIndexColumn) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.IndexColumn) }
+func (l *listOfIndexColumn) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfIndexColumn) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.IndexColumn)
+}
+
+type listOfIndexElement []*sqlast.IndexElement
+
+func (l *listOfIndexElement) Len() int                 { return len(*l) }
+func (l *listOfIndexElement) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfIndexElement) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.IndexElement) }
+func (l *listOfIndexElement) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfIndexElement) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.IndexElement)
+}
+
+type listOfIndexHint []*sqlast.IndexHint
+
+func (l *listOfIndexHint) Len() int                 { return len(*l) }
+func (l *listOfIndexHint) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfIndexHint) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.IndexHint) }
+func (l *listOfIndexHint) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfIndexHint) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.IndexHint)
+}
+
+type listOfLikeOption []*sqlast.LikeOption
+
+func (l *listOfLikeOption) Len() int                 { return len(*l) }
+func (l *listOfLikeOption) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfLikeOption) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.LikeOption) }
+func (l *listOfLikeOption) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfLikeOption) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.LikeOption)
+}
+
+type listOfLockingClause []*sqlast.LockingClause
+
+func (l *listOfLockingClause) Len() int                 { return len(*l) }
+func (l *listOfLockingClause) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfLockingClause) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.LockingClause) }
+func (l *listOfLockingClause) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfLockingClause) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.LockingClause)
+}
+
+type listOfMyDataTypeDecoration []sqlast.MyDataTypeDecoration
+
+func (l *listOfMyDataTypeDecoration) Len() int             { return len(*l) }
+func (l *listOfMyDataTypeDecoration) At(i int) sqlast.Node { return (*l)[i] }
+func (l *listOfMyDataTypeDecoration) Set(i int, n sqlast.Node) {
+	(*l)[i] = n.(sqlast.MyDataTypeDecoration)
+}
+func (l *listOfMyDataTypeDecoration) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfMyDataTypeDecoration) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.MyDataTypeDecoration)
+}
+
+type listOfNode []sqlast.Node
+
+func (l *listOfNode) Len() int                 { return len(*l) }
+func (l *listOfNode) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfNode) Set(i int, n sqlast.Node) { (*l)[i] = n }
+func (l *listOfNode) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfNode) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n
+}
+
+type listOfObjectName []*sqlast.ObjectName
+
+func (l *listOfObjectName) Len() int                 { return len(*l) }
+func (l *listOfObjectName) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfObjectName) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.ObjectName) }
+func (l *listOfObjectName) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfObjectName) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.ObjectName)
+}
+
+type listOfOrderByExpr []*sqlast.OrderByExpr
+
+func (l *listOfOrderByExpr) Len() int                 { return len(*l) }
+func (l *listOfOrderByExpr) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfOrderByExpr) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.OrderByExpr) }
+func (l *listOfOrderByExpr) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfOrderByExpr) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.OrderByExpr)
+}
+
+type listOfRowValueExpr []*sqlast.RowValueExpr
+
+func (l *listOfRowValueExpr) Len() int                 { return len(*l) }
+func (l *listOfRowValueExpr) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfRowValueExpr) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.RowValueExpr) }
+func (l *listOfRowValueExpr) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfRowValueExpr) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.RowValueExpr)
+}
+
+type listOfSQLSelectItem []sqlast.SQLSelectItem
+
+func (l *listOfSQLSelectItem) Len() int                 { return len(*l) }
+func (l *listOfSQLSelectItem) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfSQLSelectItem) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.SQLSelectItem) }
+func (l *listOfSQLSelectItem) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfSQLSelectItem) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.SQLSelectItem)
+}
+
+type listOfSequenceOption []sqlast.SequenceOption
+
+func (l *listOfSequenceOption) Len() int                 { return len(*l) }
+func (l *listOfSequenceOption) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfSequenceOption) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.SequenceOption) }
+func (l *listOfSequenceOption) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfSequenceOption) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.SequenceOption)
+}
+
+type listOfSingleQuotedString []*sqlast.SingleQuotedString
+
+func (l *listOfSingleQuotedString) Len() int             { return len(*l) }
+func (l *listOfSingleQuotedString) At(i int) sqlast.Node { return (*l)[i] }
+func (l *listOfSingleQuotedString) Set(i int, n sqlast.Node) {
+	(*l)[i] = n.(*sqlast.SingleQuotedString)
+}
+func (l *listOfSingleQuotedString) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfSingleQuotedString) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.SingleQuotedString)
+}
+
+type listOfStmt []sqlast.Stmt
+
+func (l *listOfStmt) Len() int                 { return len(*l) }
+func (l *listOfStmt) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfStmt) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.Stmt) }
+func (l *listOfStmt) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfStmt) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.Stmt)
+}
+
+type listOfTableElement []sqlast.TableElement
+
+func (l *listOfTableElement) Len() int                 { return len(*l) }
+func (l *listOfTableElement) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfTableElement) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.TableElement) }
+func (l *listOfTableElement) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfTableElement) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.TableElement)
+}
+
+type listOfTableOption []sqlast.TableOption
+
+func (l *listOfTableOption) Len() int                 { return len(*l) }
+func (l *listOfTableOption) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfTableOption) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.TableOption) }
+func (l *listOfTableOption) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfTableOption) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.TableOption)
+}
+
+type listOfTableReference []sqlast.TableReference
+
+func (l *listOfTableReference) Len() int                 { return len(*l) }
+func (l *listOfTableReference) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfTableReference) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.TableReference) }
+func (l *listOfTableReference) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfTableReference) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.TableReference)
+}
+
+type listOfTriggerEvent []*sqlast.TriggerEvent
+
+func (l *listOfTriggerEvent) Len() int                 { return len(*l) }
+func (l *listOfTriggerEvent) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfTriggerEvent) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.TriggerEvent) }
+func (l *listOfTriggerEvent) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfTriggerEvent) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.TriggerEvent)
+}
+
+type listOfType []sqlast.Type
+
+func (l *listOfType) Len() int                 { return len(*l) }
+func (l *listOfType) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfType) Set(i int, n sqlast.Node) { (*l)[i] = n.(sqlast.Type) }
+func (l *listOfType) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfType) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(sqlast.Type)
+}
+
+type listOfVacuumRelation []*sqlast.VacuumRelation
+
+func (l *listOfVacuumRelation) Len() int                 { return len(*l) }
+func (l *listOfVacuumRelation) At(i int) sqlast.Node     { return (*l)[i] }
+func (l *listOfVacuumRelation) Set(i int, n sqlast.Node) { (*l)[i] = n.(*sqlast.VacuumRelation) }
+func (l *listOfVacuumRelation) Delete(i int) {
+	copy((*l)[i:], (*l)[i+1:])
+	(*l)[len(*l)-1] = nil
+	*l = (*l)[:len(*l)-1]
+}
+func (l *listOfVacuumRelation) Insert(i int, n sqlast.Node) {
+	*l = append(*l, nil)
+	copy((*l)[i+1:], (*l)[i:])
+	(*l)[i] = n.(*sqlast.VacuumRelation)
+}
@@ -1,4 +1,4 @@
-package sqlastutil
+package sqlastutil_test
 
 import (
 	"bytes"
@@ -7,6 +7,7 @@ import (
 	"github.com/akito0107/xsqlparser"
 	"github.com/akito0107/xsqlparser/dialect"
 	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqlastutil"
 )
 
 func TestApply(t *testing.T) {
@@ -15,15 +16,15 @@ func TestApply(t *testing.T) {
 		name     string
 		src      string
 		expect   string
-		preFunc  ApplyFunc
-		postFunc ApplyFunc
+		preFunc  sqlastutil.ApplyFunc
+		postFunc sqlastutil.ApplyFunc
 	}{
 		{
 			name:   "replace long value",
 			src:    `SELECT * FROM table_a WHERE id = 1`,
 			expect: `SELECT * FROM table_a WHERE id = 2`,
-			preFunc: func(cursor *Cursor) bool {
-				switch cursor.node.(type) {
+			preFunc: func(cursor *sqlastutil.Cursor) bool {
+				switch cursor.Node().(type) {
 				case *sqlast.LongValue:
 					cursor.Replace(sqlast.NewLongValue(2))
 				}
@@ -34,8 +35,8 @@ func TestApply(t *testing.T) {
 			name:   "remove select item",
 			src:    "SELECT a, b, c FROM table_a",
 			expect: "SELECT a, b FROM table_a",
-			preFunc: func(cursor *Cursor) bool {
-				switch cursor.node.(type) {
+			preFunc: func(cursor *sqlastutil.Cursor) bool {
+				switch cursor.Node().(type) {
 				case *sqlast.UnnamedSelectItem:
 					if cursor.Index() == 2 {
 						cursor.Delete()
@@ -48,8 +49,8 @@ func TestApply(t *testing.T) {
 			name:   "insert after",
 			src:    "SELECT a, b FROM table_a",
 			expect: "SELECT a, b, c FROM table_a",
-			preFunc: func(cursor *Cursor) bool {
-				switch cursor.node.(type) {
+			preFunc: func(cursor *sqlastutil.Cursor) bool {
+				switch cursor.Node().(type) {
 				case *sqlast.UnnamedSelectItem:
 					if cursor.Index() == 1 {
 						cursor.InsertAfter(&sqlast.UnnamedSelectItem{
@@ -64,8 +65,8 @@ func TestApply(t *testing.T) {
 			name:   "insert before",
 			src:    "SELECT a, b FROM table_a",
 			expect: "SELECT c, a, b FROM table_a",
-			preFunc: func(cursor *Cursor) bool {
-				switch cursor.node.(type) {
+			preFunc: func(cursor *sqlastutil.Cursor) bool {
+				switch cursor.Node().(type) {
 				case *sqlast.UnnamedSelectItem:
 					if cursor.Index() == 0 {
 						cursor.InsertBefore(&sqlast.UnnamedSelectItem{
@@ -76,6 +77,20 @@ func TestApply(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name:   "replace values in searched case",
+			src:    "SELECT CASE WHEN a = 1 THEN 'x' ELSE 'y' END FROM table_a",
+			expect: "SELECT CASE WHEN a = 2 THEN 'z' ELSE 'z' END FROM table_a",
+			preFunc: func(cursor *sqlastutil.Cursor) bool {
+				switch cursor.Node().(type) {
+				case *sqlast.LongValue:
+					cursor.Replace(sqlast.NewLongValue(2))
+				case *sqlast.SingleQuotedString:
+					cursor.Replace(sqlast.NewSingleQuotedString("z"))
+				}
+				return true
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -89,10 +104,52 @@ func TestApply(t *testing.T) {
 				t.Fatalf("%+v", err)
 			}
 
-			res := Apply(ast, c.preFunc, c.postFunc)
+			res := sqlastutil.Apply(ast, c.preFunc, c.postFunc)
 			if c.expect != res.ToSQLString() {
 				t.Errorf("should be \n %s but \n %s", c.expect, res.ToSQLString())
 			}
 		})
 	}
 }
+
+func BenchmarkApply(b *testing.B) {
+	src := `WITH regional_sales AS (
+	SELECT region, SUM(amount) AS total_sales FROM orders GROUP BY region)
+SELECT o.product, SUM(o.quantity) AS product_units
+FROM orders AS o INNER JOIN regional_sales AS r ON o.region = r.region
+WHERE o.region IN (SELECT region FROM top_regions) AND o.amount > 100
+GROUP BY o.region, o.product
+ORDER BY product_units DESC
+LIMIT 10;
+INSERT INTO customers (customer_name, contact_name, country) VALUES ('a', 'b', 'c'), ('d', 'e', 'f');
+UPDATE customers SET contact_name = 'x', city = 'y' WHERE customer_id = 1;
+CREATE TABLE persons (person_id int PRIMARY KEY, last_name varchar(255) NOT NULL, age int CHECK(age > 0));`
+
+	parser, err := xsqlparser.NewParser(bytes.NewBufferString(src), &dialect.GenericSQLDialect{})
+	if err != nil {
+		b.Fatalf("%+v", err)
+	}
+	f, err := parser.ParseFile()
+	if err != nil {
+		b.Fatalf("%+v", err)
+	}
+
+	b.Run("inspect", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sqlastutil.Apply(f, func(cursor *sqlastutil.Cursor) bool {
+				return true
+			}, nil)
+		}
+	})
+
+	b.Run("replace", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sqlastutil.Apply(f, func(cursor *sqlastutil.Cursor) bool {
+				if ident, ok := cursor.Node().(*sqlast.Ident); ok {
+					cursor.Replace(ident)
+				}
+				return true
+			}, nil)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package sqlastutil
+
+import (
+	"github.com/akito0107/xsqlparser/sqlast"
+)
+
+// Stats summarizes placeholders, literals and expressions in a statement.
+type Stats struct {
+	Placeholders     int
+	StringLiterals   int // '...', N'...', X'...', B'...' and $$...$$
+	NumericLiterals  int
+	BooleanLiterals  int
+	DateTimeLiterals int // DATE '...', TIME '...' and TIMESTAMP '...'
+	NullLiterals     int
+	InListSizes      []int // number of elements of each IN ( list ) in appearance order, including elided ones
+	// MaxExprDepth is the maximum nesting depth of composite expressions
+	// such as a = 1 (1) or (a = 1) AND b (3). Identifiers and literals don't count.
+	MaxExprDepth int
+}
+
+// Analyze collects Stats of node.
+// Pass each statement of a File to get per-statement stats.
+func Analyze(node sqlast.Node) *Stats {
+	stats := &Stats{}
+	sqlast.Walk(&statsVisitor{stats: stats}, node)
+	return stats
+}
+
+type statsVisitor struct {
+	stats *Stats
+	depth int // depth of composite expressions enclosing the node
+}
+
+func (s *statsVisitor) Visit(node sqlast.Node) sqlast.Visitor {
+	if node == nil {
+		return nil
+	}
+
+	depth := s.depth
+	switch n := node.(type) {
+	case *sqlast.Placeholder:
+		s.stats.Placeholders++
+	case *sqlast.SingleQuotedString, *sqlast.NationalStringLiteral, *sqlast.DollarQuotedString,
+		*sqlast.HexStringLiteral, *sqlast.BitStringLiteral:
+		s.stats.StringLiterals++
+	case *sqlast.LongValue, *sqlast.DoubleValue:
+		s.stats.NumericLiterals++
+	case *sqlast.BooleanValue:
+		s.stats.BooleanLiterals++
+	case *sqlast.DateValue, *sqlast.TimeValue, *sqlast.DateTimeValue, *sqlast.TimestampValue:
+		s.stats.DateTimeLiterals++
+	case *sqlast.NullValue:
+		s.stats.NullLiterals++
+	case *sqlast.InList:
+		s.stats.InListSizes = append(s.stats.InListSizes, len(n.List)+n.Elided)
+		depth++
+	case *sqlast.IsNull,
+		*sqlast.IsNotNull,
+		*sqlast.IsOf,
+		*sqlast.InSubQuery,
+		*sqlast.Between,
+		*sqlast.BinaryExpr,
+		*sqlast.Cast,
+		*sqlast.Nested,
+		*sqlast.UnaryExpr,
+		*sqlast.Function,
+		*sqlast.CaseExpr,
+		*sqlast.Exists,
+		*sqlast.SubQuery,
+		*sqlast.Subscript,
+		*sqlast.ArrayConstructor,
+		*sqlast.QuantifiedComparison,
+		*sqlast.ExtractExpr,
+		*sqlast.PositionExpr,
+		*sqlast.SubstringExpr,
+		*sqlast.TrimExpr,
+		*sqlast.OverlayExpr:
+		depth++
+	}
+
+	if depth > s.stats.MaxExprDepth {
+		s.stats.MaxExprDepth = depth
+	}
+
+	return &statsVisitor{stats: s.stats, depth: depth}
+}
@@ -0,0 +1,100 @@
+package sqlastutil_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlastutil"
+)
+
+func TestAnalyze(t *testing.T) {
+	cases := []struct {
+		name   string
+		src    string
+		expect *sqlastutil.Stats
+	}{
+		{
+			name: "literals and placeholders",
+			src:  "SELECT a, 'x', 1.5 FROM t WHERE b = ? AND c IN (1, 2, 3) AND d IS NULL AND e = NULL",
+			expect: &sqlastutil.Stats{
+				Placeholders:    1,
+				StringLiterals:  1,
+				NumericLiterals: 4,
+				NullLiterals:    1,
+				InListSizes:     []int{3},
+				MaxExprDepth:    4,
+			},
+		},
+		{
+			name: "nested expressions",
+			src:  "SELECT count(*) FROM t WHERE (a = 1 OR (b = 2 AND c IN (?, ?))) AND d = true",
+			expect: &sqlastutil.Stats{
+				Placeholders:    2,
+				NumericLiterals: 2,
+				BooleanLiterals: 1,
+				InListSizes:     []int{2},
+				MaxExprDepth:    6,
+			},
+		},
+		{
+			name: "typed literals",
+			src:  "SELECT X'1F', B'101' FROM t WHERE a > DATE '2020-01-01' AND b < TIMESTAMP 'infinity'",
+			expect: &sqlastutil.Stats{
+				StringLiterals:   2,
+				DateTimeLiterals: 2,
+				MaxExprDepth:     2,
+			},
+		},
+		{
+			name: "arrays",
+			src:  "SELECT ARRAY[1, 2][1] FROM t",
+			expect: &sqlastutil.Stats{
+				NumericLiterals: 3,
+				MaxExprDepth:    2,
+			},
+		},
+		{
+			name: "quantified comparison",
+			src:  "SELECT a FROM t WHERE a = ANY (SELECT b FROM u WHERE b > 1)",
+			expect: &sqlastutil.Stats{
+				NumericLiterals: 1,
+				MaxExprDepth:    2,
+			},
+		},
+		{
+			name: "special functions",
+			src:  "SELECT EXTRACT(YEAR FROM a), POSITION('x' IN b), SUBSTRING(c FROM 1 FOR 2), TRIM(BOTH 'x' FROM d), OVERLAY(e PLACING 'y' FROM 2) FROM t",
+			expect: &sqlastutil.Stats{
+				StringLiterals:  3,
+				NumericLiterals: 3,
+				MaxExprDepth:    1,
+			},
+		},
+		{
+			name:   "no expressions",
+			src:    "SELECT a FROM t",
+			expect: &sqlastutil.Stats{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.src), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			if diff := cmp.Diff(c.expect, sqlastutil.Analyze(stmt)); diff != "" {
+				t.Errorf("diff %s", diff)
+			}
+		})
+	}
+}
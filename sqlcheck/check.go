@@ -0,0 +1,334 @@
+// Package sqlcheck performs static checks of statements which the parser accepts
+// but databases reject or evaluate unexpectedly.
+package sqlcheck
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// Rule identifies the kind of a check.
+type Rule string
+
+const (
+	// number of columns of INSERT and values of each row differ
+	InsertArity Rule = "insert-arity"
+	// column which is neither in GROUP BY nor aggregated, or GROUP BY position out of the select list
+	GroupBy Rule = "group-by"
+	// column defined twice in CREATE TABLE
+	DuplicateColumn Rule = "duplicate-column"
+	// aggregate or window function in WHERE or ON
+	AggregateInCondition Rule = "aggregate-in-condition"
+	// duplicate columns of JOIN USING
+	JoinSpec Rule = "join-spec"
+)
+
+// Diagnostic reports a problem of Node.
+type Diagnostic struct {
+	Rule    Rule
+	Node    sqlast.Node
+	Message string
+}
+
+func (d *Diagnostic) Pos() sqltoken.Pos {
+	return d.Node.Pos()
+}
+
+func (d *Diagnostic) End() sqltoken.Pos {
+	return d.Node.End()
+}
+
+func (d *Diagnostic) String() string {
+	pos := d.Pos()
+	return fmt.Sprintf("%d:%d: %s (%s)", pos.Line, pos.Col, d.Message, d.Rule)
+}
+
+// Check returns the diagnostics of node and its descendants in order of traversal.
+func Check(node sqlast.Node) []*Diagnostic {
+	c := &checker{}
+	sqlast.Inspect(node, c.check)
+	return c.diagnostics
+}
+
+type checker struct {
+	diagnostics []*Diagnostic
+}
+
+func (c *checker) report(rule Rule, node sqlast.Node, format string, args ...interface{}) {
+	c.diagnostics = append(c.diagnostics, &Diagnostic{Rule: rule, Node: node, Message: fmt.Sprintf(format, args...)})
+}
+
+func (c *checker) check(node sqlast.Node) bool {
+	switch n := node.(type) {
+	case nil:
+		return false
+	case *sqlast.InsertStmt:
+		c.insert(n)
+	case *sqlast.SQLSelect:
+		if n.WhereClause != nil {
+			c.aggregate(n.WhereClause, "WHERE")
+		}
+		c.groupBy(n)
+	case *sqlast.QualifiedJoin:
+		c.join(n)
+	case *sqlast.CreateTableStmt:
+		c.createTable(n)
+	}
+	return true
+}
+
+func (c *checker) insert(stmt *sqlast.InsertStmt) {
+	if len(stmt.Columns) == 0 {
+		return
+	}
+
+	switch src := stmt.Source.(type) {
+	case *sqlast.ConstructorSource:
+		for _, row := range src.Rows {
+			if len(row.Values) != len(stmt.Columns) {
+				c.report(InsertArity, row, "INSERT has %d columns but %d values", len(stmt.Columns), len(row.Values))
+			}
+		}
+	case *sqlast.SubQuerySource:
+		s, ok := src.SubQuery.Body.(*sqlast.SQLSelect)
+		if !ok {
+			return
+		}
+		for _, item := range s.Projection {
+			if selectExpr(item) == nil {
+				return
+			}
+		}
+		if len(s.Projection) != len(stmt.Columns) {
+			c.report(InsertArity, src, "INSERT has %d columns but SELECT has %d", len(stmt.Columns), len(s.Projection))
+		}
+	}
+}
+
+// aggregateFunctions are functions which aggregate rows without OVER.
+var aggregateFunctions = map[string]bool{
+	"AVG": true, "COUNT": true, "MAX": true, "MIN": true, "SUM": true,
+	"ARRAY_AGG": true, "STRING_AGG": true, "GROUP_CONCAT": true,
+	"BIT_AND": true, "BIT_OR": true, "BOOL_AND": true, "BOOL_OR": true, "EVERY": true,
+	"STDDEV": true, "STDDEV_POP": true, "STDDEV_SAMP": true,
+	"VARIANCE": true, "VAR_POP": true, "VAR_SAMP": true,
+	"JSON_AGG": true, "JSONB_AGG": true, "JSON_OBJECT_AGG": true, "JSONB_OBJECT_AGG": true,
+}
+
+func isAggregate(f *sqlast.Function) bool {
+	name := f.Name.Idents[len(f.Name.Idents)-1].Value
+	return f.Over == nil && aggregateFunctions[strings.ToUpper(name)]
+}
+
+// inspectOuter calls f for node and its descendants except subqueries, which have their own scope.
+func inspectOuter(node sqlast.Node, f func(sqlast.Node) bool) {
+	sqlast.Inspect(node, func(n sqlast.Node) bool {
+		if _, ok := n.(*sqlast.QueryStmt); ok || n == nil {
+			return false
+		}
+		return f(n)
+	})
+}
+
+func (c *checker) aggregate(cond sqlast.Node, clause string) {
+	inspectOuter(cond, func(n sqlast.Node) bool {
+		f, ok := n.(*sqlast.Function)
+		if !ok {
+			return true
+		}
+		if f.Over != nil {
+			c.report(AggregateInCondition, f, "window function %s is not allowed in %s", f.Name.ToSQLString(), clause)
+			return false
+		}
+		if isAggregate(f) {
+			c.report(AggregateInCondition, f, "aggregate function %s is not allowed in %s", f.Name.ToSQLString(), clause)
+			return false
+		}
+		return true
+	})
+}
+
+// niladicFunctions are functions which are called without parentheses and parsed as identifiers.
+var niladicFunctions = map[string]bool{
+	"CURRENT_DATE": true, "CURRENT_TIME": true, "CURRENT_TIMESTAMP": true,
+	"LOCALTIME": true, "LOCALTIMESTAMP": true,
+	"CURRENT_USER": true, "SESSION_USER": true, "SYSTEM_USER": true, "USER": true,
+	"CURRENT_ROLE": true, "CURRENT_CATALOG": true, "CURRENT_SCHEMA": true,
+}
+
+// column is a column reference whose identifiers are case-folded.
+type column struct {
+	qualifier string // empty if unqualified
+	name      string
+}
+
+// foldIdent returns the name of id as the database compares it:
+// unquoted identifiers are case-insensitive, and delimited ones are compared as written.
+func foldIdent(id *sqlast.Ident) string {
+	if id.QuoteStyle == 0 || len(id.Value) < 2 {
+		return strings.ToLower(id.Value)
+	}
+	return id.Value[1 : len(id.Value)-1]
+}
+
+// columnOf returns the column which n refers to.
+func columnOf(n sqlast.Node) (column, bool) {
+	switch n := n.(type) {
+	case *sqlast.Ident:
+		return column{name: foldIdent(n)}, true
+	case *sqlast.CompoundIdent:
+		qualifier := make([]string, 0, len(n.Idents)-1)
+		for _, id := range n.Idents[:len(n.Idents)-1] {
+			qualifier = append(qualifier, foldIdent(id))
+		}
+		return column{qualifier: strings.Join(qualifier, "."), name: foldIdent(n.Idents[len(n.Idents)-1])}, true
+	}
+	return column{}, false
+}
+
+// columnSet is a set of grouped columns.
+type columnSet map[column]bool
+
+// contains reports whether col is in s. An unqualified column matches a qualified one of the same name.
+func (s columnSet) contains(col column) bool {
+	for g := range s {
+		if g.name == col.name && (g.qualifier == col.qualifier || g.qualifier == "" || col.qualifier == "") {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) groupBy(s *sqlast.SQLSelect) {
+	if len(s.GroupByClause) == 0 {
+		return
+	}
+
+	grouped := make(map[string]bool)
+	columns := make(columnSet)
+	group := func(expr sqlast.Node) {
+		grouped[groupKey(expr)] = true
+		if col, ok := columnOf(expr); ok {
+			columns[col] = true
+		}
+	}
+	for _, g := range s.GroupByClause {
+		if l, ok := g.(*sqlast.LongValue); ok {
+			if l.Long < 1 || int(l.Long) > len(s.Projection) {
+				c.report(GroupBy, g, "GROUP BY position %d is not in select list", l.Long)
+				continue
+			}
+			if expr := selectExpr(s.Projection[l.Long-1]); expr != nil {
+				group(expr)
+			}
+			continue
+		}
+		group(g)
+	}
+	for _, item := range s.Projection {
+		if a, ok := item.(*sqlast.AliasSelectItem); ok && columns[column{name: foldIdent(a.Alias)}] {
+			group(a.Expr)
+		}
+	}
+
+	var ungrouped func(n sqlast.Node) bool
+	ungrouped = func(n sqlast.Node) bool {
+		if grouped[groupKey(n)] {
+			return false
+		}
+		if col, ok := columnOf(n); ok && columns.contains(col) {
+			return false
+		}
+		switch n := n.(type) {
+		case *sqlast.Function:
+			// skip the function name
+			if !isAggregate(n) {
+				for _, arg := range n.Args {
+					inspectOuter(arg, ungrouped)
+				}
+			}
+			return false
+		case *sqlast.ExtractExpr:
+			// skip the field keyword
+			inspectOuter(n.Source, ungrouped)
+			return false
+		case *sqlast.Ident:
+			if n.QuoteStyle == 0 && niladicFunctions[strings.ToUpper(n.Value)] {
+				return false
+			}
+			c.report(GroupBy, n, "column %s must appear in GROUP BY or be used in an aggregate function", n.ToSQLString())
+			return false
+		case *sqlast.CompoundIdent:
+			c.report(GroupBy, n, "column %s must appear in GROUP BY or be used in an aggregate function", n.ToSQLString())
+			return false
+		}
+		return true
+	}
+	for _, item := range s.Projection {
+		if expr := selectExpr(item); expr != nil {
+			inspectOuter(expr, ungrouped)
+		}
+	}
+}
+
+// groupKey returns the SQL of expr with folded identifiers,
+// so that expressions which differ only in the letter case of unquoted identifiers have the same key.
+func groupKey(expr sqlast.Node) string {
+	var b strings.Builder
+	_, _ = sqlast.WriteWithHook(&b, expr, func(sw *sqlast.SQLWriter, n sqlast.Node) bool {
+		if id, ok := n.(*sqlast.Ident); ok {
+			sw.Bytes([]byte(`"` + foldIdent(id) + `"`))
+			return true
+		}
+		return false
+	})
+	return b.String()
+}
+
+// selectExpr returns the expression of item, or nil if item is a wildcard.
+func selectExpr(item sqlast.SQLSelectItem) sqlast.Node {
+	switch i := item.(type) {
+	case *sqlast.UnnamedSelectItem:
+		switch i.Node.(type) {
+		case *sqlast.Wildcard, *sqlast.QualifiedWildcard:
+			return nil
+		}
+		return i.Node
+	case *sqlast.AliasSelectItem:
+		return i.Expr
+	}
+	return nil
+}
+
+func (c *checker) join(j *sqlast.QualifiedJoin) {
+	switch spec := j.Spec.(type) {
+	case *sqlast.JoinCondition:
+		c.aggregate(spec.SearchCondition, "ON")
+	case *sqlast.NamedColumnsJoin:
+		seen := make(map[string]bool)
+		for _, col := range spec.ColumnList {
+			if seen[foldIdent(col)] {
+				c.report(JoinSpec, col, "column %s appears twice in USING", col.ToSQLString())
+			}
+			seen[foldIdent(col)] = true
+		}
+	}
+}
+
+func (c *checker) createTable(stmt *sqlast.CreateTableStmt) {
+	seen := make(map[string]bool)
+	for _, e := range stmt.Elements {
+		col, ok := e.(*sqlast.ColumnDef)
+		if !ok {
+			continue
+		}
+		name := foldIdent(col.Name)
+		if seen[name] {
+			c.report(DuplicateColumn, col.Name, "column %s is defined twice in %s", col.Name.ToSQLString(), stmt.Name.ToSQLString())
+		}
+		seen[name] = true
+	}
+}
@@ -0,0 +1,137 @@
+package sqlcheck
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser"
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestCheck(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		out  []string
+	}{
+		{
+			name: "valid",
+			in: "SELECT u.name, COUNT(*) AS cnt, MAX(o.amount) + 1 FROM users AS u " +
+				"INNER JOIN orders AS o ON u.id = o.user_id " +
+				"WHERE o.amount > (SELECT AVG(amount) FROM orders) GROUP BY u.name HAVING COUNT(*) > 1",
+		},
+		{
+			name: "insert values",
+			in:   "INSERT INTO t (a, b) VALUES (1, 2), (3), (4, 5, 6)",
+			out: []string{
+				"1:37: INSERT has 2 columns but 1 values (insert-arity)",
+				"1:42: INSERT has 2 columns but 3 values (insert-arity)",
+			},
+		},
+		{
+			name: "insert select",
+			in:   "INSERT INTO t (a, b) SELECT x FROM s",
+			out:  []string{"1:22: INSERT has 2 columns but SELECT has 1 (insert-arity)"},
+		},
+		{
+			name: "insert select wildcard",
+			in:   "INSERT INTO t (a, b) SELECT * FROM s",
+		},
+		{
+			name: "group by",
+			in:   "SELECT a, b, lower(c), COUNT(d) FROM t GROUP BY a, lower(c), 5",
+			out: []string{
+				"1:62: GROUP BY position 5 is not in select list (group-by)",
+				"1:11: column b must appear in GROUP BY or be used in an aggregate function (group-by)",
+			},
+		},
+		{
+			name: "group by alias and position",
+			in:   "SELECT lower(a) AS la, b + 1, c FROM t GROUP BY la, 2, c",
+		},
+		{
+			name: "group by case-insensitive and qualified columns",
+			in:   "SELECT a, t.b, c, CURRENT_TIMESTAMP, EXTRACT(YEAR FROM ts) FROM t GROUP BY A, b, t.c, ts",
+		},
+		{
+			name: "group by case-insensitive expression",
+			in:   "SELECT lower(a), T.b + 1 FROM t GROUP BY LOWER(A), t.B + 1",
+		},
+		{
+			name: "group by qualified column",
+			in:   "SELECT t.a, u.a FROM t, u GROUP BY t.a",
+			out:  []string{"1:13: column u.a must appear in GROUP BY or be used in an aggregate function (group-by)"},
+		},
+		{
+			name: "group by quoted column",
+			in:   `SELECT "A", EXTRACT(DAY FROM ts) FROM t GROUP BY a`,
+			out: []string{
+				`1:8: column "A" must appear in GROUP BY or be used in an aggregate function (group-by)`,
+				"1:30: column ts must appear in GROUP BY or be used in an aggregate function (group-by)",
+			},
+		},
+		{
+			name: "searched case",
+			in:   "SELECT CASE WHEN a THEN 1 END, COUNT(*) FROM t GROUP BY a",
+		},
+		{
+			name: "aggregate in where",
+			in:   "SELECT a FROM t WHERE SUM(b) > 1 AND RANK() OVER (ORDER BY a) = 1 AND lower(a) = 'x'",
+			out: []string{
+				"1:23: aggregate function SUM is not allowed in WHERE (aggregate-in-condition)",
+				"1:38: window function RANK is not allowed in WHERE (aggregate-in-condition)",
+			},
+		},
+		{
+			name: "aggregate in on",
+			in:   "SELECT * FROM a JOIN b ON count(a.id) > 0",
+			out:  []string{"1:27: aggregate function count is not allowed in ON (aggregate-in-condition)"},
+		},
+		{
+			name: "using",
+			in:   "SELECT * FROM a JOIN b USING (id, id)",
+			out:  []string{"1:35: column id appears twice in USING (join-spec)"},
+		},
+		{
+			name: "using case-insensitive",
+			in:   "SELECT * FROM a JOIN b USING (id, ID)",
+			out:  []string{"1:35: column ID appears twice in USING (join-spec)"},
+		},
+		{
+			name: "duplicate column",
+			in:   "CREATE TABLE t (a int, b int, a text)",
+			out:  []string{"1:31: column a is defined twice in t (duplicate-column)"},
+		},
+		{
+			name: "duplicate column in other cases",
+			in:   `CREATE TABLE t (id int, ID int, "id" int, "ID" int)`,
+			out: []string{
+				"1:25: column ID is defined twice in t (duplicate-column)",
+				`1:33: column "id" is defined twice in t (duplicate-column)`,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parser, err := xsqlparser.NewParser(bytes.NewBufferString(c.in), &dialect.GenericSQLDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			stmt, err := parser.ParseStatement()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+
+			var act []string
+			for _, d := range Check(stmt) {
+				act = append(act, d.String())
+			}
+			if diff := cmp.Diff(c.out, act); diff != "" {
+				t.Errorf("diff: %s", diff)
+			}
+		})
+	}
+}
@@ -66,6 +66,34 @@ const (
 	LBrace
 	// Right brace `}`
 	RBrace
+	// Placeholder i.e: ?, $1, :name, @name
+	Placeholder
+	// Dollar quoted string i.e: $$string$$, $tag$string$tag$
+	DollarQuotedString
+	// Inline data of COPY ... FROM STDIN terminated by \.
+	CopyData
+	// -> operator
+	Arrow
+	// ->> operator
+	LongArrow
+	// #> operator
+	HashArrow
+	// #>> operator
+	HashLongArrow
+	// @> operator
+	AtArrow
+	// ~ operator
+	Tilde
+	// ~* operator
+	TildeAsterisk
+	// !~ operator
+	NotTilde
+	// !~* operator
+	NotTildeAsterisk
+	// Hexadecimal string i.e: X'deadbeef'
+	HexStringLiteral
+	// Bit string i.e: B'0101'
+	BitStringLiteral
 	// ILLEGAL sqltoken
 	ILLEGAL
 )
@@ -39,12 +39,26 @@ func _() {
 	_ = x[Ampersand-28]
 	_ = x[LBrace-29]
 	_ = x[RBrace-30]
-	_ = x[ILLEGAL-31]
+	_ = x[Placeholder-31]
+	_ = x[DollarQuotedString-32]
+	_ = x[CopyData-33]
+	_ = x[Arrow-34]
+	_ = x[LongArrow-35]
+	_ = x[HashArrow-36]
+	_ = x[HashLongArrow-37]
+	_ = x[AtArrow-38]
+	_ = x[Tilde-39]
+	_ = x[TildeAsterisk-40]
+	_ = x[NotTilde-41]
+	_ = x[NotTildeAsterisk-42]
+	_ = x[HexStringLiteral-43]
+	_ = x[BitStringLiteral-44]
+	_ = x[ILLEGAL-45]
 }
 
-const _Kind_name = "SQLKeywordNumberCharSingleQuotedStringNationalStringLiteralCommaWhitespaceCommentEqNeqLtGtLtEqGtEqPlusMinusMultDivModLParenRParenPeriodColonDoubleColonSemicolonBackslashLBracketRBracketAmpersandLBraceRBraceILLEGAL"
+const _Kind_name = "SQLKeywordNumberCharSingleQuotedStringNationalStringLiteralCommaWhitespaceCommentEqNeqLtGtLtEqGtEqPlusMinusMultDivModLParenRParenPeriodColonDoubleColonSemicolonBackslashLBracketRBracketAmpersandLBraceRBracePlaceholderDollarQuotedStringCopyDataArrowLongArrowHashArrowHashLongArrowAtArrowTildeTildeAsteriskNotTildeNotTildeAsteriskHexStringLiteralBitStringLiteralILLEGAL"
 
-var _Kind_index = [...]uint8{0, 10, 16, 20, 38, 59, 64, 74, 81, 83, 86, 88, 90, 94, 98, 102, 107, 111, 114, 117, 123, 129, 135, 140, 151, 160, 169, 177, 185, 194, 200, 206, 213}
+var _Kind_index = [...]uint16{0, 10, 16, 20, 38, 59, 64, 74, 81, 83, 86, 88, 90, 94, 98, 102, 107, 111, 114, 117, 123, 129, 135, 140, 151, 160, 169, 177, 185, 194, 200, 206, 217, 235, 243, 248, 257, 266, 279, 286, 291, 304, 312, 328, 344, 360, 367}
 
 func (i Kind) String() string {
 	if i < 0 || i >= Kind(len(_Kind_index)-1) {
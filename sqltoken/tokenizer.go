@@ -5,6 +5,7 @@ import (
 	"io"
 	"strings"
 	"text/scanner"
+	"unicode"
 
 	errors "golang.org/x/xerrors"
 
@@ -19,13 +20,39 @@ type SQLWord struct {
 
 func (s *SQLWord) String() string {
 	if s.QuoteStyle == '"' || s.QuoteStyle == '[' || s.QuoteStyle == '`' {
-		return string(s.QuoteStyle) + s.Value + string(matchingEndQuote(s.QuoteStyle))
+		end := string(matchingEndQuote(s.QuoteStyle))
+		return string(s.QuoteStyle) + strings.ReplaceAll(s.Value, end, end+end) + end
 	} else if s.QuoteStyle == 0 {
 		return s.Value
 	}
 	return ""
 }
 
+// UnterminatedError is returned when a quoted string, delimited identifier
+// or block comment isn't closed before EOF.
+type UnterminatedError struct {
+	Kind    Kind   // SingleQuotedString, DollarQuotedString, SQLKeyword (delimited identifier) or Comment
+	Pos     Pos    // position of the opening quote or comment
+	Partial string // text scanned before EOF
+}
+
+func (e *UnterminatedError) Error() string {
+	var what string
+	switch e.Kind {
+	case SingleQuotedString:
+		what = "single quoted string"
+	case DollarQuotedString:
+		what = "dollar quoted string"
+	case SQLKeyword:
+		what = "delimited identifier"
+	case Comment:
+		what = "multiline comment"
+	default:
+		what = e.Kind.String()
+	}
+	return fmt.Sprintf("unclosed %s: %s at %s", what, e.Partial, e.Pos.String())
+}
+
 func matchingEndQuote(quoteStyle rune) rune {
 	switch quoteStyle {
 	case '"':
@@ -43,13 +70,13 @@ var keywordCache = map[string]*SQLWord{}
 func init() {
 	for keyword := range dialect.Keywords {
 		keywordCache[keyword] = &SQLWord{
-			Value:      keyword,
-			Keyword:    keyword,
+			Value:   keyword,
+			Keyword: keyword,
 		}
 		lower := strings.ToLower(keyword)
 		keywordCache[lower] = &SQLWord{
-			Value:      lower,
-			Keyword:    keyword,
+			Value:   lower,
+			Keyword: keyword,
 		}
 	}
 }
@@ -77,6 +104,9 @@ func MakeKeyword(word string, quoteStyle rune) *SQLWord {
 	}
 }
 
+// Token is a token scanned by Tokenizer. From is the position of its first character and To is the position after its last character.
+// Tokens cover the source without gaps, including Whitespace and Comment tokens unless DisableParseComment is given,
+// so concatenating src[From.Offset:To.Offset] of all tokens reconstructs the source exactly.
 type Token struct {
 	Kind  Kind
 	Value interface{}
@@ -84,6 +114,7 @@ type Token struct {
 	To    Pos
 }
 
+// NewPos returns the position at line and col, whose Offset is unknown.
 func NewPos(line, col int) Pos {
 	return Pos{
 		Line: line,
@@ -91,9 +122,11 @@ func NewPos(line, col int) Pos {
 	}
 }
 
+// Pos is a position in the source. Line and Col start from 1.
 type Pos struct {
-	Line int
-	Col  int
+	Line   int
+	Col    int
+	Offset int // bytes from the beginning of the source
 }
 
 func (p *Pos) String() string {
@@ -124,8 +157,23 @@ type Tokenizer struct {
 	Line         int
 	Col          int
 	parseComment bool
+	lineHasToken bool // a non-whitespace token has been scanned on the current line
+	stmtStart    bool // no token but whitespaces and comments has been scanned in the current statement
+	copyState    copyState
+	bracketDepth int // nesting depth of [ ], in which :name is a slice bound rather than a placeholder
 }
 
+// states to scan inline data of COPY ... FROM STDIN
+type copyState int
+
+const (
+	copyNone         copyState = iota
+	copyHeader                 // COPY is scanned at the beginning of statement
+	copyStdin                  // STDIN is scanned in COPY statement
+	copyAwaitNewline           // semicolon is scanned after STDIN
+	copyData                   // the next token is inline data
+)
+
 func NewTokenizer(src io.Reader, dialect dialect.Dialect) *Tokenizer {
 	var scan scanner.Scanner
 	return &Tokenizer{
@@ -134,9 +182,21 @@ func NewTokenizer(src io.Reader, dialect dialect.Dialect) *Tokenizer {
 		Line:         1,
 		Col:          1,
 		parseComment: true,
+		stmtStart:    true,
 	}
 }
 
+// Reset makes t scan src from the beginning, reusing the buffer of the scanner.
+func (t *Tokenizer) Reset(src io.Reader) {
+	t.Scanner.Init(src)
+	t.Line = 1
+	t.Col = 1
+	t.lineHasToken = false
+	t.stmtStart = true
+	t.copyState = copyNone
+	t.bracketDepth = 0
+}
+
 type TokenizerOption func(*Tokenizer)
 
 func Dialect(dialect dialect.Dialect) TokenizerOption {
@@ -168,7 +228,11 @@ func (t *Tokenizer) Tokenize() ([]*Token, error) {
 			break
 		}
 		if err != nil {
-			return nil, err
+			// the tokens scanned so far and the ILLEGAL token are returned with err
+			if t != nil {
+				tokenset = append(tokenset, t)
+			}
+			return tokenset, err
 		}
 
 		if t == nil {
@@ -196,9 +260,27 @@ func (t *Tokenizer) Scan(token *Token) (*Token, error) {
 		token.Value = ""
 		token.From = pos
 		token.To = t.Pos()
+		var uerr *UnterminatedError
+		if errors.As(err, &uerr) {
+			uerr.Pos = pos
+			token.Value = uerr.Partial
+		}
 		return token, errors.Errorf("tokenize failed: %w", err)
 	}
 
+	if tok == Comment {
+		c := str.(*CommentValue)
+		c.OwnLine = !t.lineHasToken
+		next := t.Scanner.Peek()
+		c.TrailingNewline = next == '\n' || next == '\r'
+	}
+	if tok != Whitespace {
+		t.lineHasToken = true
+	} else if str == "\n" {
+		t.lineHasToken = false
+	}
+	t.updateCopyState(tok, str)
+
 	if !t.parseComment && (tok == Whitespace || tok == Comment) {
 		return nil, nil
 	}
@@ -210,14 +292,52 @@ func (t *Tokenizer) Scan(token *Token) (*Token, error) {
 	return token, nil
 }
 
+func (t *Tokenizer) updateCopyState(tok Kind, value interface{}) {
+	switch tok {
+	case Whitespace:
+		if value == "\n" && t.copyState == copyAwaitNewline {
+			t.copyState = copyData
+		}
+		return
+	case Comment:
+		return
+	case Semicolon:
+		if t.copyState == copyStdin {
+			t.copyState = copyAwaitNewline
+		} else {
+			t.copyState = copyNone
+		}
+		t.stmtStart = true
+		return
+	case SQLKeyword:
+		word := value.(*SQLWord)
+		if word.QuoteStyle == 0 {
+			switch {
+			case word.Keyword == "COPY" && t.stmtStart:
+				t.copyState = copyHeader
+			case word.Keyword == "STDIN" && t.copyState == copyHeader:
+				t.copyState = copyStdin
+			}
+		}
+	}
+	t.stmtStart = false
+}
+
 func (t *Tokenizer) Pos() Pos {
 	return Pos{
-		Line: t.Line,
-		Col:  t.Col,
+		Line:   t.Line,
+		Col:    t.Col,
+		Offset: t.Scanner.Pos().Offset,
 	}
 }
 
 func (t *Tokenizer) next() (Kind, interface{}, error) {
+	if t.copyState == copyData {
+		t.copyState = copyNone
+		t.stmtStart = true
+		return t.tokenizeCopyData()
+	}
+
 	r := t.Scanner.Peek()
 	switch {
 	case ' ' == r:
@@ -251,7 +371,7 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		n := t.Scanner.Peek()
 		if n == '\'' {
 			t.Col += 1
-			str, err := t.tokenizeSingleQuotedString()
+			str, err := t.tokenizeSingleQuotedString(t.Dialect.UnescapeString)
 			if err != nil {
 				return ILLEGAL, "", err
 			}
@@ -261,13 +381,47 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		v := MakeKeyword(s, 0)
 		return SQLKeyword, v, nil
 
+	case 'E' == r || 'e' == r || 'X' == r || 'x' == r || 'B' == r || 'b' == r:
+		t.Scanner.Next()
+		if t.Scanner.Peek() != '\'' {
+			s := t.tokenizeWord(r)
+			return SQLKeyword, MakeKeyword(s, 0), nil
+		}
+		t.Col += 1
+		switch r {
+		case 'E', 'e':
+			str, err := t.tokenizeSingleQuotedString(unescapeEscapeString)
+			if err != nil {
+				return ILLEGAL, "", err
+			}
+			return SingleQuotedString, str, nil
+		case 'X', 'x':
+			str, err := t.tokenizeSingleQuotedString(noUnescape)
+			if err != nil {
+				return ILLEGAL, "", err
+			}
+			return HexStringLiteral, str, nil
+		default:
+			str, err := t.tokenizeSingleQuotedString(noUnescape)
+			if err != nil {
+				return ILLEGAL, "", err
+			}
+			return BitStringLiteral, str, nil
+		}
+
 	case t.Dialect.IsIdentifierStart(r):
 		t.Scanner.Next()
+		// some dialects allow @ as an identifier start, but @> is always the operator.
+		if r == '@' && t.Scanner.Peek() == '>' {
+			t.Scanner.Next()
+			t.Col += 2
+			return AtArrow, "@>", nil
+		}
 		s := t.tokenizeWord(r)
 		return SQLKeyword, MakeKeyword(s, 0), nil
 
 	case '\'' == r:
-		s, err := t.tokenizeSingleQuotedString()
+		s, err := t.tokenizeSingleQuotedString(t.Dialect.UnescapeString)
 		if err != nil {
 			return ILLEGAL, "", err
 		}
@@ -278,28 +432,57 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 		end := matchingEndQuote(r)
 
 		var s []rune
+		cols := 2
 		for {
 			n := t.Scanner.Next()
+			if n == scanner.EOF {
+				return ILLEGAL, "", &UnterminatedError{Kind: SQLKeyword, Partial: string(r) + string(s)}
+			}
 			if n == end {
-				break
+				// doubled end quote is an escaped quote
+				if t.Scanner.Peek() != end {
+					break
+				}
+				t.Scanner.Next()
+				cols += 1
 			}
 			s = append(s, n)
+			cols += 1
 		}
-		t.Col += 2 + len(s)
+		t.Col += cols
 
 		return SQLKeyword, MakeKeyword(string(s), r), nil
 
 	case '0' <= r && r <= '9':
 		var s []rune
+		exponent := false
 		for {
 			n := t.Scanner.Peek()
-			if ('0' <= n && n <= '9') || n == '.' {
+			if ('0' <= n && n <= '9') || n == '.' || n == '_' {
+				s = append(s, n)
+				t.Scanner.Next()
+			} else if (n == 'e' || n == 'E') && !exponent {
+				// exponent i.e: 1e10, 1.5E-3
+				exponent = true
 				s = append(s, n)
 				t.Scanner.Next()
+				if sign := t.Scanner.Peek(); sign == '+' || sign == '-' {
+					s = append(s, sign)
+					t.Scanner.Next()
+				}
 			} else {
 				break
 			}
 		}
+		// hexadecimal, octal and binary integers i.e: 0x1F, 0o17, 0b101 (MySQL, PostgreSQL 16+)
+		if n := t.Scanner.Peek(); len(s) == 1 && s[0] == '0' && strings.ContainsRune("xXoObB", n) {
+			s = append(s, n)
+			t.Scanner.Next()
+			for n := t.Scanner.Peek(); n == '_' || unicode.IsLetter(n) || unicode.IsDigit(n); n = t.Scanner.Peek() {
+				s = append(s, n)
+				t.Scanner.Next()
+			}
+		}
 		t.Col += len(s)
 		return Number, string(s), nil
 
@@ -323,18 +506,18 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 
 		if '-' == t.Scanner.Peek() {
 			t.Scanner.Next()
-
-			var s []rune
-			for {
-				ch := t.Scanner.Peek()
-				if ch != scanner.EOF && ch != '\n' {
-					t.Scanner.Next()
-					s = append(s, ch)
-				} else {
-					t.Col += len(s) + 2
-					return Comment, string(s), nil // Comment Node
-				}
+			s := t.tokenizeLineComment(2)
+			return Comment, &CommentValue{Text: s, Style: LineComment}, nil
+		}
+		if '>' == t.Scanner.Peek() {
+			t.Scanner.Next()
+			if '>' == t.Scanner.Peek() {
+				t.Scanner.Next()
+				t.Col += 3
+				return LongArrow, "->>", nil
 			}
+			t.Col += 2
+			return Arrow, "->", nil
 		}
 		t.Col += 1
 		return Minus, "-", nil
@@ -344,11 +527,17 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 
 		if '*' == t.Scanner.Peek() {
 			t.Scanner.Next()
+			style := BlockComment
+			if '+' == t.Scanner.Peek() && dialect.Supports(t.Dialect, dialect.OptimizerHint) {
+				t.Scanner.Next()
+				t.Col += 1
+				style = HintComment
+			}
 			str, err := t.tokenizeMultilineComment()
 			if err != nil {
 				return ILLEGAL, str, err
 			}
-			return Comment, str, nil
+			return Comment, &CommentValue{Text: str, Style: style}, nil
 		}
 		t.Col += 1
 		return Div, "/", nil
@@ -382,6 +571,16 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			t.Col += 2
 			return Neq, "!=", nil
 		}
+		if n == '~' {
+			t.Scanner.Next()
+			if '*' == t.Scanner.Peek() {
+				t.Scanner.Next()
+				t.Col += 3
+				return NotTildeAsterisk, "!~*", nil
+			}
+			t.Col += 2
+			return NotTilde, "!~", nil
+		}
 		return ILLEGAL, "", errors.Errorf("tokenizer error: illegal sequence %s%s", string(r), string(n))
 
 	case '<' == r:
@@ -419,7 +618,69 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 			return DoubleColon, "::", nil
 		}
 		t.Col += 1
+		if t.bracketDepth == 0 && dialect.Supports(t.Dialect, dialect.ColonPlaceholder) && t.Dialect.IsIdentifierStart(n) {
+			t.Scanner.Next()
+			return Placeholder, ":" + t.tokenizeWord(n), nil
+		}
 		return Colon, ":", nil
+	case '?' == r:
+		t.Scanner.Next()
+		t.Col += 1
+		return Placeholder, "?", nil
+	case '$' == r:
+		t.Scanner.Next()
+		t.Col += 1
+		if n := t.Scanner.Peek(); dialect.Supports(t.Dialect, dialect.DollarPlaceholder) && '0' <= n && n <= '9' {
+			return Placeholder, "$" + t.tokenizeDigits(), nil
+		}
+		if n := t.Scanner.Peek(); dialect.Supports(t.Dialect, dialect.DollarQuotedString) && (n == '$' || isDollarQuoteTagStart(n)) {
+			q, err := t.tokenizeDollarQuotedString()
+			if err != nil {
+				return ILLEGAL, "", err
+			}
+			return DollarQuotedString, q, nil
+		}
+		return Char, "$", nil
+	case '~' == r:
+		t.Scanner.Next()
+		if '*' == t.Scanner.Peek() {
+			t.Scanner.Next()
+			t.Col += 2
+			return TildeAsterisk, "~*", nil
+		}
+		t.Col += 1
+		return Tilde, "~", nil
+	case '#' == r:
+		t.Scanner.Next()
+		if dialect.Supports(t.Dialect, dialect.HashComment) {
+			s := t.tokenizeLineComment(1)
+			return Comment, &CommentValue{Text: s, Style: HashComment}, nil
+		}
+		if '>' == t.Scanner.Peek() {
+			t.Scanner.Next()
+			if '>' == t.Scanner.Peek() {
+				t.Scanner.Next()
+				t.Col += 3
+				return HashLongArrow, "#>>", nil
+			}
+			t.Col += 2
+			return HashArrow, "#>", nil
+		}
+		t.Col += 1
+		return Char, "#", nil
+	case '@' == r:
+		t.Scanner.Next()
+		if '>' == t.Scanner.Peek() {
+			t.Scanner.Next()
+			t.Col += 2
+			return AtArrow, "@>", nil
+		}
+		t.Col += 1
+		if n := t.Scanner.Peek(); dialect.Supports(t.Dialect, dialect.AtPlaceholder) && t.Dialect.IsIdentifierStart(n) {
+			t.Scanner.Next()
+			return Placeholder, "@" + t.tokenizeWord(n), nil
+		}
+		return Char, "@", nil
 	case ';' == r:
 		t.Scanner.Next()
 		t.Col += 1
@@ -431,10 +692,14 @@ func (t *Tokenizer) next() (Kind, interface{}, error) {
 	case '[' == r:
 		t.Scanner.Next()
 		t.Col += 1
+		t.bracketDepth++
 		return LBracket, "[", nil
 	case ']' == r:
 		t.Scanner.Next()
 		t.Col += 1
+		if t.bracketDepth > 0 {
+			t.bracketDepth--
+		}
 		return RBracket, "]", nil
 	case '&' == r:
 		t.Scanner.Next()
@@ -475,39 +740,216 @@ func (t *Tokenizer) tokenizeWord(f rune) string {
 	return str
 }
 
-func (t *Tokenizer) tokenizeSingleQuotedString() (string, error) {
+func (t *Tokenizer) tokenizeDigits() string {
+	var builder strings.Builder
+	for {
+		r := t.Scanner.Peek()
+		if '0' <= r && r <= '9' {
+			t.Scanner.Next()
+			builder.WriteRune(r)
+		} else {
+			break
+		}
+	}
+
+	str := builder.String()
+	t.Col += len(str)
+	return str
+}
+
+// tokenizeSingleQuotedString tokenizes the string quoted by '.
+// unescape returns the character escaped by backslash, ok is false if backslash isn't an escape character.
+func (t *Tokenizer) tokenizeSingleQuotedString(unescape func(r rune) (s string, ok bool)) (string, error) {
 	var builder strings.Builder
 	t.Scanner.Next()
+	cols := 2
 	for {
 		n := t.Scanner.Peek()
 		if n == '\'' {
 			t.Scanner.Next()
 			if t.Scanner.Peek() == '\'' {
-				// str = append(str, '\'')
 				builder.WriteRune('\'')
 				t.Scanner.Next()
+				cols += 2
 			} else {
 				break
 			}
 			continue
 		}
 		if n == scanner.EOF {
-			return "", errors.Errorf("unclosed single quoted string: %s at %+v", builder.String(), t.Pos())
+			return "", &UnterminatedError{Kind: SingleQuotedString, Partial: "'" + builder.String()}
 		}
 
 		t.Scanner.Next()
+		cols += 1
+		if n == '\\' {
+			e := t.Scanner.Peek()
+			if s, ok := unescape(e); ok && e != scanner.EOF {
+				t.Scanner.Next()
+				cols += 1
+				builder.WriteString(s)
+				continue
+			}
+		}
 		builder.WriteRune(n)
-		// str = append(str, n)
 	}
-	str := builder.String()
-	t.Col += 2 + len(str)
+	t.Col += cols
+
+	return builder.String(), nil
+}
+
+// unescapeEscapeString unescapes the backslash escape sequences of E'string' (PostgreSQL).
+func unescapeEscapeString(r rune) (string, bool) {
+	switch r {
+	case 'b':
+		return "\b", true
+	case 'f':
+		return "\f", true
+	case 'n':
+		return "\n", true
+	case 'r':
+		return "\r", true
+	case 't':
+		return "\t", true
+	}
+	return string(r), true
+}
+
+func noUnescape(rune) (string, bool) {
+	return "", false
+}
+
+type CommentStyle int
+
+const (
+	// -- comment
+	LineComment CommentStyle = iota
+	// /* comment */
+	BlockComment
+	// # comment (MySQL)
+	HashComment
+	// /*+ optimizer hint */ (MySQL)
+	HintComment
+)
+
+// CommentValue is the value of Comment token.
+// Text does not contain the comment markers (--, #, /*+ and the outermost /* and */).
+type CommentValue struct {
+	Text  string
+	Style CommentStyle
+	// OwnLine reports whether only whitespace precedes the comment on its first line.
+	OwnLine bool
+	// TrailingNewline reports whether the comment is immediately followed by a line break.
+	// It is false for a line comment at the end of input.
+	TrailingNewline bool
+}
 
-	return str, nil
+// DollarQuote is the value of DollarQuotedString token i.e: $tag$Value$tag$
+type DollarQuote struct {
+	Tag   string
+	Value string
 }
 
+func isDollarQuoteTagStart(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || r == '_'
+}
+
+func isDollarQuoteTagPart(r rune) bool {
+	return isDollarQuoteTagStart(r) || (r >= '0' && r <= '9')
+}
+
+// tokenizeDollarQuotedString is called after the first `$` is consumed.
+func (t *Tokenizer) tokenizeDollarQuotedString() (*DollarQuote, error) {
+	var tag strings.Builder
+	for {
+		n := t.Scanner.Next()
+		t.Col += 1
+		if n == '$' {
+			break
+		}
+		if !isDollarQuoteTagPart(n) {
+			return nil, errors.Errorf("invalid dollar quote tag: %s at %+v", tag.String()+string(n), t.Pos())
+		}
+		tag.WriteRune(n)
+	}
+
+	delim := "$" + tag.String() + "$"
+	var str []rune
+	for {
+		n := t.Scanner.Next()
+
+		if n == '\n' {
+			t.Col = 1
+			t.Line += 1
+		} else if n == scanner.EOF {
+			return nil, &UnterminatedError{Kind: DollarQuotedString, Partial: delim + string(str)}
+		} else {
+			t.Col += 1
+		}
+
+		str = append(str, n)
+		if n == '$' && strings.HasSuffix(string(str), delim) {
+			return &DollarQuote{
+				Tag:   tag.String(),
+				Value: string(str[:len(str)-len([]rune(delim))]),
+			}, nil
+		}
+	}
+}
+
+// tokenizeCopyData scans lines until the terminator line \. or EOF.
+// The returned data contains the lines with their newlines but not the terminator.
+func (t *Tokenizer) tokenizeCopyData() (Kind, interface{}, error) {
+	if t.Scanner.Peek() == scanner.EOF {
+		return ILLEGAL, "", io.EOF
+	}
+
+	var data strings.Builder
+	var line []rune
+	for {
+		n := t.Scanner.Peek()
+		if n != scanner.EOF && n != '\n' {
+			t.Scanner.Next()
+			line = append(line, n)
+			continue
+		}
+		if string(line) == "\\." {
+			t.Col += 2
+			return CopyData, data.String(), nil
+		}
+		data.WriteString(string(line))
+		if n == scanner.EOF {
+			t.Col += len(line)
+			return CopyData, data.String(), nil
+		}
+		t.Scanner.Next()
+		data.WriteRune('\n')
+		line = line[:0]
+		t.Line += 1
+		t.Col = 1
+	}
+}
+
+// tokenizeLineComment scans the text of a line comment after its marker of markerLen characters up to the line break.
+func (t *Tokenizer) tokenizeLineComment(markerLen int) string {
+	var s []rune
+	for {
+		ch := t.Scanner.Peek()
+		if ch == scanner.EOF || ch == '\n' {
+			t.Col += len(s) + markerLen
+			return string(s)
+		}
+		t.Scanner.Next()
+		s = append(s, ch)
+	}
+}
+
+// tokenizeMultilineComment scans the text of a block comment after /* up to the matching */.
+// Block comments nest if the dialect supports dialect.NestedComment.
 func (t *Tokenizer) tokenizeMultilineComment() (string, error) {
 	var str []rune
-	var mayBeClosingComment bool
+	nested := dialect.Supports(t.Dialect, dialect.NestedComment)
+	depth := 1
 	t.Col += 2
 	for {
 		n := t.Scanner.Next()
@@ -522,23 +964,26 @@ func (t *Tokenizer) tokenizeMultilineComment() (string, error) {
 			t.Col = 1
 			t.Line += 1
 		} else if n == scanner.EOF {
-			return "", errors.Errorf("unclosed multiline comment: %s at %+v", string(str), t.Pos())
+			return "", &UnterminatedError{Kind: Comment, Partial: "/*" + string(str)}
 		} else {
 			t.Col += 1
 		}
+		str = append(str, n)
 
-		if mayBeClosingComment {
-			if n == '/' {
-				break
-			} else {
-				str = append(str, n)
+		switch {
+		case n == '*' && t.Scanner.Peek() == '/':
+			t.Scanner.Next()
+			t.Col += 1
+			depth--
+			if depth == 0 {
+				return string(str[:len(str)-1]), nil
 			}
-		}
-		mayBeClosingComment = n == '*'
-		if !mayBeClosingComment {
-			str = append(str, n)
+			str = append(str, '/')
+		case nested && n == '/' && t.Scanner.Peek() == '*':
+			t.Scanner.Next()
+			t.Col += 1
+			depth++
+			str = append(str, '*')
 		}
 	}
-
-	return string(str), nil
 }
@@ -6,8 +6,10 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/google/go-cmp/cmp"
+	errors "golang.org/x/xerrors"
 
 	"github.com/akito0107/xsqlparser/dialect"
 )
@@ -179,16 +181,178 @@ func TestTokenizer_Tokenize(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "numbers with exponent and underscores",
+			in:   "1_000 1.5E-3",
+			out: []*Token{
+				{
+					Kind:  Number,
+					Value: "1_000",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Number,
+					Value: "1.5E-3",
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 1, Col: 13},
+				},
+			},
+		},
+		{
+			name: "prefixed integers",
+			in:   "0x1F 0b101",
+			out: []*Token{
+				{
+					Kind:  Number,
+					Value: "0x1F",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 5},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 5},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  Number,
+					Value: "0b101",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 11},
+				},
+			},
+		},
 		{
 			name: "minus comment",
 			in:   "-- test",
 			out: []*Token{
 				{
 					Kind:  Comment,
-					Value: " test",
+					Value: &CommentValue{Text: " test", Style: LineComment, OwnLine: true},
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 8},
+				},
+			},
+		},
+		{
+			name: "trailing and own line comments",
+			in:   "1 -- a\n/* b */\n",
+			out: []*Token{
+				{
+					Kind:  Number,
+					Value: "1",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  Comment,
+					Value: &CommentValue{Text: " a", Style: LineComment, TrailingNewline: true},
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Whitespace,
+					Value: "\n",
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 2, Col: 1},
+				},
+				{
+					Kind:  Comment,
+					Value: &CommentValue{Text: " b ", Style: BlockComment, OwnLine: true, TrailingNewline: true},
+					From:  Pos{Line: 2, Col: 1},
+					To:    Pos{Line: 2, Col: 8},
+				},
+				{
+					Kind:  Whitespace,
+					Value: "\n",
+					From:  Pos{Line: 2, Col: 8},
+					To:    Pos{Line: 3, Col: 1},
+				},
+			},
+		},
+		{
+			name: "copy from stdin data",
+			in:   "COPY t FROM STDIN;\n1\n\\.\n",
+			out: []*Token{
+				{
+					Kind:  SQLKeyword,
+					Value: MakeKeyword("COPY", 0),
 					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 5},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 5},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  SQLKeyword,
+					Value: MakeKeyword("t", 0),
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 7},
 					To:    Pos{Line: 1, Col: 8},
 				},
+				{
+					Kind:  SQLKeyword,
+					Value: MakeKeyword("FROM", 0),
+					From:  Pos{Line: 1, Col: 8},
+					To:    Pos{Line: 1, Col: 12},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 12},
+					To:    Pos{Line: 1, Col: 13},
+				},
+				{
+					Kind:  SQLKeyword,
+					Value: MakeKeyword("STDIN", 0),
+					From:  Pos{Line: 1, Col: 13},
+					To:    Pos{Line: 1, Col: 18},
+				},
+				{
+					Kind:  Semicolon,
+					Value: ";",
+					From:  Pos{Line: 1, Col: 18},
+					To:    Pos{Line: 1, Col: 19},
+				},
+				{
+					Kind:  Whitespace,
+					Value: "\n",
+					From:  Pos{Line: 1, Col: 19},
+					To:    Pos{Line: 2, Col: 1},
+				},
+				{
+					Kind:  CopyData,
+					Value: "1\n",
+					From:  Pos{Line: 2, Col: 1},
+					To:    Pos{Line: 3, Col: 3},
+				},
+				{
+					Kind:  Whitespace,
+					Value: "\n",
+					From:  Pos{Line: 3, Col: 3},
+					To:    Pos{Line: 4, Col: 1},
+				},
 			},
 		},
 		{
@@ -223,7 +387,7 @@ comment */`,
 			out: []*Token{
 				{
 					Kind:  Comment,
-					Value: " test\nmultiline\ncomment ",
+					Value: &CommentValue{Text: " test\nmultiline\ncomment ", Style: BlockComment, OwnLine: true},
 					From:  Pos{Line: 1, Col: 1},
 					To:    Pos{Line: 3, Col: 11},
 				},
@@ -415,6 +579,169 @@ comment */`,
 				},
 			},
 		},
+		{
+			name: "placeholders",
+			in:   "?,$12,:name",
+			out: []*Token{
+				{
+					Kind:  Placeholder,
+					Value: "?",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  Comma,
+					Value: ",",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  Placeholder,
+					Value: "$12",
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  Comma,
+					Value: ",",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Placeholder,
+					Value: ":name",
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 1, Col: 12},
+				},
+			},
+		},
+		{
+			name: "dollar quoted string",
+			in: `$$a$$ $tag$b
+$c$tag$`,
+			out: []*Token{
+				{
+					Kind:  DollarQuotedString,
+					Value: &DollarQuote{Value: "a"},
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  DollarQuotedString,
+					Value: &DollarQuote{Tag: "tag", Value: "b\n$c"},
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 2, Col: 8},
+				},
+			},
+		},
+		{
+			name: "json operators",
+			in:   "->->>#>#>>@>",
+			out: []*Token{
+				{
+					Kind:  Arrow,
+					Value: "->",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 3},
+				},
+				{
+					Kind:  LongArrow,
+					Value: "->>",
+					From:  Pos{Line: 1, Col: 3},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  HashArrow,
+					Value: "#>",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 8},
+				},
+				{
+					Kind:  HashLongArrow,
+					Value: "#>>",
+					From:  Pos{Line: 1, Col: 8},
+					To:    Pos{Line: 1, Col: 11},
+				},
+				{
+					Kind:  AtArrow,
+					Value: "@>",
+					From:  Pos{Line: 1, Col: 11},
+					To:    Pos{Line: 1, Col: 13},
+				},
+			},
+		},
+		{
+			name: "regex operators",
+			in:   "~~*!~!~*",
+			out: []*Token{
+				{
+					Kind:  Tilde,
+					Value: "~",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 2},
+				},
+				{
+					Kind:  TildeAsterisk,
+					Value: "~*",
+					From:  Pos{Line: 1, Col: 2},
+					To:    Pos{Line: 1, Col: 4},
+				},
+				{
+					Kind:  NotTilde,
+					Value: "!~",
+					From:  Pos{Line: 1, Col: 4},
+					To:    Pos{Line: 1, Col: 6},
+				},
+				{
+					Kind:  NotTildeAsterisk,
+					Value: "!~*",
+					From:  Pos{Line: 1, Col: 6},
+					To:    Pos{Line: 1, Col: 9},
+				},
+			},
+		},
+		{
+			name: "escape, hex and bit strings",
+			in:   `E'a\n' X'ff' B'01'`,
+			out: []*Token{
+				{
+					Kind:  SingleQuotedString,
+					Value: "a\n",
+					From:  Pos{Line: 1, Col: 1},
+					To:    Pos{Line: 1, Col: 7},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 7},
+					To:    Pos{Line: 1, Col: 8},
+				},
+				{
+					Kind:  HexStringLiteral,
+					Value: "ff",
+					From:  Pos{Line: 1, Col: 8},
+					To:    Pos{Line: 1, Col: 13},
+				},
+				{
+					Kind:  Whitespace,
+					Value: " ",
+					From:  Pos{Line: 1, Col: 13},
+					To:    Pos{Line: 1, Col: 14},
+				},
+				{
+					Kind:  BitStringLiteral,
+					Value: "01",
+					From:  Pos{Line: 1, Col: 14},
+					To:    Pos{Line: 1, Col: 19},
+				},
+			},
+		},
 		{
 			name: "others",
 			in:   "\\[{&}]",
@@ -480,13 +807,151 @@ comment */`,
 				if !reflect.DeepEqual(tok[i].Value, c.out[i].Value) {
 					t.Errorf("%d, expected value: %+v, but got %+v", i, c.out[i].Value, tok[i].Value)
 				}
-				if !reflect.DeepEqual(tok[i].From, c.out[i].From) {
+				if !reflect.DeepEqual(lineCol(tok[i].From), c.out[i].From) {
 					t.Errorf("%d, expected value: %+v, but got %+v", i, c.out[i].From, tok[i].From)
 				}
-				if !reflect.DeepEqual(tok[i].To, c.out[i].To) {
+				if !reflect.DeepEqual(lineCol(tok[i].To), c.out[i].To) {
 					t.Errorf("%d, expected value: %+v, but got %+v", i, c.out[i].To, tok[i].To)
 				}
 			}
+
+			if src := text(c.in, tok); src != c.in {
+				t.Errorf("tokens must cover the source but %q", src)
+			}
+		})
+	}
+}
+
+// lineCol returns p without Offset, which is checked by text.
+func lineCol(p Pos) Pos {
+	return Pos{Line: p.Line, Col: p.Col}
+}
+
+// text concatenates the source of tokens.
+func text(src string, tokens []*Token) string {
+	var b strings.Builder
+	for _, tok := range tokens {
+		b.WriteString(src[tok.From.Offset:tok.To.Offset])
+	}
+	return b.String()
+}
+
+func TestTokenizer_Placeholder(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+		kinds   []Kind
+	}{
+		{
+			name:    "postgres dollar",
+			in:      "$1",
+			dialect: &dialect.PostgresqlDialect{},
+			kinds:   []Kind{Placeholder},
+		},
+		{
+			name:    "postgres colon",
+			in:      ":a",
+			dialect: &dialect.PostgresqlDialect{},
+			kinds:   []Kind{Colon, SQLKeyword},
+		},
+		{
+			name:    "mysql question",
+			in:      "?",
+			dialect: &dialect.MySQLDialect{},
+			kinds:   []Kind{Placeholder},
+		},
+		{
+			name:    "mysql dollar",
+			in:      "$1",
+			dialect: &dialect.MySQLDialect{},
+			kinds:   []Kind{Char, Number},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			tok, err := NewTokenizer(strings.NewReader(c.in), c.dialect).Tokenize()
+			if err != nil {
+				t.Fatalf("should be no error %v", err)
+			}
+			var kinds []Kind
+			for _, tk := range tok {
+				kinds = append(kinds, tk.Kind)
+			}
+			if !reflect.DeepEqual(kinds, c.kinds) {
+				t.Errorf("expected %v but got %v", c.kinds, kinds)
+			}
+		})
+	}
+}
+
+func TestTokenizer_Unterminated(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+		kind    Kind
+		pos     Pos
+		partial string
+	}{
+		{
+			name:    "single quoted string",
+			in:      "SELECT 'abc",
+			dialect: &dialect.GenericSQLDialect{},
+			kind:    SingleQuotedString,
+			pos:     Pos{Line: 1, Col: 8, Offset: 7},
+			partial: "'abc",
+		},
+		{
+			name:    "delimited identifier",
+			in:      "SELECT a FROM \"tab",
+			dialect: &dialect.GenericSQLDialect{},
+			kind:    SQLKeyword,
+			pos:     Pos{Line: 1, Col: 15, Offset: 14},
+			partial: "\"tab",
+		},
+		{
+			name:    "multiline comment",
+			in:      "SELECT 1\n/* comment",
+			dialect: &dialect.GenericSQLDialect{},
+			kind:    Comment,
+			pos:     Pos{Line: 2, Col: 1, Offset: 9},
+			partial: "/* comment",
+		},
+		{
+			name:    "nested comment",
+			in:      "SELECT 1 /* a /* b */ c",
+			dialect: &dialect.PostgresqlDialect{},
+			kind:    Comment,
+			pos:     Pos{Line: 1, Col: 10, Offset: 9},
+			partial: "/* a /* b */ c",
+		},
+		{
+			name:    "dollar quoted string",
+			in:      "SELECT $tag$abc",
+			dialect: &dialect.PostgresqlDialect{},
+			kind:    DollarQuotedString,
+			pos:     Pos{Line: 1, Col: 8, Offset: 7},
+			partial: "$tag$abc",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := NewTokenizer(strings.NewReader(c.in), c.dialect).Tokenize()
+			var uerr *UnterminatedError
+			if !errors.As(err, &uerr) {
+				t.Fatalf("must be UnterminatedError but %+v", err)
+			}
+			if uerr.Kind != c.kind || uerr.Pos != c.pos || uerr.Partial != c.partial {
+				t.Errorf("unexpected error %+v", uerr)
+			}
+
+			last := toks[len(toks)-1]
+			if last.Kind != ILLEGAL || last.From != c.pos || last.Value != c.partial {
+				t.Errorf("must end with the partial token but %+v", last)
+			}
 		})
 	}
 }
@@ -544,7 +1009,7 @@ func TestTokenizer_Pos(t *testing.T) {
 					t.Fatal(err)
 				}
 
-				if d := cmp.Diff(tokenizer.Pos(), Pos{Line: 1, Col: 6 + c.add}); d != "" {
+				if d := cmp.Diff(tokenizer.Pos(), Pos{Line: 1, Col: 6 + c.add, Offset: len(src)}); d != "" {
 					t.Errorf("must be same but diff: %s", d)
 				}
 			})
@@ -607,7 +1072,10 @@ test comment
 					t.Fatal(err)
 				}
 
-				if d := cmp.Diff(tokenizer.Pos(), c.expect); d != "" {
+				// the whole source is scanned
+				expect := c.expect
+				expect.Offset = len(c.src)
+				if d := cmp.Diff(tokenizer.Pos(), expect); d != "" {
 					t.Errorf("must be same but diff: %s", d)
 				}
 			})
@@ -623,6 +1091,10 @@ test comment
 				name: "incomplete quoted string",
 				src:  "'test",
 			},
+			{
+				name: "unclosed delimited identifier",
+				src:  `"test`,
+			},
 			{
 				name: "unclosed multiline comment",
 				src: `
@@ -757,3 +1229,130 @@ HAVING COUNT(customer_id) > 3`,
 		})
 	}
 }
+
+func TestTokenizer_Offset(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		dialect dialect.Dialect
+	}{
+		{
+			name:    "escapes and unicode",
+			in:      "SELECT 'it''s', \"a\"\"b\", N'日本語', E'\\n' FROM\tテーブル\r\nWHERE a <> $1;",
+			dialect: &dialect.PostgresqlDialect{},
+		},
+		{
+			name:    "comments",
+			in:      "-- head\r\nSELECT /* block\n comment */ 1; -- tail\n",
+			dialect: &dialect.MySQLDialect{},
+		},
+		{
+			name:    "dollar quoted string",
+			in:      "CREATE FUNCTION f() RETURNS int AS $$ SELECT 1 $$ LANGUAGE sql",
+			dialect: &dialect.PostgresqlDialect{},
+		},
+		{
+			name:    "copy data",
+			in:      "COPY t (a, b) FROM STDIN;\n1\tjohn\n2\t\\N\n\\.\nSELECT 1",
+			dialect: &dialect.PostgresqlDialect{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := NewTokenizer(strings.NewReader(c.in), c.dialect).Tokenize()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if src := text(c.in, toks); src != c.in {
+				t.Errorf("tokens must cover the source but %q", src)
+			}
+			for i := 1; i < len(toks); i++ {
+				if toks[i-1].To.Offset != toks[i].From.Offset {
+					t.Errorf("gap between %+v and %+v", toks[i-1], toks[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTokenizer_Comment(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       string
+		dialect  dialect.Dialect
+		kinds    []Kind
+		comments []*CommentValue
+	}{
+		{
+			name:     "mysql hash comment",
+			in:       "SELECT 1 # comment\n",
+			dialect:  &dialect.MySQLDialect{},
+			kinds:    []Kind{SQLKeyword, Whitespace, Number, Whitespace, Comment, Whitespace},
+			comments: []*CommentValue{{Text: " comment", Style: HashComment, TrailingNewline: true}},
+		},
+		{
+			name:     "mysql hint comment",
+			in:       "SELECT /*+ BKA(t) */ 1",
+			dialect:  &dialect.MySQLDialect{},
+			kinds:    []Kind{SQLKeyword, Whitespace, Comment, Whitespace, Number},
+			comments: []*CommentValue{{Text: " BKA(t) ", Style: HintComment}},
+		},
+		{
+			name:     "postgres hint comment",
+			in:       "/*+ SeqScan(t) */",
+			dialect:  &dialect.PostgresqlDialect{},
+			kinds:    []Kind{Comment},
+			comments: []*CommentValue{{Text: "+ SeqScan(t) ", Style: BlockComment, OwnLine: true}},
+		},
+		{
+			name:    "postgres hash operator",
+			in:      "a #> b",
+			dialect: &dialect.PostgresqlDialect{},
+			kinds:   []Kind{SQLKeyword, Whitespace, HashArrow, Whitespace, SQLKeyword},
+		},
+		{
+			name:     "postgres nested comment",
+			in:       "/* a /* b */ c */ 1",
+			dialect:  &dialect.PostgresqlDialect{},
+			kinds:    []Kind{Comment, Whitespace, Number},
+			comments: []*CommentValue{{Text: " a /* b */ c ", Style: BlockComment, OwnLine: true}},
+		},
+		{
+			name:     "mysql comment doesn't nest",
+			in:       "/* a /* b */ c",
+			dialect:  &dialect.MySQLDialect{},
+			kinds:    []Kind{Comment, Whitespace, SQLKeyword},
+			comments: []*CommentValue{{Text: " a /* b ", Style: BlockComment, OwnLine: true}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			toks, err := NewTokenizer(strings.NewReader(c.in), c.dialect).Tokenize()
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if src := text(c.in, toks); src != c.in {
+				t.Errorf("tokens must cover the source but %q", src)
+			}
+			var kinds []Kind
+			var comments []*CommentValue
+			for _, tok := range toks {
+				kinds = append(kinds, tok.Kind)
+				if tok.Kind == Comment {
+					comments = append(comments, tok.Value.(*CommentValue))
+				}
+				if tok.From.Line == tok.To.Line && tok.To.Col-tok.From.Col != utf8.RuneCountInString(c.in[tok.From.Offset:tok.To.Offset]) {
+					t.Errorf("columns of %+v must match the source", tok)
+				}
+			}
+			if diff := cmp.Diff(c.kinds, kinds); diff != "" {
+				t.Errorf("diff of kinds %s", diff)
+			}
+			if diff := cmp.Diff(c.comments, comments); diff != "" {
+				t.Errorf("diff of comments %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package xsqlparser
+
+import (
+	"io"
+
+	errors "golang.org/x/xerrors"
+
+	"github.com/akito0107/xsqlparser/dialect"
+	"github.com/akito0107/xsqlparser/sqlast"
+	"github.com/akito0107/xsqlparser/sqltoken"
+)
+
+// NewStreamParser returns a Parser which tokenizes src lazily.
+// Call Next to parse statements one by one; tokens of parsed statements are released,
+// so the memory usage is proportional to one statement rather than the whole src.
+// Tokenize errors are returned by Next.
+func NewStreamParser(src io.Reader, dialect dialect.Dialect, opts ...ParserOption) *Parser {
+	parser := &Parser{
+		tokenizer: sqltoken.NewTokenizer(src, dialect),
+		stream:    true,
+		dialect:   dialect,
+	}
+	for _, o := range opts {
+		o(parser)
+	}
+	return parser
+}
+
+// Next parses the next statement. Statements are delimited by semicolons,
+// and the one after the last statement may be omitted.
+// It returns io.EOF when all statements are parsed.
+// A malformed statement results in an error, never a panic.
+func (p *Parser) Next() (stmt sqlast.Stmt, err error) {
+	defer p.recoverParseError(&err)
+
+	if p.stream {
+		if err := p.fill(); err != nil {
+			return nil, err
+		}
+	}
+
+	for ok, _ := p.consumeToken(sqltoken.Semicolon); ok; ok, _ = p.consumeToken(sqltoken.Semicolon) {
+	}
+	if _, err := p.peekToken(); err == EOF {
+		return nil, io.EOF
+	}
+
+	stmt, err = p.ParseStatement()
+	if err != nil {
+		return nil, errors.Errorf("ParseStatement failed: %w", err)
+	}
+
+	// inline data of COPY follows the delimiter
+	if c, ok := stmt.(*sqlast.CopyStmt); ok && c.Data != nil {
+		return stmt, nil
+	}
+	if ok, _ := p.consumeToken(sqltoken.Semicolon); !ok {
+		if tok, err := p.peekToken(); err != EOF {
+			return nil, errors.Errorf("expect semicolon but %+v", tok)
+		}
+	}
+	return stmt, nil
+}
+
+// fill drops the consumed tokens and reads tokens from the tokenizer
+// until the next statement and its delimiter are read.
+// The first token after the delimiter is read ahead, which is the inline data of COPY FROM STDIN
+// or the first token of the following statement.
+func (p *Parser) fill() error {
+	rest := make([]*sqltoken.Token, len(p.tokens)-int(p.index))
+	copy(rest, p.tokens[p.index:])
+	p.tokens = rest
+	p.index = 0
+
+	var started, delimited bool
+	// done reports whether tok is the token after the delimiter
+	done := func(tok *sqltoken.Token) bool {
+		switch {
+		case tok.Kind == sqltoken.Whitespace || tok.Kind == sqltoken.Comment:
+			return false
+		case delimited:
+			return true
+		case tok.Kind == sqltoken.Semicolon:
+			delimited = started
+			return false
+		}
+		started = true
+		return false
+	}
+	for _, tok := range p.tokens {
+		if done(tok) {
+			return nil
+		}
+	}
+
+	for {
+		tok, err := p.tokenizer.NextToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if tok != nil && tok.Kind == sqltoken.ILLEGAL {
+				return &ParseError{Pos: tok.From, Err: err}
+			}
+			return errors.Errorf("tokenize failed: %w", err)
+		}
+		if tok == nil {
+			continue
+		}
+		p.tokens = append(p.tokens, tok)
+		if done(tok) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package xsqlparser
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/akito0107/xsqlparser/dialect"
+)
+
+func TestParser_Next(t *testing.T) {
+	src := ";SELECT a FROM t;\n" +
+		"-- comment\n" +
+		"INSERT INTO t (a) VALUES (';');;\n" +
+		"COPY customers (id, name) FROM STDIN;\n1\tjohn\n2\t\\N\n\\.\n" +
+		"DELETE FROM t WHERE a = 1"
+	expected := []string{
+		"SELECT a FROM t",
+		"INSERT INTO t (a) VALUES (';')",
+		"COPY customers (id, name) FROM STDIN;\n1\tjohn\n2\t\\N\n\\.",
+		"DELETE FROM t WHERE a = 1",
+	}
+
+	newParsers := map[string]func() *Parser{
+		"stream": func() *Parser {
+			return NewStreamParser(strings.NewReader(src), &dialect.PostgresqlDialect{}, ParseComment())
+		},
+		"tokenized": func() *Parser {
+			p, err := NewParser(strings.NewReader(src), &dialect.PostgresqlDialect{})
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			return p
+		},
+	}
+
+	for name, newParser := range newParsers {
+		t.Run(name, func(t *testing.T) {
+			p := newParser()
+			for _, e := range expected {
+				stmt, err := p.Next()
+				if err != nil {
+					t.Fatalf("%+v", err)
+				}
+				if act := stmt.ToSQLString(); act != e {
+					t.Errorf("must be %q but %q", e, act)
+				}
+			}
+			if _, err := p.Next(); err != io.EOF {
+				t.Errorf("must be io.EOF but %+v", err)
+			}
+		})
+	}
+}
+
+func TestParser_Next_ReleaseTokens(t *testing.T) {
+	var b strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&b, "INSERT INTO t (a, b) VALUES (%d, 'value %d');\n", i, i)
+	}
+
+	p := NewStreamParser(strings.NewReader(b.String()), &dialect.GenericSQLDialect{})
+	for i := 0; ; i++ {
+		_, err := p.Next()
+		if err == io.EOF {
+			if i != 1000 {
+				t.Errorf("must be 1000 statements but %d", i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("%+v", err)
+		}
+		if len(p.tokens) > 30 {
+			t.Fatalf("tokens of parsed statements must be released but %d tokens are held", len(p.tokens))
+		}
+	}
+}
+
+func TestParser_Next_Errors(t *testing.T) {
+	t.Run("tokenize error", func(t *testing.T) {
+		p := NewStreamParser(strings.NewReader("SELECT 1; SELECT 'a"), &dialect.GenericSQLDialect{})
+		if _, err := p.Next(); err != nil {
+			t.Fatalf("%+v", err)
+		}
+		_, err := p.Next()
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Fatalf("must be ParseError but %+v", err)
+		}
+		if perr.Pos.Line != 1 || perr.Pos.Col != 18 {
+			t.Errorf("must be at 1:18 but %+v", perr.Pos)
+		}
+	})
+
+	t.Run("malformed statement", func(t *testing.T) {
+		for _, src := range []string{
+			"SELECT .5",
+			"INSERT INTO t (a) VALUES (1) ON CONFLICT (a) NOTHING",
+		} {
+			p := NewStreamParser(strings.NewReader(src), &dialect.GenericSQLDialect{})
+			if _, err := p.Next(); err == nil {
+				t.Errorf("%q must be error", src)
+			}
+		}
+
+		p := NewStreamParser(strings.NewReader("SELECT .5; SELECT 1"), &dialect.GenericSQLDialect{})
+		_, err := p.Next()
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("must be ParseError but %+v", err)
+		}
+	})
+
+	t.Run("missing delimiter", func(t *testing.T) {
+		p := NewStreamParser(strings.NewReader("SELECT 1 SELECT 2"), &dialect.GenericSQLDialect{})
+		if _, err := p.Next(); err == nil {
+			t.Error("must be error")
+		}
+	})
+}
+
+func TestParser_ParseSQL_Stream(t *testing.T) {
+	p := NewStreamParser(strings.NewReader("SELECT 1; SELECT 2;"), &dialect.GenericSQLDialect{})
+	stmts, err := p.ParseSQL()
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	if len(stmts) != 2 {
+		t.Errorf("must be 2 statements but %d", len(stmts))
+	}
+}
@@ -1,19 +1,13 @@
 package xsqlparser
 
 import (
-	"reflect"
-	"unicode"
-
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/akito0107/xsqlparser/sqlasttest"
 )
 
-var IgnoreMarker = cmp.FilterPath(func(paths cmp.Path) bool {
-	s := paths.Last().Type()
-	name := s.Name()
-	r := []rune(name)
-	return s.Kind() == reflect.Struct && len(r) > 0 && unicode.IsLower(r[0])
-}, cmp.Ignore())
+var IgnoreMarker = sqlasttest.IgnoreMarker
 
 func CompareWithoutMarker(a, b interface{}) string {
 	return cmp.Diff(a, b, IgnoreMarker)
-}
\ No newline at end of file
+}
@@ -0,0 +1,324 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genapply: ")
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var flags struct {
+		SourceDir  string
+		SourcePkg  string
+		OutputName string
+		Package    string
+	}
+
+	flag.StringVar(&flags.SourceDir, "src", "../sqlast", "directory of the node package")
+	flag.StringVar(&flags.SourcePkg, "srcpkg", "sqlast", "name of the node package")
+	flag.StringVar(&flags.OutputName, "o", "rewrite_gen.go", "output filename")
+	flag.StringVar(&flags.Package, "pkg", os.Getenv("GOPACKAGE"), "package name")
+	flag.Parse()
+
+	nodes, err := loadNodes(flags.SourceDir)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(flags.Package, flags.SourcePkg, nodes)
+	if err != nil {
+		return fmt.Errorf("failed to format source code: %s", err.Error())
+	}
+
+	err = ioutil.WriteFile(flags.OutputName, src, 0666)
+	if err != nil {
+		return fmt.Errorf("failed to write generate code: %s", err.Error())
+	}
+	return nil
+}
+
+// field is a field of a node which holds child node(s).
+type field struct {
+	Name   string
+	Type   string // element type if IsList
+	IsList bool
+}
+
+type node struct {
+	Name   string
+	Fields []*field
+}
+
+// loadNodes returns the types which have WriteTo method in dir with their child node fields.
+func loadNodes(dir string) ([]*node, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %s", err.Error())
+	}
+
+	structs := make(map[string]*ast.StructType)
+	ifaces := make(map[string]*ast.InterfaceType)
+	nodeNames := make(map[string]bool)
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						ts, ok := spec.(*ast.TypeSpec)
+						if !ok {
+							continue
+						}
+						switch t := ts.Type.(type) {
+						case *ast.StructType:
+							structs[ts.Name.Name] = t
+						case *ast.InterfaceType:
+							ifaces[ts.Name.Name] = t
+						}
+					}
+				case *ast.FuncDecl:
+					if d.Recv == nil || d.Name.Name != "WriteTo" {
+						continue
+					}
+					if name := receiverTypeName(d.Recv.List[0].Type); name != "" {
+						nodeNames[name] = true
+					}
+				}
+			}
+		}
+	}
+
+	nodeIfaces := nodeInterfaces(ifaces)
+
+	// elemType returns the type name of expr if expr is a node type.
+	elemType := func(expr ast.Expr) string {
+		switch t := expr.(type) {
+		case *ast.StarExpr:
+			if ident, ok := t.X.(*ast.Ident); ok && nodeNames[ident.Name] {
+				return "*" + ident.Name
+			}
+		case *ast.Ident:
+			if nodeIfaces[t.Name] {
+				return t.Name
+			}
+		}
+		return ""
+	}
+
+	var collect func(st *ast.StructType) []*field
+	collect = func(st *ast.StructType) []*field {
+		var fields []*field
+		for _, f := range st.Fields.List {
+			if len(f.Names) == 0 {
+				// fields of embedded struct (e.g. CharsetCollation) are promoted
+				if ident, ok := f.Type.(*ast.Ident); ok && ast.IsExported(ident.Name) && !nodeNames[ident.Name] {
+					if embedded, ok := structs[ident.Name]; ok {
+						fields = append(fields, collect(embedded)...)
+					}
+				}
+				continue
+			}
+			typ, isList := "", false
+			if arr, ok := f.Type.(*ast.ArrayType); ok && arr.Len == nil {
+				typ, isList = elemType(arr.Elt), true
+			} else {
+				typ = elemType(f.Type)
+			}
+			if typ == "" {
+				continue
+			}
+			for _, name := range f.Names {
+				if ast.IsExported(name.Name) {
+					fields = append(fields, &field{Name: name.Name, Type: typ, IsList: isList})
+				}
+			}
+		}
+		return fields
+	}
+
+	var nodes []*node
+	for name := range nodeNames {
+		n := &node{Name: name}
+		if st, ok := structs[name]; ok {
+			n.Fields = collect(st)
+		}
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	return nodes, nil
+}
+
+// nodeInterfaces returns names of the interfaces which embed Node.
+func nodeInterfaces(ifaces map[string]*ast.InterfaceType) map[string]bool {
+	res := map[string]bool{"Node": true}
+	for changed := true; changed; {
+		changed = false
+		for name, it := range ifaces {
+			if res[name] {
+				continue
+			}
+			for _, m := range it.Methods.List {
+				if ident, ok := m.Type.(*ast.Ident); ok && len(m.Names) == 0 && res[ident.Name] {
+					res[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+		return ident.Name
+	}
+	return ""
+}
+
+func generate(pkg, srcPkg string, nodes []*node) ([]byte, error) {
+	qualify := func(typ string) string {
+		if strings.HasPrefix(typ, "*") {
+			return "*" + srcPkg + "." + typ[1:]
+		}
+		return srcPkg + "." + typ
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "package %s\n", pkg)
+	fmt.Fprintf(buf, "// Code generated by genapply. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "import (\n\"log\"\n\n\"github.com/akito0107/xsqlparser/%s\"\n)\n\n", srcPkg)
+
+	fmt.Fprintf(buf, "// isNil reports whether n is nil or a nil pointer of the node type.\n")
+	fmt.Fprintf(buf, "func isNil(n %s.Node) bool {\n", srcPkg)
+	fmt.Fprintf(buf, "switch n := n.(type) {\n")
+	fmt.Fprintf(buf, "case nil:\nreturn true\n")
+	for _, n := range nodes {
+		fmt.Fprintf(buf, "case *%s.%s:\nreturn n == nil\n", srcPkg, n.Name)
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "return false\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// setField sets n to the field name of parent.\n")
+	fmt.Fprintf(buf, "func setField(parent %s.Node, name string, n %s.Node) {\n", srcPkg, srcPkg)
+	fmt.Fprintf(buf, "switch p := parent.(type) {\n")
+	for _, n := range nodes {
+		if !hasField(n, false) {
+			continue
+		}
+		fmt.Fprintf(buf, "case *%s.%s:\n", srcPkg, n.Name)
+		fmt.Fprintf(buf, "switch name {\n")
+		for _, f := range n.Fields {
+			if f.IsList {
+				continue
+			}
+			fmt.Fprintf(buf, "case %q:\n", f.Name)
+			if f.Type == "Node" {
+				fmt.Fprintf(buf, "p.%s = n\n", f.Name)
+			} else {
+				fmt.Fprintf(buf, "p.%s = n.(%s)\n", f.Name, qualify(f.Type))
+			}
+			fmt.Fprintf(buf, "return\n")
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "log.Panicf(\"%%T has no node field %%s\", parent, name)\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// listField returns the slice field name of parent.\n")
+	fmt.Fprintf(buf, "func listField(parent %s.Node, name string) nodeList {\n", srcPkg)
+	fmt.Fprintf(buf, "switch p := parent.(type) {\n")
+	elemTypes := make(map[string]bool)
+	for _, n := range nodes {
+		if !hasField(n, true) {
+			continue
+		}
+		fmt.Fprintf(buf, "case *%s.%s:\n", srcPkg, n.Name)
+		fmt.Fprintf(buf, "switch name {\n")
+		for _, f := range n.Fields {
+			if !f.IsList {
+				continue
+			}
+			elemTypes[f.Type] = true
+			fmt.Fprintf(buf, "case %q:\n", f.Name)
+			fmt.Fprintf(buf, "return (*%s)(&p.%s)\n", listTypeName(f.Type), f.Name)
+		}
+		fmt.Fprintf(buf, "}\n")
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "log.Panicf(\"%%T has no node list field %%s\", parent, name)\n")
+	fmt.Fprintf(buf, "return nil\n")
+	fmt.Fprintf(buf, "}\n\n")
+
+	var types []string
+	for t := range elemTypes {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return listTypeName(types[i]) < listTypeName(types[j]) })
+
+	for _, t := range types {
+		l := listTypeName(t)
+		assert := fmt.Sprintf("n.(%s)", qualify(t))
+		if t == "Node" {
+			assert = "n"
+		}
+		fmt.Fprintf(buf, "type %s []%s\n\n", l, qualify(t))
+		fmt.Fprintf(buf, "func (l *%s) Len() int { return len(*l) }\n", l)
+		fmt.Fprintf(buf, "func (l *%s) At(i int) %s.Node { return (*l)[i] }\n", l, srcPkg)
+		fmt.Fprintf(buf, "func (l *%s) Set(i int, n %s.Node) { (*l)[i] = %s }\n", l, srcPkg, assert)
+		fmt.Fprintf(buf, "func (l *%s) Delete(i int) {\n", l)
+		fmt.Fprintf(buf, "copy((*l)[i:], (*l)[i+1:])\n")
+		fmt.Fprintf(buf, "(*l)[len(*l)-1] = nil\n")
+		fmt.Fprintf(buf, "*l = (*l)[:len(*l)-1]\n")
+		fmt.Fprintf(buf, "}\n")
+		fmt.Fprintf(buf, "func (l *%s) Insert(i int, n %s.Node) {\n", l, srcPkg)
+		fmt.Fprintf(buf, "*l = append(*l, nil)\n")
+		fmt.Fprintf(buf, "copy((*l)[i+1:], (*l)[i:])\n")
+		fmt.Fprintf(buf, "(*l)[i] = %s\n", assert)
+		fmt.Fprintf(buf, "}\n\n")
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func hasField(n *node, isList bool) bool {
+	for _, f := range n.Fields {
+		if f.IsList == isList {
+			return true
+		}
+	}
+	return false
+}
+
+func listTypeName(typ string) string {
+	return "listOf" + strings.TrimPrefix(typ, "*")
+}
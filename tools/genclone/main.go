@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genclone: ")
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var flags struct {
+		SourceDir  string
+		SourcePkg  string
+		OutputName string
+		Package    string
+	}
+
+	flag.StringVar(&flags.SourceDir, "src", "../sqlast", "directory of the node package")
+	flag.StringVar(&flags.SourcePkg, "srcpkg", "sqlast", "name of the node package")
+	flag.StringVar(&flags.OutputName, "o", "clone_gen.go", "output filename")
+	flag.StringVar(&flags.Package, "pkg", os.Getenv("GOPACKAGE"), "package name")
+	flag.Parse()
+
+	pkg, err := loadPackage(flags.SourceDir)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(flags.Package, flags.SourcePkg, pkg)
+	if err != nil {
+		return fmt.Errorf("failed to format source code: %s", err.Error())
+	}
+
+	err = ioutil.WriteFile(flags.OutputName, src, 0666)
+	if err != nil {
+		return fmt.Errorf("failed to write generate code: %s", err.Error())
+	}
+	return nil
+}
+
+type nodePackage struct {
+	structs    map[string]*ast.StructType
+	nodeNames  []string
+	isNode     map[string]bool
+	nodeIfaces map[string]bool
+}
+
+// loadPackage collects struct types, node types (which have WriteTo method)
+// and node interfaces (which embed Node) in dir.
+func loadPackage(dir string) (*nodePackage, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %s", err.Error())
+	}
+
+	p := &nodePackage{
+		structs: make(map[string]*ast.StructType),
+		isNode:  make(map[string]bool),
+	}
+	ifaces := make(map[string]*ast.InterfaceType)
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch d := decl.(type) {
+				case *ast.GenDecl:
+					for _, spec := range d.Specs {
+						ts, ok := spec.(*ast.TypeSpec)
+						if !ok {
+							continue
+						}
+						switch t := ts.Type.(type) {
+						case *ast.StructType:
+							p.structs[ts.Name.Name] = t
+						case *ast.InterfaceType:
+							ifaces[ts.Name.Name] = t
+						}
+					}
+				case *ast.FuncDecl:
+					if d.Recv == nil || d.Name.Name != "WriteTo" {
+						continue
+					}
+					if name := receiverTypeName(d.Recv.List[0].Type); name != "" {
+						p.isNode[name] = true
+						p.nodeNames = append(p.nodeNames, name)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(p.nodeNames)
+	p.nodeIfaces = nodeInterfaces(ifaces)
+
+	return p, nil
+}
+
+// nodeInterfaces returns names of the interfaces which embed Node.
+func nodeInterfaces(ifaces map[string]*ast.InterfaceType) map[string]bool {
+	res := map[string]bool{"Node": true}
+	for changed := true; changed; {
+		changed = false
+		for name, it := range ifaces {
+			if res[name] {
+				continue
+			}
+			for _, m := range it.Methods.List {
+				if ident, ok := m.Type.(*ast.Ident); ok && len(m.Names) == 0 && res[ident.Name] {
+					res[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+		return ident.Name
+	}
+	return ""
+}
+
+type generator struct {
+	buf    *bytes.Buffer
+	srcPkg string
+	pkg    *nodePackage
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, format, args...)
+}
+
+// elem returns the expression which clones v of type expr, or "" if v can be copied as is.
+func (g *generator) elem(expr ast.Expr, v string) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok && g.pkg.isNode[ident.Name] {
+			return fmt.Sprintf("c.clone%s(%s)", ident.Name, v)
+		}
+	case *ast.Ident:
+		if t.Name == "Node" {
+			return fmt.Sprintf("c.clone(%s)", v)
+		}
+		if g.pkg.nodeIfaces[t.Name] {
+			return fmt.Sprintf("c.clone(%s).(%s.%s)", v, g.srcPkg, t.Name)
+		}
+	}
+	return ""
+}
+
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return g.srcPkg + "." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		return t.X.(*ast.Ident).Name + "." + t.Sel.Name
+	}
+	panic(fmt.Sprintf("unsupported type %T", expr))
+}
+
+func (g *generator) fields(st *ast.StructType) {
+	for _, f := range st.Fields.List {
+		if len(f.Names) == 0 {
+			// fields of embedded struct (e.g. CharsetCollation) are promoted
+			if ident, ok := f.Type.(*ast.Ident); ok && ast.IsExported(ident.Name) && !g.pkg.isNode[ident.Name] {
+				if embedded, ok := g.pkg.structs[ident.Name]; ok {
+					g.fields(embedded)
+				}
+			}
+			continue
+		}
+		for _, name := range f.Names {
+			if !ast.IsExported(name.Name) {
+				continue
+			}
+			g.field(name.Name, f.Type)
+		}
+	}
+}
+
+func (g *generator) field(name string, expr ast.Expr) {
+	switch t := expr.(type) {
+	case *ast.SelectorExpr:
+		if x, ok := t.X.(*ast.Ident); ok && x.Name == "sqltoken" && t.Sel.Name == "Pos" {
+			g.printf("x.%s = c.pos(n.%s)\n", name, name)
+		}
+		return
+	case *ast.StarExpr:
+		if e := g.elem(t, "n."+name); e != "" {
+			g.printf("x.%s = %s\n", name, e)
+			return
+		}
+		// pointer to basic type like *uint
+		g.printf("if n.%s != nil {\n", name)
+		g.printf("v := *n.%s\n", name)
+		g.printf("x.%s = &v\n", name)
+		g.printf("}\n")
+		return
+	case *ast.ArrayType:
+		if t.Len != nil {
+			return
+		}
+		g.printf("if n.%s != nil {\n", name)
+		g.printf("x.%s = make([]%s, len(n.%s))\n", name, g.typeString(t.Elt), name)
+		if e := g.elem(t.Elt, "e"); e != "" {
+			g.printf("for i, e := range n.%s {\n", name)
+			if strings.Contains(e, ").(") {
+				// keep nil elements of interface slices as is
+				g.printf("if e != nil {\n")
+				g.printf("x.%s[i] = %s\n", name, e)
+				g.printf("}\n")
+			} else {
+				g.printf("x.%s[i] = %s\n", name, e)
+			}
+			g.printf("}\n")
+		} else {
+			g.printf("copy(x.%s, n.%s)\n", name, name)
+		}
+		g.printf("}\n")
+		return
+	case *ast.Ident:
+		if e := g.elem(t, "n."+name); e != "" {
+			if strings.Contains(e, ").(") {
+				g.printf("if n.%s != nil {\n", name)
+				g.printf("x.%s = %s\n", name, e)
+				g.printf("}\n")
+			} else {
+				g.printf("x.%s = %s\n", name, e)
+			}
+		}
+	}
+}
+
+func generate(pkg, srcPkg string, p *nodePackage) ([]byte, error) {
+	g := &generator{buf: &bytes.Buffer{}, srcPkg: srcPkg, pkg: p}
+
+	g.printf("package %s\n", pkg)
+	g.printf("// Code generated by genclone. DO NOT EDIT.\n\n")
+	g.printf("import (\n\"log\"\n\n\"github.com/akito0107/xsqlparser/%s\"\n)\n\n", srcPkg)
+
+	g.printf("func (c *cloner) clone(node %s.Node) %s.Node {\n", srcPkg, srcPkg)
+	g.printf("switch n := node.(type) {\n")
+	g.printf("case nil:\nreturn nil\n")
+	for _, name := range p.nodeNames {
+		g.printf("case *%s.%s:\nreturn c.clone%s(n)\n", srcPkg, name, name)
+	}
+	g.printf("}\n")
+	g.printf("log.Panicf(\"not implemented type %%T: %%+v\", node, node)\n")
+	g.printf("return nil\n")
+	g.printf("}\n\n")
+
+	for _, name := range p.nodeNames {
+		g.printf("func (c *cloner) clone%s(n *%s.%s) *%s.%s {\n", name, srcPkg, name, srcPkg, name)
+		g.printf("if n == nil {\nreturn nil\n}\n")
+		g.printf("x := *n\n")
+		if st, ok := p.structs[name]; ok {
+			g.fields(st)
+		}
+		g.printf("return &x\n")
+		g.printf("}\n\n")
+	}
+
+	return format.Source(g.buf.Bytes())
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genkind: ")
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var flags struct {
+		KindTypeName string
+		OutputName   string
+		Package      string
+	}
+
+	flag.StringVar(&flags.KindTypeName, "t", "NodeKind", "kind type name")
+	flag.StringVar(&flags.OutputName, "o", "node_kind_gen.go", "output filename")
+	flag.StringVar(&flags.Package, "pkg", os.Getenv("GOPACKAGE"), "package name")
+	flag.Parse()
+
+	names, err := nodeTypeNames(".", flags.OutputName)
+	if err != nil {
+		return err
+	}
+
+	prev, err := existingKinds(flags.OutputName, flags.KindTypeName)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(flags.Package, flags.KindTypeName, assignKinds(prev, names), names)
+	if err != nil {
+		return fmt.Errorf("failed to format source code: %s", err.Error())
+	}
+
+	err = ioutil.WriteFile(flags.OutputName, src, 0666)
+	if err != nil {
+		return fmt.Errorf("failed to write generate code: %s", err.Error())
+	}
+	return nil
+}
+
+// nodeTypeNames returns names of the types which have WriteTo method in dir.
+func nodeTypeNames(dir, outputName string) ([]string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputName
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %s", err.Error())
+	}
+
+	var names []string
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || fn.Name.Name != "WriteTo" {
+					continue
+				}
+				if name := receiverTypeName(fn.Recv.List[0].Type); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// existingKinds returns the values of the kinds declared in the output file generated before,
+// indexed by the node type names. It returns nil if the file doesn't exist.
+// Kinds are read both from explicit values and from the iota sequence of older generated files.
+func existingKinds(outputName, kindTypeName string) (map[string]int, error) {
+	if _, err := os.Stat(outputName); os.IsNotExist(err) {
+		return nil, nil
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), outputName, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %s", outputName, err.Error())
+	}
+
+	kinds := make(map[string]int)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for i, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			name := strings.TrimPrefix(vs.Names[0].Name, "Kind")
+			if name == "Invalid" {
+				continue
+			}
+			v := i
+			if len(vs.Values) > 0 {
+				if lit, ok := vs.Values[0].(*ast.BasicLit); ok && lit.Kind == token.INT {
+					if v, err = strconv.Atoi(lit.Value); err != nil {
+						return nil, fmt.Errorf("invalid value of Kind%s: %s", name, err.Error())
+					}
+				}
+			}
+			kinds[name] = v
+		}
+	}
+	return kinds, nil
+}
+
+// assignKinds returns the values of the kinds of names and prev.
+// Values of prev are kept as they are, including ones of removed node types,
+// and new names are appended after the largest value so that no value is ever reused.
+func assignKinds(prev map[string]int, names []string) map[string]int {
+	kinds := make(map[string]int, len(prev)+len(names))
+	last := 0
+	for n, v := range prev {
+		kinds[n] = v
+		if v > last {
+			last = v
+		}
+	}
+	for _, n := range names {
+		if _, ok := kinds[n]; !ok {
+			last++
+			kinds[n] = last
+		}
+	}
+	return kinds
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+		return ident.Name
+	}
+	return ""
+}
+
+// generate returns the source code of the kinds. Values of kinds are written explicitly in
+// ascending order, and kinds of removed node types are kept without Kind methods.
+func generate(pkg, kindTypeName string, kinds map[string]int, names []string) ([]byte, error) {
+	ordered := make([]string, 0, len(kinds))
+	for n := range kinds {
+		ordered = append(ordered, n)
+	}
+	sort.Slice(ordered, func(i, j int) bool { return kinds[ordered[i]] < kinds[ordered[j]] })
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "package %s\n", pkg)
+	fmt.Fprintf(buf, "// Code generated by genkind. DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "import \"strconv\"\n\n")
+
+	fmt.Fprintf(buf, "// Values of kinds are append-only. They are never renumbered nor reused.\n")
+	fmt.Fprintf(buf, "const (\n")
+	fmt.Fprintf(buf, "KindInvalid %s = 0\n", kindTypeName)
+	for _, n := range ordered {
+		fmt.Fprintf(buf, "Kind%s %s = %d\n", n, kindTypeName, kinds[n])
+	}
+	fmt.Fprintf(buf, ")\n\n")
+
+	fmt.Fprintf(buf, "var %sNames = [...]string{\n", toPrivate(kindTypeName))
+	fmt.Fprintf(buf, "KindInvalid: \"Invalid\",\n")
+	for _, n := range ordered {
+		fmt.Fprintf(buf, "Kind%s: %q,\n", n, n)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "func (k %s) String() string {\n", kindTypeName)
+	fmt.Fprintf(buf, "if k < 0 || int(k) >= len(%sNames) {\n", toPrivate(kindTypeName))
+	fmt.Fprintf(buf, "return \"%s(\" + strconv.Itoa(int(k)) + \")\"\n", kindTypeName)
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "return %sNames[k]\n", toPrivate(kindTypeName))
+	fmt.Fprintf(buf, "}\n\n")
+
+	for _, n := range names {
+		fmt.Fprintf(buf, "func (*%s) Kind() %s { return Kind%s }\n", n, kindTypeName, n)
+	}
+	fmt.Fprintf(buf, "\n")
+
+	fmt.Fprintf(buf, "// New returns a new zero value node of k. It returns nil if k is not a valid kind.\n")
+	fmt.Fprintf(buf, "func (k %s) New() Node {\n", kindTypeName)
+	fmt.Fprintf(buf, "switch k {\n")
+	for _, n := range names {
+		fmt.Fprintf(buf, "case Kind%s:\nreturn &%s{}\n", n, n)
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "return nil\n")
+	fmt.Fprintf(buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+func toPrivate(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExistingKinds(t *testing.T) {
+	cases := []struct {
+		name     string
+		src      string
+		expected map[string]int
+	}{
+		{
+			name: "iota",
+			src: `package p
+
+const (
+	KindInvalid NodeKind = iota
+	KindA
+	KindB
+)
+`,
+			expected: map[string]int{"A": 1, "B": 2},
+		},
+		{
+			name: "explicit",
+			src: `package p
+
+const (
+	KindInvalid NodeKind = 0
+	KindB NodeKind = 2
+	KindA NodeKind = 3
+)
+`,
+			expected: map[string]int{"B": 2, "A": 3},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "genkind")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "node_kind_gen.go")
+			if err := ioutil.WriteFile(path, []byte(c.src), 0666); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := existingKinds(path, "NodeKind")
+			if err != nil {
+				t.Fatalf("%+v", err)
+			}
+			if diff := cmp.Diff(c.expected, got); diff != "" {
+				t.Errorf("unexpected kinds. diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAssignKinds(t *testing.T) {
+	// B is removed and C is added
+	prev := map[string]int{"A": 1, "B": 2}
+	got := assignKinds(prev, []string{"A", "C"})
+	expected := map[string]int{"A": 1, "B": 2, "C": 3}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("unexpected kinds. diff: %s", diff)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genvisitor: ")
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	var flags struct {
+		VisitorTypeName string
+		OutputName      string
+		Package         string
+	}
+
+	flag.StringVar(&flags.VisitorTypeName, "t", "TypedVisitor", "visitor interface name")
+	flag.StringVar(&flags.OutputName, "o", "visitor_gen.go", "output filename")
+	flag.StringVar(&flags.Package, "pkg", os.Getenv("GOPACKAGE"), "package name")
+	flag.Parse()
+
+	names, err := nodeTypeNames(".", flags.OutputName)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(flags.Package, flags.VisitorTypeName, names)
+	if err != nil {
+		return fmt.Errorf("failed to format source code: %s", err.Error())
+	}
+
+	err = ioutil.WriteFile(flags.OutputName, src, 0666)
+	if err != nil {
+		return fmt.Errorf("failed to write generate code: %s", err.Error())
+	}
+	return nil
+}
+
+// nodeTypeNames returns names of the types which have WriteTo method in dir.
+func nodeTypeNames(dir, outputName string) ([]string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputName
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package: %s", err.Error())
+	}
+
+	var names []string
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || fn.Recv == nil || fn.Name.Name != "WriteTo" {
+					continue
+				}
+				if name := receiverTypeName(fn.Recv.List[0].Type); name != "" {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && ast.IsExported(ident.Name) {
+		return ident.Name
+	}
+	return ""
+}
+
+func generate(pkg, visitorTypeName string, names []string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "package %s\n", pkg)
+	fmt.Fprintf(buf, "// Code generated by genvisitor. DO NOT EDIT.\n\n")
+
+	fmt.Fprintf(buf, "// %s has a Visit method for each node type.\n", visitorTypeName)
+	fmt.Fprintf(buf, "// If a method returns false, the children of the node are not visited.\n")
+	fmt.Fprintf(buf, "type %s interface {\n", visitorTypeName)
+	for _, n := range names {
+		fmt.Fprintf(buf, "Visit%s(node *%s) bool\n", n, n)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// BaseVisitor implements %s with methods which do nothing and return true.\n", visitorTypeName)
+	fmt.Fprintf(buf, "// Embed it and override the methods for the nodes of interest.\n")
+	fmt.Fprintf(buf, "type BaseVisitor struct{}\n\n")
+	fmt.Fprintf(buf, "var _ %s = BaseVisitor{}\n\n", visitorTypeName)
+	for _, n := range names {
+		fmt.Fprintf(buf, "func (BaseVisitor) Visit%s(*%s) bool { return true }\n", n, n)
+	}
+	fmt.Fprintf(buf, "\n")
+
+	fmt.Fprintf(buf, "func dispatch%s(v %s, node Node) bool {\n", visitorTypeName, visitorTypeName)
+	fmt.Fprintf(buf, "switch n := node.(type) {\n")
+	for _, n := range names {
+		fmt.Fprintf(buf, "case *%s:\n", n)
+		fmt.Fprintf(buf, "return v.Visit%s(n)\n", n)
+	}
+	fmt.Fprintf(buf, "}\n")
+	fmt.Fprintf(buf, "return true\n")
+	fmt.Fprintf(buf, "}\n")
+
+	return format.Source(buf.Bytes())
+}